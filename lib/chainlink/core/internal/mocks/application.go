@@ -9,6 +9,8 @@ import (
 
 	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
 
+	ccip "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+
 	chainlink "github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 
 	context "context"
@@ -497,6 +499,53 @@ func (_c *Application_GetAuditLogger_Call) RunAndReturn(run func() audit.AuditLo
 	return _c
 }
 
+// GetCCIPPriceServices provides a mock function with no fields
+func (_m *Application) GetCCIPPriceServices() *ccip.PriceServiceRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCCIPPriceServices")
+	}
+
+	var r0 *ccip.PriceServiceRegistry
+	if rf, ok := ret.Get(0).(func() *ccip.PriceServiceRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ccip.PriceServiceRegistry)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetCCIPPriceServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCCIPPriceServices'
+type Application_GetCCIPPriceServices_Call struct {
+	*mock.Call
+}
+
+// GetCCIPPriceServices is a helper method to define mock.On call
+func (_e *Application_Expecter) GetCCIPPriceServices() *Application_GetCCIPPriceServices_Call {
+	return &Application_GetCCIPPriceServices_Call{Call: _e.mock.On("GetCCIPPriceServices")}
+}
+
+func (_c *Application_GetCCIPPriceServices_Call) Run(run func()) *Application_GetCCIPPriceServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetCCIPPriceServices_Call) Return(_a0 *ccip.PriceServiceRegistry) *Application_GetCCIPPriceServices_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetCCIPPriceServices_Call) RunAndReturn(run func() *ccip.PriceServiceRegistry) *Application_GetCCIPPriceServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConfig provides a mock function with no fields
 func (_m *Application) GetConfig() chainlink.GeneralConfig {
 	ret := _m.Called()
@@ -25,29 +25,557 @@ func (_m *ORM) EXPECT() *ORM_Expecter {
 	return &ORM_Expecter{mock: &_m.Mock}
 }
 
+// CleanupStalePrices provides a mock function with given fields: ctx, destChainSelector, retention
+func (_m *ORM) CleanupStalePrices(ctx context.Context, destChainSelector uint64, retention time.Duration) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupStalePrices")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Duration) (int64, error)); ok {
+		return rf(ctx, destChainSelector, retention)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Duration) int64); ok {
+		r0 = rf(ctx, destChainSelector, retention)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Duration) error); ok {
+		r1 = rf(ctx, destChainSelector, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_CleanupStalePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupStalePrices'
+type ORM_CleanupStalePrices_Call struct {
+	*mock.Call
+}
+
+// CleanupStalePrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - retention time.Duration
+func (_e *ORM_Expecter) CleanupStalePrices(ctx interface{}, destChainSelector interface{}, retention interface{}) *ORM_CleanupStalePrices_Call {
+	return &ORM_CleanupStalePrices_Call{Call: _e.mock.On("CleanupStalePrices", ctx, destChainSelector, retention)}
+}
+
+func (_c *ORM_CleanupStalePrices_Call) Run(run func(ctx context.Context, destChainSelector uint64, retention time.Duration)) *ORM_CleanupStalePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ORM_CleanupStalePrices_Call) Return(_a0 int64, _a1 error) *ORM_CleanupStalePrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_CleanupStalePrices_Call) RunAndReturn(run func(context.Context, uint64, time.Duration) (int64, error)) *ORM_CleanupStalePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePricesByJobID provides a mock function with given fields: ctx, jobID
+func (_m *ORM) DeletePricesByJobID(ctx context.Context, jobID int32) (int64, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePricesByJobID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (int64, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) int64); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_DeletePricesByJobID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePricesByJobID'
+type ORM_DeletePricesByJobID_Call struct {
+	*mock.Call
+}
+
+// DeletePricesByJobID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) DeletePricesByJobID(ctx interface{}, jobID interface{}) *ORM_DeletePricesByJobID_Call {
+	return &ORM_DeletePricesByJobID_Call{Call: _e.mock.On("DeletePricesByJobID", ctx, jobID)}
+}
+
+func (_c *ORM_DeletePricesByJobID_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_DeletePricesByJobID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_DeletePricesByJobID_Call) Return(_a0 int64, _a1 error) *ORM_DeletePricesByJobID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_DeletePricesByJobID_Call) RunAndReturn(run func(context.Context, int32) (int64, error)) *ORM_DeletePricesByJobID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTokenMetadata provides a mock function with given fields: ctx, destChainSelector, tokenAddrs
+func (_m *ORM) DeleteTokenMetadata(ctx context.Context, destChainSelector uint64, tokenAddrs []string) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector, tokenAddrs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTokenMetadata")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []string) (int64, error)); ok {
+		return rf(ctx, destChainSelector, tokenAddrs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []string) int64); ok {
+		r0 = rf(ctx, destChainSelector, tokenAddrs)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []string) error); ok {
+		r1 = rf(ctx, destChainSelector, tokenAddrs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_DeleteTokenMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTokenMetadata'
+type ORM_DeleteTokenMetadata_Call struct {
+	*mock.Call
+}
+
+// DeleteTokenMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - tokenAddrs []string
+func (_e *ORM_Expecter) DeleteTokenMetadata(ctx interface{}, destChainSelector interface{}, tokenAddrs interface{}) *ORM_DeleteTokenMetadata_Call {
+	return &ORM_DeleteTokenMetadata_Call{Call: _e.mock.On("DeleteTokenMetadata", ctx, destChainSelector, tokenAddrs)}
+}
+
+func (_c *ORM_DeleteTokenMetadata_Call) Run(run func(ctx context.Context, destChainSelector uint64, tokenAddrs []string)) *ORM_DeleteTokenMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteTokenMetadata_Call) Return(_a0 int64, _a1 error) *ORM_DeleteTokenMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_DeleteTokenMetadata_Call) RunAndReturn(run func(context.Context, uint64, []string) (int64, error)) *ORM_DeleteTokenMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGasPriceHistory provides a mock function with given fields: ctx, destChainSelector, sourceChainSelector, from, to
+func (_m *ORM) GetGasPriceHistory(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from time.Time, to time.Time) ([]ccip.GasPrice, error) {
+	ret := _m.Called(ctx, destChainSelector, sourceChainSelector, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGasPriceHistory")
+	}
+
+	var r0 []ccip.GasPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time, time.Time) ([]ccip.GasPrice, error)); ok {
+		return rf(ctx, destChainSelector, sourceChainSelector, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time, time.Time) []ccip.GasPrice); ok {
+		r0 = rf(ctx, destChainSelector, sourceChainSelector, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.GasPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, destChainSelector, sourceChainSelector, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetGasPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGasPriceHistory'
+type ORM_GetGasPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetGasPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - sourceChainSelector uint64
+//   - from time.Time
+//   - to time.Time
+func (_e *ORM_Expecter) GetGasPriceHistory(ctx interface{}, destChainSelector interface{}, sourceChainSelector interface{}, from interface{}, to interface{}) *ORM_GetGasPriceHistory_Call {
+	return &ORM_GetGasPriceHistory_Call{Call: _e.mock.On("GetGasPriceHistory", ctx, destChainSelector, sourceChainSelector, from, to)}
+}
+
+func (_c *ORM_GetGasPriceHistory_Call) Run(run func(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from time.Time, to time.Time)) *ORM_GetGasPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ORM_GetGasPriceHistory_Call) Return(_a0 []ccip.GasPrice, _a1 error) *ORM_GetGasPriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetGasPriceHistory_Call) RunAndReturn(run func(context.Context, uint64, uint64, time.Time, time.Time) ([]ccip.GasPrice, error)) *ORM_GetGasPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGasPricesByDestChain provides a mock function with given fields: ctx, destChainSelector
 func (_m *ORM) GetGasPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]ccip.GasPrice, error) {
 	ret := _m.Called(ctx, destChainSelector)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGasPricesByDestChain")
+		panic("no return value specified for GetGasPricesByDestChain")
+	}
+
+	var r0 []ccip.GasPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]ccip.GasPrice, error)); ok {
+		return rf(ctx, destChainSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []ccip.GasPrice); ok {
+		r0 = rf(ctx, destChainSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.GasPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, destChainSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetGasPricesByDestChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGasPricesByDestChain'
+type ORM_GetGasPricesByDestChain_Call struct {
+	*mock.Call
+}
+
+// GetGasPricesByDestChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+func (_e *ORM_Expecter) GetGasPricesByDestChain(ctx interface{}, destChainSelector interface{}) *ORM_GetGasPricesByDestChain_Call {
+	return &ORM_GetGasPricesByDestChain_Call{Call: _e.mock.On("GetGasPricesByDestChain", ctx, destChainSelector)}
+}
+
+func (_c *ORM_GetGasPricesByDestChain_Call) Run(run func(ctx context.Context, destChainSelector uint64)) *ORM_GetGasPricesByDestChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *ORM_GetGasPricesByDestChain_Call) Return(_a0 []ccip.GasPrice, _a1 error) *ORM_GetGasPricesByDestChain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetGasPricesByDestChain_Call) RunAndReturn(run func(context.Context, uint64) ([]ccip.GasPrice, error)) *ORM_GetGasPricesByDestChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGasPricesByJobID provides a mock function with given fields: ctx, jobID
+func (_m *ORM) GetGasPricesByJobID(ctx context.Context, jobID int32) ([]ccip.GasPrice, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGasPricesByJobID")
+	}
+
+	var r0 []ccip.GasPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]ccip.GasPrice, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []ccip.GasPrice); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.GasPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetGasPricesByJobID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGasPricesByJobID'
+type ORM_GetGasPricesByJobID_Call struct {
+	*mock.Call
+}
+
+// GetGasPricesByJobID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) GetGasPricesByJobID(ctx interface{}, jobID interface{}) *ORM_GetGasPricesByJobID_Call {
+	return &ORM_GetGasPricesByJobID_Call{Call: _e.mock.On("GetGasPricesByJobID", ctx, jobID)}
+}
+
+func (_c *ORM_GetGasPricesByJobID_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_GetGasPricesByJobID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_GetGasPricesByJobID_Call) Return(_a0 []ccip.GasPrice, _a1 error) *ORM_GetGasPricesByJobID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetGasPricesByJobID_Call) RunAndReturn(run func(context.Context, int32) ([]ccip.GasPrice, error)) *ORM_GetGasPricesByJobID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceVersion provides a mock function with given fields: ctx, destChainSelector
+func (_m *ORM) GetPriceVersion(ctx context.Context, destChainSelector uint64) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceVersion")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (int64, error)); ok {
+		return rf(ctx, destChainSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) int64); ok {
+		r0 = rf(ctx, destChainSelector)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, destChainSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetPriceVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceVersion'
+type ORM_GetPriceVersion_Call struct {
+	*mock.Call
+}
+
+// GetPriceVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+func (_e *ORM_Expecter) GetPriceVersion(ctx interface{}, destChainSelector interface{}) *ORM_GetPriceVersion_Call {
+	return &ORM_GetPriceVersion_Call{Call: _e.mock.On("GetPriceVersion", ctx, destChainSelector)}
+}
+
+func (_c *ORM_GetPriceVersion_Call) Run(run func(ctx context.Context, destChainSelector uint64)) *ORM_GetPriceVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *ORM_GetPriceVersion_Call) Return(_a0 int64, _a1 error) *ORM_GetPriceVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetPriceVersion_Call) RunAndReturn(run func(context.Context, uint64) (int64, error)) *ORM_GetPriceVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPricesByDestChain provides a mock function with given fields: ctx, destChainSelector
+func (_m *ORM) GetPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]ccip.Price, error) {
+	ret := _m.Called(ctx, destChainSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPricesByDestChain")
+	}
+
+	var r0 []ccip.Price
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]ccip.Price, error)); ok {
+		return rf(ctx, destChainSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []ccip.Price); ok {
+		r0 = rf(ctx, destChainSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.Price)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, destChainSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetPricesByDestChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPricesByDestChain'
+type ORM_GetPricesByDestChain_Call struct {
+	*mock.Call
+}
+
+// GetPricesByDestChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+func (_e *ORM_Expecter) GetPricesByDestChain(ctx interface{}, destChainSelector interface{}) *ORM_GetPricesByDestChain_Call {
+	return &ORM_GetPricesByDestChain_Call{Call: _e.mock.On("GetPricesByDestChain", ctx, destChainSelector)}
+}
+
+func (_c *ORM_GetPricesByDestChain_Call) Run(run func(ctx context.Context, destChainSelector uint64)) *ORM_GetPricesByDestChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *ORM_GetPricesByDestChain_Call) Return(_a0 []ccip.Price, _a1 error) *ORM_GetPricesByDestChain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetPricesByDestChain_Call) RunAndReturn(run func(context.Context, uint64) ([]ccip.Price, error)) *ORM_GetPricesByDestChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokenMetadata provides a mock function with given fields: ctx, destChainSelector
+func (_m *ORM) GetTokenMetadata(ctx context.Context, destChainSelector uint64) ([]ccip.TokenMetadata, error) {
+	ret := _m.Called(ctx, destChainSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenMetadata")
+	}
+
+	var r0 []ccip.TokenMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]ccip.TokenMetadata, error)); ok {
+		return rf(ctx, destChainSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []ccip.TokenMetadata); ok {
+		r0 = rf(ctx, destChainSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.TokenMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, destChainSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetTokenMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenMetadata'
+type ORM_GetTokenMetadata_Call struct {
+	*mock.Call
+}
+
+// GetTokenMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+func (_e *ORM_Expecter) GetTokenMetadata(ctx interface{}, destChainSelector interface{}) *ORM_GetTokenMetadata_Call {
+	return &ORM_GetTokenMetadata_Call{Call: _e.mock.On("GetTokenMetadata", ctx, destChainSelector)}
+}
+
+func (_c *ORM_GetTokenMetadata_Call) Run(run func(ctx context.Context, destChainSelector uint64)) *ORM_GetTokenMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *ORM_GetTokenMetadata_Call) Return(_a0 []ccip.TokenMetadata, _a1 error) *ORM_GetTokenMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetTokenMetadata_Call) RunAndReturn(run func(context.Context, uint64) ([]ccip.TokenMetadata, error)) *ORM_GetTokenMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokenPriceHistory provides a mock function with given fields: ctx, destChainSelector, tokenAddr, from, to
+func (_m *ORM) GetTokenPriceHistory(ctx context.Context, destChainSelector uint64, tokenAddr string, from time.Time, to time.Time) ([]ccip.TokenPrice, error) {
+	ret := _m.Called(ctx, destChainSelector, tokenAddr, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenPriceHistory")
 	}
 
-	var r0 []ccip.GasPrice
+	var r0 []ccip.TokenPrice
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]ccip.GasPrice, error)); ok {
-		return rf(ctx, destChainSelector)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, time.Time, time.Time) ([]ccip.TokenPrice, error)); ok {
+		return rf(ctx, destChainSelector, tokenAddr, from, to)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) []ccip.GasPrice); ok {
-		r0 = rf(ctx, destChainSelector)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, time.Time, time.Time) []ccip.TokenPrice); ok {
+		r0 = rf(ctx, destChainSelector, tokenAddr, from, to)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]ccip.GasPrice)
+			r0 = ret.Get(0).([]ccip.TokenPrice)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, destChainSelector)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, destChainSelector, tokenAddr, from, to)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -55,31 +583,34 @@ func (_m *ORM) GetGasPricesByDestChain(ctx context.Context, destChainSelector ui
 	return r0, r1
 }
 
-// ORM_GetGasPricesByDestChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGasPricesByDestChain'
-type ORM_GetGasPricesByDestChain_Call struct {
+// ORM_GetTokenPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenPriceHistory'
+type ORM_GetTokenPriceHistory_Call struct {
 	*mock.Call
 }
 
-// GetGasPricesByDestChain is a helper method to define mock.On call
+// GetTokenPriceHistory is a helper method to define mock.On call
 //   - ctx context.Context
 //   - destChainSelector uint64
-func (_e *ORM_Expecter) GetGasPricesByDestChain(ctx interface{}, destChainSelector interface{}) *ORM_GetGasPricesByDestChain_Call {
-	return &ORM_GetGasPricesByDestChain_Call{Call: _e.mock.On("GetGasPricesByDestChain", ctx, destChainSelector)}
+//   - tokenAddr string
+//   - from time.Time
+//   - to time.Time
+func (_e *ORM_Expecter) GetTokenPriceHistory(ctx interface{}, destChainSelector interface{}, tokenAddr interface{}, from interface{}, to interface{}) *ORM_GetTokenPriceHistory_Call {
+	return &ORM_GetTokenPriceHistory_Call{Call: _e.mock.On("GetTokenPriceHistory", ctx, destChainSelector, tokenAddr, from, to)}
 }
 
-func (_c *ORM_GetGasPricesByDestChain_Call) Run(run func(ctx context.Context, destChainSelector uint64)) *ORM_GetGasPricesByDestChain_Call {
+func (_c *ORM_GetTokenPriceHistory_Call) Run(run func(ctx context.Context, destChainSelector uint64, tokenAddr string, from time.Time, to time.Time)) *ORM_GetTokenPriceHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(time.Time), args[4].(time.Time))
 	})
 	return _c
 }
 
-func (_c *ORM_GetGasPricesByDestChain_Call) Return(_a0 []ccip.GasPrice, _a1 error) *ORM_GetGasPricesByDestChain_Call {
+func (_c *ORM_GetTokenPriceHistory_Call) Return(_a0 []ccip.TokenPrice, _a1 error) *ORM_GetTokenPriceHistory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ORM_GetGasPricesByDestChain_Call) RunAndReturn(run func(context.Context, uint64) ([]ccip.GasPrice, error)) *ORM_GetGasPricesByDestChain_Call {
+func (_c *ORM_GetTokenPriceHistory_Call) RunAndReturn(run func(context.Context, uint64, string, time.Time, time.Time) ([]ccip.TokenPrice, error)) *ORM_GetTokenPriceHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -143,6 +674,124 @@ func (_c *ORM_GetTokenPricesByDestChain_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetTokenPricesByJobID provides a mock function with given fields: ctx, jobID
+func (_m *ORM) GetTokenPricesByJobID(ctx context.Context, jobID int32) ([]ccip.TokenPrice, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenPricesByJobID")
+	}
+
+	var r0 []ccip.TokenPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]ccip.TokenPrice, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []ccip.TokenPrice); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.TokenPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetTokenPricesByJobID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenPricesByJobID'
+type ORM_GetTokenPricesByJobID_Call struct {
+	*mock.Call
+}
+
+// GetTokenPricesByJobID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) GetTokenPricesByJobID(ctx interface{}, jobID interface{}) *ORM_GetTokenPricesByJobID_Call {
+	return &ORM_GetTokenPricesByJobID_Call{Call: _e.mock.On("GetTokenPricesByJobID", ctx, jobID)}
+}
+
+func (_c *ORM_GetTokenPricesByJobID_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_GetTokenPricesByJobID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_GetTokenPricesByJobID_Call) Return(_a0 []ccip.TokenPrice, _a1 error) *ORM_GetTokenPricesByJobID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetTokenPricesByJobID_Call) RunAndReturn(run func(context.Context, int32) ([]ccip.TokenPrice, error)) *ORM_GetTokenPricesByJobID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribePriceUpdates provides a mock function with given fields: ctx, destChainSelector
+func (_m *ORM) SubscribePriceUpdates(ctx context.Context, destChainSelector uint64) (<-chan struct{}, error) {
+	ret := _m.Called(ctx, destChainSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePriceUpdates")
+	}
+
+	var r0 <-chan struct{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (<-chan struct{}, error)); ok {
+		return rf(ctx, destChainSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) <-chan struct{}); ok {
+		r0 = rf(ctx, destChainSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, destChainSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_SubscribePriceUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribePriceUpdates'
+type ORM_SubscribePriceUpdates_Call struct {
+	*mock.Call
+}
+
+// SubscribePriceUpdates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+func (_e *ORM_Expecter) SubscribePriceUpdates(ctx interface{}, destChainSelector interface{}) *ORM_SubscribePriceUpdates_Call {
+	return &ORM_SubscribePriceUpdates_Call{Call: _e.mock.On("SubscribePriceUpdates", ctx, destChainSelector)}
+}
+
+func (_c *ORM_SubscribePriceUpdates_Call) Run(run func(ctx context.Context, destChainSelector uint64)) *ORM_SubscribePriceUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *ORM_SubscribePriceUpdates_Call) Return(_a0 <-chan struct{}, _a1 error) *ORM_SubscribePriceUpdates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_SubscribePriceUpdates_Call) RunAndReturn(run func(context.Context, uint64) (<-chan struct{}, error)) *ORM_SubscribePriceUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertGasPricesForDestChain provides a mock function with given fields: ctx, destChainSelector, gasPrices
 func (_m *ORM) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []ccip.GasPrice) (int64, error) {
 	ret := _m.Called(ctx, destChainSelector, gasPrices)
@@ -201,6 +850,181 @@ func (_c *ORM_UpsertGasPricesForDestChain_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UpsertGasPricesForDestChains provides a mock function with given fields: ctx, updates
+func (_m *ORM) UpsertGasPricesForDestChains(ctx context.Context, updates []ccip.GasPricesUpdate) ([]int64, error) {
+	ret := _m.Called(ctx, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertGasPricesForDestChains")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ccip.GasPricesUpdate) ([]int64, error)); ok {
+		return rf(ctx, updates)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []ccip.GasPricesUpdate) []int64); ok {
+		r0 = rf(ctx, updates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []ccip.GasPricesUpdate) error); ok {
+		r1 = rf(ctx, updates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_UpsertGasPricesForDestChains_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertGasPricesForDestChains'
+type ORM_UpsertGasPricesForDestChains_Call struct {
+	*mock.Call
+}
+
+// UpsertGasPricesForDestChains is a helper method to define mock.On call
+//   - ctx context.Context
+//   - updates []ccip.GasPricesUpdate
+func (_e *ORM_Expecter) UpsertGasPricesForDestChains(ctx interface{}, updates interface{}) *ORM_UpsertGasPricesForDestChains_Call {
+	return &ORM_UpsertGasPricesForDestChains_Call{Call: _e.mock.On("UpsertGasPricesForDestChains", ctx, updates)}
+}
+
+func (_c *ORM_UpsertGasPricesForDestChains_Call) Run(run func(ctx context.Context, updates []ccip.GasPricesUpdate)) *ORM_UpsertGasPricesForDestChains_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ccip.GasPricesUpdate))
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertGasPricesForDestChains_Call) Return(_a0 []int64, _a1 error) *ORM_UpsertGasPricesForDestChains_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_UpsertGasPricesForDestChains_Call) RunAndReturn(run func(context.Context, []ccip.GasPricesUpdate) ([]int64, error)) *ORM_UpsertGasPricesForDestChains_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertPrices provides a mock function with given fields: ctx, destChainSelector, prices
+func (_m *ORM) UpsertPrices(ctx context.Context, destChainSelector uint64, prices []ccip.Price) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector, prices)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertPrices")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []ccip.Price) (int64, error)); ok {
+		return rf(ctx, destChainSelector, prices)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []ccip.Price) int64); ok {
+		r0 = rf(ctx, destChainSelector, prices)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []ccip.Price) error); ok {
+		r1 = rf(ctx, destChainSelector, prices)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_UpsertPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPrices'
+type ORM_UpsertPrices_Call struct {
+	*mock.Call
+}
+
+// UpsertPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - prices []ccip.Price
+func (_e *ORM_Expecter) UpsertPrices(ctx interface{}, destChainSelector interface{}, prices interface{}) *ORM_UpsertPrices_Call {
+	return &ORM_UpsertPrices_Call{Call: _e.mock.On("UpsertPrices", ctx, destChainSelector, prices)}
+}
+
+func (_c *ORM_UpsertPrices_Call) Run(run func(ctx context.Context, destChainSelector uint64, prices []ccip.Price)) *ORM_UpsertPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]ccip.Price))
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertPrices_Call) Return(_a0 int64, _a1 error) *ORM_UpsertPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_UpsertPrices_Call) RunAndReturn(run func(context.Context, uint64, []ccip.Price) (int64, error)) *ORM_UpsertPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertTokenMetadata provides a mock function with given fields: ctx, destChainSelector, metadata
+func (_m *ORM) UpsertTokenMetadata(ctx context.Context, destChainSelector uint64, metadata []ccip.TokenMetadata) (int64, error) {
+	ret := _m.Called(ctx, destChainSelector, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertTokenMetadata")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []ccip.TokenMetadata) (int64, error)); ok {
+		return rf(ctx, destChainSelector, metadata)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []ccip.TokenMetadata) int64); ok {
+		r0 = rf(ctx, destChainSelector, metadata)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []ccip.TokenMetadata) error); ok {
+		r1 = rf(ctx, destChainSelector, metadata)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_UpsertTokenMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertTokenMetadata'
+type ORM_UpsertTokenMetadata_Call struct {
+	*mock.Call
+}
+
+// UpsertTokenMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - metadata []ccip.TokenMetadata
+func (_e *ORM_Expecter) UpsertTokenMetadata(ctx interface{}, destChainSelector interface{}, metadata interface{}) *ORM_UpsertTokenMetadata_Call {
+	return &ORM_UpsertTokenMetadata_Call{Call: _e.mock.On("UpsertTokenMetadata", ctx, destChainSelector, metadata)}
+}
+
+func (_c *ORM_UpsertTokenMetadata_Call) Run(run func(ctx context.Context, destChainSelector uint64, metadata []ccip.TokenMetadata)) *ORM_UpsertTokenMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]ccip.TokenMetadata))
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertTokenMetadata_Call) Return(_a0 int64, _a1 error) *ORM_UpsertTokenMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_UpsertTokenMetadata_Call) RunAndReturn(run func(context.Context, uint64, []ccip.TokenMetadata) (int64, error)) *ORM_UpsertTokenMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertTokenPricesForDestChain provides a mock function with given fields: ctx, destChainSelector, tokenPrices, interval
 func (_m *ORM) UpsertTokenPricesForDestChain(ctx context.Context, destChainSelector uint64, tokenPrices []ccip.TokenPrice, interval time.Duration) (int64, error) {
 	ret := _m.Called(ctx, destChainSelector, tokenPrices, interval)
@@ -260,6 +1084,65 @@ func (_c *ORM_UpsertTokenPricesForDestChain_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// UpsertTokenPricesForDestChains provides a mock function with given fields: ctx, updates
+func (_m *ORM) UpsertTokenPricesForDestChains(ctx context.Context, updates []ccip.TokenPricesUpdate) ([]int64, error) {
+	ret := _m.Called(ctx, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertTokenPricesForDestChains")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ccip.TokenPricesUpdate) ([]int64, error)); ok {
+		return rf(ctx, updates)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []ccip.TokenPricesUpdate) []int64); ok {
+		r0 = rf(ctx, updates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []ccip.TokenPricesUpdate) error); ok {
+		r1 = rf(ctx, updates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_UpsertTokenPricesForDestChains_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertTokenPricesForDestChains'
+type ORM_UpsertTokenPricesForDestChains_Call struct {
+	*mock.Call
+}
+
+// UpsertTokenPricesForDestChains is a helper method to define mock.On call
+//   - ctx context.Context
+//   - updates []ccip.TokenPricesUpdate
+func (_e *ORM_Expecter) UpsertTokenPricesForDestChains(ctx interface{}, updates interface{}) *ORM_UpsertTokenPricesForDestChains_Call {
+	return &ORM_UpsertTokenPricesForDestChains_Call{Call: _e.mock.On("UpsertTokenPricesForDestChains", ctx, updates)}
+}
+
+func (_c *ORM_UpsertTokenPricesForDestChains_Call) Run(run func(ctx context.Context, updates []ccip.TokenPricesUpdate)) *ORM_UpsertTokenPricesForDestChains_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ccip.TokenPricesUpdate))
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertTokenPricesForDestChains_Call) Return(_a0 []int64, _a1 error) *ORM_UpsertTokenPricesForDestChains_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_UpsertTokenPricesForDestChains_Call) RunAndReturn(run func(context.Context, []ccip.TokenPricesUpdate) ([]int64, error)) *ORM_UpsertTokenPricesForDestChains_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewORM(t interface {
@@ -48,7 +48,12 @@ type observedORM struct {
 var _ ORM = (*observedORM)(nil)
 
 func NewObservedORM(ds sqlutil.DataSource, lggr logger.Logger) (*observedORM, error) {
-	delegate, err := NewORM(ds, lggr)
+	return NewObservedORMWithReadReplica(ds, ds, lggr)
+}
+
+// NewObservedORMWithReadReplica is the observed counterpart of NewORMWithReadReplica.
+func NewObservedORMWithReadReplica(ds sqlutil.DataSource, readReplica sqlutil.DataSource, lggr logger.Logger) (*observedORM, error) {
+	delegate, err := NewORMWithReadReplica(ds, readReplica, lggr)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,12 @@ func (o *observedORM) GetTokenPricesByDestChain(ctx context.Context, destChainSe
 	})
 }
 
+func (o *observedORM) GetPriceVersion(ctx context.Context, destChainSelector uint64) (int64, error) {
+	return withObservedQuery(o, "GetPriceVersion", destChainSelector, func() (int64, error) {
+		return o.ORM.GetPriceVersion(ctx, destChainSelector)
+	})
+}
+
 func (o *observedORM) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []GasPrice) (int64, error) {
 	return withObservedQueryAndRowsAffected(o, "UpsertGasPricesForDestChain", destChainSelector, func() (int64, error) {
 		return o.ORM.UpsertGasPricesForDestChain(ctx, destChainSelector, gasPrices)
@@ -84,6 +95,74 @@ func (o *observedORM) UpsertTokenPricesForDestChain(ctx context.Context, destCha
 	})
 }
 
+func (o *observedORM) GetGasPriceHistory(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from, to time.Time) ([]GasPrice, error) {
+	return withObservedQueryAndResults(o, "GetGasPriceHistory", destChainSelector, func() ([]GasPrice, error) {
+		return o.ORM.GetGasPriceHistory(ctx, destChainSelector, sourceChainSelector, from, to)
+	})
+}
+
+func (o *observedORM) GetTokenPriceHistory(ctx context.Context, destChainSelector uint64, tokenAddr string, from, to time.Time) ([]TokenPrice, error) {
+	return withObservedQueryAndResults(o, "GetTokenPriceHistory", destChainSelector, func() ([]TokenPrice, error) {
+		return o.ORM.GetTokenPriceHistory(ctx, destChainSelector, tokenAddr, from, to)
+	})
+}
+
+func (o *observedORM) CleanupStalePrices(ctx context.Context, destChainSelector uint64, retention time.Duration) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "CleanupStalePrices", destChainSelector, func() (int64, error) {
+		return o.ORM.CleanupStalePrices(ctx, destChainSelector, retention)
+	})
+}
+
+func (o *observedORM) GetPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]Price, error) {
+	return withObservedQueryAndResults(o, "GetPricesByDestChain", destChainSelector, func() ([]Price, error) {
+		return o.ORM.GetPricesByDestChain(ctx, destChainSelector)
+	})
+}
+
+func (o *observedORM) UpsertPrices(ctx context.Context, destChainSelector uint64, prices []Price) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "UpsertPrices", destChainSelector, func() (int64, error) {
+		return o.ORM.UpsertPrices(ctx, destChainSelector, prices)
+	})
+}
+
+func (o *observedORM) UpsertGasPricesForDestChains(ctx context.Context, updates []GasPricesUpdate) ([]int64, error) {
+	destChainSelectors := make([]uint64, len(updates))
+	for i, update := range updates {
+		destChainSelectors[i] = update.DestChainSelector
+	}
+	return withObservedBatchQuery(o, "UpsertGasPricesForDestChains", destChainSelectors, func() ([]int64, error) {
+		return o.ORM.UpsertGasPricesForDestChains(ctx, updates)
+	})
+}
+
+func (o *observedORM) UpsertTokenPricesForDestChains(ctx context.Context, updates []TokenPricesUpdate) ([]int64, error) {
+	destChainSelectors := make([]uint64, len(updates))
+	for i, update := range updates {
+		destChainSelectors[i] = update.DestChainSelector
+	}
+	return withObservedBatchQuery(o, "UpsertTokenPricesForDestChains", destChainSelectors, func() ([]int64, error) {
+		return o.ORM.UpsertTokenPricesForDestChains(ctx, updates)
+	})
+}
+
+func (o *observedORM) GetTokenMetadata(ctx context.Context, destChainSelector uint64) ([]TokenMetadata, error) {
+	return withObservedQueryAndResults(o, "GetTokenMetadata", destChainSelector, func() ([]TokenMetadata, error) {
+		return o.ORM.GetTokenMetadata(ctx, destChainSelector)
+	})
+}
+
+func (o *observedORM) UpsertTokenMetadata(ctx context.Context, destChainSelector uint64, metadata []TokenMetadata) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "UpsertTokenMetadata", destChainSelector, func() (int64, error) {
+		return o.ORM.UpsertTokenMetadata(ctx, destChainSelector, metadata)
+	})
+}
+
+func (o *observedORM) DeleteTokenMetadata(ctx context.Context, destChainSelector uint64, tokenAddrs []string) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "DeleteTokenMetadata", destChainSelector, func() (int64, error) {
+		return o.ORM.DeleteTokenMetadata(ctx, destChainSelector, tokenAddrs)
+	})
+}
+
 func withObservedQueryAndRowsAffected(o *observedORM, queryName string, chainSelector uint64, query func() (int64, error)) (int64, error) {
 	rowsAffected, err := withObservedQuery(o, queryName, chainSelector, query)
 	if err == nil {
@@ -113,3 +192,28 @@ func withObservedQuery[T any](o *observedORM, queryName string, chainSelector ui
 	}()
 	return query()
 }
+
+// withObservedBatchQuery observes a query writing the rows of multiple dest chains, its duration is reported for
+// each of the dest chains while the dataset size is the number of rows affected of the given dest chain only.
+func withObservedBatchQuery(o *observedORM, queryName string, chainSelectors []uint64, query func() ([]int64, error)) ([]int64, error) {
+	queryStarted := time.Now()
+	rowsAffected, err := query()
+	queryDuration := time.Since(queryStarted)
+
+	datasetSizes := make(map[uint64]int64, len(chainSelectors))
+	for i, chainSelector := range chainSelectors {
+		if err != nil {
+			datasetSizes[chainSelector] = 0
+			continue
+		}
+		datasetSizes[chainSelector] += rowsAffected[i]
+	}
+	for chainSelector, datasetSize := range datasetSizes {
+		chainSelectorLabel := strconv.FormatUint(chainSelector, 10)
+		o.queryDuration.WithLabelValues(queryName, chainSelectorLabel).Observe(float64(queryDuration))
+		if err == nil {
+			o.datasetSize.WithLabelValues(queryName, chainSelectorLabel).Set(float64(datasetSize))
+		}
+	}
+	return rowsAffected, err
+}
@@ -2,10 +2,14 @@ package ccip
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/jackc/pgx/v4/stdlib"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
@@ -15,47 +19,182 @@ import (
 type GasPrice struct {
 	SourceChainSelector uint64
 	GasPrice            *assets.Wei
+	// ExecGasPrice and DAGasPrice are the execution and data availability components of GasPrice,
+	// they are nil when the breakdown is not known.
+	ExecGasPrice *assets.Wei
+	DAGasPrice   *assets.Wei
+	// BaseFee and PriorityFee are the EIP-1559 fees making up ExecGasPrice, they are nil when the gas price estimator
+	// doesn't know them.
+	BaseFee     *assets.Wei
+	PriorityFee *assets.Wei
+	// GasPriceWei is the native gas price GasPrice was converted to USD from, encoded with the data availability
+	// component like GasPrice. It is nil when not known.
+	GasPriceWei *assets.Wei
+	// UpdatedAt is populated on reads only, it is ignored by upserts which always use the DB statement timestamp.
+	UpdatedAt time.Time
+	// Version is populated on reads only, every upsert of the row assigns a new, higher version.
+	Version int64
+	// JobID is the job which wrote the price, zero when unknown.
+	JobID int32
 }
 
 type TokenPrice struct {
 	TokenAddr  string
 	TokenPrice *assets.Wei
+	// Confidence and SourceCount are the confidence in TokenPrice and the number of price sources it was derived from,
+	// they are nil when the price getter doesn't know how much its prices can be trusted.
+	Confidence  *float64
+	SourceCount *int32
+	// UpdatedAt is populated on reads only, it is ignored by upserts which always use the DB statement timestamp.
+	UpdatedAt time.Time
+	// Version is populated on reads only, every upsert of the row assigns a new, higher version.
+	Version int64
+	// JobID is the job which wrote the price, zero when unknown.
+	JobID int32
+}
+
+// TokenMetadata describes a token of a dest chain, so that it can be referred to by symbol rather than by address.
+type TokenMetadata struct {
+	TokenAddr string
+	Symbol    string
+	Decimals  uint8
+	// CoinGeckoID identifies the token in external price APIs, empty when unknown.
+	CoinGeckoID string `db:"coingecko_id"`
+	// UpdatedAt is populated on reads only, it is ignored by upserts which always use the DB statement timestamp.
+	UpdatedAt time.Time
+}
+
+// PriceAssetType distinguishes gas and token prices in the consolidated prices table.
+type PriceAssetType string
+
+const (
+	GasPriceAsset   PriceAssetType = "gas"
+	TokenPriceAsset PriceAssetType = "token"
+)
+
+// Price is a gas or token price of the consolidated ccip.observed_prices table.
+// Asset is the source chain selector for gas prices and the token address for token prices.
+type Price struct {
+	AssetType PriceAssetType
+	Asset     string
+	Price     *assets.Wei
+	// UpdatedAt is populated on reads only, it is ignored by upserts which always use the DB statement timestamp.
+	UpdatedAt time.Time
+}
+
+// GasPricesUpdate are the gas prices of a dest chain, written alongside the gas prices of other dest chains.
+type GasPricesUpdate struct {
+	DestChainSelector uint64
+	GasPrices         []GasPrice
+}
+
+// TokenPricesUpdate are the token prices of a dest chain, written alongside the token prices of other dest chains.
+// Only the tokens which were not updated within Interval are written.
+type TokenPricesUpdate struct {
+	DestChainSelector uint64
+	TokenPrices       []TokenPrice
+	Interval          time.Duration
 }
 
 type ORM interface {
 	GetGasPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]GasPrice, error)
 	GetTokenPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]TokenPrice, error)
+	// GetPriceVersion returns the highest version of the gas and token prices of the dest chain, zero when there are none.
+	// Comparing it with the versions of previously read prices detects prices written in between reads.
+	GetPriceVersion(ctx context.Context, destChainSelector uint64) (int64, error)
+
+	// GetGasPricesByJobID returns the gas prices last written by the job, a job writes the prices of a single dest chain.
+	GetGasPricesByJobID(ctx context.Context, jobID int32) ([]GasPrice, error)
+	// GetTokenPricesByJobID returns the token prices last written by the job, a job writes the prices of a single dest chain.
+	GetTokenPricesByJobID(ctx context.Context, jobID int32) ([]TokenPrice, error)
 
 	UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []GasPrice) (int64, error)
 	UpsertTokenPricesForDestChain(ctx context.Context, destChainSelector uint64, tokenPrices []TokenPrice, interval time.Duration) (int64, error)
+
+	// GetGasPriceHistory returns all gas prices of the source chain written for the dest chain within [from, to], ordered by time.
+	GetGasPriceHistory(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from, to time.Time) ([]GasPrice, error)
+	// GetTokenPriceHistory returns all prices of the token written for the dest chain within [from, to], ordered by time.
+	GetTokenPriceHistory(ctx context.Context, destChainSelector uint64, tokenAddr string, from, to time.Time) ([]TokenPrice, error)
+
+	// DeletePricesByJobID deletes the gas and token prices last written by the job, e.g. when the job is deleted.
+	// Prices of the job which were since overwritten by other jobs are kept.
+	DeletePricesByJobID(ctx context.Context, jobID int32) (int64, error)
+
+	// CleanupStalePrices deletes gas and token prices of the dest chain which were not updated within the retention period.
+	CleanupStalePrices(ctx context.Context, destChainSelector uint64, retention time.Duration) (int64, error)
+
+	// GetPricesByDestChain returns the gas and token prices of the dest chain from the consolidated prices table.
+	GetPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]Price, error)
+	// UpsertPrices inserts or updates gas and token prices of the dest chain in the consolidated prices table.
+	UpsertPrices(ctx context.Context, destChainSelector uint64, prices []Price) (int64, error)
+
+	// UpsertGasPricesForDestChains upserts the gas prices of multiple dest chains in a single transaction.
+	// It returns the number of upserted rows of each update, in the order of the updates.
+	UpsertGasPricesForDestChains(ctx context.Context, updates []GasPricesUpdate) ([]int64, error)
+	// UpsertTokenPricesForDestChains upserts the token prices of multiple dest chains in a single transaction.
+	// It returns the number of upserted rows of each update, in the order of the updates.
+	UpsertTokenPricesForDestChains(ctx context.Context, updates []TokenPricesUpdate) ([]int64, error)
+
+	// SubscribePriceUpdates LISTENs for writes of the gas and token prices of the dest chain on a dedicated connection.
+	// The returned channel receives a signal after prices were written, signals are coalesced while not consumed.
+	// The channel is closed when ctx is done or the connection is lost.
+	SubscribePriceUpdates(ctx context.Context, destChainSelector uint64) (<-chan struct{}, error)
+
+	// GetTokenMetadata returns the metadata of all tokens of the dest chain.
+	GetTokenMetadata(ctx context.Context, destChainSelector uint64) ([]TokenMetadata, error)
+	// UpsertTokenMetadata inserts or updates the metadata of the given tokens of the dest chain.
+	UpsertTokenMetadata(ctx context.Context, destChainSelector uint64, metadata []TokenMetadata) (int64, error)
+	// DeleteTokenMetadata deletes the metadata of the given tokens of the dest chain.
+	DeleteTokenMetadata(ctx context.Context, destChainSelector uint64, tokenAddrs []string) (int64, error)
+}
+
+// priceUpdatesChannel is notified by the price table triggers, the payload is the dest chain selector.
+const priceUpdatesChannel = "ccip_price_updates"
+
+// connSource is implemented by *sqlx.DB, LISTEN needs a connection which is not shared through the pool.
+type connSource interface {
+	Conn(ctx context.Context) (*sql.Conn, error)
 }
 
 type orm struct {
-	ds   sqlutil.DataSource
-	lggr logger.Logger
+	ds sqlutil.DataSource
+	// readDs serves the latest prices reads, it is either ds or a read replica of it
+	readDs sqlutil.DataSource
+	lggr   logger.Logger
 }
 
 var _ ORM = (*orm)(nil)
 
 func NewORM(ds sqlutil.DataSource, lggr logger.Logger) (ORM, error) {
+	return NewORMWithReadReplica(ds, ds, lggr)
+}
+
+// NewORMWithReadReplica creates an ORM reading the latest gas and token prices of dest chains from the read replica,
+// while writes and all other queries go to the primary ds. Reads can lag behind writes by the replication delay,
+// which is acceptable for prices that are refreshed in the background anyway.
+func NewORMWithReadReplica(ds sqlutil.DataSource, readReplica sqlutil.DataSource, lggr logger.Logger) (ORM, error) {
 	if ds == nil {
 		return nil, errors.New("datasource to CCIP NewORM cannot be nil")
 	}
+	if readReplica == nil {
+		return nil, errors.New("read replica datasource to CCIP NewORM cannot be nil")
+	}
 
 	return &orm{
-		ds:   ds,
-		lggr: lggr,
+		ds:     ds,
+		readDs: readReplica,
+		lggr:   lggr,
 	}, nil
 }
 
 func (o *orm) GetGasPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]GasPrice, error) {
 	var gasPrices []GasPrice
 	stmt := `
-		SELECT source_chain_selector, gas_price
+		SELECT source_chain_selector, gas_price, exec_gas_price, da_gas_price, base_fee, priority_fee, gas_price_wei, updated_at, version, COALESCE(job_id, 0) AS job_id
 		FROM ccip.observed_gas_prices
 		WHERE chain_selector = $1;
 	`
-	err := o.ds.SelectContext(ctx, &gasPrices, stmt, destChainSelector)
+	err := o.readDs.SelectContext(ctx, &gasPrices, stmt, destChainSelector)
 	if err != nil {
 		return nil, err
 	}
@@ -66,18 +205,86 @@ func (o *orm) GetGasPricesByDestChain(ctx context.Context, destChainSelector uin
 func (o *orm) GetTokenPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]TokenPrice, error) {
 	var tokenPrices []TokenPrice
 	stmt := `
-		SELECT token_addr, token_price
+		SELECT token_addr, token_price, confidence, source_count, updated_at, version, COALESCE(job_id, 0) AS job_id
 		FROM ccip.observed_token_prices
 		WHERE chain_selector = $1;
 	`
-	err := o.ds.SelectContext(ctx, &tokenPrices, stmt, destChainSelector)
+	err := o.readDs.SelectContext(ctx, &tokenPrices, stmt, destChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	return tokenPrices, nil
+}
+
+func (o *orm) GetGasPricesByJobID(ctx context.Context, jobID int32) ([]GasPrice, error) {
+	var gasPrices []GasPrice
+	stmt := `
+		SELECT source_chain_selector, gas_price, exec_gas_price, da_gas_price, base_fee, priority_fee, gas_price_wei, updated_at, version, job_id
+		FROM ccip.observed_gas_prices
+		WHERE job_id = $1
+		ORDER BY chain_selector, source_chain_selector;
+	`
+	err := o.ds.SelectContext(ctx, &gasPrices, stmt, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return gasPrices, nil
+}
+
+func (o *orm) GetTokenPricesByJobID(ctx context.Context, jobID int32) ([]TokenPrice, error) {
+	var tokenPrices []TokenPrice
+	stmt := `
+		SELECT token_addr, token_price, confidence, source_count, updated_at, version, job_id
+		FROM ccip.observed_token_prices
+		WHERE job_id = $1
+		ORDER BY chain_selector, token_addr;
+	`
+	err := o.ds.SelectContext(ctx, &tokenPrices, stmt, jobID)
 	if err != nil {
 		return nil, err
 	}
 	return tokenPrices, nil
 }
 
+func (o *orm) GetPriceVersion(ctx context.Context, destChainSelector uint64) (int64, error) {
+	var version int64
+	stmt := `
+		SELECT COALESCE(MAX(version), 0)
+		FROM (
+			SELECT version FROM ccip.observed_gas_prices WHERE chain_selector = $1
+			UNION ALL
+			SELECT version FROM ccip.observed_token_prices WHERE chain_selector = $1
+		) AS versions;
+	`
+	if err := o.readDs.GetContext(ctx, &version, stmt, destChainSelector); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
 func (o *orm) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []GasPrice) (int64, error) {
+	return o.upsertGasPrices(ctx, o.ds, destChainSelector, gasPrices)
+}
+
+func (o *orm) UpsertGasPricesForDestChains(ctx context.Context, updates []GasPricesUpdate) ([]int64, error) {
+	rowsUpserted := make([]int64, len(updates))
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		for i, update := range updates {
+			rows, err := o.upsertGasPrices(ctx, tx, update.DestChainSelector, update.GasPrices)
+			if err != nil {
+				return fmt.Errorf("dest chain %d: %w", update.DestChainSelector, err)
+			}
+			rowsUpserted[i] = rows
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rowsUpserted, nil
+}
+
+func (o *orm) upsertGasPrices(ctx context.Context, ds sqlutil.DataSource, destChainSelector uint64, gasPrices []GasPrice) (int64, error) {
 	if len(gasPrices) == 0 {
 		return 0, nil
 	}
@@ -94,15 +301,30 @@ func (o *orm) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector
 			"chain_selector":        destChainSelector,
 			"source_chain_selector": price.SourceChainSelector,
 			"gas_price":             price.GasPrice,
+			"exec_gas_price":        price.ExecGasPrice,
+			"da_gas_price":          price.DAGasPrice,
+			"base_fee":              price.BaseFee,
+			"priority_fee":          price.PriorityFee,
+			"gas_price_wei":         price.GasPriceWei,
+			"job_id":                price.JobID,
 		})
 	}
 
-	stmt := `INSERT INTO ccip.observed_gas_prices (chain_selector, source_chain_selector, gas_price, updated_at)
-		VALUES (:chain_selector, :source_chain_selector, :gas_price, statement_timestamp())
-		ON CONFLICT (source_chain_selector, chain_selector)
-		DO UPDATE SET gas_price = EXCLUDED.gas_price, updated_at = EXCLUDED.updated_at;`
+	// Every upserted row is also appended to the history table within the same statement
+	stmt := `WITH upserted AS (
+			INSERT INTO ccip.observed_gas_prices (chain_selector, source_chain_selector, gas_price, exec_gas_price, da_gas_price, base_fee, priority_fee, gas_price_wei, job_id, updated_at)
+			VALUES (:chain_selector, :source_chain_selector, :gas_price, :exec_gas_price, :da_gas_price, :base_fee, :priority_fee, :gas_price_wei, NULLIF(:job_id, 0), statement_timestamp())
+			ON CONFLICT (source_chain_selector, chain_selector)
+			DO UPDATE SET gas_price = EXCLUDED.gas_price, exec_gas_price = EXCLUDED.exec_gas_price,
+				da_gas_price = EXCLUDED.da_gas_price, base_fee = EXCLUDED.base_fee, priority_fee = EXCLUDED.priority_fee,
+				gas_price_wei = EXCLUDED.gas_price_wei, job_id = EXCLUDED.job_id, updated_at = EXCLUDED.updated_at,
+				version = nextval('ccip.observed_price_version_seq')
+			RETURNING chain_selector, source_chain_selector, gas_price, exec_gas_price, da_gas_price, base_fee, priority_fee, gas_price_wei, job_id, updated_at
+		)
+		INSERT INTO ccip.observed_gas_prices_history (chain_selector, source_chain_selector, gas_price, exec_gas_price, da_gas_price, base_fee, priority_fee, gas_price_wei, job_id, created_at)
+		SELECT chain_selector, source_chain_selector, gas_price, exec_gas_price, da_gas_price, base_fee, priority_fee, gas_price_wei, job_id, updated_at FROM upserted;`
 
-	result, err := o.ds.NamedExecContext(ctx, stmt, insertData)
+	result, err := ds.NamedExecContext(ctx, stmt, insertData)
 	if err != nil {
 		return 0, fmt.Errorf("error inserting gas prices %w", err)
 	}
@@ -114,11 +336,35 @@ func (o *orm) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector
 // If price for a token doesn't change or was updated recently we don't include that token to the upsert query.
 // We don't run in TX intentionally, because we don't want to lock the table and conflicts are resolved on the insert level
 func (o *orm) UpsertTokenPricesForDestChain(ctx context.Context, destChainSelector uint64, tokenPrices []TokenPrice, interval time.Duration) (int64, error) {
+	return o.upsertTokenPrices(ctx, o.ds, destChainSelector, tokenPrices, interval)
+}
+
+// UpsertTokenPricesForDestChains runs in TX, unlike UpsertTokenPricesForDestChain, so that the updates of all dest
+// chains are written at once. The same relevant tokens filtering is applied to each update.
+func (o *orm) UpsertTokenPricesForDestChains(ctx context.Context, updates []TokenPricesUpdate) ([]int64, error) {
+	rowsUpserted := make([]int64, len(updates))
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		for i, update := range updates {
+			rows, err := o.upsertTokenPrices(ctx, tx, update.DestChainSelector, update.TokenPrices, update.Interval)
+			if err != nil {
+				return fmt.Errorf("dest chain %d: %w", update.DestChainSelector, err)
+			}
+			rowsUpserted[i] = rows
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rowsUpserted, nil
+}
+
+func (o *orm) upsertTokenPrices(ctx context.Context, ds sqlutil.DataSource, destChainSelector uint64, tokenPrices []TokenPrice, interval time.Duration) (int64, error) {
 	if len(tokenPrices) == 0 {
 		return 0, nil
 	}
 
-	tokensToUpdate, err := o.pickOnlyRelevantTokensForUpdate(ctx, destChainSelector, tokenPrices, interval)
+	tokensToUpdate, err := o.pickOnlyRelevantTokensForUpdate(ctx, ds, destChainSelector, tokenPrices, interval)
 	if err != nil || len(tokensToUpdate) == 0 {
 		return 0, err
 	}
@@ -129,30 +375,317 @@ func (o *orm) UpsertTokenPricesForDestChain(ctx context.Context, destChainSelect
 			"chain_selector": destChainSelector,
 			"token_addr":     price.TokenAddr,
 			"token_price":    price.TokenPrice,
+			"confidence":     price.Confidence,
+			"source_count":   price.SourceCount,
+			"job_id":         price.JobID,
 		})
 	}
 
-	stmt := `INSERT INTO ccip.observed_token_prices (chain_selector, token_addr, token_price, updated_at)
-		VALUES (:chain_selector, :token_addr, :token_price, statement_timestamp())
-		ON CONFLICT (token_addr, chain_selector) 
-		DO UPDATE SET token_price = EXCLUDED.token_price, updated_at = EXCLUDED.updated_at;`
-	result, err := o.ds.NamedExecContext(ctx, stmt, insertData)
+	// Every upserted row is also appended to the history table within the same statement
+	stmt := `WITH upserted AS (
+			INSERT INTO ccip.observed_token_prices (chain_selector, token_addr, token_price, confidence, source_count, job_id, updated_at)
+			VALUES (:chain_selector, :token_addr, :token_price, :confidence, :source_count, NULLIF(:job_id, 0), statement_timestamp())
+			ON CONFLICT (token_addr, chain_selector)
+			DO UPDATE SET token_price = EXCLUDED.token_price, confidence = EXCLUDED.confidence,
+				source_count = EXCLUDED.source_count, job_id = EXCLUDED.job_id, updated_at = EXCLUDED.updated_at,
+				version = nextval('ccip.observed_price_version_seq')
+			RETURNING chain_selector, token_addr, token_price, confidence, source_count, job_id, updated_at
+		)
+		INSERT INTO ccip.observed_token_prices_history (chain_selector, token_addr, token_price, confidence, source_count, job_id, created_at)
+		SELECT chain_selector, token_addr, token_price, confidence, source_count, job_id, updated_at FROM upserted;`
+	result, err := ds.NamedExecContext(ctx, stmt, insertData)
 	if err != nil {
 		return 0, fmt.Errorf("error inserting token prices %w", err)
 	}
 	return result.RowsAffected()
 }
 
+func (o *orm) GetGasPriceHistory(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from, to time.Time) ([]GasPrice, error) {
+	var gasPrices []GasPrice
+	stmt := `
+		SELECT source_chain_selector, gas_price, exec_gas_price, da_gas_price, base_fee, priority_fee, gas_price_wei, created_at AS updated_at
+		FROM ccip.observed_gas_prices_history
+		WHERE chain_selector = $1
+			AND source_chain_selector = $2
+			AND created_at BETWEEN $3 AND $4
+		ORDER BY created_at, id;
+	`
+	err := o.ds.SelectContext(ctx, &gasPrices, stmt, destChainSelector, sourceChainSelector, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return gasPrices, nil
+}
+
+func (o *orm) GetTokenPriceHistory(ctx context.Context, destChainSelector uint64, tokenAddr string, from, to time.Time) ([]TokenPrice, error) {
+	var tokenPrices []TokenPrice
+	stmt := `
+		SELECT token_addr, token_price, confidence, source_count, created_at AS updated_at
+		FROM ccip.observed_token_prices_history
+		WHERE chain_selector = $1
+			AND token_addr = $2
+			AND created_at BETWEEN $3 AND $4
+		ORDER BY created_at, id;
+	`
+	err := o.ds.SelectContext(ctx, &tokenPrices, stmt, destChainSelector, []byte(tokenAddr), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return tokenPrices, nil
+}
+
+func (o *orm) DeletePricesByJobID(ctx context.Context, jobID int32) (int64, error) {
+	// Gas and token prices are deleted in TX, so that a failure doesn't leave only the gas prices of the job deleted
+	var rowsDeleted int64
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		gasResult, err := tx.ExecContext(ctx, `DELETE FROM ccip.observed_gas_prices WHERE job_id = $1;`, jobID)
+		if err != nil {
+			return fmt.Errorf("error deleting gas prices of job %d %w", jobID, err)
+		}
+		gasRows, err := gasResult.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		tokenResult, err := tx.ExecContext(ctx, `DELETE FROM ccip.observed_token_prices WHERE job_id = $1;`, jobID)
+		if err != nil {
+			return fmt.Errorf("error deleting token prices of job %d %w", jobID, err)
+		}
+		tokenRows, err := tokenResult.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		rowsDeleted = gasRows + tokenRows
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return rowsDeleted, nil
+}
+
+func (o *orm) CleanupStalePrices(ctx context.Context, destChainSelector uint64, retention time.Duration) (int64, error) {
+	pgInterval := fmt.Sprintf("%d milliseconds", retention.Milliseconds())
+
+	gasResult, err := o.ds.ExecContext(ctx, `
+		DELETE FROM ccip.observed_gas_prices
+		WHERE chain_selector = $1 AND updated_at < statement_timestamp() - $2::interval;
+	`, destChainSelector, pgInterval)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting stale gas prices %w", err)
+	}
+	gasRows, err := gasResult.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	tokenResult, err := o.ds.ExecContext(ctx, `
+		DELETE FROM ccip.observed_token_prices
+		WHERE chain_selector = $1 AND updated_at < statement_timestamp() - $2::interval;
+	`, destChainSelector, pgInterval)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting stale token prices %w", err)
+	}
+	tokenRows, err := tokenResult.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	pricesResult, err := o.ds.ExecContext(ctx, `
+		DELETE FROM ccip.observed_prices
+		WHERE chain_selector = $1 AND updated_at < statement_timestamp() - $2::interval;
+	`, destChainSelector, pgInterval)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting stale prices %w", err)
+	}
+	pricesRows, err := pricesResult.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return gasRows + tokenRows + pricesRows, nil
+}
+
+func (o *orm) GetPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]Price, error) {
+	var prices []Price
+	stmt := `
+		SELECT asset_type, asset, price, updated_at
+		FROM ccip.observed_prices
+		WHERE chain_selector = $1;
+	`
+	err := o.readDs.SelectContext(ctx, &prices, stmt, destChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+func (o *orm) UpsertPrices(ctx context.Context, destChainSelector uint64, prices []Price) (int64, error) {
+	if len(prices) == 0 {
+		return 0, nil
+	}
+
+	uniquePrices := make(map[string]Price)
+	for _, price := range prices {
+		uniquePrices[fmt.Sprintf("%s-%s", price.AssetType, price.Asset)] = price
+	}
+
+	insertData := make([]map[string]interface{}, 0, len(uniquePrices))
+	for _, price := range uniquePrices {
+		insertData = append(insertData, map[string]interface{}{
+			"chain_selector": destChainSelector,
+			"asset_type":     price.AssetType,
+			"asset":          price.Asset,
+			"price":          price.Price,
+		})
+	}
+
+	stmt := `INSERT INTO ccip.observed_prices (chain_selector, asset_type, asset, price, updated_at)
+		VALUES (:chain_selector, :asset_type, :asset, :price, statement_timestamp())
+		ON CONFLICT (chain_selector, asset_type, asset)
+		DO UPDATE SET price = EXCLUDED.price, updated_at = EXCLUDED.updated_at;`
+	result, err := o.ds.NamedExecContext(ctx, stmt, insertData)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting prices %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (o *orm) SubscribePriceUpdates(ctx context.Context, destChainSelector uint64) (<-chan struct{}, error) {
+	cs, ok := o.ds.(connSource)
+	if !ok {
+		return nil, fmt.Errorf("datasource %T does not support LISTEN", o.ds)
+	}
+	conn, err := cs.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error acquiring connection for price updates %w", err)
+	}
+	err = conn.Raw(func(driverConn any) error {
+		if _, ok := driverConn.(*stdlib.Conn); !ok {
+			return fmt.Errorf("driver connection %T does not support LISTEN", driverConn)
+		}
+		return nil
+	})
+	if err == nil {
+		_, err = conn.ExecContext(ctx, "LISTEN "+priceUpdatesChannel)
+	}
+	if err != nil {
+		return nil, errors.Join(err, conn.Close())
+	}
+
+	payload := strconv.FormatUint(destChainSelector, 10)
+	updates := make(chan struct{}, 1)
+	go func() {
+		defer close(updates)
+		// The connection is closed by pgx when ctx is done while waiting, it is never reused by the pool
+		defer conn.Close()
+
+		err := conn.Raw(func(driverConn any) error {
+			pgConn := driverConn.(*stdlib.Conn).Conn()
+			for {
+				notification, err := pgConn.WaitForNotification(ctx)
+				if err != nil {
+					return err
+				}
+				if notification.Payload != payload {
+					continue
+				}
+				select {
+				case updates <- struct{}{}:
+				default:
+				}
+			}
+		})
+		if ctx.Err() == nil {
+			o.lggr.Warnw("Price updates subscription ended", "destChainSelector", destChainSelector, "err", err)
+		}
+	}()
+	return updates, nil
+}
+
+func (o *orm) GetTokenMetadata(ctx context.Context, destChainSelector uint64) ([]TokenMetadata, error) {
+	var metadata []TokenMetadata
+	stmt := `
+		SELECT token_addr, symbol, decimals, coingecko_id, updated_at
+		FROM ccip.token_metadata
+		WHERE chain_selector = $1
+		ORDER BY token_addr;
+	`
+	err := o.ds.SelectContext(ctx, &metadata, stmt, destChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+func (o *orm) UpsertTokenMetadata(ctx context.Context, destChainSelector uint64, metadata []TokenMetadata) (int64, error) {
+	if len(metadata) == 0 {
+		return 0, nil
+	}
+
+	uniqueMetadata := make(map[string]TokenMetadata, len(metadata))
+	for _, m := range metadata {
+		uniqueMetadata[m.TokenAddr] = m
+	}
+
+	insertData := make([]map[string]interface{}, 0, len(uniqueMetadata))
+	for _, m := range uniqueMetadata {
+		insertData = append(insertData, map[string]interface{}{
+			"chain_selector": destChainSelector,
+			"token_addr":     m.TokenAddr,
+			"symbol":         m.Symbol,
+			"decimals":       m.Decimals,
+			"coingecko_id":   m.CoinGeckoID,
+		})
+	}
+
+	stmt := `INSERT INTO ccip.token_metadata (chain_selector, token_addr, symbol, decimals, coingecko_id, updated_at)
+		VALUES (:chain_selector, :token_addr, :symbol, :decimals, :coingecko_id, statement_timestamp())
+		ON CONFLICT (chain_selector, token_addr)
+		DO UPDATE SET symbol = EXCLUDED.symbol, decimals = EXCLUDED.decimals, coingecko_id = EXCLUDED.coingecko_id,
+			updated_at = EXCLUDED.updated_at;`
+	result, err := o.ds.NamedExecContext(ctx, stmt, insertData)
+	if err != nil {
+		return 0, fmt.Errorf("error inserting token metadata %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (o *orm) DeleteTokenMetadata(ctx context.Context, destChainSelector uint64, tokenAddrs []string) (int64, error) {
+	if len(tokenAddrs) == 0 {
+		return 0, nil
+	}
+
+	addrs := make([][]byte, 0, len(tokenAddrs))
+	for _, addr := range tokenAddrs {
+		addrs = append(addrs, []byte(addr))
+	}
+	result, err := o.ds.ExecContext(ctx, `
+		DELETE FROM ccip.token_metadata
+		WHERE chain_selector = $1 AND token_addr = any($2);
+	`, destChainSelector, addrs)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting token metadata %w", err)
+	}
+	return result.RowsAffected()
+}
+
 // pickOnlyRelevantTokensForUpdate returns only tokens that need to be updated. Multiple jobs can be updating the same tokens,
 // in order to reduce table locking and redundant upserts we start with reading the table and checking which tokens are eligible for update.
 // A token is eligible for update when time since last update is greater than the interval.
 func (o *orm) pickOnlyRelevantTokensForUpdate(
 	ctx context.Context,
+	ds sqlutil.DataSource,
 	destChainSelector uint64,
 	tokenPrices []TokenPrice,
 	interval time.Duration,
 ) ([]TokenPrice, error) {
 	tokenPricesByAddress := toTokensByAddress(tokenPrices)
+	observedByAddress := make(map[string]TokenPrice, len(tokenPrices))
+	for _, tk := range tokenPrices {
+		observedByAddress[tk.TokenAddr] = tk
+	}
 
 	// Picks only tokens which were recently updated and can be ignored,
 	// we will filter out these tokens from the upsert query.
@@ -169,7 +702,7 @@ func (o *orm) pickOnlyRelevantTokensForUpdate(
 	pgInterval := fmt.Sprintf("%d milliseconds", interval.Milliseconds())
 	args := []interface{}{destChainSelector, tokenAddrsToBytes(tokenPricesByAddress), pgInterval}
 	var dbTokensToIgnore []string
-	if err := o.ds.SelectContext(ctx, &dbTokensToIgnore, stmt, args...); err != nil {
+	if err := ds.SelectContext(ctx, &dbTokensToIgnore, stmt, args...); err != nil {
 		return nil, err
 	}
 
@@ -183,7 +716,7 @@ func (o *orm) pickOnlyRelevantTokensForUpdate(
 		eligibleForUpdate := false
 		if _, ok := tokensToIgnore[tokenAddr]; !ok {
 			eligibleForUpdate = true
-			tokenPricesToUpdate = append(tokenPricesToUpdate, TokenPrice{TokenAddr: tokenAddr, TokenPrice: tokenPrice})
+			tokenPricesToUpdate = append(tokenPricesToUpdate, observedByAddress[tokenAddr])
 		}
 		o.lggr.Debugw(
 			"Token price eligibility for database update",
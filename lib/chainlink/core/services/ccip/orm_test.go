@@ -1,6 +1,7 @@
 package ccip
 
 import (
+	"math"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -115,6 +116,61 @@ func TestInitORM(t *testing.T) {
 	assert.NotNil(t, orm)
 }
 
+func TestInitORMWithReadReplica(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	_, err := NewORMWithReadReplica(db, nil, logger.TestLogger(t))
+	require.Error(t, err)
+	_, err = NewORMWithReadReplica(nil, db, logger.TestLogger(t))
+	require.Error(t, err)
+}
+
+func TestORM_ReadReplica(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	// Every test DB runs in its own transaction, writes to the primary are never visible on the replica
+	primary := pgtest.NewSqlxDB(t)
+	replica := pgtest.NewSqlxDB(t)
+	orm, err := NewORMWithReadReplica(primary, replica, logger.TestLogger(t))
+	require.NoError(t, err)
+	replicaORM, err := NewORM(replica, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	destSelector := rand.Uint64()
+	tokenPrices := generateRandomTokenPrices(generateTokenAddresses(2))
+
+	_, err = orm.UpsertGasPricesForDestChain(ctx, destSelector, generateGasPrices(rand.Uint64(), 1))
+	require.NoError(t, err)
+	_, err = orm.UpsertTokenPricesForDestChain(ctx, destSelector, tokenPrices, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, getGasTableRowCount(t, primary))
+	assert.Equal(t, 2, getTokenTableRowCount(t, primary))
+
+	gasPrices, err := orm.GetGasPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Empty(t, gasPrices)
+	dbTokenPrices, err := orm.GetTokenPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Empty(t, dbTokenPrices)
+
+	// Prices replicated to the replica are read from it, rows of another dest chain are used so that the uncommitted
+	// rows of the primary transaction don't block the replica upserts
+	replicatedDestSelector := rand.Uint64()
+	_, err = replicaORM.UpsertGasPricesForDestChain(ctx, replicatedDestSelector, generateGasPrices(rand.Uint64(), 1))
+	require.NoError(t, err)
+	_, err = replicaORM.UpsertTokenPricesForDestChain(ctx, replicatedDestSelector, tokenPrices, time.Hour)
+	require.NoError(t, err)
+
+	gasPrices, err = orm.GetGasPricesByDestChain(ctx, replicatedDestSelector)
+	require.NoError(t, err)
+	assert.Len(t, gasPrices, 1)
+	dbTokenPrices, err = orm.GetTokenPricesByDestChain(ctx, replicatedDestSelector)
+	require.NoError(t, err)
+	assert.Len(t, dbTokenPrices, 2)
+}
+
 func TestORM_EmptyGasPrices(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -183,6 +239,7 @@ func TestORM_InsertAndGetGasPrices(t *testing.T) {
 	for _, price := range prices {
 		selector := price.SourceChainSelector
 		assert.Equal(t, expectedPrices[selector].GasPrice, price.GasPrice)
+		assert.False(t, price.UpdatedAt.IsZero())
 	}
 
 	// after the initial inserts, insert new round of prices, 1 price per selector this time
@@ -286,6 +343,7 @@ func TestORM_InsertAndGetTokenPrices(t *testing.T) {
 	for _, price := range prices {
 		addr := price.TokenAddr
 		assert.Equal(t, expectedPrices[addr].TokenPrice, price.TokenPrice)
+		assert.False(t, price.UpdatedAt.IsZero())
 	}
 
 	// after the initial inserts, insert new round of prices, 1 price per selector this time
@@ -356,6 +414,492 @@ func TestORM_InsertTokenPricesWhenExpired(t *testing.T) {
 	}
 }
 
+func TestORM_PriceHistory(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	sourceSelector := rand.Uint64()
+	tokenAddr := generateTokenAddresses(1)[0]
+	start := time.Now().Add(-time.Minute)
+
+	gasPrices := generateGasPrices(sourceSelector, 3)
+	tokenPrices := generateTokenPrices(tokenAddr, 3)
+	for i := range gasPrices {
+		_, err := orm.UpsertGasPricesForDestChain(ctx, destSelector, gasPrices[i:i+1])
+		require.NoError(t, err)
+		_, err = orm.UpsertTokenPricesForDestChain(ctx, destSelector, tokenPrices[i:i+1], 0)
+		require.NoError(t, err)
+	}
+	// Upsert skipped because of the interval is not recorded in the history
+	_, err := orm.UpsertTokenPricesForDestChain(ctx, destSelector, generateTokenPrices(tokenAddr, 1), time.Hour)
+	require.NoError(t, err)
+
+	gasHistory, err := orm.GetGasPriceHistory(ctx, destSelector, sourceSelector, start, time.Now())
+	require.NoError(t, err)
+	require.Len(t, gasHistory, len(gasPrices))
+	for i, price := range gasHistory {
+		assert.Equal(t, sourceSelector, price.SourceChainSelector)
+		assert.Equal(t, gasPrices[i].GasPrice, price.GasPrice)
+		assert.False(t, price.UpdatedAt.IsZero())
+	}
+
+	tokenHistory, err := orm.GetTokenPriceHistory(ctx, destSelector, tokenAddr, start, time.Now())
+	require.NoError(t, err)
+	require.Len(t, tokenHistory, len(tokenPrices))
+	for i, price := range tokenHistory {
+		assert.Equal(t, tokenAddr, price.TokenAddr)
+		assert.Equal(t, tokenPrices[i].TokenPrice, price.TokenPrice)
+	}
+
+	// Time range and selectors are respected
+	gasHistory, err = orm.GetGasPriceHistory(ctx, destSelector, sourceSelector, start.Add(-time.Hour), start)
+	require.NoError(t, err)
+	assert.Empty(t, gasHistory)
+
+	gasHistory, err = orm.GetGasPriceHistory(ctx, destSelector, sourceSelector+1, start, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, gasHistory)
+
+	tokenHistory, err = orm.GetTokenPriceHistory(ctx, destSelector+1, tokenAddr, start, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, tokenHistory)
+}
+
+func TestORM_CleanupStalePrices(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, db := setupORM(t)
+
+	destSelector := rand.Uint64()
+	otherDestSelector := rand.Uint64()
+	addrs := generateTokenAddresses(3)
+
+	for _, selector := range []uint64{destSelector, otherDestSelector} {
+		_, err := orm.UpsertGasPricesForDestChain(ctx, selector, generateGasPrices(rand.Uint64(), 1))
+		require.NoError(t, err)
+		_, err = orm.UpsertTokenPricesForDestChain(ctx, selector, generateRandomTokenPrices(addrs), 0)
+		require.NoError(t, err)
+	}
+
+	// Nothing is stale yet
+	deleted, err := orm.CleanupStalePrices(ctx, destSelector, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	time.Sleep(100 * time.Millisecond)
+
+	// Only the prices of the given dest chain are deleted
+	deleted, err = orm.CleanupStalePrices(ctx, destSelector, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1+len(addrs)), deleted)
+
+	gasPrices, err := orm.GetGasPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Empty(t, gasPrices)
+	tokenPrices, err := orm.GetTokenPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Empty(t, tokenPrices)
+
+	assert.Equal(t, 1, getGasTableRowCount(t, db))
+	assert.Equal(t, len(addrs), getTokenTableRowCount(t, db))
+}
+
+func TestORM_GasPriceComponents(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	gasPrices := []GasPrice{
+		{
+			SourceChainSelector: 1,
+			GasPrice:            assets.NewWei(big.NewInt(3e9)),
+			ExecGasPrice:        assets.NewWei(big.NewInt(1e9)),
+			DAGasPrice:          assets.NewWei(big.NewInt(2e9)),
+			BaseFee:             assets.NewWei(big.NewInt(8e8)),
+			PriorityFee:         assets.NewWei(big.NewInt(2e8)),
+			GasPriceWei:         assets.NewWei(big.NewInt(3e15)),
+		},
+		{
+			SourceChainSelector: 2,
+			GasPrice:            assets.NewWei(big.NewInt(4e9)),
+		},
+	}
+	_, err := orm.UpsertGasPricesForDestChain(ctx, destSelector, gasPrices)
+	require.NoError(t, err)
+
+	dbGasPrices, err := orm.GetGasPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, dbGasPrices, 2)
+	for _, price := range dbGasPrices {
+		switch price.SourceChainSelector {
+		case 1:
+			assert.Equal(t, gasPrices[0].ExecGasPrice, price.ExecGasPrice)
+			assert.Equal(t, gasPrices[0].DAGasPrice, price.DAGasPrice)
+			assert.Equal(t, gasPrices[0].BaseFee, price.BaseFee)
+			assert.Equal(t, gasPrices[0].PriorityFee, price.PriorityFee)
+			assert.Equal(t, gasPrices[0].GasPriceWei, price.GasPriceWei)
+		case 2:
+			assert.Nil(t, price.ExecGasPrice)
+			assert.Nil(t, price.DAGasPrice)
+			assert.Nil(t, price.BaseFee)
+			assert.Nil(t, price.PriorityFee)
+			assert.Nil(t, price.GasPriceWei)
+		}
+	}
+
+	history, err := orm.GetGasPriceHistory(ctx, destSelector, 1, time.Now().Add(-time.Minute), time.Now())
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, gasPrices[0].DAGasPrice, history[0].DAGasPrice)
+	assert.Equal(t, gasPrices[0].PriorityFee, history[0].PriorityFee)
+	assert.Equal(t, gasPrices[0].GasPriceWei, history[0].GasPriceWei)
+}
+
+func TestORM_ConsolidatedPrices(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	otherDestSelector := rand.Uint64()
+	prices := []Price{
+		{AssetType: GasPriceAsset, Asset: "1", Price: assets.NewWei(big.NewInt(1e9))},
+		{AssetType: TokenPriceAsset, Asset: "0x0001", Price: assets.NewWei(big.NewInt(2e18))},
+		// The same asset can be both a gas and a token price
+		{AssetType: TokenPriceAsset, Asset: "1", Price: assets.NewWei(big.NewInt(3e18))},
+	}
+
+	rowsInserted, err := orm.UpsertPrices(ctx, destSelector, prices)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(prices)), rowsInserted)
+	_, err = orm.UpsertPrices(ctx, otherDestSelector, prices[:1])
+	require.NoError(t, err)
+
+	// Duplicates are deduplicated and existing prices are updated
+	rowsUpdated, err := orm.UpsertPrices(ctx, destSelector, []Price{
+		{AssetType: GasPriceAsset, Asset: "1", Price: assets.NewWei(big.NewInt(5e9))},
+		{AssetType: GasPriceAsset, Asset: "1", Price: assets.NewWei(big.NewInt(4e9))},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), rowsUpdated)
+
+	dbPrices, err := orm.GetPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, dbPrices, len(prices))
+	for _, price := range dbPrices {
+		assert.False(t, price.UpdatedAt.IsZero())
+		switch {
+		case price.AssetType == GasPriceAsset:
+			assert.Equal(t, int64(4e9), price.Price.Int64())
+		case price.Asset == "0x0001":
+			assert.Equal(t, prices[1].Price.String(), price.Price.String())
+		default:
+			assert.Equal(t, prices[2].Price.String(), price.Price.String())
+		}
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	deleted, err := orm.CleanupStalePrices(ctx, destSelector, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(prices)), deleted)
+
+	dbPrices, err = orm.GetPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Empty(t, dbPrices)
+	dbPrices, err = orm.GetPricesByDestChain(ctx, otherDestSelector)
+	require.NoError(t, err)
+	assert.Len(t, dbPrices, 1)
+}
+
+func TestORM_UpsertPricesForDestChains(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, db := setupORM(t)
+
+	destSelectors := []uint64{rand.Uint64(), rand.Uint64()}
+	addrs := generateTokenAddresses(2)
+
+	gasRows, err := orm.UpsertGasPricesForDestChains(ctx, []GasPricesUpdate{
+		{DestChainSelector: destSelectors[0], GasPrices: generateGasPrices(rand.Uint64(), 2)},
+		{DestChainSelector: destSelectors[1], GasPrices: generateGasPrices(rand.Uint64(), 1)},
+		{DestChainSelector: destSelectors[1]},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 1, 0}, gasRows)
+	assert.Equal(t, 3, getGasTableRowCount(t, db))
+
+	tokenRows, err := orm.UpsertTokenPricesForDestChains(ctx, []TokenPricesUpdate{
+		{DestChainSelector: destSelectors[0], TokenPrices: generateRandomTokenPrices(addrs), Interval: time.Hour},
+		{DestChainSelector: destSelectors[1], TokenPrices: generateRandomTokenPrices(addrs[:1]), Interval: time.Hour},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 1}, tokenRows)
+	assert.Equal(t, 3, getTokenTableRowCount(t, db))
+
+	// Tokens updated within the interval are skipped, the same as for a single dest chain
+	tokenRows, err = orm.UpsertTokenPricesForDestChains(ctx, []TokenPricesUpdate{
+		{DestChainSelector: destSelectors[0], TokenPrices: generateRandomTokenPrices(addrs), Interval: time.Hour},
+		{DestChainSelector: destSelectors[1], TokenPrices: generateRandomTokenPrices(addrs), Interval: time.Hour},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0, 1}, tokenRows)
+
+	for _, destSelector := range destSelectors {
+		tokenPrices, err := orm.GetTokenPricesByDestChain(ctx, destSelector)
+		require.NoError(t, err)
+		assert.Len(t, tokenPrices, len(addrs))
+	}
+}
+
+func TestORM_SubscribePriceUpdatesUnsupportedDriver(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	// Test DBs are wrapped in a transaction per test, notifications are never delivered outside of it
+	orm, _ := setupORM(t)
+
+	updates, err := orm.SubscribePriceUpdates(ctx, 1)
+	require.ErrorContains(t, err, "does not support LISTEN")
+	assert.Nil(t, updates)
+}
+
+func TestORM_PriceVersion(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	version, err := orm.GetPriceVersion(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Zero(t, version)
+
+	sourceSelector := rand.Uint64()
+	_, err = orm.UpsertGasPricesForDestChain(ctx, destSelector, generateGasPrices(sourceSelector, 1))
+	require.NoError(t, err)
+	gasPrices, err := orm.GetGasPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, gasPrices, 1)
+	gasVersion := gasPrices[0].Version
+
+	version, err = orm.GetPriceVersion(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Equal(t, gasVersion, version)
+
+	// Token writes take versions from the same sequence as gas writes
+	_, err = orm.UpsertTokenPricesForDestChain(ctx, destSelector, generateRandomTokenPrices(generateTokenAddresses(1)), time.Hour)
+	require.NoError(t, err)
+	tokenPrices, err := orm.GetTokenPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, tokenPrices, 1)
+	assert.Greater(t, tokenPrices[0].Version, gasVersion)
+
+	// Updating a row bumps its version
+	_, err = orm.UpsertGasPricesForDestChain(ctx, destSelector, generateGasPrices(sourceSelector, 1))
+	require.NoError(t, err)
+	gasPrices, err = orm.GetGasPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, gasPrices, 1)
+	assert.Greater(t, gasPrices[0].Version, tokenPrices[0].Version)
+
+	version, err = orm.GetPriceVersion(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Equal(t, gasPrices[0].Version, version)
+}
+
+func TestORM_PricesByJobID(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	jobID := rand.Int31n(math.MaxInt32 - 1)
+	otherJobID := jobID + 1
+	sourceSelector := rand.Uint64()
+	otherSourceSelector := rand.Uint64()
+	tokenAddrs := generateTokenAddresses(2)
+
+	_, err := orm.UpsertGasPricesForDestChain(ctx, destSelector, []GasPrice{
+		{SourceChainSelector: sourceSelector, GasPrice: assets.NewWeiI(1), JobID: jobID},
+		{SourceChainSelector: otherSourceSelector, GasPrice: assets.NewWeiI(2), JobID: otherJobID},
+	})
+	require.NoError(t, err)
+	_, err = orm.UpsertTokenPricesForDestChain(ctx, destSelector, []TokenPrice{
+		{TokenAddr: tokenAddrs[0], TokenPrice: assets.NewWeiI(3), JobID: jobID},
+		{TokenAddr: tokenAddrs[1], TokenPrice: assets.NewWeiI(4)},
+	}, time.Hour)
+	require.NoError(t, err)
+
+	gasPrices, err := orm.GetGasPricesByJobID(ctx, jobID)
+	require.NoError(t, err)
+	require.Len(t, gasPrices, 1)
+	assert.Equal(t, sourceSelector, gasPrices[0].SourceChainSelector)
+	assert.Equal(t, jobID, gasPrices[0].JobID)
+
+	tokenPrices, err := orm.GetTokenPricesByJobID(ctx, jobID)
+	require.NoError(t, err)
+	require.Len(t, tokenPrices, 1)
+	assert.Equal(t, tokenAddrs[0], tokenPrices[0].TokenAddr)
+
+	// Prices written without a job are not owned by any job
+	tokenPrices, err = orm.GetTokenPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	jobIDs := make(map[string]int32, len(tokenPrices))
+	for _, tokenPrice := range tokenPrices {
+		jobIDs[tokenPrice.TokenAddr] = tokenPrice.JobID
+	}
+	assert.Equal(t, map[string]int32{tokenAddrs[0]: jobID, tokenAddrs[1]: 0}, jobIDs)
+
+	// The ownership moves to the job which last wrote the price
+	_, err = orm.UpsertGasPricesForDestChain(ctx, destSelector, []GasPrice{
+		{SourceChainSelector: sourceSelector, GasPrice: assets.NewWeiI(5), JobID: otherJobID},
+	})
+	require.NoError(t, err)
+	gasPrices, err = orm.GetGasPricesByJobID(ctx, jobID)
+	require.NoError(t, err)
+	assert.Empty(t, gasPrices)
+	gasPrices, err = orm.GetGasPricesByJobID(ctx, otherJobID)
+	require.NoError(t, err)
+	assert.Len(t, gasPrices, 2)
+}
+
+func TestORM_DeletePricesByJobID(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	deletedJobID := rand.Int31n(math.MaxInt32 - 1)
+	otherJobID := deletedJobID + 1
+	tokenAddrs := generateTokenAddresses(2)
+
+	_, err := orm.UpsertGasPricesForDestChain(ctx, destSelector, []GasPrice{
+		{SourceChainSelector: rand.Uint64(), GasPrice: assets.NewWeiI(1), JobID: deletedJobID},
+		{SourceChainSelector: rand.Uint64(), GasPrice: assets.NewWeiI(2), JobID: otherJobID},
+	})
+	require.NoError(t, err)
+	_, err = orm.UpsertTokenPricesForDestChain(ctx, destSelector, []TokenPrice{
+		{TokenAddr: tokenAddrs[0], TokenPrice: assets.NewWeiI(3), JobID: deletedJobID},
+		{TokenAddr: tokenAddrs[1], TokenPrice: assets.NewWeiI(4), JobID: otherJobID},
+	}, time.Hour)
+	require.NoError(t, err)
+
+	rowsDeleted, err := orm.DeletePricesByJobID(ctx, deletedJobID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rowsDeleted)
+
+	gasPrices, err := orm.GetGasPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, gasPrices, 1)
+	assert.Equal(t, otherJobID, gasPrices[0].JobID)
+	tokenPrices, err := orm.GetTokenPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, tokenPrices, 1)
+	assert.Equal(t, otherJobID, tokenPrices[0].JobID)
+
+	rowsDeleted, err = orm.DeletePricesByJobID(ctx, deletedJobID)
+	require.NoError(t, err)
+	assert.Zero(t, rowsDeleted)
+}
+
+func TestORM_TokenMetadata(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	addrs := generateTokenAddresses(2)
+
+	metadata, err := orm.GetTokenMetadata(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Empty(t, metadata)
+
+	rows, err := orm.UpsertTokenMetadata(ctx, destSelector, []TokenMetadata{
+		{TokenAddr: addrs[0], Symbol: "LINK", Decimals: 18, CoinGeckoID: "chainlink"},
+		{TokenAddr: addrs[1], Symbol: "USDC", Decimals: 6},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rows)
+
+	// Upserting updates the existing metadata
+	_, err = orm.UpsertTokenMetadata(ctx, destSelector, []TokenMetadata{
+		{TokenAddr: addrs[1], Symbol: "USDC", Decimals: 6, CoinGeckoID: "usd-coin"},
+	})
+	require.NoError(t, err)
+
+	metadata, err = orm.GetTokenMetadata(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, metadata, 2)
+	metadataByAddr := make(map[string]TokenMetadata, len(metadata))
+	for _, m := range metadata {
+		assert.False(t, m.UpdatedAt.IsZero())
+		metadataByAddr[m.TokenAddr] = m
+	}
+	assert.Equal(t, "LINK", metadataByAddr[addrs[0]].Symbol)
+	assert.Equal(t, uint8(18), metadataByAddr[addrs[0]].Decimals)
+	assert.Equal(t, "chainlink", metadataByAddr[addrs[0]].CoinGeckoID)
+	assert.Equal(t, "usd-coin", metadataByAddr[addrs[1]].CoinGeckoID)
+
+	// Metadata of other dest chains is left untouched
+	otherMetadata, err := orm.GetTokenMetadata(ctx, destSelector+1)
+	require.NoError(t, err)
+	assert.Empty(t, otherMetadata)
+
+	rows, err = orm.DeleteTokenMetadata(ctx, destSelector, addrs[:1])
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), rows)
+	metadata, err = orm.GetTokenMetadata(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, metadata, 1)
+	assert.Equal(t, addrs[1], metadata[0].TokenAddr)
+}
+
+func TestORM_TokenPriceConfidence(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	tokenAddrs := generateTokenAddresses(2)
+	confidence, sourceCount := 0.75, int32(3)
+	tokenPrices := []TokenPrice{
+		{
+			TokenAddr:   tokenAddrs[0],
+			TokenPrice:  assets.NewWei(big.NewInt(1e18)),
+			Confidence:  &confidence,
+			SourceCount: &sourceCount,
+		},
+		{
+			TokenAddr:  tokenAddrs[1],
+			TokenPrice: assets.NewWei(big.NewInt(2e18)),
+		},
+	}
+	_, err := orm.UpsertTokenPricesForDestChain(ctx, destSelector, tokenPrices, 0)
+	require.NoError(t, err)
+
+	dbTokenPrices, err := orm.GetTokenPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	require.Len(t, dbTokenPrices, 2)
+	for _, price := range dbTokenPrices {
+		switch price.TokenAddr {
+		case tokenAddrs[0]:
+			assert.Equal(t, &confidence, price.Confidence)
+			assert.Equal(t, &sourceCount, price.SourceCount)
+		case tokenAddrs[1]:
+			assert.Nil(t, price.Confidence)
+			assert.Nil(t, price.SourceCount)
+		}
+	}
+
+	history, err := orm.GetTokenPriceHistory(ctx, destSelector, tokenAddrs[0], time.Now().Add(-time.Minute), time.Now())
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, &confidence, history[0].Confidence)
+}
+
 func Benchmark_UpsertsTheSameTokenPrices(b *testing.B) {
 	db := pgtest.NewSqlxDB(b)
 	orm, err := NewORM(db, logger.NullLogger)
@@ -67,6 +67,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
+	ccip2 "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
@@ -135,6 +136,9 @@ type Application interface {
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// GetCCIPPriceServices returns the price services of the CCIP commit plugins running on this node.
+	GetCCIPPriceServices() *ccip2.PriceServiceRegistry
+
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast (evm only)
 	// is set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error
@@ -180,6 +184,7 @@ type ChainlinkApplication struct {
 	profiler                 *pyroscope.Profiler
 	loopRegistry             *plugins.LoopRegistry
 	loopRegistrarConfig      plugins.RegistrarConfig
+	ccipPriceServices        *ccip2.PriceServiceRegistry
 
 	started     bool
 	startStopMu sync.Mutex
@@ -615,6 +620,8 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		globalLogger.Debug("Off-chain reporting disabled")
 	}
 
+	// Filled by the CCIP commit plugins of the OCR2 delegate, it stays empty when OCR2 is disabled
+	ccipPriceServices := ccip2.NewPriceServiceRegistry()
 	if cfg.OCR2().Enabled() {
 		globalLogger.Debug("Off-chain reporting v2 enabled")
 
@@ -638,6 +645,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				MailMon:               mailMon,
 				CapabilitiesRegistry:  opts.CapabilitiesRegistry,
 				RetirementReportCache: opts.RetirementReportCache,
+				CCIPPriceServices:     ccipPriceServices,
 			},
 			ocr2DelegateConfig,
 		)
@@ -740,6 +748,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		profiler:                 profiler,
 		loopRegistry:             loopRegistry,
 		loopRegistrarConfig:      loopRegistrarConfig,
+		ccipPriceServices:        ccipPriceServices,
 
 		ds: opts.DS,
 
@@ -1261,6 +1270,10 @@ func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+func (app *ChainlinkApplication) GetCCIPPriceServices() *ccip2.PriceServiceRegistry {
+	return app.ccipPriceServices
+}
+
 // ReplayFromBlock implements the Application interface.
 func (app *ChainlinkApplication) ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error {
 	switch chainFamily {
@@ -51,6 +51,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
+	ccip2 "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipcommit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipexec"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
@@ -122,6 +123,7 @@ type Delegate struct {
 	isNewlyCreatedJob     bool // Set to true if this is a new job freshly added, false if job was present already on node boot.
 	mailMon               *mailbox.Monitor
 	retirementReportCache retirement.RetirementReportCache
+	ccipPriceServices     *ccip2.PriceServiceRegistry
 
 	legacyChains         legacyevm.LegacyChainContainer // legacy: use relayers instead
 	capabilitiesRegistry core.CapabilitiesRegistry
@@ -232,6 +234,8 @@ type DelegateOpts struct {
 	MailMon               *mailbox.Monitor
 	CapabilitiesRegistry  core.CapabilitiesRegistry
 	RetirementReportCache retirement.RetirementReportCache
+	// CCIPPriceServices tracks the price services of the CCIP commit plugins, they are not tracked when nil
+	CCIPPriceServices *ccip2.PriceServiceRegistry
 }
 
 func NewDelegate(
@@ -257,6 +261,7 @@ func NewDelegate(
 		mailMon:               opts.MailMon,
 		capabilitiesRegistry:  opts.CapabilitiesRegistry,
 		retirementReportCache: opts.RetirementReportCache,
+		ccipPriceServices:     opts.CCIPPriceServices,
 	}
 }
 
@@ -323,6 +328,11 @@ func (d *Delegate) cleanupEVM(ctx context.Context, jb job.Job, relayID types.Rel
 		}
 		filters = append(filters, filters21...)
 	case types.CCIPCommit:
+		if err = ccipcommit.DeleteCommitPluginPrices(ctx, d.ds, d.lggr, jb.ID); err != nil {
+			// Price cleanup is optimistic, the prices are deleted as stale eventually anyway
+			d.lggr.Errorw("failed to delete ccip commit plugin prices", "err", err, "spec", spec)
+		}
+
 		// Write PluginConfig bytes to send source/dest relayer provider + info outside of top level rargs/pargs over the wire
 		var pluginJobSpecConfig ccipconfig.CommitPluginJobSpecConfig
 		err = json.Unmarshal(spec.PluginConfig.Bytes(), &pluginJobSpecConfig)
@@ -1686,14 +1696,18 @@ func (d *Delegate) newServicesCCIPCommit(ctx context.Context, lggr logger.Sugare
 		logError,
 		pluginJobSpecConfig,
 		d.RelayGetter,
+		d.cfg.Mercury(),
+		d.ccipPriceServices,
 	)
 }
 
-func newCCIPCommitPluginBytes(isSourceProvider bool, sourceStartBlock uint64, destStartBlock uint64) config.CommitPluginConfig {
+func newCCIPCommitPluginBytes(isSourceProvider bool, sourceStartBlock uint64, destStartBlock uint64, typeAndVersionOverrides map[cciptypes.Address]string, logsConfig *ccipconfig.ReaderLogsConfig) config.CommitPluginConfig {
 	return config.CommitPluginConfig{
-		IsSourceProvider: isSourceProvider,
-		SourceStartBlock: sourceStartBlock,
-		DestStartBlock:   destStartBlock,
+		IsSourceProvider:        isSourceProvider,
+		SourceStartBlock:        sourceStartBlock,
+		DestStartBlock:          destStartBlock,
+		TypeAndVersionOverrides: typeAndVersionOverrides,
+		LogsConfig:              logsConfig,
 	}
 }
 
@@ -1709,7 +1723,7 @@ func (d *Delegate) ccipCommitGetDstProvider(ctx context.Context, jb job.Job, plu
 	}
 
 	// Write PluginConfig bytes to send source/dest relayer provider + info outside of top level rargs/pargs over the wire
-	dstConfigBytes, err := newCCIPCommitPluginBytes(false, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock).Encode()
+	dstConfigBytes, err := newCCIPCommitPluginBytes(false, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock, pluginJobSpecConfig.TypeAndVersionOverrides, pluginJobSpecConfig.DestLogs).Encode()
 	if err != nil {
 		return nil, err
 	}
@@ -1743,7 +1757,7 @@ func (d *Delegate) ccipCommitGetDstProvider(ctx context.Context, jb job.Job, plu
 
 func (d *Delegate) ccipCommitGetSrcProvider(ctx context.Context, jb job.Job, pluginJobSpecConfig ccipconfig.CommitPluginJobSpecConfig, transmitterID string, dstProvider types.CCIPCommitProvider) (srcProvider types.CCIPCommitProvider, srcChainID uint64, err error) {
 	spec := jb.OCR2OracleSpec
-	srcConfigBytes, err := newCCIPCommitPluginBytes(true, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock).Encode()
+	srcConfigBytes, err := newCCIPCommitPluginBytes(true, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock, pluginJobSpecConfig.TypeAndVersionOverrides, pluginJobSpecConfig.SourceLogs).Encode()
 	if err != nil {
 		return nil, 0, err
 	}
@@ -1870,7 +1884,7 @@ func (d *Delegate) ccipExecGetDstProvider(ctx context.Context, jb job.Job, plugi
 
 	// PROVIDER BASED ARG CONSTRUCTION
 	// Write PluginConfig bytes to send source/dest relayer provider + info outside of top level rargs/pargs over the wire
-	dstConfigBytes, err := newExecPluginConfig(false, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock, pluginJobSpecConfig.USDCConfig, pluginJobSpecConfig.LBTCConfig, string(jb.ID)).Encode()
+	dstConfigBytes, err := newExecPluginConfig(false, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock, pluginJobSpecConfig.USDCConfig, pluginJobSpecConfig.LBTCConfig, string(jb.ID), pluginJobSpecConfig.TypeAndVersionOverrides, pluginJobSpecConfig.DestLogs).Encode()
 	if err != nil {
 		return nil, err
 	}
@@ -1903,7 +1917,7 @@ func (d *Delegate) ccipExecGetDstProvider(ctx context.Context, jb job.Job, plugi
 
 func (d *Delegate) ccipExecGetSrcProvider(ctx context.Context, jb job.Job, pluginJobSpecConfig ccipconfig.ExecPluginJobSpecConfig, transmitterID string, dstProvider types.CCIPExecProvider) (srcProvider types.CCIPExecProvider, srcChainID uint64, err error) {
 	spec := jb.OCR2OracleSpec
-	srcConfigBytes, err := newExecPluginConfig(true, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock, pluginJobSpecConfig.USDCConfig, pluginJobSpecConfig.LBTCConfig, string(jb.ID)).Encode()
+	srcConfigBytes, err := newExecPluginConfig(true, pluginJobSpecConfig.SourceStartBlock, pluginJobSpecConfig.DestStartBlock, pluginJobSpecConfig.USDCConfig, pluginJobSpecConfig.LBTCConfig, string(jb.ID), pluginJobSpecConfig.TypeAndVersionOverrides, pluginJobSpecConfig.SourceLogs).Encode()
 	if err != nil {
 		return nil, 0, err
 	}
@@ -1952,14 +1966,16 @@ func (d *Delegate) ccipExecGetSrcProvider(ctx context.Context, jb job.Job, plugi
 	return
 }
 
-func newExecPluginConfig(isSourceProvider bool, srcStartBlock uint64, dstStartBlock uint64, usdcConfig ccipconfig.USDCConfig, lbtcConfig ccipconfig.LBTCConfig, jobID string) config.ExecPluginConfig {
+func newExecPluginConfig(isSourceProvider bool, srcStartBlock uint64, dstStartBlock uint64, usdcConfig ccipconfig.USDCConfig, lbtcConfig ccipconfig.LBTCConfig, jobID string, typeAndVersionOverrides map[cciptypes.Address]string, logsConfig *ccipconfig.ReaderLogsConfig) config.ExecPluginConfig {
 	return config.ExecPluginConfig{
-		IsSourceProvider: isSourceProvider,
-		SourceStartBlock: srcStartBlock,
-		DestStartBlock:   dstStartBlock,
-		USDCConfig:       usdcConfig,
-		LBTCConfig:       lbtcConfig,
-		JobID:            jobID,
+		IsSourceProvider:        isSourceProvider,
+		SourceStartBlock:        srcStartBlock,
+		DestStartBlock:          dstStartBlock,
+		USDCConfig:              usdcConfig,
+		LBTCConfig:              lbtcConfig,
+		JobID:                   jobID,
+		TypeAndVersionOverrides: typeAndVersionOverrides,
+		LogsConfig:              logsConfig,
 	}
 }
 
@@ -134,6 +134,7 @@ func (rf *CommitReportingPluginFactory) NewReportingPluginFn(ctx context.Context
 			metricsCollector:        rf.config.metricsCollector,
 			chainHealthcheck:        rf.config.chainHealthcheck,
 			priceService:            rf.config.priceService,
+			priceSourceSelection:    rf.config.priceSourceSelection,
 		}
 
 		pluginInfo := types.ReportingPluginInfo{
@@ -2,17 +2,22 @@ package ccipcommit
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	chainselectors "github.com/smartcontractkit/chain-selectors"
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
 	"go.uber.org/multierr"
@@ -30,8 +35,10 @@ import (
 	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
 	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
 
+	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -41,6 +48,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/observability"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/oraclelib"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
@@ -53,6 +61,8 @@ var defaultNewReportingPluginRetryConfig = ccipdata.RetryConfig{
 	MaxRetries: (6 * 4) + 10,
 }
 
+const dataStreamsRequestTimeout = 10 * time.Second
+
 func NewCommitServices(
 	ctx context.Context,
 	ds sqlutil.DataSource,
@@ -68,6 +78,8 @@ func NewCommitServices(
 	logError func(string),
 	pluginJobSpecConfig ccipconfig.CommitPluginJobSpecConfig,
 	relayGetter RelayGetter,
+	mercuryCredentials MercuryCredentialsGetter,
+	priceServices *ccip.PriceServiceRegistry,
 ) ([]job.ServiceCtx, error) {
 	spec := jb.OCR2OracleSpec
 
@@ -133,11 +145,24 @@ func NewCommitServices(
 	}
 
 	priceGetter, err := initCommitPriceGetter(ctx, lggr, pluginJobSpecConfig, jb, sourceNative,
-		pr, relayGetter, srcChain.Selector, dstChain.Selector)
+		pr, relayGetter, mercuryCredentials, srcChain.Selector, dstChain.Selector)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create price getter: %w", err)
 	}
 
+	if estimatorConfig, ok := pluginJobSpecConfig.EIP1559GasPriceEstimators[srcChain.Selector]; ok {
+		commitStoreReader, err = withEIP1559GasPriceEstimator(srcProvider, commitStoreReader, estimatorConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create eip1559 gas price estimator: %w", err)
+		}
+	}
+	if pluginJobSpecConfig.SimulatedGasPriceSchedule != "" {
+		commitStoreReader, err = withSimulatedGasPriceEstimator(lggr, commitStoreReader, pluginJobSpecConfig.SimulatedGasPriceSchedule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create simulated gas price estimator: %w", err)
+		}
+	}
+
 	// Prom wrappers
 	onRampReader = observability.NewObservedOnRampReader(onRampReader, sourceChainID, ccip.CommitPluginLabel)
 	commitStoreReader = observability.NewObservedCommitStoreReader(commitStoreReader, destChainID, ccip.CommitPluginLabel)
@@ -183,6 +208,85 @@ func NewCommitServices(
 	}
 	// --------------------------------------------------------------------------------
 
+	if batchConfig := pluginJobSpecConfig.PriceGetterBatch; batchConfig != nil {
+		batchedPriceGetter, ok := priceGetter.(ccip.BatchedPriceGetter)
+		if !ok {
+			return nil, fmt.Errorf("price getter batch config is set but price getter %T does not batch price queries", priceGetter)
+		}
+		if err = batchedPriceGetter.SetBatchConfig(*batchConfig); err != nil {
+			return nil, fmt.Errorf("set price getter batch config: %w", err)
+		}
+	}
+
+	if len(pluginJobSpecConfig.PriceGetterRateLimits) > 0 {
+		priceGetter, err = withRateLimits(lggr, pluginJobSpecConfig.PriceGetterRateLimits, priceGetter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if pluginJobSpecConfig.UniswapV3TWAPPriceGetterConfig != nil {
+		priceGetter, err = newUniswapV3TWAPPriceGetter(ctx, lggr, spec, relayGetter, *pluginJobSpecConfig.UniswapV3TWAPPriceGetterConfig, priceGetter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	circuitBreakerConfig := pluginJobSpecConfig.PriceGetterCircuitBreaker
+	priceGetter, err = withCircuitBreaker(lggr, "jobSpec", circuitBreakerConfig, priceGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cross rates are applied before aggregation, the additional price sources return USD prices
+	if len(pluginJobSpecConfig.CrossRates) > 0 {
+		priceGetter, err = ccip.NewCrossRatePriceGetter(lggr.Named("CrossRates"), pluginJobSpecConfig.CrossRates, priceGetter)
+		if err != nil {
+			return nil, fmt.Errorf("creating cross rate price getter: %w", err)
+		}
+	}
+
+	if pluginJobSpecConfig.PriceAggregation != nil {
+		priceGetter, err = newAggregatedPriceGetter(ctx, lggr, spec, relayGetter, *pluginJobSpecConfig.PriceAggregation, circuitBreakerConfig, priceGetter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if pluginJobSpecConfig.PriceGetterCache != nil {
+		priceGetter, err = withPriceGetterCache(lggr, pluginJobSpecConfig, srcChain.Selector, dstChain.Selector, priceGetter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Price overrides apply on top of all price sources, including the prices shared with the other lanes
+	if len(pluginJobSpecConfig.PriceOverrides) > 0 {
+		lggr.Warnw("Price overrides are configured, the prices of the overridden tokens are fixed until they expire",
+			"priceOverrides", pluginJobSpecConfig.PriceOverrides)
+		priceGetter, err = ccip.NewFixedPriceGetter(lggr.Named("PriceOverrides"), pluginJobSpecConfig.PriceOverrides, priceGetter)
+		if err != nil {
+			return nil, fmt.Errorf("creating price overrides: %w", err)
+		}
+	}
+
+	priceServiceOpts, err := getPriceServiceOptions(pluginJobSpecConfig.PriceService)
+	if err != nil {
+		return nil, err
+	}
+	priceServiceOpts.PriceUpdater = ccipcalc.EvmAddrToGeneric(commitStoreAddress)
+	priceServiceOpts.Registry = priceServices
+	if pluginJobSpecConfig.PriceService != nil && pluginJobSpecConfig.PriceService.BatchWriteWindow != nil {
+		priceServiceOpts.WriteBatcher, err = sharedPriceWriteBatcher(lggr, orm, pluginJobSpecConfig.PriceService.BatchWriteWindow.Duration())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = pluginJobSpecConfig.PriceSourceSelection.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid price source selection: %w", err)
+	}
+
 	priceService := db.NewPriceService(
 		lggr,
 		orm,
@@ -192,6 +296,7 @@ func NewCommitServices(
 		sourceNative,
 		priceGetter,
 		offRampReader,
+		priceServiceOpts,
 	)
 
 	wrappedPluginFactory := NewCommitReportingPluginFactory(CommitPluginStaticConfig{
@@ -207,6 +312,7 @@ func NewCommitServices(
 		metricsCollector:              metricsCollector,
 		chainHealthcheck:              chainHealthCheck,
 		priceService:                  priceService,
+		priceSourceSelection:          pluginJobSpecConfig.PriceSourceSelection,
 	})
 	argsNoPlugin.ReportingPluginFactory = promwrapper.NewPromFactory(wrappedPluginFactory, "CCIPCommit", jb.OCR2OracleSpec.Relay, big.NewInt(0).SetInt64(destChainID))
 	argsNoPlugin.Logger = commonlogger.NewOCRWrapper(commitLggr, true, logError)
@@ -242,12 +348,14 @@ func initCommitPriceGetter(
 	sourceNativeTokenAddr cciptypes.Address,
 	pipelineRunner pipeline.Runner,
 	relayGetter RelayGetter,
+	mercuryCredentials MercuryCredentialsGetter,
 	sourceChainSelector uint64,
 	destChainSelector uint64,
 ) (priceGetter ccip.AllTokensPriceGetter, err error) {
 	spec := jb.OCR2OracleSpec
 	withPipeline := strings.Trim(pluginJobSpecConfig.TokenPricesUSDPipeline, "\n\t ") != ""
-	if withPipeline {
+	switch {
+	case withPipeline:
 		priceGetter, err = ccip.NewPipelineGetter(
 			pluginJobSpecConfig.TokenPricesUSDPipeline,
 			pipelineRunner,
@@ -262,84 +370,565 @@ func initCommitPriceGetter(
 		if err != nil {
 			return nil, fmt.Errorf("creating pipeline price getter: %w", err)
 		}
-	} else {
+	case len(pluginJobSpecConfig.TokenPricePipelines) > 0:
+		priceGetter, err = ccip.NewTokenPipelineGetter(
+			pluginJobSpecConfig.TokenPricePipelines,
+			pipelineRunner,
+			jb.ID,
+			jb.Name.ValueOrZero(),
+			lggr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("creating token pipeline price getter: %w", err)
+		}
+	case pluginJobSpecConfig.DataStreamsPriceGetterConfig != nil:
+		dataStreamsConfig := *pluginJobSpecConfig.DataStreamsPriceGetterConfig
+		credentials := mercuryCredentials.Credentials(dataStreamsConfig.CredentialName)
+		if credentials == nil {
+			return nil, fmt.Errorf("no mercury credentials named %q", dataStreamsConfig.CredentialName)
+		}
+		priceGetter, err = ccip.NewDataStreamsPriceGetter(lggr, dataStreamsConfig, *credentials, &http.Client{Timeout: dataStreamsRequestTimeout})
+		if err != nil {
+			return nil, fmt.Errorf("creating data streams price getter: %w", err)
+		}
+	default:
 		// Use dynamic price getter.
 		if pluginJobSpecConfig.PriceGetterConfig == nil {
 			return nil, errors.New("priceGetterConfig is nil")
 		}
 
-		// Configure contract readers for all chains specified in the aggregator configurations.
-		// Some lanes (e.g. Wemix/Kroma) requires other clients than source and destination, since they use feeds from other chains.
-		aggregatorChainsToContracts := make(map[uint64][]common.Address)
-		for _, aggCfg := range pluginJobSpecConfig.PriceGetterConfig.AggregatorPrices {
-			if _, ok := aggregatorChainsToContracts[aggCfg.ChainID]; !ok {
-				aggregatorChainsToContracts[aggCfg.ChainID] = make([]common.Address, 0)
-			}
+		priceGetter, err = newDynamicPriceGetter(ctx, spec, relayGetter, *pluginJobSpecConfig.PriceGetterConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return priceGetter, nil
+}
 
-			aggregatorChainsToContracts[aggCfg.ChainID] = append(aggregatorChainsToContracts[aggCfg.ChainID], aggCfg.AggregatorContractAddress)
+// newDynamicPriceGetter creates a dynamic price getter along with contract readers for all chains specified in
+// the aggregator configurations.
+func newDynamicPriceGetter(
+	ctx context.Context,
+	spec *job.OCR2OracleSpec,
+	relayGetter RelayGetter,
+	priceGetterConfig ccipconfig.DynamicPriceGetterConfig,
+) (*ccip.DynamicPriceGetter, error) {
+	// Configure contract readers for all chains specified in the aggregator configurations.
+	// Some lanes (e.g. Wemix/Kroma) requires other clients than source and destination, since they use feeds from other chains.
+	aggregatorChainsToContracts := make(map[uint64][]common.Address)
+	for _, aggCfg := range priceGetterConfig.AggregatorPrices {
+		if _, ok := aggregatorChainsToContracts[aggCfg.ChainID]; !ok {
+			aggregatorChainsToContracts[aggCfg.ChainID] = make([]common.Address, 0)
 		}
 
-		for _, priceCfg := range pluginJobSpecConfig.PriceGetterConfig.TokenPrices {
-			if priceCfg.AggregatorConfig == nil {
-				continue
-			}
-			aggCfg := *priceCfg.AggregatorConfig
-			contractAddrs, ok := aggregatorChainsToContracts[aggCfg.ChainID]
-			if !ok {
-				aggregatorChainsToContracts[aggCfg.ChainID] = make([]common.Address, 0)
-			}
-			if !slices.Contains(contractAddrs, aggCfg.AggregatorContractAddress) {
-				aggregatorChainsToContracts[aggCfg.ChainID] = append(aggregatorChainsToContracts[aggCfg.ChainID],
-					aggCfg.AggregatorContractAddress)
-			}
+		aggregatorChainsToContracts[aggCfg.ChainID] = append(aggregatorChainsToContracts[aggCfg.ChainID], aggCfg.AggregatorContractAddress)
+	}
+
+	for _, priceCfg := range priceGetterConfig.TokenPrices {
+		if priceCfg.AggregatorConfig == nil {
+			continue
+		}
+		aggCfg := *priceCfg.AggregatorConfig
+		contractAddrs, ok := aggregatorChainsToContracts[aggCfg.ChainID]
+		if !ok {
+			aggregatorChainsToContracts[aggCfg.ChainID] = make([]common.Address, 0)
+		}
+		if !slices.Contains(contractAddrs, aggCfg.AggregatorContractAddress) {
+			aggregatorChainsToContracts[aggCfg.ChainID] = append(aggregatorChainsToContracts[aggCfg.ChainID],
+				aggCfg.AggregatorContractAddress)
 		}
+	}
 
-		contractReaders := map[uint64]commontypes.ContractReader{}
+	contractReaders := map[uint64]commontypes.ContractReader{}
 
-		for chainID, aggregatorContracts := range aggregatorChainsToContracts {
-			relayID := commontypes.RelayID{Network: spec.Relay, ChainID: strconv.FormatUint(chainID, 10)}
-			relay, rerr := relayGetter.Get(relayID)
-			if rerr != nil {
-				return nil, fmt.Errorf("get relay by id=%v: %w", relayID, err)
-			}
+	for chainID, aggregatorContracts := range aggregatorChainsToContracts {
+		relayID := commontypes.RelayID{Network: spec.Relay, ChainID: strconv.FormatUint(chainID, 10)}
+		relay, rerr := relayGetter.Get(relayID)
+		if rerr != nil {
+			return nil, fmt.Errorf("get relay by id=%v: %w", relayID, rerr)
+		}
 
-			contractsConfig := make(map[string]evmrelaytypes.ChainContractReader, len(aggregatorContracts))
-			for i := range aggregatorContracts {
-				contractsConfig[fmt.Sprintf("%v_%v", ccip.OffchainAggregator, i)] = evmrelaytypes.ChainContractReader{
-					ContractABI: ccip.OffChainAggregatorABI,
-					Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
-						"decimals": { // CR consumers choose an alias
-							ChainSpecificName: "decimals",
-						},
-						"latestRoundData": {
-							ChainSpecificName: "latestRoundData",
-						},
+		contractsConfig := make(map[string]evmrelaytypes.ChainContractReader, len(aggregatorContracts))
+		for i := range aggregatorContracts {
+			contractsConfig[fmt.Sprintf("%v_%v", ccip.OffchainAggregator, i)] = evmrelaytypes.ChainContractReader{
+				ContractABI: ccip.OffChainAggregatorABI,
+				Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+					"decimals": { // CR consumers choose an alias
+						ChainSpecificName: "decimals",
 					},
-				}
-			}
-			contractReaderConfig := evmrelaytypes.ChainReaderConfig{
-				Contracts: contractsConfig,
+					"latestRoundData": {
+						ChainSpecificName: "latestRoundData",
+					},
+				},
 			}
+		}
+		contractReaderConfig := evmrelaytypes.ChainReaderConfig{
+			Contracts: contractsConfig,
+		}
 
-			contractReaderConfigJSONBytes, jerr := json.Marshal(contractReaderConfig)
-			if jerr != nil {
-				return nil, fmt.Errorf("marshal contract reader config: %w", jerr)
-			}
+		contractReaderConfigJSONBytes, jerr := json.Marshal(contractReaderConfig)
+		if jerr != nil {
+			return nil, fmt.Errorf("marshal contract reader config: %w", jerr)
+		}
+
+		contractReader, cerr := relay.NewContractReader(ctx, contractReaderConfigJSONBytes)
+		if cerr != nil {
+			return nil, fmt.Errorf("new ccip commit contract reader %w", cerr)
+		}
+
+		contractReaders[chainID] = contractReader
+	}
+
+	priceGetter, err := ccip.NewDynamicPriceGetter(priceGetterConfig, contractReaders)
+	if err != nil {
+		return nil, fmt.Errorf("creating dynamic price getter: %w", err)
+	}
+	return priceGetter, nil
+}
+
+// newUniswapV3TWAPPriceGetter wraps the price getter with a TWAP price getter along with contract readers for all
+// chains of the pools.
+func newUniswapV3TWAPPriceGetter(
+	ctx context.Context,
+	lggr logger.Logger,
+	spec *job.OCR2OracleSpec,
+	relayGetter RelayGetter,
+	twapConfig ccipconfig.UniswapV3TWAPPriceGetterConfig,
+	priceGetter ccip.AllTokensPriceGetter,
+) (*ccip.UniswapV3TWAPPriceGetter, error) {
+	poolsPerChain := make(map[uint64]int)
+	for _, pool := range twapConfig.Pools {
+		poolsPerChain[pool.ChainID]++
+	}
 
-			contractReader, cerr := relay.NewContractReader(ctx, contractReaderConfigJSONBytes)
-			if cerr != nil {
-				return nil, fmt.Errorf("new ccip commit contract reader %w", cerr)
+	contractReaders := map[uint64]commontypes.ContractReader{}
+	for chainID, numPools := range poolsPerChain {
+		relayID := commontypes.RelayID{Network: spec.Relay, ChainID: strconv.FormatUint(chainID, 10)}
+		relay, err := relayGetter.Get(relayID)
+		if err != nil {
+			return nil, fmt.Errorf("get relay by id=%v: %w", relayID, err)
+		}
+
+		// The pools are bound by index, a batch call binds at most one pool per token
+		contractsConfig := make(map[string]evmrelaytypes.ChainContractReader, numPools)
+		for i := range numPools {
+			contractsConfig[fmt.Sprintf("%v_%v", ccip.UniswapV3Pool, i)] = evmrelaytypes.ChainContractReader{
+				ContractABI: ccip.UniswapV3PoolABI,
+				Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+					ccip.ObserveMethodName: {
+						ChainSpecificName: ccip.ObserveMethodName,
+					},
+				},
 			}
+		}
 
-			contractReaders[chainID] = contractReader
+		contractReaderConfigJSONBytes, err := json.Marshal(evmrelaytypes.ChainReaderConfig{Contracts: contractsConfig})
+		if err != nil {
+			return nil, fmt.Errorf("marshal contract reader config: %w", err)
+		}
+		contractReader, err := relay.NewContractReader(ctx, contractReaderConfigJSONBytes)
+		if err != nil {
+			return nil, fmt.Errorf("new ccip commit contract reader %w", err)
 		}
+		contractReaders[chainID] = contractReader
+	}
+
+	twapPriceGetter, err := ccip.NewUniswapV3TWAPPriceGetter(lggr.Named("UniswapV3TWAP"), twapConfig, contractReaders, priceGetter)
+	if err != nil {
+		return nil, fmt.Errorf("creating uniswap v3 twap price getter: %w", err)
+	}
+	return twapPriceGetter, nil
+}
+
+// eip1559FeeSource is implemented by the in-process EVM source providers, the fee history of the source chain can't be
+// read through the providers of other relays.
+type eip1559FeeSource interface {
+	prices.FeeHistoryReader
+	SourceMaxGasPrice() *big.Int
+}
+
+// eip1559CommitStoreReader wraps the gas price estimator of the commit store, so that the execution gas price is derived
+// from the EIP-1559 fee history of the source chain.
+type eip1559CommitStoreReader struct {
+	ccipdata.CommitStoreReader
+	feeSource eip1559FeeSource
+	cfg       ccipconfig.EIP1559GasPriceEstimatorConfig
+}
 
-		priceGetter, err = ccip.NewDynamicPriceGetter(*pluginJobSpecConfig.PriceGetterConfig, contractReaders)
+func withEIP1559GasPriceEstimator(
+	srcProvider commontypes.CCIPCommitProvider,
+	commitStoreReader ccipdata.CommitStoreReader,
+	cfg ccipconfig.EIP1559GasPriceEstimatorConfig,
+) (ccipdata.CommitStoreReader, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid eip1559 gas price estimator config: %w", err)
+	}
+	feeSource, ok := srcProvider.(eip1559FeeSource)
+	if !ok {
+		return nil, fmt.Errorf("source provider %T can't read the fee history of the source chain", srcProvider)
+	}
+	return &eip1559CommitStoreReader{
+		CommitStoreReader: commitStoreReader,
+		feeSource:         feeSource,
+		cfg:               cfg,
+	}, nil
+}
+
+// GasPriceEstimator returns the gas price estimator of the commit store with the execution gas price derived from the
+// fee history, it is rebuilt on every config change of the commit store.
+func (r *eip1559CommitStoreReader) GasPriceEstimator(ctx context.Context) (cciptypes.GasPriceEstimatorCommit, error) {
+	estimator, err := r.CommitStoreReader.GasPriceEstimator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eip1559Estimator, err := prices.NewEIP1559GasPriceEstimator(estimator, r.feeSource, r.feeSource.SourceMaxGasPrice(), r.cfg)
+	if err != nil {
+		return nil, err
+	}
+	return eip1559Estimator, nil
+}
+
+// simulatedCommitStoreReader wraps the gas price estimator of the commit store, so that the gas price follows a
+// scripted schedule, which starts when the job is created and is kept across config changes of the commit store.
+type simulatedCommitStoreReader struct {
+	ccipdata.CommitStoreReader
+	schedule prices.SimulatedGasPriceSchedule
+	start    time.Time
+}
+
+func withSimulatedGasPriceEstimator(
+	lggr logger.Logger,
+	commitStoreReader ccipdata.CommitStoreReader,
+	schedule string,
+) (ccipdata.CommitStoreReader, error) {
+	if !build.IsDev() && !build.IsTest() {
+		return nil, fmt.Errorf("simulated gas prices are only available in dev and test builds, not in %s builds", build.Mode())
+	}
+	parsedSchedule, err := prices.ParseSimulatedGasPriceSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+	lggr.Warnw("Using simulated source gas prices", "steps", len(parsedSchedule.Steps), "repeat", parsedSchedule.Repeat)
+	return &simulatedCommitStoreReader{
+		CommitStoreReader: commitStoreReader,
+		schedule:          parsedSchedule,
+		start:             time.Now(),
+	}, nil
+}
+
+// GasPriceEstimator returns the gas price estimator of the commit store with the gas price replaced by the schedule.
+func (r *simulatedCommitStoreReader) GasPriceEstimator(ctx context.Context) (cciptypes.GasPriceEstimatorCommit, error) {
+	estimator, err := r.CommitStoreReader.GasPriceEstimator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	simulatedEstimator, err := prices.NewSimulatedGasPriceEstimator(estimator, r.schedule, r.start)
+	if err != nil {
+		return nil, err
+	}
+	return simulatedEstimator, nil
+}
+
+// newAggregatedPriceGetter wraps the job spec price getter with the redundant price getters of the aggregation config.
+func newAggregatedPriceGetter(
+	ctx context.Context,
+	lggr logger.Logger,
+	spec *job.OCR2OracleSpec,
+	relayGetter RelayGetter,
+	aggregationConfig ccipconfig.PriceAggregationConfig,
+	circuitBreakerConfig *ccipconfig.CircuitBreakerConfig,
+	priceGetter ccip.AllTokensPriceGetter,
+) (ccip.AllTokensPriceGetter, error) {
+	if err := aggregationConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid price aggregation config: %w", err)
+	}
+
+	priceGetters := []ccip.AllTokensPriceGetter{priceGetter}
+	for i, priceGetterConfig := range aggregationConfig.PriceGetterConfigs {
+		additionalPriceGetter, err := newDynamicPriceGetter(ctx, spec, relayGetter, priceGetterConfig)
+		if err != nil {
+			return nil, fmt.Errorf("creating price getter %d for aggregation: %w", i, err)
+		}
+		additionalPriceGetterWithCB, err := withCircuitBreaker(lggr, fmt.Sprintf("priceAggregation_%d", i), circuitBreakerConfig, additionalPriceGetter)
 		if err != nil {
-			return nil, fmt.Errorf("creating dynamic price getter: %w", err)
+			return nil, err
 		}
+		priceGetters = append(priceGetters, additionalPriceGetterWithCB)
 	}
-	return priceGetter, nil
+
+	aggregatedPriceGetter, err := ccip.NewAggregatedPriceGetter(lggr, aggregationConfig.AggregationMode(), priceGetters...)
+	if err != nil {
+		return nil, fmt.Errorf("creating aggregated price getter: %w", err)
+	}
+	return aggregatedPriceGetter, nil
+}
+
+// withCircuitBreaker wraps the price getter with a circuit breaker, the price getter is returned as is when circuitBreakerConfig is nil.
+func withCircuitBreaker(
+	lggr logger.Logger,
+	name string,
+	circuitBreakerConfig *ccipconfig.CircuitBreakerConfig,
+	priceGetter ccip.AllTokensPriceGetter,
+) (ccip.AllTokensPriceGetter, error) {
+	if circuitBreakerConfig == nil {
+		return priceGetter, nil
+	}
+	if err := circuitBreakerConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid price getter circuit breaker config: %w", err)
+	}
+
+	circuitBreaker, err := ccip.NewCircuitBreakerPriceGetter(
+		lggr,
+		name,
+		priceGetter,
+		int(circuitBreakerConfig.FailureThreshold),
+		circuitBreakerConfig.OpenDuration.Duration(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating circuit breaker for price getter %s: %w", name, err)
+	}
+	return circuitBreaker, nil
+}
+
+var (
+	hostRateLimitsOnce sync.Once
+	// hostRateLimits holds the request budgets of the hosts queried by the price getters of all lanes.
+	hostRateLimits *ccip.HostRateLimits
+)
+
+// withRateLimits sets the budgets of the hosts and wraps the price getter with the shared host rate limits.
+func withRateLimits(
+	lggr logger.Logger,
+	rateLimits map[string]ccipconfig.HostRateLimitConfig,
+	priceGetter ccip.AllTokensPriceGetter,
+) (ccip.AllTokensPriceGetter, error) {
+	httpPriceGetter, ok := priceGetter.(ccip.HTTPPriceGetter)
+	if !ok {
+		return nil, fmt.Errorf("price getter rate limits are set but price getter %T does not query HTTP APIs", priceGetter)
+	}
+
+	hostRateLimitsOnce.Do(func() {
+		hostRateLimits = ccip.NewHostRateLimits(lggr.Named("PriceGetterRateLimits"))
+	})
+	for host, rateLimit := range rateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid rate limit of host %s: %w", host, err)
+		}
+		if err := hostRateLimits.SetBudget(host, rateLimit.RequestsPerSecond, int(rateLimit.Burst)); err != nil {
+			return nil, err
+		}
+	}
+
+	rateLimitedPriceGetter, err := ccip.NewRateLimitedPriceGetter(hostRateLimits, httpPriceGetter)
+	if err != nil {
+		return nil, fmt.Errorf("creating rate limited price getter: %w", err)
+	}
+	return rateLimitedPriceGetter, nil
+}
+
+// withPriceGetterCache wraps the price getter with the price cache of the configured ttl. The cache key is derived from the
+// price sources of the job spec, so that only the lanes with the same price sources share prices.
+func withPriceGetterCache(
+	lggr logger.Logger,
+	pluginJobSpecConfig ccipconfig.CommitPluginJobSpecConfig,
+	sourceChainSelector uint64,
+	destChainSelector uint64,
+	priceGetter ccip.AllTokensPriceGetter,
+) (ccip.AllTokensPriceGetter, error) {
+	cacheConfig := pluginJobSpecConfig.PriceGetterCache
+	if err := cacheConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid price getter cache config: %w", err)
+	}
+
+	// The legacy pipeline getter assigns the chain selectors of the lane to the tokens of its pipeline.
+	var laneSelectors []uint64
+	if strings.Trim(pluginJobSpecConfig.TokenPricesUSDPipeline, "\n\t ") != "" {
+		laneSelectors = []uint64{sourceChainSelector, destChainSelector}
+	}
+	priceSources, err := json.Marshal(struct {
+		TokenPricesUSDPipeline       string
+		PriceGetterConfig            *ccipconfig.DynamicPriceGetterConfig
+		TokenPricePipelines          ccipconfig.TokenPricePipelinesConfig
+		DataStreamsPriceGetterConfig *ccipconfig.DataStreamsPriceGetterConfig
+		PriceAggregation             *ccipconfig.PriceAggregationConfig
+		LaneSelectors                []uint64
+	}{
+		TokenPricesUSDPipeline:       pluginJobSpecConfig.TokenPricesUSDPipeline,
+		PriceGetterConfig:            pluginJobSpecConfig.PriceGetterConfig,
+		TokenPricePipelines:          pluginJobSpecConfig.TokenPricePipelines,
+		DataStreamsPriceGetterConfig: pluginJobSpecConfig.DataStreamsPriceGetterConfig,
+		PriceAggregation:             pluginJobSpecConfig.PriceAggregation,
+		LaneSelectors:                laneSelectors,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal price sources: %w", err)
+	}
+	key := sha256.Sum256(priceSources)
+
+	cache, err := sharedPriceCache(cacheConfig.TTL.Duration())
+	if err != nil {
+		return nil, err
+	}
+	cachedPriceGetter, err := ccip.NewCachedPriceGetter(lggr, cache, hex.EncodeToString(key[:]), priceGetter)
+	if err != nil {
+		return nil, fmt.Errorf("creating cached price getter: %w", err)
+	}
+	return cachedPriceGetter, nil
+}
+
+var (
+	priceCachesMu sync.Mutex
+	// priceCaches are shared by the commit plugins of all lanes configured with the same price getter cache ttl,
+	// they are kept for the lifetime of the node.
+	priceCaches = make(map[time.Duration]*ccip.PriceCache)
+)
+
+// sharedPriceCache returns the price cache of the given ttl, creating it on first use.
+func sharedPriceCache(ttl time.Duration) (*ccip.PriceCache, error) {
+	priceCachesMu.Lock()
+	defer priceCachesMu.Unlock()
+
+	if cache, ok := priceCaches[ttl]; ok {
+		return cache, nil
+	}
+	cache, err := ccip.NewPriceCache(ttl)
+	if err != nil {
+		return nil, fmt.Errorf("creating price cache: %w", err)
+	}
+	priceCaches[ttl] = cache
+	return cache, nil
+}
+
+var (
+	priceWriteBatchersMu sync.Mutex
+	// priceWriteBatchers are shared by the commit plugins of all lanes configured with the same batch write window,
+	// they are kept for the lifetime of the node as they hold no resources while idle.
+	priceWriteBatchers = make(map[time.Duration]*db.PriceWriteBatcher)
+)
+
+// sharedPriceWriteBatcher returns the price write batcher of the given flush window, creating it on first use.
+func sharedPriceWriteBatcher(lggr logger.Logger, orm cciporm.ORM, flushWindow time.Duration) (*db.PriceWriteBatcher, error) {
+	priceWriteBatchersMu.Lock()
+	defer priceWriteBatchersMu.Unlock()
+
+	if batcher, ok := priceWriteBatchers[flushWindow]; ok {
+		return batcher, nil
+	}
+	batcher, err := db.NewPriceWriteBatcher(lggr, orm, flushWindow)
+	if err != nil {
+		return nil, fmt.Errorf("creating price write batcher: %w", err)
+	}
+	priceWriteBatchers[flushWindow] = batcher
+	return batcher, nil
+}
+
+// getPriceServiceOptions converts the job spec price service config to PriceService options,
+// token addresses are converted to generic addresses.
+func getPriceServiceOptions(priceServiceConfig *ccipconfig.PriceServiceConfig) (db.PriceServiceOptions, error) {
+	if priceServiceConfig == nil {
+		return db.PriceServiceOptions{}, nil
+	}
+	if err := priceServiceConfig.Validate(); err != nil {
+		return db.PriceServiceOptions{}, fmt.Errorf("invalid price service config: %w", err)
+	}
+
+	tokenUpdateIntervals := make(map[cciptypes.Address]time.Duration, len(priceServiceConfig.TokenUpdateIntervals))
+	for token, interval := range priceServiceConfig.TokenUpdateIntervals {
+		tokenUpdateIntervals[ccipcalc.EvmAddrToGeneric(token)] = interval.Duration()
+	}
+	opts := db.PriceServiceOptions{
+		TokenUpdateIntervals:          tokenUpdateIntervals,
+		AllowPartialTokenPriceUpdates: priceServiceConfig.AllowPartialTokenPriceUpdates,
+		DualWritePrices:               priceServiceConfig.DualWritePrices,
+		ReadConsolidatedPrices:        priceServiceConfig.ReadConsolidatedPrices,
+		PushPriceRefresh:              priceServiceConfig.PushPriceRefresh,
+		ConsistentPriceReads:          priceServiceConfig.ConsistentPriceReads,
+		WriteDeviationBps:             priceServiceConfig.WriteDeviationBps,
+		MinTokenPriceConfidence:       priceServiceConfig.MinTokenPriceConfidence,
+	}
+	if priceServiceConfig.StalePriceRetention != nil {
+		opts.StalePriceRetention = priceServiceConfig.StalePriceRetention.Duration()
+	}
+	if priceServiceConfig.MaxPriceAge != nil {
+		opts.MaxPriceAge = priceServiceConfig.MaxPriceAge.Duration()
+	}
+	if priceServiceConfig.WriteHeartbeat != nil {
+		opts.WriteHeartbeat = priceServiceConfig.WriteHeartbeat.Duration()
+	}
+	if bounds := priceServiceConfig.GasPriceBounds; bounds != nil {
+		opts.GasPriceBounds = &db.PriceBounds{Min: bounds.Min, Max: bounds.Max}
+	}
+	if bounds := priceServiceConfig.TokenPriceBounds; bounds != nil {
+		opts.TokenPriceBounds = &db.PriceBounds{Min: bounds.Min, Max: bounds.Max}
+	}
+	if endpointsConfig := priceServiceConfig.GasPriceEndpoints; endpointsConfig != nil {
+		gasPriceEndpoints := &db.GasPriceEndpoints{
+			MaxDeviationBps: endpointsConfig.MaxDeviationBps,
+			MinResponses:    int(endpointsConfig.MinResponses),
+		}
+		for _, endpoint := range endpointsConfig.Endpoints {
+			client, err := ethclient.Dial(endpoint.URL)
+			if err != nil {
+				return db.PriceServiceOptions{}, fmt.Errorf("failed to dial gas price endpoint %s: %w", endpoint.Name, err)
+			}
+			gasPriceEndpoints.Endpoints = append(gasPriceEndpoints.Endpoints, prices.NamedGasPriceEndpoint{
+				Name:     endpoint.Name,
+				Endpoint: prices.NewRPCGasPriceEndpoint(client),
+			})
+		}
+		opts.GasPriceEndpoints = gasPriceEndpoints
+	}
+	if dampening := priceServiceConfig.GasPriceDampening; dampening != nil {
+		opts.GasPriceDampening = &db.GasPriceDampening{
+			MaxMultiplier: dampening.MaxMultiplier,
+			MinMultiplier: dampening.MinMultiplier,
+		}
+		if dampening.Window != nil {
+			opts.GasPriceDampening.Window = dampening.Window.Duration()
+		}
+	}
+	if check := priceServiceConfig.NativePriceCheck; check != nil {
+		opts.NativePriceCheck = &db.NativePriceCheck{
+			DestToken:         ccipcalc.EvmAddrToGeneric(check.DestToken),
+			MaxDeviationBps:   check.MaxDeviationBps,
+			RejectOnDeviation: check.RejectOnDeviation,
+		}
+		if check.MaxOnChainPriceAge != nil {
+			opts.NativePriceCheck.MaxOnChainPriceAge = check.MaxOnChainPriceAge.Duration()
+		}
+	}
+	if signedPrices := priceServiceConfig.SignedPrices; signedPrices != nil {
+		priceVerifier, err := pricegetter.NewECDSAPriceVerifier(signedPrices.Signers, signedPrices.MaxPayloadAge.Duration())
+		if err != nil {
+			return db.PriceServiceOptions{}, fmt.Errorf("invalid signed prices config: %w", err)
+		}
+		opts.PriceVerifier = priceVerifier
+	}
+	for _, token := range priceServiceConfig.TokenAllowlist {
+		opts.TokenAllowlist = append(opts.TokenAllowlist, ccipcalc.EvmAddrToGeneric(token))
+	}
+	for _, token := range priceServiceConfig.TokenDenylist {
+		opts.TokenDenylist = append(opts.TokenDenylist, ccipcalc.EvmAddrToGeneric(token))
+	}
+	if len(priceServiceConfig.TokenMetadata) > 0 {
+		opts.TokenMetadata = make(map[cciptypes.Address]db.TokenMetadata, len(priceServiceConfig.TokenMetadata))
+		for token, metadata := range priceServiceConfig.TokenMetadata {
+			opts.TokenMetadata[ccipcalc.EvmAddrToGeneric(token)] = db.TokenMetadata{
+				Symbol:      metadata.Symbol,
+				CoinGeckoID: metadata.CoinGeckoID,
+			}
+		}
+	}
+	if quoteCurrency := priceServiceConfig.QuoteCurrency; quoteCurrency != nil {
+		opts.QuoteCurrency = &db.QuoteCurrency{
+			Symbol: quoteCurrency.Symbol,
+			Token: ccipcommon.TokenID{
+				TokenAddress:  ccipcalc.EvmAddrToGeneric(quoteCurrency.TokenAddress),
+				ChainSelector: quoteCurrency.ChainSelector,
+			},
+		}
+	}
+	return opts, nil
 }
 
 func CommitReportToEthTxMeta(typ ccipconfig.ContractType, ver semver.Version) (func(report []byte) (*txmgr.TxMeta, error), error) {
@@ -370,7 +959,27 @@ func UnregisterCommitPluginLpFilters(srcProvider commontypes.CCIPCommitProvider,
 	return multiErr
 }
 
+// DeleteCommitPluginPrices deletes the gas and token prices last written by the PriceService of the job, so that the
+// prices of a deleted lane do not feed the Commit observations of the other lanes of its dest chain.
+func DeleteCommitPluginPrices(ctx context.Context, ds sqlutil.DataSource, lggr logger.Logger, jobID int32) error {
+	orm, err := cciporm.NewORM(ds, lggr)
+	if err != nil {
+		return err
+	}
+	rowsDeleted, err := orm.DeletePricesByJobID(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to delete prices of job %d: %w", jobID, err)
+	}
+	lggr.Infow("Deleted prices of job", "jobID", jobID, "rowsDeleted", rowsDeleted)
+	return nil
+}
+
 type RelayGetter interface {
 	Get(id commontypes.RelayID) (loop.Relayer, error)
 	GetIDToRelayerMap() (map[commontypes.RelayID]loop.Relayer, error)
 }
+
+// MercuryCredentialsGetter resolves the Mercury credentials of the node secrets by name, they are used to query Data Streams.
+type MercuryCredentialsGetter interface {
+	Credentials(credName string) *commontypes.MercuryCredentials
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
@@ -62,9 +63,10 @@ type CommitPluginStaticConfig struct {
 	destChainSelector     uint64
 	priceRegistryProvider ccipdataprovider.PriceRegistry
 	// Offchain
-	metricsCollector ccip.PluginMetricsCollector
-	chainHealthcheck cache.ChainHealthcheck
-	priceService     db.PriceService
+	metricsCollector     ccip.PluginMetricsCollector
+	chainHealthcheck     cache.ChainHealthcheck
+	priceService         db.PriceService
+	priceSourceSelection ccipconfig.PriceSourceSelection
 }
 
 type CommitReportingPlugin struct {
@@ -86,7 +88,8 @@ type CommitReportingPlugin struct {
 	// State
 	chainHealthcheck cache.ChainHealthcheck
 	// DB
-	priceService db.PriceService
+	priceService         db.PriceService
+	priceSourceSelection ccipconfig.PriceSourceSelection
 }
 
 // Query is not used by the CCIP Commit plugin.
@@ -159,6 +162,13 @@ func (r *CommitReportingPlugin) observePriceUpdates(
 		return nil, nil, nil, fmt.Errorf("failed to get prices from PriceService: %w", err)
 	}
 
+	if r.priceSourceSelection == ccipconfig.PriceSourceFreshest {
+		gasPricesUSD, tokenPricesUSD, err = r.selectFreshestPrices(ctx, gasPricesUSD, tokenPricesUSD)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	// Set prices to empty maps if nil to be friendlier to JSON encoding
 	if gasPricesUSD == nil {
 		gasPricesUSD = map[uint64]*big.Int{}
@@ -173,6 +183,63 @@ func (r *CommitReportingPlugin) observePriceUpdates(
 	return gasPricesUSD, sourceGasPriceUSD, tokenPricesUSD, nil
 }
 
+// selectFreshestPrices replaces the DB gas and token prices with the prices last written to the dest price registry
+// whenever the on-chain price is fresher, e.g. while the PriceService of this node fails to refresh some prices but
+// other nodes keep updating them on-chain. Only the prices returned by the DB are considered, so a price missing from
+// the DB is not observed either. The DB prices are used as is when the on-chain prices can't be read.
+func (r *CommitReportingPlugin) selectFreshestPrices(
+	ctx context.Context,
+	gasPricesUSD map[uint64]*big.Int,
+	tokenPricesUSD map[cciptypes.Address]*big.Int,
+) (map[uint64]*big.Int, map[cciptypes.Address]*big.Int, error) {
+	dbGasPrices, dbTokenPrices, err := r.priceService.GetGasAndTokenPricesWithTimestamps(ctx, r.destChainSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get price timestamps from PriceService: %w", err)
+	}
+
+	now := time.Now()
+	onChainGasPrices, err := r.getLatestGasPriceUpdate(ctx, now)
+	if err != nil {
+		r.lggr.Warnw("Failed to read on-chain gas prices, observing the DB prices", "err", err)
+		return gasPricesUSD, tokenPricesUSD, nil
+	}
+	onChainTokenPrices, err := r.getLatestTokenPriceUpdates(ctx, now)
+	if err != nil {
+		r.lggr.Warnw("Failed to read on-chain token prices, observing the DB prices", "err", err)
+		return gasPricesUSD, tokenPricesUSD, nil
+	}
+
+	selectedGasPrices := make(map[uint64]*big.Int, len(gasPricesUSD))
+	onChainGasSelected := make(map[uint64]time.Time)
+	for chainSelector, price := range gasPricesUSD {
+		selectedGasPrices[chainSelector] = price
+		onChainUpdate, ok := onChainGasPrices[chainSelector]
+		if ok && onChainUpdate.timestamp.After(dbGasPrices[chainSelector].UpdatedAt) {
+			selectedGasPrices[chainSelector] = onChainUpdate.value
+			onChainGasSelected[chainSelector] = onChainUpdate.timestamp
+		}
+	}
+
+	selectedTokenPrices := make(map[cciptypes.Address]*big.Int, len(tokenPricesUSD))
+	onChainTokenSelected := make(map[cciptypes.Address]time.Time)
+	for token, price := range tokenPricesUSD {
+		selectedTokenPrices[token] = price
+		onChainUpdate, ok := onChainTokenPrices[token]
+		if ok && onChainUpdate.timestamp.After(dbTokenPrices[token].UpdatedAt) {
+			selectedTokenPrices[token] = onChainUpdate.value
+			onChainTokenSelected[token] = onChainUpdate.timestamp
+		}
+	}
+
+	if len(onChainGasSelected) > 0 || len(onChainTokenSelected) > 0 {
+		r.lggr.Infow("Observing on-chain prices fresher than the DB prices",
+			"gasPrices", onChainGasSelected,
+			"tokenPrices", onChainTokenSelected,
+		)
+	}
+	return selectedGasPrices, selectedTokenPrices, nil
+}
+
 func (r *CommitReportingPlugin) calculateMinMaxSequenceNumbers(ctx context.Context, lggr logger.Logger) (uint64, uint64, []cciptypes.Hash, error) {
 	nextSeqNum, err := r.commitStoreReader.GetExpectedNextSequenceNumber(ctx)
 	if err != nil {
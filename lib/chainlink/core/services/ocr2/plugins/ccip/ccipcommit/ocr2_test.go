@@ -42,6 +42,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
 	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
+	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
 	ccipdbmocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 )
@@ -750,6 +751,119 @@ func TestCommitReportingPlugin_observePriceUpdates(t *testing.T) {
 	}
 }
 
+func TestCommitReportingPlugin_observePriceUpdates_freshest(t *testing.T) {
+	destChainSelector := uint64(12345)
+	sourceChainSelector := uint64(67890)
+	otherChainSelector := uint64(13579)
+
+	token1 := ccipcalc.HexToAddress("0x123")
+	token2 := ccipcalc.HexToAddress("0x234")
+
+	now := time.Now().Truncate(time.Second)
+	dbGasPrices := map[uint64]db.TimestampedPrice{
+		sourceChainSelector: {Value: big.NewInt(1e18), UpdatedAt: now.Add(-10 * time.Minute)},
+		otherChainSelector:  {Value: big.NewInt(4e18), UpdatedAt: now.Add(-time.Minute)},
+	}
+	dbTokenPrices := map[cciptypes.Address]db.TimestampedPrice{
+		token1: {Value: big.NewInt(2e18), UpdatedAt: now.Add(-time.Minute)},
+		token2: {Value: big.NewInt(3e18), UpdatedAt: now.Add(-10 * time.Minute)},
+	}
+
+	onChainGasPriceUpdates := []cciptypes.GasPriceUpdateWithTxMeta{
+		{GasPriceUpdate: cciptypes.GasPriceUpdate{
+			GasPrice:         cciptypes.GasPrice{DestChainSelector: sourceChainSelector, Value: big.NewInt(5e18)},
+			TimestampUnixSec: big.NewInt(now.Add(-5 * time.Minute).Unix()),
+		}},
+		{GasPriceUpdate: cciptypes.GasPriceUpdate{
+			GasPrice:         cciptypes.GasPrice{DestChainSelector: otherChainSelector, Value: big.NewInt(6e18)},
+			TimestampUnixSec: big.NewInt(now.Add(-5 * time.Minute).Unix()),
+		}},
+	}
+	onChainTokenPriceUpdates := []cciptypes.TokenPriceUpdateWithTxMeta{
+		{TokenPriceUpdate: cciptypes.TokenPriceUpdate{
+			TokenPrice:       cciptypes.TokenPrice{Token: token1, Value: big.NewInt(7e18)},
+			TimestampUnixSec: big.NewInt(now.Add(-5 * time.Minute).Unix()),
+		}},
+		{TokenPriceUpdate: cciptypes.TokenPriceUpdate{
+			TokenPrice:       cciptypes.TokenPrice{Token: token2, Value: big.NewInt(8e18)},
+			TimestampUnixSec: big.NewInt(now.Add(-5 * time.Minute).Unix()),
+		}},
+	}
+
+	testCases := []struct {
+		name                string
+		onChainErr          error
+		expectedGasPrices   map[uint64]*big.Int
+		expectedTokenPrices map[cciptypes.Address]*big.Int
+	}{
+		{
+			name: "fresher on-chain prices are observed",
+			expectedGasPrices: map[uint64]*big.Int{
+				sourceChainSelector: big.NewInt(5e18),
+				otherChainSelector:  big.NewInt(4e18),
+			},
+			expectedTokenPrices: map[cciptypes.Address]*big.Int{
+				token1: big.NewInt(2e18),
+				token2: big.NewInt(8e18),
+			},
+		},
+		{
+			name:       "db prices are observed when on-chain prices can't be read",
+			onChainErr: errors.New("rpc error"),
+			expectedGasPrices: map[uint64]*big.Int{
+				sourceChainSelector: big.NewInt(1e18),
+				otherChainSelector:  big.NewInt(4e18),
+			},
+			expectedTokenPrices: map[cciptypes.Address]*big.Int{
+				token1: big.NewInt(2e18),
+				token2: big.NewInt(3e18),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tests.Context(t)
+
+			mockPriceService := ccipdbmocks.NewPriceService(t)
+			mockPriceService.On("GetGasAndTokenPrices", ctx, destChainSelector).Return(
+				map[uint64]*big.Int{
+					sourceChainSelector: dbGasPrices[sourceChainSelector].Value,
+					otherChainSelector:  dbGasPrices[otherChainSelector].Value,
+				},
+				map[cciptypes.Address]*big.Int{
+					token1: dbTokenPrices[token1].Value,
+					token2: dbTokenPrices[token2].Value,
+				},
+				nil,
+			)
+			mockPriceService.On("GetGasAndTokenPricesWithTimestamps", ctx, destChainSelector).Return(dbGasPrices, dbTokenPrices, nil)
+
+			priceRegistryReader := ccipdatamocks.NewPriceRegistryReader(t)
+			priceRegistryReader.On("GetAllGasPriceUpdatesCreatedAfter", ctx, mock.Anything, 0).Return(onChainGasPriceUpdates, tc.onChainErr)
+			priceRegistryReader.On("GetTokenPriceUpdatesCreatedAfter", ctx, mock.Anything, 0).Return(onChainTokenPriceUpdates, nil).Maybe()
+
+			p := &CommitReportingPlugin{
+				lggr:                    logger.TestLogger(t),
+				destChainSelector:       destChainSelector,
+				sourceChainSelector:     sourceChainSelector,
+				destPriceRegistryReader: priceRegistryReader,
+				priceService:            mockPriceService,
+				priceSourceSelection:    ccipconfig.PriceSourceFreshest,
+				offchainConfig: cciptypes.CommitOffchainConfig{
+					GasPriceHeartBeat:   time.Hour,
+					TokenPriceHeartBeat: time.Hour,
+				},
+			}
+			gasPricesUSD, sourceGasPriceUSD, tokenPricesUSD, err := p.observePriceUpdates(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedGasPrices, gasPricesUSD)
+			assert.Equal(t, tc.expectedTokenPrices, tokenPricesUSD)
+			assert.Equal(t, tc.expectedGasPrices[sourceChainSelector], sourceGasPriceUSD)
+		})
+	}
+}
+
 type CommitObservationLegacy struct {
 	Interval          cciptypes.CommitStoreInterval  `json:"interval"`
 	TokenPricesUSD    map[cciptypes.Address]*big.Int `json:"tokensPerFeeCoin"`
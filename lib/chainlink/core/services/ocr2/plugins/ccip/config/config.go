@@ -3,13 +3,17 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/bytes"
 
@@ -26,11 +30,69 @@ type CommitPluginJobSpecConfig struct {
 	TokenPricesUSDPipeline string `json:"tokenPricesUSDPipeline,omitempty"`
 	// PriceGetterConfig defines where to get the token prices from (i.e. static or aggregator source).
 	PriceGetterConfig *DynamicPriceGetterConfig `json:"priceGetterConfig,omitempty"`
+	// TokenPricePipelines defines a pipeline per token, e.g. a median over several bridge tasks.
+	TokenPricePipelines TokenPricePipelinesConfig `json:"tokenPricePipelines,omitempty"`
+	// DataStreamsPriceGetterConfig reads the token prices from Data Streams reports instead of on-chain aggregators.
+	DataStreamsPriceGetterConfig *DataStreamsPriceGetterConfig `json:"dataStreamsPriceGetterConfig,omitempty"`
+	// PriceAggregation optionally defines redundant price sources, their prices are aggregated with the prices
+	// of the job spec price getter.
+	PriceAggregation *PriceAggregationConfig `json:"priceAggregation,omitempty"`
+	// PriceService optionally tunes the background price updates of the commit plugin.
+	PriceService *PriceServiceConfig `json:"priceService,omitempty"`
+	// PriceSourceSelection defines how the commit plugin picks between the prices of the PriceService DB and the
+	// prices last written on-chain to the dest price registry, defaults to the DB prices.
+	PriceSourceSelection PriceSourceSelection `json:"priceSourceSelection,omitempty"`
+	// PriceGetterCircuitBreaker optionally stops querying price sources which keep failing, every price source
+	// (the job spec price getter and each of the PriceAggregation price getters) gets its own circuit.
+	PriceGetterCircuitBreaker *CircuitBreakerConfig `json:"priceGetterCircuitBreaker,omitempty"`
+	// PriceGetterCache optionally shares the prices of the price getter with the other lanes of the node configured
+	// with the same price sources, so that the lanes make a single upstream call per token within the ttl.
+	PriceGetterCache *PriceGetterCacheConfig `json:"priceGetterCache,omitempty"`
+	// PriceGetterRateLimits optionally limits the requests of the price getter to the given hosts, the budget of a host
+	// is shared by all lanes of the node. It only applies to the price getters querying HTTP APIs.
+	PriceGetterRateLimits map[string]HostRateLimitConfig `json:"priceGetterRateLimits,omitempty"`
+	// PriceGetterBatch optionally splits the price queries of many tokens into chunks queried in parallel, e.g. the
+	// aggregator multicalls of the dynamic price getter.
+	PriceGetterBatch *PriceGetterBatchConfig `json:"priceGetterBatch,omitempty"`
+	// PriceOverrides optionally fixes the prices of the given tokens until they expire, e.g. as a break-glass override
+	// while the price source of a token is down. The other tokens are priced by the price getter.
+	PriceOverrides FixedPricesConfig `json:"priceOverrides,omitempty"`
+	// CrossRates optionally derives the USD prices of tokens whose price source is denominated in another token,
+	// e.g. a TOKEN/ETH feed multiplied by the ETH/USD price.
+	CrossRates CrossRatesConfig `json:"crossRates,omitempty"`
+	// UniswapV3TWAPPriceGetterConfig optionally prices the tokens lacking price feeds with the TWAP of Uniswap v3
+	// pools, the other tokens are priced by the price getter.
+	UniswapV3TWAPPriceGetterConfig *UniswapV3TWAPPriceGetterConfig `json:"uniswapV3TWAPPriceGetterConfig,omitempty"`
+	// EIP1559GasPriceEstimators optionally derives the execution gas price of the source chain from its EIP-1559 fee
+	// history instead of the fee estimator of the node, keyed by source chain selector. The base fee and the priority
+	// fee are stored separately alongside the gas price.
+	EIP1559GasPriceEstimators map[uint64]EIP1559GasPriceEstimatorConfig `json:"eip1559GasPriceEstimators,omitempty"`
+	// SimulatedGasPriceSchedule replaces the source chain gas price with a scripted TOML gas price schedule of ramps
+	// and spikes, see prices.SimulatedGasPriceSchedule. It is only honored by dev and test builds, for system tests.
+	SimulatedGasPriceSchedule string `json:"simulatedGasPriceSchedule,omitempty"`
+	// TypeAndVersionOverrides optionally replaces the on-chain typeAndVersion of the given lane contracts, e.g.
+	// "EVM2EVMOffRamp 1.5.0", for proxies returning a misleading typeAndVersion.
+	TypeAndVersionOverrides map[cciptypes.Address]string `json:"typeAndVersionOverrides,omitempty"`
+	// SourceLogs and DestLogs optionally override how far back the lane readers of the source and dest chains query
+	// the logs and the depth at which the logs are final, e.g. for fast-finality chains.
+	SourceLogs *ReaderLogsConfig `json:"sourceLogs,omitempty"`
+	DestLogs   *ReaderLogsConfig `json:"destLogs,omitempty"`
+}
+
+// ReaderLogsConfig specifies the logs queried by the readers of the lane contracts of a chain.
+type ReaderLogsConfig struct {
+	// Lookback is the retention of the logs processed by the commit and exec plugins, defaults to 30 days.
+	Lookback commonconfig.Duration `json:"lookback,omitempty"`
+	// FinalityDepth is the number of confirmations after which the logs are final, the finality of the log poller is
+	// used when unset.
+	FinalityDepth uint32 `json:"finalityDepth,omitempty"`
 }
 
 type CommitPluginConfig struct {
 	IsSourceProvider                 bool
 	SourceStartBlock, DestStartBlock uint64
+	TypeAndVersionOverrides          map[cciptypes.Address]string `json:",omitempty"`
+	LogsConfig                       *ReaderLogsConfig            `json:",omitempty"`
 }
 
 func (c CommitPluginConfig) Encode() ([]byte, error) {
@@ -174,6 +236,744 @@ func (c *DynamicPriceGetterConfig) Validate() error {
 	return nil
 }
 
+// TokenPricePipelineConfig specifies the pipeline computing the USD price of a token.
+type TokenPricePipelineConfig struct {
+	// TokenAddress is the address of the token on the chain that is deployed on.
+	TokenAddress common.Address `json:"tokenAddress"`
+	// ChainSelector is the chain selector of the chain that the token is deployed on (source or dest).
+	ChainSelector uint64 `json:"chainSelector,string"`
+	// Pipeline is the DOT source of the pipeline, its final result must be the single price of the token.
+	Pipeline string `json:"pipeline"`
+}
+
+// TokenPricePipelinesConfig specifies the token price pipelines of the job spec.
+type TokenPricePipelinesConfig []TokenPricePipelineConfig
+
+// Validate checks the configuration for errors. The pipelines themselves are parsed by the price getter.
+func (c TokenPricePipelinesConfig) Validate() error {
+	type tokenKey struct {
+		ChainSelector uint64
+		TokenAddress  common.Address
+	}
+
+	seenTokens := make(map[tokenKey]struct{})
+	for _, cfg := range c {
+		if cfg.TokenAddress == utils.ZeroAddress {
+			return fmt.Errorf("token address is zero: %v", cfg.TokenAddress)
+		}
+		if cfg.ChainSelector == 0 {
+			return fmt.Errorf("chain selector of token %v is zero", cfg.TokenAddress)
+		}
+		if strings.Trim(cfg.Pipeline, "\n\t ") == "" {
+			return fmt.Errorf("pipeline of token %v is empty", cfg.TokenAddress)
+		}
+
+		k := tokenKey{ChainSelector: cfg.ChainSelector, TokenAddress: cfg.TokenAddress}
+		if _, seen := seenTokens[k]; seen {
+			return fmt.Errorf("duplicate token price pipeline, (token, chain) pair appears twice: %v", k)
+		}
+		seenTokens[k] = struct{}{}
+	}
+	return nil
+}
+
+// FixedPriceConfig is an operator configured USD price of a token, it is ignored once it expires.
+type FixedPriceConfig struct {
+	TokenAddress  common.Address `json:"tokenAddress"`
+	ChainSelector uint64         `json:"chainSelector,string"`
+	// Price is the USD price of 1e18 of the smallest token units, like the prices of the other price getters.
+	Price     *big.Int  `json:"price"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type FixedPricesConfig []FixedPriceConfig
+
+// Validate checks the configuration for errors. Fixed prices must expire, so that a forgotten override doesn't
+// keep reporting a price that is long outdated.
+func (c FixedPricesConfig) Validate() error {
+	type tokenKey struct {
+		ChainSelector uint64
+		TokenAddress  common.Address
+	}
+
+	seenTokens := make(map[tokenKey]struct{})
+	for _, cfg := range c {
+		if cfg.TokenAddress == utils.ZeroAddress {
+			return fmt.Errorf("token address is zero: %v", cfg.TokenAddress)
+		}
+		if cfg.ChainSelector == 0 {
+			return fmt.Errorf("chain selector of token %v is zero", cfg.TokenAddress)
+		}
+		if cfg.Price == nil || cfg.Price.Sign() <= 0 {
+			return fmt.Errorf("fixed price of token %v must be positive", cfg.TokenAddress)
+		}
+		if cfg.ExpiresAt.IsZero() {
+			return fmt.Errorf("fixed price of token %v has no expiry", cfg.TokenAddress)
+		}
+
+		k := tokenKey{ChainSelector: cfg.ChainSelector, TokenAddress: cfg.TokenAddress}
+		if _, seen := seenTokens[k]; seen {
+			return fmt.Errorf("duplicate fixed price, (token, chain) pair appears twice: %v", k)
+		}
+		seenTokens[k] = struct{}{}
+	}
+	return nil
+}
+
+// CrossRateConfig specifies that the price source of a token is denominated in the quote token, its USD price is the
+// price of the price source multiplied by the USD price of the quote token. The quote token can have a cross rate
+// itself, so that longer conversion paths can be configured.
+type CrossRateConfig struct {
+	TokenAddress       common.Address `json:"tokenAddress"`
+	ChainSelector      uint64         `json:"chainSelector,string"`
+	QuoteTokenAddress  common.Address `json:"quoteTokenAddress"`
+	QuoteChainSelector uint64         `json:"quoteChainSelector,string"`
+}
+
+type CrossRatesConfig []CrossRateConfig
+
+// Validate checks the configuration for errors, a token can have a single cross rate and the conversion paths
+// must not loop.
+func (c CrossRatesConfig) Validate() error {
+	type tokenKey struct {
+		ChainSelector uint64
+		TokenAddress  common.Address
+	}
+
+	quotes := make(map[tokenKey]tokenKey, len(c))
+	for _, cfg := range c {
+		if cfg.TokenAddress == utils.ZeroAddress || cfg.QuoteTokenAddress == utils.ZeroAddress {
+			return fmt.Errorf("token address is zero: %v", cfg)
+		}
+		if cfg.ChainSelector == 0 || cfg.QuoteChainSelector == 0 {
+			return fmt.Errorf("chain selector is zero: %v", cfg)
+		}
+
+		k := tokenKey{ChainSelector: cfg.ChainSelector, TokenAddress: cfg.TokenAddress}
+		if _, seen := quotes[k]; seen {
+			return fmt.Errorf("duplicate cross rate, (token, chain) pair appears twice: %v", k)
+		}
+		quotes[k] = tokenKey{ChainSelector: cfg.QuoteChainSelector, TokenAddress: cfg.QuoteTokenAddress}
+	}
+
+	for token := range quotes {
+		visited := map[tokenKey]struct{}{token: {}}
+		for quote, ok := quotes[token]; ok; quote, ok = quotes[quote] {
+			if _, loop := visited[quote]; loop {
+				return fmt.Errorf("conversion path of token %v loops", token)
+			}
+			visited[quote] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// UniswapV3TWAPPriceGetterConfig specifies the Uniswap v3 pools of the tokens priced by their time weighted average
+// price over the window.
+type UniswapV3TWAPPriceGetterConfig struct {
+	Window commonconfig.Duration `json:"window"`
+	Pools  []UniswapV3PoolConfig `json:"pools"`
+}
+
+// UniswapV3PoolConfig specifies the pool of a token, the TWAP of the pool is the price of the token denominated in
+// the quote token, e.g. use cross rates to convert it into USD.
+type UniswapV3PoolConfig struct {
+	// TokenAddress is the address of the token on the chain of the pool.
+	TokenAddress common.Address `json:"tokenAddress"`
+	// ChainSelector is the chain selector of the chain that the token is priced for.
+	ChainSelector uint64 `json:"chainSelector,string"`
+	TokenDecimals uint8  `json:"tokenDecimals"`
+	// ChainID is the EVM chain ID of the chain that the pool is deployed on.
+	ChainID            uint64         `json:"chainID,string"`
+	PoolAddress        common.Address `json:"poolAddress"`
+	QuoteTokenAddress  common.Address `json:"quoteTokenAddress"`
+	QuoteTokenDecimals uint8          `json:"quoteTokenDecimals"`
+	// MinPrice and MaxPrice optionally clamp the TWAP, a thinly traded pool can be moved far away from the market.
+	MinPrice *big.Int `json:"minPrice,omitempty"`
+	MaxPrice *big.Int `json:"maxPrice,omitempty"`
+}
+
+// Validate checks the configuration for errors.
+func (c *UniswapV3TWAPPriceGetterConfig) Validate() error {
+	window := c.Window.Duration()
+	if window < time.Second || window.Seconds() > math.MaxUint32 {
+		return fmt.Errorf("twap window must be between 1s and %ds: %s", uint32(math.MaxUint32), window)
+	}
+	if len(c.Pools) == 0 {
+		return errors.New("at least one pool is required")
+	}
+
+	type tokenKey struct {
+		ChainSelector uint64
+		TokenAddress  common.Address
+	}
+
+	seenTokens := make(map[tokenKey]struct{})
+	for _, pool := range c.Pools {
+		if pool.TokenAddress == utils.ZeroAddress || pool.QuoteTokenAddress == utils.ZeroAddress || pool.PoolAddress == utils.ZeroAddress {
+			return fmt.Errorf("address is zero in pool config of token %v", pool.TokenAddress)
+		}
+		if pool.TokenAddress == pool.QuoteTokenAddress {
+			return fmt.Errorf("token %v is quoted in itself", pool.TokenAddress)
+		}
+		if pool.ChainSelector == 0 || pool.ChainID == 0 {
+			return fmt.Errorf("chain selector or chain ID of token %v is zero", pool.TokenAddress)
+		}
+		if pool.TokenDecimals > 36 || pool.QuoteTokenDecimals > 36 {
+			return fmt.Errorf("decimals of token %v or of its quote token exceed 36", pool.TokenAddress)
+		}
+		if (pool.MinPrice != nil && pool.MinPrice.Sign() <= 0) || (pool.MaxPrice != nil && pool.MaxPrice.Sign() <= 0) {
+			return fmt.Errorf("price bounds of token %v must be positive", pool.TokenAddress)
+		}
+		if pool.MinPrice != nil && pool.MaxPrice != nil && pool.MinPrice.Cmp(pool.MaxPrice) > 0 {
+			return fmt.Errorf("min price of token %v exceeds its max price", pool.TokenAddress)
+		}
+
+		k := tokenKey{ChainSelector: pool.ChainSelector, TokenAddress: pool.TokenAddress}
+		if _, seen := seenTokens[k]; seen {
+			return fmt.Errorf("duplicate pool, (token, chain) pair appears twice: %v", k)
+		}
+		seenTokens[k] = struct{}{}
+	}
+	return nil
+}
+
+// DataStreamsPriceGetterConfig specifies the Data Streams feeds of the token prices and how their reports are verified.
+type DataStreamsPriceGetterConfig struct {
+	// CredentialName is the name of the Mercury credentials in the node secrets used to query the Data Streams API.
+	CredentialName string `json:"credentialName"`
+	// Signers are the oracles allowed to sign the reports, F+1 of them must sign every report.
+	Signers []common.Address `json:"signers"`
+	F       uint8            `json:"f"`
+	// MaxReportAge rejects the reports which were observed longer ago.
+	MaxReportAge commonconfig.Duration `json:"maxReportAge"`
+	// Feeds maps the tokens of the job spec to their feeds.
+	Feeds []DataStreamsFeedConfig `json:"feeds"`
+}
+
+// DataStreamsFeedConfig maps a token to the Data Streams feed of its USD price.
+type DataStreamsFeedConfig struct {
+	// TokenAddress is the address of the token on the chain that is deployed on.
+	TokenAddress common.Address `json:"tokenAddress"`
+	// ChainSelector is the chain selector of the chain that the token is deployed on (source or dest).
+	ChainSelector uint64 `json:"chainSelector,string"`
+	// FeedID is the ID of a v3 (crypto) feed, its benchmark price of one whole token with 18 decimals is used.
+	FeedID common.Hash `json:"feedID"`
+}
+
+// Validate checks the configuration for errors.
+func (c *DataStreamsPriceGetterConfig) Validate() error {
+	type tokenKey struct {
+		ChainSelector uint64
+		TokenAddress  common.Address
+	}
+
+	if c.CredentialName == "" {
+		return errors.New("credential name is empty")
+	}
+	if len(c.Signers) <= int(c.F) {
+		return fmt.Errorf("at least f+1 signers are required, got %d signers with f=%d", len(c.Signers), c.F)
+	}
+	if c.MaxReportAge.Duration() <= 0 {
+		return errors.New("max report age must be positive")
+	}
+	if len(c.Feeds) == 0 {
+		return errors.New("no feeds defined")
+	}
+
+	seenTokens := make(map[tokenKey]struct{})
+	for _, feed := range c.Feeds {
+		if feed.TokenAddress == utils.ZeroAddress {
+			return fmt.Errorf("token address is zero: %v", feed)
+		}
+		if feed.ChainSelector == 0 {
+			return fmt.Errorf("chain selector is zero: %v", feed)
+		}
+		if feed.FeedID == (common.Hash{}) {
+			return fmt.Errorf("feed id is zero: %v", feed)
+		}
+
+		k := tokenKey{ChainSelector: feed.ChainSelector, TokenAddress: feed.TokenAddress}
+		if _, seen := seenTokens[k]; seen {
+			return fmt.Errorf("duplicate data streams feed, (token, chain) pair appears twice: %v", feed)
+		}
+		seenTokens[k] = struct{}{}
+	}
+	return nil
+}
+
+// PriceAggregationMode defines how prices of the same token returned by redundant price sources are combined.
+type PriceAggregationMode string
+
+const (
+	PriceAggregationMedian      PriceAggregationMode = "median"
+	PriceAggregationMean        PriceAggregationMode = "mean"
+	PriceAggregationTrimmedMean PriceAggregationMode = "trimmedMean"
+)
+
+// Validate checks that the aggregation mode is supported.
+func (m PriceAggregationMode) Validate() error {
+	switch m {
+	case PriceAggregationMedian, PriceAggregationMean, PriceAggregationTrimmedMean:
+		return nil
+	default:
+		return fmt.Errorf("unsupported price aggregation mode %q", m)
+	}
+}
+
+// PriceSourceSelection defines which source the commit plugin observes the gas and token prices from.
+type PriceSourceSelection string
+
+const (
+	// PriceSourceDB observes the prices of the PriceService DB.
+	PriceSourceDB PriceSourceSelection = "db"
+	// PriceSourceFreshest observes, per token and per chain gas price, the fresher of the PriceService DB price and
+	// the price last written to the dest price registry, so that a stale DB does not hold back fresher on-chain prices.
+	PriceSourceFreshest PriceSourceSelection = "freshest"
+)
+
+// Validate checks that the price source selection is supported, an empty selection defaults to PriceSourceDB.
+func (s PriceSourceSelection) Validate() error {
+	switch s {
+	case "", PriceSourceDB, PriceSourceFreshest:
+		return nil
+	default:
+		return fmt.Errorf("unsupported price source selection %q", s)
+	}
+}
+
+// PriceAggregationConfig specifies redundant price sources and how their prices are aggregated.
+type PriceAggregationConfig struct {
+	// Mode defaults to median when not set.
+	Mode PriceAggregationMode `json:"mode,omitempty"`
+	// PriceGetterConfigs are queried concurrently with the main price getter of the job spec.
+	PriceGetterConfigs []DynamicPriceGetterConfig `json:"priceGetterConfigs"`
+}
+
+// AggregationMode returns the configured aggregation mode, or median if not set.
+func (c *PriceAggregationConfig) AggregationMode() PriceAggregationMode {
+	if c.Mode == "" {
+		return PriceAggregationMedian
+	}
+	return c.Mode
+}
+
+// Validate checks the configuration for errors.
+func (c *PriceAggregationConfig) Validate() error {
+	if err := c.AggregationMode().Validate(); err != nil {
+		return err
+	}
+	if len(c.PriceGetterConfigs) == 0 {
+		return errors.New("price aggregation requires at least one additional price getter config")
+	}
+	for i := range c.PriceGetterConfigs {
+		if c.PriceGetterConfigs[i].IsDeprecated() {
+			return fmt.Errorf("price getter config at index %d uses deprecated fields, use tokenPrices instead", i)
+		}
+		if err := c.PriceGetterConfigs[i].Validate(); err != nil {
+			return fmt.Errorf("invalid price getter config at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// CircuitBreakerConfig specifies when the circuit of a failing price source opens and for how long.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures opening the circuit.
+	FailureThreshold uint32 `json:"failureThreshold"`
+	// OpenDuration is how long the price source is skipped before it is probed again.
+	OpenDuration commonconfig.Duration `json:"openDuration"`
+}
+
+// Validate checks the configuration for errors.
+func (c *CircuitBreakerConfig) Validate() error {
+	if c.FailureThreshold == 0 {
+		return errors.New("circuit breaker failure threshold must be positive")
+	}
+	if c.OpenDuration.Duration() <= 0 {
+		return errors.New("circuit breaker open duration must be positive")
+	}
+	return nil
+}
+
+// PriceGetterCacheConfig specifies how long the prices of a price getter are shared by the lanes of the node.
+type PriceGetterCacheConfig struct {
+	TTL commonconfig.Duration `json:"ttl"`
+}
+
+// Validate checks the configuration for errors.
+func (c *PriceGetterCacheConfig) Validate() error {
+	if c.TTL.Duration() <= 0 {
+		return errors.New("price getter cache ttl must be positive")
+	}
+	return nil
+}
+
+// HostRateLimitConfig specifies the request budget of a host as a token bucket.
+type HostRateLimitConfig struct {
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	Burst             uint32  `json:"burst"`
+}
+
+// Validate checks the configuration for errors.
+func (c *HostRateLimitConfig) Validate() error {
+	if c.RequestsPerSecond <= 0 {
+		return errors.New("requests per second must be positive")
+	}
+	if c.Burst == 0 {
+		return errors.New("burst must be positive")
+	}
+	return nil
+}
+
+// PriceGetterBatchConfig specifies how the price getter splits the price queries of many tokens.
+type PriceGetterBatchConfig struct {
+	// ChunkSize is the max number of tokens queried at once, e.g. the number of aggregators read by a multicall.
+	ChunkSize uint32 `json:"chunkSize"`
+	// Parallelism is the max number of chunks queried concurrently.
+	Parallelism uint32 `json:"parallelism"`
+}
+
+// Validate checks the configuration for errors.
+func (c *PriceGetterBatchConfig) Validate() error {
+	if c.ChunkSize == 0 {
+		return errors.New("chunk size must be positive")
+	}
+	if c.Parallelism == 0 {
+		return errors.New("parallelism must be positive")
+	}
+	return nil
+}
+
+// DefaultEIP1559FeeHistoryBlocks is the number of blocks the priority fee is taken from when FeeHistoryBlocks is not set.
+const DefaultEIP1559FeeHistoryBlocks = 20
+
+// maxEIP1559FeeHistoryBlocks is the max number of blocks served by eth_feeHistory of most RPC providers.
+const maxEIP1559FeeHistoryBlocks = 1024
+
+// EIP1559GasPriceEstimatorConfig specifies how the execution gas price is derived from the EIP-1559 fee history, i.e.
+// the base fee of the next block times BaseFeeMultiplier plus the priority fee at PriorityFeePercentile.
+type EIP1559GasPriceEstimatorConfig struct {
+	// BaseFeeMultiplier scales the base fee to cover its increase until the prices are used, e.g. 1.25.
+	BaseFeeMultiplier float64 `json:"baseFeeMultiplier"`
+	// PriorityFeePercentile selects the priority fee paid by the given percentile of the transactions of each block,
+	// the median over the blocks is used.
+	PriorityFeePercentile float64 `json:"priorityFeePercentile"`
+	// FeeHistoryBlocks is the number of recent blocks the priority fee is taken from, defaults to 20.
+	FeeHistoryBlocks uint32 `json:"feeHistoryBlocks,omitempty"`
+}
+
+// Validate checks the configuration for errors.
+func (c *EIP1559GasPriceEstimatorConfig) Validate() error {
+	if c.BaseFeeMultiplier <= 0 {
+		return errors.New("base fee multiplier must be positive")
+	}
+	if c.PriorityFeePercentile < 0 || c.PriorityFeePercentile > 100 {
+		return fmt.Errorf("priority fee percentile %v must be between 0 and 100", c.PriorityFeePercentile)
+	}
+	if c.FeeHistoryBlocks > maxEIP1559FeeHistoryBlocks {
+		return fmt.Errorf("fee history blocks %d exceeds the max of %d", c.FeeHistoryBlocks, maxEIP1559FeeHistoryBlocks)
+	}
+	return nil
+}
+
+// BlockCount returns the number of blocks the priority fee is taken from.
+func (c *EIP1559GasPriceEstimatorConfig) BlockCount() uint64 {
+	if c.FeeHistoryBlocks == 0 {
+		return DefaultEIP1559FeeHistoryBlocks
+	}
+	return uint64(c.FeeHistoryBlocks)
+}
+
+// PriceServiceConfig specifies overrides for the background price updates.
+type PriceServiceConfig struct {
+	// TokenUpdateIntervals overrides the default update interval of the given tokens, e.g. to refresh
+	// volatile tokens more often than stable ones.
+	TokenUpdateIntervals map[common.Address]commonconfig.Duration `json:"tokenUpdateIntervals,omitempty"`
+	// AllowPartialTokenPriceUpdates writes the prices of the tokens that were priced successfully and reports
+	// the failing ones, so that a single delisted token does not stall the price updates of the whole lane.
+	AllowPartialTokenPriceUpdates bool `json:"allowPartialTokenPriceUpdates,omitempty"`
+	// StalePriceRetention defines how long prices are kept in the DB without being updated, before they are deleted.
+	StalePriceRetention *commonconfig.Duration `json:"stalePriceRetention,omitempty"`
+	// MaxPriceAge makes the commit plugin ignore prices in the DB which were not updated within that period.
+	MaxPriceAge *commonconfig.Duration `json:"maxPriceAge,omitempty"`
+	// MinTokenPriceConfidence makes the commit plugin ignore token prices in the DB with a lower confidence score, from
+	// 0 to 1. Only aggregated prices have a confidence, the other prices are never ignored.
+	MinTokenPriceConfidence float64 `json:"minTokenPriceConfidence,omitempty"`
+	// QuoteCurrency denominates the prices in the given asset instead of USD, e.g. for EUR or ETH-denominated pricing.
+	QuoteCurrency *QuoteCurrencyConfig `json:"quoteCurrency,omitempty"`
+	// TokenAllowlist restricts the dest tokens whose prices are written to the listed ones, all tokens when empty.
+	TokenAllowlist []common.Address `json:"tokenAllowlist,omitempty"`
+	// TokenDenylist excludes the listed dest tokens from price writes, e.g. a token with a broken price feed,
+	// without changing the price getter configuration.
+	TokenDenylist []common.Address `json:"tokenDenylist,omitempty"`
+	// DualWritePrices additionally writes the prices to the consolidated prices table, so that it is populated
+	// before switching reads to it.
+	DualWritePrices bool `json:"dualWritePrices,omitempty"`
+	// ReadConsolidatedPrices reads the prices from the consolidated prices table instead of the gas and token
+	// price tables. It requires DualWritePrices, so that the legacy tables stay up to date for a rollback.
+	ReadConsolidatedPrices bool `json:"readConsolidatedPrices,omitempty"`
+	// BatchWriteWindow groups the price writes of all lanes with the same window into a single transaction per window,
+	// reducing the number of small transactions on nodes running many lanes. Prices are written per lane when unset.
+	BatchWriteWindow *commonconfig.Duration `json:"batchWriteWindow,omitempty"`
+	// PushPriceRefresh refreshes the prices read by the commit plugin when the DB notifies about price writes,
+	// instead of re-reading them from the DB every few seconds.
+	PushPriceRefresh bool `json:"pushPriceRefresh,omitempty"`
+	// ConsistentPriceReads re-reads the gas and token prices when prices were written while reading them, so that
+	// the commit plugin never combines gas and token prices of different price updates.
+	ConsistentPriceReads bool `json:"consistentPriceReads,omitempty"`
+	// TokenMetadata maps dest tokens to their symbol and external price API identifiers, it is shared with the other
+	// lanes of the dest chain through the DB.
+	TokenMetadata map[common.Address]TokenMetadataConfig `json:"tokenMetadata,omitempty"`
+	// WriteDeviationBps only writes prices which deviate by more than the given basis points from the prices in the DB,
+	// cutting the writes of stable prices, e.g. of stablecoins. All prices are written when unset.
+	WriteDeviationBps uint32 `json:"writeDeviationBps,omitempty"`
+	// WriteHeartbeat forces writing prices which did not deviate after that period, defaults to 1 hour.
+	WriteHeartbeat *commonconfig.Duration `json:"writeHeartbeat,omitempty"`
+	// GasPriceBounds and TokenPriceBounds reject gas and token prices out of bounds at write time, guarding
+	// against unit scaling bugs propagating into the fee calculation.
+	GasPriceBounds   *PriceBoundsConfig `json:"gasPriceBounds,omitempty"`
+	TokenPriceBounds *PriceBoundsConfig `json:"tokenPriceBounds,omitempty"`
+	// GasPriceDampening caps and floors the observed gas prices relative to the trailing median of the written gas
+	// prices, so that a single RPC returning an absurd gas price does not inflate the fees across the whole lane.
+	GasPriceDampening *GasPriceDampeningConfig `json:"gasPriceDampening,omitempty"`
+	// GasPriceEndpoints queries the execution gas price of the source chain from additional RPCs along with the node's
+	// gas estimator and takes the median after discarding outliers, improving resilience to a single flaky RPC.
+	GasPriceEndpoints *GasPriceEndpointsConfig `json:"gasPriceEndpoints,omitempty"`
+	// NativePriceCheck cross-checks the source native price against the price of a dest token in the dest price
+	// registry, alerting on or rejecting a diverged price before it is used to convert the gas price to USD.
+	NativePriceCheck *NativePriceCheckConfig `json:"nativePriceCheck,omitempty"`
+	// SignedPrices only trusts prices signed by one of the given signers, the price getter must provide signed prices.
+	SignedPrices *SignedPricesConfig `json:"signedPrices,omitempty"`
+}
+
+// SignedPricesConfig specifies the signers whose signed prices are trusted, and how old signed prices may be.
+type SignedPricesConfig struct {
+	Signers       []common.Address      `json:"signers"`
+	MaxPayloadAge commonconfig.Duration `json:"maxPayloadAge"`
+}
+
+// PriceBoundsConfig specifies the inclusive absolute bounds of a price, either bound is optional.
+type PriceBoundsConfig struct {
+	Min *big.Int `json:"min,omitempty"`
+	Max *big.Int `json:"max,omitempty"`
+}
+
+// Validate checks the bounds are not negative and not empty.
+func (c *PriceBoundsConfig) Validate() error {
+	if c.Min != nil && c.Min.Sign() < 0 {
+		return errors.New("min must not be negative")
+	}
+	if c.Max != nil && c.Max.Sign() <= 0 {
+		return errors.New("max must be positive")
+	}
+	if c.Min != nil && c.Max != nil && c.Min.Cmp(c.Max) > 0 {
+		return errors.New("min must not be greater than max")
+	}
+	return nil
+}
+
+// GasPriceDampeningConfig specifies the multiples of the trailing median gas price the observed gas prices are capped
+// and floored to, e.g. never writing more than 3x the 1 hour median.
+type GasPriceDampeningConfig struct {
+	// Window is the trailing period the median is taken over, defaults to 1 hour.
+	Window *commonconfig.Duration `json:"window,omitempty"`
+	// MaxMultiplier caps the gas price to the given multiple of the median, no cap when unset.
+	MaxMultiplier float64 `json:"maxMultiplier,omitempty"`
+	// MinMultiplier floors the gas price to the given fraction of the median, no floor when unset.
+	MinMultiplier float64 `json:"minMultiplier,omitempty"`
+}
+
+// Validate checks a cap or a floor is set, the cap is not below the median and the floor is not above it.
+func (c *GasPriceDampeningConfig) Validate() error {
+	if c.MaxMultiplier == 0 && c.MinMultiplier == 0 {
+		return errors.New("max multiplier or min multiplier must be set")
+	}
+	if c.MaxMultiplier != 0 && c.MaxMultiplier < 1 {
+		return fmt.Errorf("max multiplier must be at least 1: %v", c.MaxMultiplier)
+	}
+	if c.MinMultiplier < 0 || c.MinMultiplier > 1 {
+		return fmt.Errorf("min multiplier must be between 0 and 1: %v", c.MinMultiplier)
+	}
+	if c.Window != nil && c.Window.Duration() <= 0 {
+		return errors.New("window must be positive")
+	}
+	return nil
+}
+
+// GasPriceEndpointsConfig specifies the additional RPCs of the source chain the gas price is queried from.
+type GasPriceEndpointsConfig struct {
+	Endpoints []GasPriceEndpointConfig `json:"endpoints"`
+	// MaxDeviationBps discards the gas prices deviating more from the median of all endpoints, defaults to 2000 (20%).
+	MaxDeviationBps uint32 `json:"maxDeviationBps,omitempty"`
+	// MinResponses is the number of endpoints, including the node's gas estimator, which must respond, defaults to
+	// a majority.
+	MinResponses uint32 `json:"minResponses,omitempty"`
+}
+
+// GasPriceEndpointConfig is an RPC of the source chain, the name labels its health metrics.
+type GasPriceEndpointConfig struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Validate checks the endpoints are set, uniquely named, and enough of them exist to reach the min responses.
+func (c *GasPriceEndpointsConfig) Validate() error {
+	if len(c.Endpoints) == 0 {
+		return errors.New("at least one gas price endpoint must be set")
+	}
+	names := make(map[string]struct{}, len(c.Endpoints))
+	for i, endpoint := range c.Endpoints {
+		if endpoint.Name == "" {
+			return fmt.Errorf("name of gas price endpoint %d must be set", i)
+		}
+		if endpoint.URL == "" {
+			return fmt.Errorf("url of gas price endpoint %s must be set", endpoint.Name)
+		}
+		if _, ok := names[endpoint.Name]; ok {
+			return fmt.Errorf("duplicate gas price endpoint name %s", endpoint.Name)
+		}
+		names[endpoint.Name] = struct{}{}
+	}
+	if int(c.MinResponses) > len(c.Endpoints)+1 {
+		return fmt.Errorf("min responses must be at most %d", len(c.Endpoints)+1)
+	}
+	return nil
+}
+
+// NativePriceCheckConfig specifies the dest token priced like the source native token, e.g. WETH on the dest chain for
+// an Ethereum source chain, and how far the source native price may diverge from its on-chain price.
+type NativePriceCheckConfig struct {
+	DestToken       common.Address `json:"destToken"`
+	MaxDeviationBps uint32         `json:"maxDeviationBps"`
+	// RejectOnDeviation fails the gas price update on a diverged price, which is only alerted on otherwise.
+	RejectOnDeviation bool `json:"rejectOnDeviation,omitempty"`
+	// MaxOnChainPriceAge skips the check when the on-chain price is older, it is never skipped when unset.
+	MaxOnChainPriceAge *commonconfig.Duration `json:"maxOnChainPriceAge,omitempty"`
+}
+
+// Validate checks the dest token and the max deviation are set.
+func (c *NativePriceCheckConfig) Validate() error {
+	if c.DestToken == (common.Address{}) {
+		return errors.New("dest token must be set")
+	}
+	if c.MaxDeviationBps == 0 {
+		return errors.New("max deviation bps must be positive")
+	}
+	if c.MaxOnChainPriceAge != nil && c.MaxOnChainPriceAge.Duration() <= 0 {
+		return errors.New("max on-chain price age must be positive")
+	}
+	return nil
+}
+
+// TokenMetadataConfig specifies the human-readable metadata of a token.
+type TokenMetadataConfig struct {
+	Symbol      string `json:"symbol"`
+	CoinGeckoID string `json:"coingeckoId,omitempty"`
+}
+
+// QuoteCurrencyConfig specifies the asset prices are denominated in. The price getter must return the USD price of
+// the given token, which is used as the price of one unit of the quote currency.
+type QuoteCurrencyConfig struct {
+	Symbol        string         `json:"symbol"`
+	TokenAddress  common.Address `json:"tokenAddress"`
+	ChainSelector uint64         `json:"chainSelector,string"`
+}
+
+const (
+	// minStalePriceRetention prevents deleting prices which are still regularly updated.
+	minStalePriceRetention = time.Hour
+	// maxWriteDeviationBps is a 100% deviation, higher thresholds would suppress all price changes until the heartbeat.
+	maxWriteDeviationBps = 10_000
+)
+
+// Validate checks the configuration for errors.
+func (c *PriceServiceConfig) Validate() error {
+	for token, interval := range c.TokenUpdateIntervals {
+		if interval.Duration() <= 0 {
+			return fmt.Errorf("update interval of token %s must be positive", token.Hex())
+		}
+	}
+	if c.StalePriceRetention != nil && c.StalePriceRetention.Duration() < minStalePriceRetention {
+		return fmt.Errorf("stale price retention must be at least %s", minStalePriceRetention)
+	}
+	if c.MaxPriceAge != nil && c.MaxPriceAge.Duration() <= 0 {
+		return errors.New("max price age must be positive")
+	}
+	if c.MinTokenPriceConfidence < 0 || c.MinTokenPriceConfidence > 1 {
+		return fmt.Errorf("min token price confidence must be between 0 and 1: %v", c.MinTokenPriceConfidence)
+	}
+	if c.QuoteCurrency != nil {
+		if c.QuoteCurrency.Symbol == "" {
+			return errors.New("quote currency symbol must be set")
+		}
+		if c.QuoteCurrency.TokenAddress == (common.Address{}) {
+			return errors.New("quote currency token address must be set")
+		}
+	}
+	for _, token := range c.TokenDenylist {
+		if slices.Contains(c.TokenAllowlist, token) {
+			return fmt.Errorf("token %s is both allowlisted and denylisted", token.Hex())
+		}
+	}
+	if c.BatchWriteWindow != nil && c.BatchWriteWindow.Duration() <= 0 {
+		return errors.New("batch write window must be positive")
+	}
+	if c.ReadConsolidatedPrices && !c.DualWritePrices {
+		return errors.New("reading consolidated prices requires dual writing prices")
+	}
+	for token, metadata := range c.TokenMetadata {
+		if metadata.Symbol == "" {
+			return fmt.Errorf("symbol of token %s must be set", token.Hex())
+		}
+	}
+	if c.WriteDeviationBps > maxWriteDeviationBps {
+		return fmt.Errorf("write deviation must be at most %d bps", maxWriteDeviationBps)
+	}
+	if c.WriteHeartbeat != nil {
+		if c.WriteHeartbeat.Duration() <= 0 {
+			return errors.New("write heartbeat must be positive")
+		}
+		if c.MaxPriceAge != nil && c.WriteHeartbeat.Duration() >= c.MaxPriceAge.Duration() {
+			return errors.New("write heartbeat must be shorter than max price age")
+		}
+	}
+	if c.GasPriceBounds != nil {
+		if err := c.GasPriceBounds.Validate(); err != nil {
+			return fmt.Errorf("invalid gas price bounds: %w", err)
+		}
+	}
+	if c.TokenPriceBounds != nil {
+		if err := c.TokenPriceBounds.Validate(); err != nil {
+			return fmt.Errorf("invalid token price bounds: %w", err)
+		}
+	}
+	if c.GasPriceDampening != nil {
+		if err := c.GasPriceDampening.Validate(); err != nil {
+			return fmt.Errorf("invalid gas price dampening: %w", err)
+		}
+	}
+	if c.GasPriceEndpoints != nil {
+		if err := c.GasPriceEndpoints.Validate(); err != nil {
+			return fmt.Errorf("invalid gas price endpoints: %w", err)
+		}
+	}
+	if c.NativePriceCheck != nil {
+		if err := c.NativePriceCheck.Validate(); err != nil {
+			return fmt.Errorf("invalid native price check: %w", err)
+		}
+	}
+	if c.SignedPrices != nil {
+		if len(c.SignedPrices.Signers) == 0 {
+			return errors.New("signed prices require at least one signer")
+		}
+		if c.SignedPrices.MaxPayloadAge.Duration() <= 0 {
+			return errors.New("max signed price payload age must be positive")
+		}
+	}
+	return nil
+}
+
 // AggregatorPriceConfig specifies a price retrieved from an aggregator contract.
 type AggregatorPriceConfig struct {
 	ChainID                   uint64         `json:"chainID,string"`
@@ -205,6 +1005,13 @@ type ExecPluginJobSpecConfig struct {
 	SourceStartBlock, DestStartBlock uint64 // Only for first time job add.
 	USDCConfig                       USDCConfig
 	LBTCConfig                       LBTCConfig
+	// TypeAndVersionOverrides optionally replaces the on-chain typeAndVersion of the given lane contracts, e.g.
+	// "EVM2EVMOffRamp 1.5.0", for proxies returning a misleading typeAndVersion.
+	TypeAndVersionOverrides map[cciptypes.Address]string `json:"typeAndVersionOverrides,omitempty"`
+	// SourceLogs and DestLogs optionally override how far back the lane readers of the source and dest chains query
+	// the logs and the depth at which the logs are final, e.g. for fast-finality chains.
+	SourceLogs *ReaderLogsConfig `json:"sourceLogs,omitempty"`
+	DestLogs   *ReaderLogsConfig `json:"destLogs,omitempty"`
 }
 
 type USDCConfig struct {
@@ -230,6 +1037,8 @@ type ExecPluginConfig struct {
 	USDCConfig                       USDCConfig
 	LBTCConfig                       LBTCConfig
 	JobID                            string
+	TypeAndVersionOverrides          map[cciptypes.Address]string `json:",omitempty"`
+	LogsConfig                       *ReaderLogsConfig            `json:",omitempty"`
 }
 
 func (e ExecPluginConfig) Encode() ([]byte, error) {
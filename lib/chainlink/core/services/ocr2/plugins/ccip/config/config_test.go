@@ -3,8 +3,10 @@ package config
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	chainsel "github.com/smartcontractkit/chain-selectors"
@@ -464,3 +466,708 @@ func TestDynamicPriceGetterConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestPriceAggregationConfig(t *testing.T) {
+	validPriceGetterConfig := DynamicPriceGetterConfig{
+		TokenPrices: []TokenPriceConfig{
+			{
+				TokenAddress:  common.HexToAddress("0x0820c05e1fba1244763a494a52272170c321cad3"),
+				ChainSelector: chainsel.TEST_1000.Selector,
+				StaticConfig:  &StaticPriceConfig{ChainID: 1000, Price: big.NewInt(1e18)},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expMode  PriceAggregationMode
+		expError bool
+	}{
+		{
+			name: "mode defaults to median",
+			jsonCfg: `{
+				"priceGetterConfigs": [
+					{"tokenPrices": [{"tokenAddress": "0x0820c05e1fba1244763a494a52272170c321cad3", "chainSelector": "11787463284727550157", "staticConfig": {"chainID": "1000", "price": 1000000000000000000}}]}
+				]
+			}`,
+			expMode: PriceAggregationMedian,
+		},
+		{
+			name: "trimmed mean",
+			jsonCfg: `{
+				"mode": "trimmedMean",
+				"priceGetterConfigs": [
+					{"tokenPrices": [{"tokenAddress": "0x0820c05e1fba1244763a494a52272170c321cad3", "chainSelector": "11787463284727550157", "staticConfig": {"chainID": "1000", "price": 1000000000000000000}}]}
+				]
+			}`,
+			expMode: PriceAggregationTrimmedMean,
+		},
+		{
+			name: "unknown mode",
+			jsonCfg: `{
+				"mode": "max",
+				"priceGetterConfigs": [
+					{"tokenPrices": [{"tokenAddress": "0x0820c05e1fba1244763a494a52272170c321cad3", "chainSelector": "11787463284727550157", "staticConfig": {"chainID": "1000", "price": 1000000000000000000}}]}
+				]
+			}`,
+			expError: true,
+		},
+		{
+			name:     "no price getter configs",
+			jsonCfg:  `{"mode": "mean"}`,
+			expError: true,
+		},
+		{
+			name: "deprecated price getter config",
+			jsonCfg: `{
+				"priceGetterConfigs": [
+					{"staticPrices": {"0xec8c353470ccaa4f43067fcde40558e084a12927": {"chainID": "1057", "price": 1000000000000000000}}}
+				]
+			}`,
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg PriceAggregationConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+
+			err := cfg.Validate()
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expMode, cfg.AggregationMode())
+			require.Equal(t, []DynamicPriceGetterConfig{validPriceGetterConfig}, cfg.PriceGetterConfigs)
+		})
+	}
+}
+
+func TestPriceSourceSelection(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		exp      PriceSourceSelection
+		expError bool
+	}{
+		{name: "defaults to db", jsonCfg: `{"offRamp": "0x0820c05e1fba1244763a494a52272170c321cad3"}`, exp: ""},
+		{name: "db", jsonCfg: `{"priceSourceSelection": "db"}`, exp: PriceSourceDB},
+		{name: "freshest", jsonCfg: `{"priceSourceSelection": "freshest"}`, exp: PriceSourceFreshest},
+		{name: "unknown selection", jsonCfg: `{"priceSourceSelection": "onchain"}`, expError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg CommitPluginJobSpecConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.PriceSourceSelection.Validate())
+				return
+			}
+			require.NoError(t, cfg.PriceSourceSelection.Validate())
+			require.Equal(t, tc.exp, cfg.PriceSourceSelection)
+		})
+	}
+}
+
+func TestTokenPricePipelinesConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expError bool
+	}{
+		{
+			name: "valid config",
+			jsonCfg: `[
+				{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "pipeline": "median [type=median values=<[1, 2]>];"},
+				{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "2", "pipeline": "median [type=median values=<[1, 2]>];"}
+			]`,
+		},
+		{
+			name:     "zero token address",
+			jsonCfg:  `[{"tokenAddress": "0x0000000000000000000000000000000000000000", "chainSelector": "1", "pipeline": "median [type=median values=<[1, 2]>];"}]`,
+			expError: true,
+		},
+		{
+			name:     "zero chain selector",
+			jsonCfg:  `[{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "0", "pipeline": "median [type=median values=<[1, 2]>];"}]`,
+			expError: true,
+		},
+		{
+			name:     "empty pipeline",
+			jsonCfg:  `[{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "pipeline": " \n"}]`,
+			expError: true,
+		},
+		{
+			name: "duplicate token",
+			jsonCfg: `[
+				{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "pipeline": "median [type=median values=<[1, 2]>];"},
+				{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "pipeline": "median [type=median values=<[3, 4]>];"}
+			]`,
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg TokenPricePipelinesConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestFixedPricesConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expError bool
+	}{
+		{
+			name: "valid config",
+			jsonCfg: `[
+				{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "price": 1000000000000000000, "expiresAt": "2030-01-01T00:00:00Z"},
+				{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "2", "price": 1000000000000000000, "expiresAt": "2030-01-01T00:00:00Z"}
+			]`,
+		},
+		{
+			name:     "zero price",
+			jsonCfg:  `[{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "price": 0, "expiresAt": "2030-01-01T00:00:00Z"}]`,
+			expError: true,
+		},
+		{
+			name:     "missing price",
+			jsonCfg:  `[{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "expiresAt": "2030-01-01T00:00:00Z"}]`,
+			expError: true,
+		},
+		{
+			name:     "missing expiry",
+			jsonCfg:  `[{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "price": 1000000000000000000}]`,
+			expError: true,
+		},
+		{
+			name:     "zero chain selector",
+			jsonCfg:  `[{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "0", "price": 1000000000000000000, "expiresAt": "2030-01-01T00:00:00Z"}]`,
+			expError: true,
+		},
+		{
+			name: "duplicate token",
+			jsonCfg: `[
+				{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "price": 1000000000000000000, "expiresAt": "2030-01-01T00:00:00Z"},
+				{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "price": 2000000000000000000, "expiresAt": "2030-01-01T00:00:00Z"}
+			]`,
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg FixedPricesConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestCrossRatesConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expError bool
+	}{
+		{
+			name: "valid conversion path",
+			jsonCfg: `[
+				{"tokenAddress": "0x0000000000000000000000000000000000000001", "chainSelector": "1", "quoteTokenAddress": "0x0000000000000000000000000000000000000002", "quoteChainSelector": "1"},
+				{"tokenAddress": "0x0000000000000000000000000000000000000002", "chainSelector": "1", "quoteTokenAddress": "0x0000000000000000000000000000000000000003", "quoteChainSelector": "2"}
+			]`,
+		},
+		{
+			name:     "zero quote token address",
+			jsonCfg:  `[{"tokenAddress": "0x0000000000000000000000000000000000000001", "chainSelector": "1", "quoteTokenAddress": "0x0000000000000000000000000000000000000000", "quoteChainSelector": "1"}]`,
+			expError: true,
+		},
+		{
+			name:     "missing quote chain selector",
+			jsonCfg:  `[{"tokenAddress": "0x0000000000000000000000000000000000000001", "chainSelector": "1", "quoteTokenAddress": "0x0000000000000000000000000000000000000002"}]`,
+			expError: true,
+		},
+		{
+			name: "duplicate token",
+			jsonCfg: `[
+				{"tokenAddress": "0x0000000000000000000000000000000000000001", "chainSelector": "1", "quoteTokenAddress": "0x0000000000000000000000000000000000000002", "quoteChainSelector": "1"},
+				{"tokenAddress": "0x0000000000000000000000000000000000000001", "chainSelector": "1", "quoteTokenAddress": "0x0000000000000000000000000000000000000003", "quoteChainSelector": "1"}
+			]`,
+			expError: true,
+		},
+		{
+			name:     "token quoted in itself",
+			jsonCfg:  `[{"tokenAddress": "0x0000000000000000000000000000000000000001", "chainSelector": "1", "quoteTokenAddress": "0x0000000000000000000000000000000000000001", "quoteChainSelector": "1"}]`,
+			expError: true,
+		},
+		{
+			name: "conversion path loops",
+			jsonCfg: `[
+				{"tokenAddress": "0x0000000000000000000000000000000000000001", "chainSelector": "1", "quoteTokenAddress": "0x0000000000000000000000000000000000000002", "quoteChainSelector": "1"},
+				{"tokenAddress": "0x0000000000000000000000000000000000000002", "chainSelector": "1", "quoteTokenAddress": "0x0000000000000000000000000000000000000001", "quoteChainSelector": "1"}
+			]`,
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg CrossRatesConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestUniswapV3TWAPPriceGetterConfig(t *testing.T) {
+	const pool = `{"tokenAddress": "0x0000000000000000000000000000000000000001", "chainSelector": "1", "tokenDecimals": 18, "chainID": "1", "poolAddress": "0x0000000000000000000000000000000000000010", "quoteTokenAddress": "0x0000000000000000000000000000000000000002", "quoteTokenDecimals": 6%s}`
+
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expError bool
+	}{
+		{
+			name:    "valid pool",
+			jsonCfg: `{"window": "30m", "pools": [` + fmt.Sprintf(pool, `, "minPrice": 1, "maxPrice": 2`) + `]}`,
+		},
+		{
+			name:     "window below 1s",
+			jsonCfg:  `{"window": "500ms", "pools": [` + fmt.Sprintf(pool, "") + `]}`,
+			expError: true,
+		},
+		{
+			name:     "no pools",
+			jsonCfg:  `{"window": "30m", "pools": []}`,
+			expError: true,
+		},
+		{
+			name:     "min price above max price",
+			jsonCfg:  `{"window": "30m", "pools": [` + fmt.Sprintf(pool, `, "minPrice": 2, "maxPrice": 1`) + `]}`,
+			expError: true,
+		},
+		{
+			name:     "duplicate token",
+			jsonCfg:  `{"window": "30m", "pools": [` + fmt.Sprintf(pool, "") + `,` + fmt.Sprintf(pool, "") + `]}`,
+			expError: true,
+		},
+		{
+			name:     "missing chain ID",
+			jsonCfg:  `{"window": "30m", "pools": [{"tokenAddress": "0x0000000000000000000000000000000000000001", "chainSelector": "1", "poolAddress": "0x0000000000000000000000000000000000000010", "quoteTokenAddress": "0x0000000000000000000000000000000000000002"}]}`,
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg UniswapV3TWAPPriceGetterConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestDataStreamsPriceGetterConfig(t *testing.T) {
+	const feed = `{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1", "feedID": "0x0003c317fec7fad514c67aacc6366bf2f007ce37100e3cddcacd0ccaa1f3746d"}`
+	const signers = `"signers": ["0x3fc9FaA15d71EeD614e5322bd9554Fb35cC381d2", "0xBa6534da0E49c71cD9d0292203F1524876f33E23"]`
+
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expError bool
+	}{
+		{name: "valid config", jsonCfg: `{"credentialName": "streams", ` + signers + `, "f": 1, "maxReportAge": "1m", "feeds": [` + feed + `]}`},
+		{name: "missing credential name", jsonCfg: `{` + signers + `, "f": 1, "maxReportAge": "1m", "feeds": [` + feed + `]}`, expError: true},
+		{name: "not enough signers", jsonCfg: `{"credentialName": "streams", ` + signers + `, "f": 2, "maxReportAge": "1m", "feeds": [` + feed + `]}`, expError: true},
+		{name: "missing max report age", jsonCfg: `{"credentialName": "streams", ` + signers + `, "f": 1, "feeds": [` + feed + `]}`, expError: true},
+		{name: "no feeds", jsonCfg: `{"credentialName": "streams", ` + signers + `, "f": 1, "maxReportAge": "1m", "feeds": []}`, expError: true},
+		{name: "duplicate feed", jsonCfg: `{"credentialName": "streams", ` + signers + `, "f": 1, "maxReportAge": "1m", "feeds": [` + feed + `, ` + feed + `]}`, expError: true},
+		{
+			name:     "zero feed id",
+			jsonCfg:  `{"credentialName": "streams", ` + signers + `, "f": 1, "maxReportAge": "1m", "feeds": [{"tokenAddress": "0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5", "chainSelector": "1"}]}`,
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg DataStreamsPriceGetterConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestCircuitBreakerConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expError bool
+	}{
+		{name: "valid config", jsonCfg: `{"failureThreshold": 3, "openDuration": "5m"}`},
+		{name: "zero failure threshold", jsonCfg: `{"failureThreshold": 0, "openDuration": "5m"}`, expError: true},
+		{name: "missing open duration", jsonCfg: `{"failureThreshold": 3}`, expError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg CircuitBreakerConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestPriceGetterCacheConfig(t *testing.T) {
+	var cfg PriceGetterCacheConfig
+	require.NoError(t, json.Unmarshal([]byte(`{"ttl": "5s"}`), &cfg))
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, 5*time.Second, cfg.TTL.Duration())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"ttl": "0s"}`), &cfg))
+	require.Error(t, cfg.Validate())
+}
+
+func TestHostRateLimitConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expError bool
+	}{
+		{name: "valid config", jsonCfg: `{"requestsPerSecond": 0.5, "burst": 5}`},
+		{name: "zero requests per second", jsonCfg: `{"requestsPerSecond": 0, "burst": 5}`, expError: true},
+		{name: "missing burst", jsonCfg: `{"requestsPerSecond": 2}`, expError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg HostRateLimitConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestPriceGetterBatchConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expError bool
+	}{
+		{name: "valid config", jsonCfg: `{"chunkSize": 50, "parallelism": 4}`},
+		{name: "missing chunk size", jsonCfg: `{"parallelism": 4}`, expError: true},
+		{name: "zero parallelism", jsonCfg: `{"chunkSize": 50, "parallelism": 0}`, expError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg PriceGetterBatchConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestEIP1559GasPriceEstimatorConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jsonCfg  string
+		expError bool
+	}{
+		{name: "valid config", jsonCfg: `{"baseFeeMultiplier": 1.25, "priorityFeePercentile": 60, "feeHistoryBlocks": 10}`},
+		{name: "zero percentile", jsonCfg: `{"baseFeeMultiplier": 1, "priorityFeePercentile": 0}`},
+		{name: "missing base fee multiplier", jsonCfg: `{"priorityFeePercentile": 60}`, expError: true},
+		{name: "percentile above 100", jsonCfg: `{"baseFeeMultiplier": 1, "priorityFeePercentile": 101}`, expError: true},
+		{name: "too many fee history blocks", jsonCfg: `{"baseFeeMultiplier": 1, "priorityFeePercentile": 50, "feeHistoryBlocks": 2048}`, expError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg EIP1559GasPriceEstimatorConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+			if tc.expError {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+
+	t.Run("fee history blocks default", func(t *testing.T) {
+		cfg := EIP1559GasPriceEstimatorConfig{BaseFeeMultiplier: 1}
+		require.Equal(t, uint64(DefaultEIP1559FeeHistoryBlocks), cfg.BlockCount())
+	})
+
+	t.Run("keyed by source chain selector", func(t *testing.T) {
+		var cfg CommitPluginJobSpecConfig
+		require.NoError(t, json.Unmarshal([]byte(`{"eip1559GasPriceEstimators": {"5009297550715157269": {"baseFeeMultiplier": 1.5, "priorityFeePercentile": 50}}}`), &cfg))
+		require.Contains(t, cfg.EIP1559GasPriceEstimators, uint64(5009297550715157269))
+		require.InDelta(t, 1.5, cfg.EIP1559GasPriceEstimators[5009297550715157269].BaseFeeMultiplier, 1e-9)
+	})
+}
+
+func TestPriceServiceConfig(t *testing.T) {
+	testCases := []struct {
+		name         string
+		jsonCfg      string
+		expIntervals map[common.Address]time.Duration
+		expPartial   bool
+		expError     bool
+	}{
+		{
+			name: "valid config",
+			jsonCfg: `{
+				"tokenUpdateIntervals": {
+					"0x0820c05e1fba1244763a494a52272170c321cad3": "30s",
+					"0xec8c353470ccaa4f43067fcde40558e084a12927": "1h"
+				},
+				"allowPartialTokenPriceUpdates": true
+			}`,
+			expIntervals: map[common.Address]time.Duration{
+				common.HexToAddress("0x0820c05e1fba1244763a494a52272170c321cad3"): 30 * time.Second,
+				common.HexToAddress("0xec8c353470ccaa4f43067fcde40558e084a12927"): time.Hour,
+			},
+			expPartial: true,
+		},
+		{
+			name:         "empty config",
+			jsonCfg:      `{}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "zero interval",
+			jsonCfg:  `{"tokenUpdateIntervals": {"0x0820c05e1fba1244763a494a52272170c321cad3": "0s"}}`,
+			expError: true,
+		},
+		{
+			name:         "valid stale price retention",
+			jsonCfg:      `{"stalePriceRetention": "48h"}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:         "valid max price age",
+			jsonCfg:      `{"maxPriceAge": "30m"}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "zero max price age",
+			jsonCfg:  `{"maxPriceAge": "0s"}`,
+			expError: true,
+		},
+		{
+			name:         "valid min token price confidence",
+			jsonCfg:      `{"minTokenPriceConfidence": 0.5}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "min token price confidence above 1",
+			jsonCfg:  `{"minTokenPriceConfidence": 1.5}`,
+			expError: true,
+		},
+		{
+			name:     "too short stale price retention",
+			jsonCfg:  `{"stalePriceRetention": "10m"}`,
+			expError: true,
+		},
+		{
+			name:         "valid quote currency",
+			jsonCfg:      `{"quoteCurrency": {"symbol": "EUR", "tokenAddress": "0x0820c05e1fba1244763a494a52272170c321cad3", "chainSelector": "1"}}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "quote currency without symbol",
+			jsonCfg:  `{"quoteCurrency": {"tokenAddress": "0x0820c05e1fba1244763a494a52272170c321cad3", "chainSelector": "1"}}`,
+			expError: true,
+		},
+		{
+			name:         "valid token allow and deny lists",
+			jsonCfg:      `{"tokenAllowlist": ["0x0820c05e1fba1244763a494a52272170c321cad3"], "tokenDenylist": ["0xec8c353470ccaa4f43067fcde40558e084a12927"]}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "token both allowlisted and denylisted",
+			jsonCfg:  `{"tokenAllowlist": ["0x0820c05e1fba1244763a494a52272170c321cad3"], "tokenDenylist": ["0x0820c05e1fba1244763a494a52272170c321cad3"]}`,
+			expError: true,
+		},
+		{
+			name:         "dual write with consolidated reads",
+			jsonCfg:      `{"dualWritePrices": true, "readConsolidatedPrices": true}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:         "valid batch write window",
+			jsonCfg:      `{"batchWriteWindow": "2s"}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "zero batch write window",
+			jsonCfg:  `{"batchWriteWindow": "0s"}`,
+			expError: true,
+		},
+		{
+			name:         "valid token metadata",
+			jsonCfg:      `{"tokenMetadata": {"0x0820c05e1fba1244763a494a52272170c321cad3": {"symbol": "LINK", "coingeckoId": "chainlink"}}}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "token metadata without symbol",
+			jsonCfg:  `{"tokenMetadata": {"0x0820c05e1fba1244763a494a52272170c321cad3": {"coingeckoId": "chainlink"}}}`,
+			expError: true,
+		},
+		{
+			name:         "write deviation with heartbeat",
+			jsonCfg:      `{"writeDeviationBps": 50, "writeHeartbeat": "30m", "maxPriceAge": "1h"}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "write deviation above 100%",
+			jsonCfg:  `{"writeDeviationBps": 10001}`,
+			expError: true,
+		},
+		{
+			name:     "write heartbeat not shorter than max price age",
+			jsonCfg:  `{"writeDeviationBps": 50, "writeHeartbeat": "1h", "maxPriceAge": "1h"}`,
+			expError: true,
+		},
+		{
+			name:         "price bounds",
+			jsonCfg:      `{"gasPriceBounds": {"max": 1000000000000000000}, "tokenPriceBounds": {"min": 1000000000, "max": 1000000000000000000000000}}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "price bounds with min above max",
+			jsonCfg:  `{"tokenPriceBounds": {"min": 2000, "max": 1000}}`,
+			expError: true,
+		},
+		{
+			name:         "gas price dampening",
+			jsonCfg:      `{"gasPriceDampening": {"window": "1h", "maxMultiplier": 3, "minMultiplier": 0.5}}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "gas price dampening without multipliers",
+			jsonCfg:  `{"gasPriceDampening": {"window": "1h"}}`,
+			expError: true,
+		},
+		{
+			name:     "gas price dampening with max multiplier below 1",
+			jsonCfg:  `{"gasPriceDampening": {"maxMultiplier": 0.9}}`,
+			expError: true,
+		},
+		{
+			name:         "gas price endpoints",
+			jsonCfg:      `{"gasPriceEndpoints": {"endpoints": [{"name": "a", "url": "https://a.example"}, {"name": "b", "url": "https://b.example"}], "minResponses": 2}}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "gas price endpoints with duplicate names",
+			jsonCfg:  `{"gasPriceEndpoints": {"endpoints": [{"name": "a", "url": "https://a.example"}, {"name": "a", "url": "https://b.example"}]}}`,
+			expError: true,
+		},
+		{
+			name:     "gas price endpoints with unreachable min responses",
+			jsonCfg:  `{"gasPriceEndpoints": {"endpoints": [{"name": "a", "url": "https://a.example"}], "minResponses": 3}}`,
+			expError: true,
+		},
+		{
+			name:         "native price check",
+			jsonCfg:      `{"nativePriceCheck": {"destToken": "0x0820c05e1fba1244763a494a52272170c321cad3", "maxDeviationBps": 1000, "rejectOnDeviation": true, "maxOnChainPriceAge": "1h"}}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "native price check without dest token",
+			jsonCfg:  `{"nativePriceCheck": {"maxDeviationBps": 1000}}`,
+			expError: true,
+		},
+		{
+			name:     "native price check without max deviation",
+			jsonCfg:  `{"nativePriceCheck": {"destToken": "0x0820c05e1fba1244763a494a52272170c321cad3"}}`,
+			expError: true,
+		},
+		{
+			name:         "signed prices",
+			jsonCfg:      `{"signedPrices": {"signers": ["0x0820c05e1fba1244763a494a52272170c321cad3"], "maxPayloadAge": "5m"}}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "signed prices without signers",
+			jsonCfg:  `{"signedPrices": {"maxPayloadAge": "5m"}}`,
+			expError: true,
+		},
+		{
+			name:         "push price refresh",
+			jsonCfg:      `{"pushPriceRefresh": true}`,
+			expIntervals: map[common.Address]time.Duration{},
+		},
+		{
+			name:     "consolidated reads without dual write",
+			jsonCfg:  `{"readConsolidatedPrices": true}`,
+			expError: true,
+		},
+		{
+			name:     "quote currency without token",
+			jsonCfg:  `{"quoteCurrency": {"symbol": "EUR", "chainSelector": "1"}}`,
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg PriceServiceConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.jsonCfg), &cfg))
+
+			err := cfg.Validate()
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			intervals := make(map[common.Address]time.Duration, len(cfg.TokenUpdateIntervals))
+			for token, interval := range cfg.TokenUpdateIntervals {
+				intervals[token] = interval.Duration()
+			}
+			require.Equal(t, tc.expIntervals, intervals)
+			require.Equal(t, tc.expPartial, cfg.AllowPartialTokenPriceUpdates)
+		})
+	}
+}
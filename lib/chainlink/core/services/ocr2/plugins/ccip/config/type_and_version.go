@@ -48,7 +48,11 @@ func TypeAndVersion(addr common.Address, client bind.ContractBackend) (ContractT
 	if err != nil {
 		return "", semver.Version{}, fmt.Errorf("error calling typeAndVersion on addr: %s %w", addr.String(), err)
 	}
+	return ParseContractTypeAndVersion(tvStr)
+}
 
+// ParseContractTypeAndVersion parses the typeAndVersion string of a CCIP contract, e.g. "EVM2EVMOffRamp 1.5.0".
+func ParseContractTypeAndVersion(tvStr string) (ContractType, semver.Version, error) {
 	contractType, versionStr, err := ParseTypeAndVersion(tvStr)
 	if err != nil {
 		return "", semver.Version{}, err
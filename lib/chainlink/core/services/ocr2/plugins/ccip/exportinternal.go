@@ -3,6 +3,7 @@ package ccip
 import (
 	"context"
 	"math/big"
+	"net/http"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -23,14 +24,18 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/ccipdataprovider"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/factory"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/logpollerutil"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 const OffchainAggregator = "OffchainAggregator"
 const DecimalsMethodName = "decimals"
 const LatestRoundDataMethodName = "latestRoundData"
+const UniswapV3Pool = pricegetter.UniswapV3Pool
+const ObserveMethodName = pricegetter.ObserveMethodName
 
 func GenericAddrToEvm(addr ccip.Address) (common.Address, error) {
 	return ccipcalc.GenericAddrToEvm(addr)
@@ -58,6 +63,46 @@ func NewOffRampReader(ctx context.Context, lggr logger.Logger, versionFinder Ver
 	return factory.NewOffRampReader(ctx, lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, registerFilters, feeEstimatorConfig)
 }
 
+type LogsConfig = ccipdata.LogsConfig
+
+func NewLogsConfig(cfg *config.ReaderLogsConfig) LogsConfig {
+	return ccipdata.NewLogsConfig(cfg)
+}
+
+func NewCommitStoreReaderWithLogsConfig(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, address ccip.Address, ec client.Client, lp logpoller.LogPoller, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader, logsConfig LogsConfig) (ccipdata.CommitStoreReader, error) {
+	return factory.NewCommitStoreReaderWithLogsConfig(ctx, lggr, versionFinder, address, ec, lp, feeEstimatorConfig, logsConfig)
+}
+
+func NewOffRampReaderWithLogsConfig(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, addr ccip.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, registerFilters bool, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader, logsConfig LogsConfig) (ccipdata.OffRampReader, error) {
+	return factory.NewOffRampReaderWithLogsConfig(ctx, lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, registerFilters, feeEstimatorConfig, logsConfig)
+}
+
+func NewOnRampReaderWithLogsConfig(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, sourceSelector, destSelector uint64, onRampAddress ccip.Address, sourceLP logpoller.LogPoller, source client.Client, logsConfig LogsConfig) (ccipdata.OnRampReader, error) {
+	return factory.NewOnRampReaderWithLogsConfig(ctx, lggr, versionFinder, sourceSelector, destSelector, onRampAddress, sourceLP, source, logsConfig)
+}
+
+type LaneContracts = factory.LaneContracts
+
+type LaneFilters = factory.LaneFilters
+
+func NewLaneFilters(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, lane LaneContracts, sourceLP, destLP logpoller.LogPoller, sourceClient, destClient client.Client, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) (LaneFilters, error) {
+	return factory.NewLaneFilters(ctx, lggr, versionFinder, lane, sourceLP, destLP, sourceClient, destClient, feeEstimatorConfig)
+}
+
+func RegisterLaneFilters(ctx context.Context, sourceLP, destLP logpoller.LogPoller, filters LaneFilters) error {
+	return factory.RegisterLaneFilters(ctx, sourceLP, destLP, filters)
+}
+
+func UnregisterLaneFilters(ctx context.Context, sourceLP, destLP logpoller.LogPoller, filters LaneFilters) error {
+	return factory.UnregisterLaneFilters(ctx, sourceLP, destLP, filters)
+}
+
+type DeferredFilterRegistration = logpollerutil.DeferredRegistration
+
+func NewOffRampReaderWithDeferredFilters(lggr logger.Logger, versionFinder VersionFinder, addr ccip.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader, logsConfig LogsConfig) (ccipdata.OffRampReader, *DeferredFilterRegistration, error) {
+	return factory.NewOffRampReaderWithDeferredFilters(lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, feeEstimatorConfig, logsConfig)
+}
+
 func CloseOffRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, addr ccip.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) error {
 	return factory.CloseOffRampReader(ctx, lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, feeEstimatorConfig)
 }
@@ -66,6 +111,10 @@ func NewEvmVersionFinder() factory.EvmVersionFinder {
 	return factory.NewEvmVersionFinder()
 }
 
+func NewEvmVersionFinderWithOverrides(overrides map[ccip.Address]string) (factory.EvmVersionFinder, error) {
+	return factory.NewEvmVersionFinderWithOverrides(overrides)
+}
+
 func NewOnRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, sourceSelector, destSelector uint64, onRampAddress ccip.Address, sourceLP logpoller.LogPoller, source client.Client) (ccipdata.OnRampReader, error) {
 	return factory.NewOnRampReader(ctx, lggr, versionFinder, sourceSelector, destSelector, onRampAddress, sourceLP, source)
 }
@@ -74,6 +123,22 @@ func CloseOnRampReader(ctx context.Context, lggr logger.Logger, versionFinder Ve
 	return factory.CloseOnRampReader(ctx, lggr, versionFinder, sourceSelector, destSelector, onRampAddress, sourceLP, source)
 }
 
+type ChainReaders = factory.ChainReaders
+
+type ChainReadersFactory = factory.ChainReadersFactory
+
+func RegisterChainReadersFactory(family string, chainReadersFactory ChainReadersFactory) error {
+	return factory.RegisterChainReadersFactory(family, chainReadersFactory)
+}
+
+func NewChainReaders(chainSelector uint64) (ChainReaders, error) {
+	return factory.NewChainReaders(chainSelector)
+}
+
+func NewEvmChainReaders(versionFinder VersionFinder, cl client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, maxGasPrice *big.Int, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) ChainReaders {
+	return factory.NewEvmChainReaders(versionFinder, cl, lp, estimator, maxGasPrice, feeEstimatorConfig)
+}
+
 type OffRampReader = ccipdata.OffRampReader
 
 type DynamicPriceGetterClient = pricegetter.DynamicPriceGetterClient
@@ -82,6 +147,28 @@ type DynamicPriceGetter = pricegetter.DynamicPriceGetter
 
 type AllTokensPriceGetter = pricegetter.AllTokensPriceGetter
 
+type AggregatedPriceGetter = pricegetter.AggregatedPriceGetter
+
+type CircuitBreakerPriceGetter = pricegetter.CircuitBreakerPriceGetter
+
+type BatchedPriceGetter = pricegetter.BatchedPriceGetter
+
+type CrossRatePriceGetter = pricegetter.CrossRatePriceGetter
+
+type FixedPriceGetter = pricegetter.FixedPriceGetter
+
+type UniswapV3TWAPPriceGetter = pricegetter.UniswapV3TWAPPriceGetter
+
+type HTTPPriceGetter = pricegetter.HTTPPriceGetter
+
+type HostRateLimits = pricegetter.HostRateLimits
+
+type RateLimitedPriceGetter = pricegetter.RateLimitedPriceGetter
+
+type PriceCache = pricegetter.PriceCache
+
+type CachedPriceGetter = pricegetter.CachedPriceGetter
+
 func NewPipelineGetter(
 	source string,
 	runner pipeline.Runner,
@@ -97,6 +184,25 @@ func NewPipelineGetter(
 		sourceNativeTokenAddr, sourceChainSelector, destChainSelector)
 }
 
+func NewTokenPipelineGetter(
+	cfg config.TokenPricePipelinesConfig,
+	runner pipeline.Runner,
+	jobID int32,
+	name string,
+	lggr logger.Logger,
+) (*pricegetter.TokenPipelineGetter, error) {
+	return pricegetter.NewTokenPipelineGetter(cfg, runner, jobID, name, lggr)
+}
+
+func NewDataStreamsPriceGetter(
+	lggr logger.Logger,
+	cfg config.DataStreamsPriceGetterConfig,
+	credentials types.MercuryCredentials,
+	httpClient *http.Client,
+) (*pricegetter.DataStreamsPriceGetter, error) {
+	return pricegetter.NewDataStreamsPriceGetter(lggr, cfg, credentials, httpClient)
+}
+
 func NewDynamicPriceGetterClient(batchCaller rpclib.EvmBatchCaller) DynamicPriceGetterClient {
 	return pricegetter.NewDynamicPriceGetterClient(batchCaller)
 }
@@ -105,6 +211,42 @@ func NewDynamicPriceGetter(cfg config.DynamicPriceGetterConfig, contractReaders
 	return pricegetter.NewDynamicPriceGetter(cfg, contractReaders)
 }
 
+func NewAggregatedPriceGetter(lggr logger.Logger, mode config.PriceAggregationMode, getters ...AllTokensPriceGetter) (*AggregatedPriceGetter, error) {
+	return pricegetter.NewAggregatedPriceGetter(lggr, mode, getters...)
+}
+
+func NewCircuitBreakerPriceGetter(lggr logger.Logger, name string, getter AllTokensPriceGetter, failureThreshold int, openDuration time.Duration) (*CircuitBreakerPriceGetter, error) {
+	return pricegetter.NewCircuitBreakerPriceGetter(lggr, name, getter, failureThreshold, openDuration)
+}
+
+func NewCrossRatePriceGetter(lggr logger.Logger, crossRates config.CrossRatesConfig, getter AllTokensPriceGetter) (*CrossRatePriceGetter, error) {
+	return pricegetter.NewCrossRatePriceGetter(lggr, crossRates, getter)
+}
+
+func NewFixedPriceGetter(lggr logger.Logger, prices config.FixedPricesConfig, getter AllTokensPriceGetter) (*FixedPriceGetter, error) {
+	return pricegetter.NewFixedPriceGetter(lggr, prices, getter)
+}
+
+func NewUniswapV3TWAPPriceGetter(lggr logger.Logger, cfg config.UniswapV3TWAPPriceGetterConfig, contractReaders map[uint64]types.ContractReader, getter AllTokensPriceGetter) (*UniswapV3TWAPPriceGetter, error) {
+	return pricegetter.NewUniswapV3TWAPPriceGetter(lggr, cfg, contractReaders, getter)
+}
+
+func NewHostRateLimits(lggr logger.Logger) *HostRateLimits {
+	return pricegetter.NewHostRateLimits(lggr)
+}
+
+func NewRateLimitedPriceGetter(rateLimits *HostRateLimits, getter HTTPPriceGetter) (*RateLimitedPriceGetter, error) {
+	return pricegetter.NewRateLimitedPriceGetter(rateLimits, getter)
+}
+
+func NewPriceCache(ttl time.Duration) (*PriceCache, error) {
+	return pricegetter.NewPriceCache(ttl)
+}
+
+func NewCachedPriceGetter(lggr logger.Logger, cache *PriceCache, key string, getter AllTokensPriceGetter) (*CachedPriceGetter, error) {
+	return pricegetter.NewCachedPriceGetter(lggr, cache, key, getter)
+}
+
 func NewDynamicLimitedBatchCaller(
 	lggr logger.Logger, batchSender rpclib.BatchSender, batchSizeLimit, backOffMultiplier, parallelRpcCallsLimit uint,
 ) *rpclib.DynamicLimitedBatchCaller {
@@ -121,6 +263,23 @@ func CloseUSDCReader(ctx context.Context, lggr logger.Logger, jobID string, tran
 
 type USDCReaderImpl = ccipdata.USDCReaderImpl
 
+type TokenDataReaderType = factory.TokenDataReaderType
+
+const USDCTokenDataReader = factory.USDCTokenDataReader
+const LBTCTokenDataReader = factory.LBTCTokenDataReader
+
+type TokenDataReaderConfig = factory.TokenDataReaderConfig
+
+type TokenDataReaderFactory = factory.TokenDataReaderFactory
+
+func RegisterTokenDataReaderFactory(typ TokenDataReaderType, tokenDataReaderFactory TokenDataReaderFactory) error {
+	return factory.RegisterTokenDataReaderFactory(typ, tokenDataReaderFactory)
+}
+
+func NewTokenDataReader(lggr logger.Logger, cfg TokenDataReaderConfig) (tokendata.Reader, error) {
+	return factory.NewTokenDataReader(lggr, cfg)
+}
+
 var DefaultRpcBatchSizeLimit = rpclib.DefaultRpcBatchSizeLimit
 var DefaultRpcBatchBackOffMultiplier = rpclib.DefaultRpcBatchBackOffMultiplier
 var DefaultMaxParallelRpcCalls = rpclib.DefaultMaxParallelRpcCalls
@@ -161,3 +320,5 @@ func NewCommitOffchainConfig(
 }
 
 const OffChainAggregatorABI = offchainaggregator.OffchainAggregatorABI
+
+const UniswapV3PoolABI = pricegetter.UniswapV3PoolABI
@@ -36,6 +36,14 @@ func CalculateUsdPerUnitGas(sourceGasPrice *big.Int, usdPerFeeCoin *big.Int) *bi
 	return tmp.Div(tmp, big.NewInt(1e18))
 }
 
+// ConvertUsdToQuote converts a USD denominated value to the quote currency: (usdValue * 1e18) / usdPerQuoteUnit.
+// usdPerQuoteUnit is the USD price of one unit of the quote currency, in 1e18 scale like the USD value.
+func ConvertUsdToQuote(usdValue *big.Int, usdPerQuoteUnit *big.Int) *big.Int {
+	// usd / (usd / quote) = quote, the value is multiplied by 1e18 first to keep the 1e18 scale
+	tmp := new(big.Int).Mul(usdValue, big.NewInt(1e18))
+	return tmp.Div(tmp, usdPerQuoteUnit)
+}
+
 // BigIntSortedMiddle returns the middle number after sorting the provided numbers. nil is returned if the provided slice is empty.
 // If length of the provided slice is even, the right-hand-side value of the middle 2 numbers is returned.
 // The objective of this function is to always pick within the range of values reported by honest nodes when we have 2f+1 values.
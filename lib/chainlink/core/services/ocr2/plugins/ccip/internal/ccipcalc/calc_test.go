@@ -102,6 +102,41 @@ func TestCalculateUsdPerUnitGas(t *testing.T) {
 	}
 }
 
+func TestConvertUsdToQuote(t *testing.T) {
+	testCases := []struct {
+		name            string
+		usdValue        *big.Int
+		usdPerQuoteUnit *big.Int
+		exp             *big.Int
+	}{
+		{
+			name:            "base case",
+			usdValue:        big.NewInt(6e18),
+			usdPerQuoteUnit: big.NewInt(2e18),
+			exp:             big.NewInt(3e18),
+		},
+		{
+			name:            "quote currency cheaper than usd",
+			usdValue:        big.NewInt(1e18),
+			usdPerQuoteUnit: big.NewInt(5e17),
+			exp:             big.NewInt(2e18),
+		},
+		{
+			name:            "usd quote currency",
+			usdValue:        big.NewInt(1234),
+			usdPerQuoteUnit: big.NewInt(1e18),
+			exp:             big.NewInt(1234),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ConvertUsdToQuote(tc.usdValue, tc.usdPerQuoteUnit)
+			assert.Zero(t, tc.exp.Cmp(res))
+		})
+	}
+}
+
 func TestBigIntSortedMiddle(t *testing.T) {
 	tests := []struct {
 		name string
@@ -62,6 +62,10 @@ type CommitStoreReader interface {
 	cciptypes.CommitStoreReader
 	SetGasEstimator(ctx context.Context, gpe gas.EvmFeeEstimator) error
 	SetSourceMaxGasPrice(ctx context.Context, sourceMaxGasPrice *big.Int) error
+	// SubscribeAcceptedCommitReports streams the commit reports accepted with an interval ending at or after minSeqNum,
+	// in sequence number order. The reports are read from the log poller every pollInterval, the filters of the reader
+	// must therefore be registered. The channel is closed once ctx is done.
+	SubscribeAcceptedCommitReports(ctx context.Context, minSeqNum uint64, confirmations int, pollInterval time.Duration) (<-chan cciptypes.CommitStoreReportWithTxMeta, error)
 }
 
 // FetchCommitStoreStaticConfig provides access to a commitStore's static config, which is required to access the source chain ID.
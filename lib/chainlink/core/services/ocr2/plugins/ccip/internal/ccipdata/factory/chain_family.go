@@ -0,0 +1,117 @@
+package factory
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/pkg/errors"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// ChainReaders creates and closes the readers of the CCIP contracts deployed on a chain. The readers are returned
+// behind the cciptypes interfaces, so that the plugins consume the readers of every chain family the same way.
+type ChainReaders interface {
+	NewOnRampReader(ctx context.Context, lggr logger.Logger, sourceSelector, destSelector uint64, onRamp cciptypes.Address) (cciptypes.OnRampReader, error)
+	CloseOnRampReader(ctx context.Context, lggr logger.Logger, sourceSelector, destSelector uint64, onRamp cciptypes.Address) error
+	NewOffRampReader(ctx context.Context, lggr logger.Logger, offRamp cciptypes.Address) (cciptypes.OffRampReader, error)
+	CloseOffRampReader(ctx context.Context, lggr logger.Logger, offRamp cciptypes.Address) error
+	NewPriceRegistryReader(ctx context.Context, lggr logger.Logger, priceRegistry cciptypes.Address) (cciptypes.PriceRegistryReader, error)
+	ClosePriceRegistryReader(ctx context.Context, lggr logger.Logger, priceRegistry cciptypes.Address) error
+}
+
+// ChainReadersFactory returns the ChainReaders of a chain of the family the factory is registered for.
+type ChainReadersFactory func(chainSelector uint64) (ChainReaders, error)
+
+var (
+	chainReadersFactoriesMu sync.RWMutex
+	chainReadersFactories   = map[string]ChainReadersFactory{}
+)
+
+// RegisterChainReadersFactory registers the ChainReadersFactory of a non-EVM chain family, e.g. chainsel.FamilySolana
+// or chainsel.FamilyAptos. The EVM readers depend on the log poller and the client of the EVM relayer, they are
+// created with NewEvmChainReaders.
+func RegisterChainReadersFactory(family string, factory ChainReadersFactory) error {
+	if family == chainsel.FamilyEVM {
+		return errors.New("EVM chain readers are created with NewEvmChainReaders")
+	}
+	chainReadersFactoriesMu.Lock()
+	defer chainReadersFactoriesMu.Unlock()
+	if _, exists := chainReadersFactories[family]; exists {
+		return errors.Errorf("chain readers factory of family %s is already registered", family)
+	}
+	chainReadersFactories[family] = factory
+	return nil
+}
+
+// NewChainReaders returns the ChainReaders of the chain, dispatching on the family of its selector to the factory
+// registered with RegisterChainReadersFactory.
+func NewChainReaders(chainSelector uint64) (ChainReaders, error) {
+	family, err := chainsel.GetSelectorFamily(chainSelector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the family of chain %d", chainSelector)
+	}
+	chainReadersFactoriesMu.RLock()
+	factory, exists := chainReadersFactories[family]
+	chainReadersFactoriesMu.RUnlock()
+	if !exists {
+		return nil, errors.Errorf("no chain readers factory is registered for family %s of chain %d", family, chainSelector)
+	}
+	return factory(chainSelector)
+}
+
+// evmChainReaders are the ChainReaders of an EVM chain, the readers are created and closed by the version-dispatching
+// functions of this package.
+type evmChainReaders struct {
+	versionFinder      VersionFinder
+	client             client.Client
+	lp                 logpoller.LogPoller
+	estimator          gas.EvmFeeEstimator
+	maxGasPrice        *big.Int
+	feeEstimatorConfig ccipdata.FeeEstimatorConfigReader
+}
+
+// NewEvmChainReaders returns the ChainReaders of an EVM chain. The estimator, maxGasPrice and feeEstimatorConfig are
+// only used by the offRamp readers.
+func NewEvmChainReaders(versionFinder VersionFinder, cl client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, maxGasPrice *big.Int, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) ChainReaders {
+	return &evmChainReaders{
+		versionFinder:      versionFinder,
+		client:             cl,
+		lp:                 lp,
+		estimator:          estimator,
+		maxGasPrice:        maxGasPrice,
+		feeEstimatorConfig: feeEstimatorConfig,
+	}
+}
+
+func (r *evmChainReaders) NewOnRampReader(ctx context.Context, lggr logger.Logger, sourceSelector, destSelector uint64, onRamp cciptypes.Address) (cciptypes.OnRampReader, error) {
+	return NewOnRampReader(ctx, lggr, r.versionFinder, sourceSelector, destSelector, onRamp, r.lp, r.client)
+}
+
+func (r *evmChainReaders) CloseOnRampReader(ctx context.Context, lggr logger.Logger, sourceSelector, destSelector uint64, onRamp cciptypes.Address) error {
+	return CloseOnRampReader(ctx, lggr, r.versionFinder, sourceSelector, destSelector, onRamp, r.lp, r.client)
+}
+
+func (r *evmChainReaders) NewOffRampReader(ctx context.Context, lggr logger.Logger, offRamp cciptypes.Address) (cciptypes.OffRampReader, error) {
+	return NewOffRampReader(ctx, lggr, r.versionFinder, offRamp, r.client, r.lp, r.estimator, r.maxGasPrice, true, r.feeEstimatorConfig)
+}
+
+func (r *evmChainReaders) CloseOffRampReader(ctx context.Context, lggr logger.Logger, offRamp cciptypes.Address) error {
+	return CloseOffRampReader(ctx, lggr, r.versionFinder, offRamp, r.client, r.lp, r.estimator, r.maxGasPrice, r.feeEstimatorConfig)
+}
+
+func (r *evmChainReaders) NewPriceRegistryReader(ctx context.Context, lggr logger.Logger, priceRegistry cciptypes.Address) (cciptypes.PriceRegistryReader, error) {
+	return NewPriceRegistryReader(ctx, lggr, r.versionFinder, priceRegistry, r.lp, r.client)
+}
+
+func (r *evmChainReaders) ClosePriceRegistryReader(ctx context.Context, lggr logger.Logger, priceRegistry cciptypes.Address) error {
+	return ClosePriceRegistryReader(ctx, lggr, r.versionFinder, priceRegistry, r.lp, r.client)
+}
@@ -0,0 +1,74 @@
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	mocks2 "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+)
+
+// onRampChainReaders are the ChainReaders of a chain family which only supports onRamps.
+type onRampChainReaders struct {
+	ChainReaders
+	onRamp cciptypes.OnRampReader
+}
+
+func (r onRampChainReaders) NewOnRampReader(context.Context, logger.Logger, uint64, uint64, cciptypes.Address) (cciptypes.OnRampReader, error) {
+	return r.onRamp, nil
+}
+
+func TestNewChainReaders(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	addr := cciptypes.Address(utils.RandomAddress().String())
+
+	t.Run("registered families", func(t *testing.T) {
+		solanaSelector := chainsel.SOLANA_DEVNET.Selector
+		_, err := NewChainReaders(solanaSelector)
+		require.ErrorContains(t, err, "no chain readers factory is registered for family solana")
+
+		onRamp := ccipdatamocks.NewOnRampReader(t)
+		var gotSelector uint64
+		require.NoError(t, RegisterChainReadersFactory(chainsel.FamilySolana, func(chainSelector uint64) (ChainReaders, error) {
+			gotSelector = chainSelector
+			return onRampChainReaders{onRamp: onRamp}, nil
+		}))
+		require.ErrorContains(t, RegisterChainReadersFactory(chainsel.FamilySolana, nil), "already registered")
+
+		readers, err := NewChainReaders(solanaSelector)
+		require.NoError(t, err)
+		assert.Equal(t, solanaSelector, gotSelector)
+		reader, err := readers.NewOnRampReader(ctx, lggr, solanaSelector, chainsel.TEST_1000.Selector, addr)
+		require.NoError(t, err)
+		assert.Equal(t, onRamp, reader)
+	})
+
+	t.Run("EVM", func(t *testing.T) {
+		require.ErrorContains(t, RegisterChainReadersFactory(chainsel.FamilyEVM, nil), "NewEvmChainReaders")
+
+		lp := mocks2.NewLogPoller(t)
+		lp.On("RegisterFilter", mock.Anything, mock.Anything).Return(nil)
+		versionFinder := newMockVersionFinder(ccipconfig.EVM2EVMOnRamp, *semver.MustParse(ccipdata.V1_5_0), nil)
+		readers := NewEvmChainReaders(versionFinder, nil, lp, nil, nil, ccipdatamocks.NewFeeEstimatorConfigReader(t))
+
+		reader, err := readers.NewOnRampReader(ctx, lggr, 1000, 2000, addr)
+		require.NoError(t, err)
+		onRampAddr, err := reader.Address(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, addr, onRampAddr)
+	})
+}
@@ -0,0 +1,195 @@
+package factory
+
+import (
+	"context"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// AssertReadersCloseEnv enables the runtime assertion that the Close*Reader functions unregister every filter of the
+// readers, the filters left registered are logged as errors.
+const AssertReadersCloseEnv = "CL_CCIP_ASSERT_READERS_CLOSE"
+
+func assertReadersClose() bool {
+	return os.Getenv(AssertReadersCloseEnv) == "true"
+}
+
+// filterRecorder is a LogPoller recording the filters registered and unregistered through it. The calls are forwarded
+// to the wrapped LogPoller, unless it is a dry run in which case only the filter methods may be called.
+type filterRecorder struct {
+	logpoller.LogPoller
+	dryRun bool
+
+	mu           sync.Mutex
+	registered   map[string]struct{}
+	unregistered map[string]struct{}
+}
+
+func newFilterRecorder(lp logpoller.LogPoller) *filterRecorder {
+	return &filterRecorder{
+		LogPoller:    lp,
+		registered:   make(map[string]struct{}),
+		unregistered: make(map[string]struct{}),
+	}
+}
+
+func newDryRunFilterRecorder() *filterRecorder {
+	r := newFilterRecorder(nil)
+	r.dryRun = true
+	return r
+}
+
+func (r *filterRecorder) RegisterFilter(ctx context.Context, filter logpoller.Filter) error {
+	if !r.dryRun {
+		if err := r.LogPoller.RegisterFilter(ctx, filter); err != nil {
+			return err
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.registered[filter.Name] = struct{}{}
+	delete(r.unregistered, filter.Name)
+	return nil
+}
+
+func (r *filterRecorder) UnregisterFilter(ctx context.Context, name string) error {
+	if !r.dryRun {
+		if err := r.LogPoller.UnregisterFilter(ctx, name); err != nil {
+			return err
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.unregistered[name] = struct{}{}
+	return nil
+}
+
+func (r *filterRecorder) HasFilter(name string) bool {
+	if !r.dryRun {
+		return r.LogPoller.HasFilter(name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, registered := r.registered[name]
+	_, unregistered := r.unregistered[name]
+	return registered && !unregistered
+}
+
+// notUnregistered returns the names of the filters which were not unregistered, sorted.
+func (r *filterRecorder) notUnregistered(filters []string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var names []string
+	for _, name := range filters {
+		if _, ok := r.unregistered[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// leakedFilters returns the names of the filters which were registered but not unregistered, sorted.
+func (r *filterRecorder) leakedFilters() []string {
+	r.mu.Lock()
+	registered := make([]string, 0, len(r.registered))
+	for name := range r.registered {
+		registered = append(registered, name)
+	}
+	r.mu.Unlock()
+	return r.notUnregistered(registered)
+}
+
+// readerWithFilters is a reader of the factory before its filters are registered or after they are unregistered.
+type readerWithFilters interface {
+	Close() error
+	Filters() []logpoller.Filter
+}
+
+// closeAndAssertReader closes the reader, when the reader was created with a filterRecorder it logs the filters of the
+// reader that Close left registered.
+func closeAndAssertReader(lggr logger.Logger, readerName string, reader readerWithFilters, lp logpoller.LogPoller) error {
+	err := reader.Close()
+	recorder, ok := lp.(*filterRecorder)
+	if !ok || err != nil {
+		return err
+	}
+	filterNames := make([]string, 0, len(reader.Filters()))
+	for _, filter := range reader.Filters() {
+		// The filters of zero addresses are never registered
+		if slices.Contains(filter.Addresses, common.Address{}) {
+			continue
+		}
+		filterNames = append(filterNames, filter.Name)
+	}
+	if leaked := recorder.notUnregistered(filterNames); len(leaked) > 0 {
+		lggr.Errorw("Reader close left filters registered", "reader", readerName, "filters", leaked)
+	}
+	return nil
+}
+
+// closingLogPoller returns the LogPoller the readers being closed are created with, it records the filters
+// unregistered by the readers when the runtime assertion of AssertReadersCloseEnv is enabled.
+func closingLogPoller(lp logpoller.LogPoller) logpoller.LogPoller {
+	if assertReadersClose() {
+		return newFilterRecorder(lp)
+	}
+	return lp
+}
+
+// ValidateLaneReadersClose is a dry run of the creation and the closing of the readers of the lane, it returns an
+// error naming the filters registered by the New*Reader functions that the Close*Reader ones don't unregister. No
+// filter is registered to or unregistered from the log pollers of the lane, the clients are only used to read the
+// typeAndVersion of the lane contracts.
+func ValidateLaneReadersClose(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, lane LaneContracts, sourceClient, destClient client.Client, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) error {
+	sourceLP, destLP := newDryRunFilterRecorder(), newDryRunFilterRecorder()
+
+	if _, err := NewOnRampReader(ctx, lggr, versionFinder, lane.SourceSelector, lane.DestSelector, lane.OnRamp, sourceLP, sourceClient); err != nil {
+		return errors.Wrap(err, "new onRamp reader")
+	}
+	if err := CloseOnRampReader(ctx, lggr, versionFinder, lane.SourceSelector, lane.DestSelector, lane.OnRamp, sourceLP, sourceClient); err != nil {
+		return errors.Wrap(err, "close onRamp reader")
+	}
+	if _, err := NewOffRampReader(ctx, lggr, versionFinder, lane.OffRamp, destClient, destLP, nil, nil, true, feeEstimatorConfig); err != nil {
+		return errors.Wrap(err, "new offRamp reader")
+	}
+	if err := CloseOffRampReader(ctx, lggr, versionFinder, lane.OffRamp, destClient, destLP, nil, nil, feeEstimatorConfig); err != nil {
+		return errors.Wrap(err, "close offRamp reader")
+	}
+	if _, err := NewCommitStoreReader(ctx, lggr, versionFinder, lane.CommitStore, destClient, destLP, feeEstimatorConfig); err != nil {
+		return errors.Wrap(err, "new commitStore reader")
+	}
+	if err := CloseCommitStoreReader(ctx, lggr, versionFinder, lane.CommitStore, destClient, destLP, feeEstimatorConfig); err != nil {
+		return errors.Wrap(err, "close commitStore reader")
+	}
+	if lane.PriceRegistry != "" {
+		if _, err := NewPriceRegistryReader(ctx, lggr, versionFinder, lane.PriceRegistry, destLP, destClient); err != nil {
+			return errors.Wrap(err, "new priceRegistry reader")
+		}
+		if err := ClosePriceRegistryReader(ctx, lggr, versionFinder, lane.PriceRegistry, destLP, destClient); err != nil {
+			return errors.Wrap(err, "close priceRegistry reader")
+		}
+	}
+
+	var err error
+	if leaked := sourceLP.leakedFilters(); len(leaked) > 0 {
+		err = multierr.Append(err, errors.Errorf("source filters %v are not unregistered on close", leaked))
+	}
+	if leaked := destLP.leakedFilters(); len(leaked) > 0 {
+		err = multierr.Append(err, errors.Errorf("dest filters %v are not unregistered on close", leaked))
+	}
+	return err
+}
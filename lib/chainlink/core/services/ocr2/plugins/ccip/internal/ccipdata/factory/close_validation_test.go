@@ -0,0 +1,89 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	mocks2 "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
+)
+
+type leakyReader struct {
+	filters []logpoller.Filter
+}
+
+func (r leakyReader) Close() error {
+	return nil
+}
+
+func (r leakyReader) Filters() []logpoller.Filter {
+	return r.filters
+}
+
+func TestValidateLaneReadersClose(t *testing.T) {
+	lane := LaneContracts{
+		SourceSelector: 1000,
+		DestSelector:   2000,
+		OnRamp:         cciptypes.Address(utils.RandomAddress().String()),
+		OffRamp:        cciptypes.Address(utils.RandomAddress().String()),
+		CommitStore:    cciptypes.Address(utils.RandomAddress().String()),
+	}
+	versionFinder := laneVersionFinder{
+		lane.OnRamp:      ccipconfig.EVM2EVMOnRamp,
+		lane.OffRamp:     ccipconfig.EVM2EVMOffRamp,
+		lane.CommitStore: ccipconfig.CommitStore,
+	}
+
+	AssertLaneReadersClose(t, versionFinder, lane, nil, nil, ccipdatamocks.NewFeeEstimatorConfigReader(t))
+}
+
+func TestFilterRecorder(t *testing.T) {
+	ctx := tests.Context(t)
+	recorder := newDryRunFilterRecorder()
+
+	require.NoError(t, recorder.RegisterFilter(ctx, logpoller.Filter{Name: "a"}))
+	require.NoError(t, recorder.RegisterFilter(ctx, logpoller.Filter{Name: "b"}))
+	require.NoError(t, recorder.UnregisterFilter(ctx, "a"))
+
+	assert.False(t, recorder.HasFilter("a"))
+	assert.True(t, recorder.HasFilter("b"))
+	assert.Equal(t, []string{"b"}, recorder.leakedFilters())
+}
+
+func TestReadersCloseAssertion(t *testing.T) {
+	t.Setenv(AssertReadersCloseEnv, "true")
+	ctx := tests.Context(t)
+	lggr, observedLogs := logger.TestObserved(t, zapcore.ErrorLevel)
+	lp := mocks2.NewLogPoller(t)
+
+	t.Run("closed reader", func(t *testing.T) {
+		addr := cciptypes.Address(utils.RandomAddress().String())
+		versionFinder := newMockVersionFinder(ccipconfig.CommitStore, *semver.MustParse(ccipdata.V1_2_0), nil)
+		lp.On("UnregisterFilter", mock.Anything, logpoller.FilterName(v1_2_0.ExecReportAccepts, addr)).Return(nil).Once()
+
+		require.NoError(t, CloseCommitStoreReader(ctx, lggr, versionFinder, addr, nil, lp, ccipdatamocks.NewFeeEstimatorConfigReader(t)))
+		assert.Equal(t, 0, observedLogs.Len())
+	})
+
+	t.Run("leaky reader", func(t *testing.T) {
+		reader := leakyReader{filters: []logpoller.Filter{{Name: "leaked", Addresses: []common.Address{utils.RandomAddress()}}}}
+
+		require.NoError(t, closeAndAssertReader(lggr, "LeakyReader", reader, closingLogPoller(lp)))
+		require.Equal(t, 1, observedLogs.FilterMessage("Reader close left filters registered").Len())
+	})
+}
@@ -19,29 +19,60 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_5_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/observability"
 )
 
 func NewCommitStoreReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, address cciptypes.Address, ec client.Client, lp logpoller.LogPoller, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) (ccipdata.CommitStoreReader, error) {
-	return initOrCloseCommitStoreReader(ctx, lggr, versionFinder, address, ec, lp, feeEstimatorConfig, false)
+	return initOrCloseCommitStoreReader(ctx, lggr, versionFinder, address, ec, lp, feeEstimatorConfig, false, ccipdata.LogsConfig{})
+}
+
+// NewCommitStoreReaderWithLogsConfig is NewCommitStoreReader reading the logs with the lookback and the finality depth
+// of logsConfig.
+func NewCommitStoreReaderWithLogsConfig(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, address cciptypes.Address, ec client.Client, lp logpoller.LogPoller, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader, logsConfig ccipdata.LogsConfig) (ccipdata.CommitStoreReader, error) {
+	return initOrCloseCommitStoreReader(ctx, lggr, versionFinder, address, ec, lp, feeEstimatorConfig, false, logsConfig)
 }
 
 func CloseCommitStoreReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, address cciptypes.Address, ec client.Client, lp logpoller.LogPoller, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) error {
-	_, err := initOrCloseCommitStoreReader(ctx, lggr, versionFinder, address, ec, lp, feeEstimatorConfig, true)
+	_, err := initOrCloseCommitStoreReader(ctx, lggr, versionFinder, address, ec, lp, feeEstimatorConfig, true, ccipdata.LogsConfig{})
 	return err
 }
 
-func initOrCloseCommitStoreReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, address cciptypes.Address, ec client.Client, lp logpoller.LogPoller, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader, closeReader bool) (ccipdata.CommitStoreReader, error) {
+func initOrCloseCommitStoreReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, address cciptypes.Address, ec client.Client, lp logpoller.LogPoller, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader, closeReader bool, logsConfig ccipdata.LogsConfig) (ccipdata.CommitStoreReader, error) {
+	if closeReader {
+		lp = closingLogPoller(lp)
+	}
+	cs, version, err := newCommitStoreReader(lggr, versionFinder, address, ec, lp, feeEstimatorConfig)
+	if err != nil {
+		return nil, err
+	}
+	if closeReader {
+		return nil, closeAndAssertReader(lggr, "CommitStoreReader", cs, lp)
+	}
+	cs.SetLogsConfig(logsConfig)
+	return observability.NewTracedCommitStoreReader(cs, version.String(), address), cs.RegisterFilters(ctx)
+}
+
+// commitStoreReaderWithFilters is the CommitStoreReader of a given commitStore version, prior to the registration of
+// its filters.
+type commitStoreReaderWithFilters interface {
+	ccipdata.CommitStoreReader
+	RegisterFilters(ctx context.Context) error
+	Filters() []logpoller.Filter
+	SetLogsConfig(cfg ccipdata.LogsConfig)
+}
+
+func newCommitStoreReader(lggr logger.Logger, versionFinder VersionFinder, address cciptypes.Address, ec client.Client, lp logpoller.LogPoller, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) (commitStoreReaderWithFilters, semver.Version, error) {
 	contractType, version, err := versionFinder.TypeAndVersion(address, ec)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to read type and version")
+		return nil, semver.Version{}, errors.Wrapf(err, "unable to read type and version")
 	}
 	if contractType != ccipconfig.CommitStore {
-		return nil, errors.Errorf("expected %v got %v", ccipconfig.CommitStore, contractType)
+		return nil, semver.Version{}, errors.Errorf("expected %v got %v", ccipconfig.CommitStore, contractType)
 	}
 
 	evmAddr, err := ccipcalc.GenericAddrToEvm(address)
 	if err != nil {
-		return nil, err
+		return nil, semver.Version{}, err
 	}
 
 	lggr.Infow("Initializing CommitStore Reader", "version", version.String())
@@ -50,23 +81,17 @@ func initOrCloseCommitStoreReader(ctx context.Context, lggr logger.Logger, versi
 	case ccipdata.V1_2_0:
 		cs, err := v1_2_0.NewCommitStore(lggr, evmAddr, ec, lp, feeEstimatorConfig)
 		if err != nil {
-			return nil, err
-		}
-		if closeReader {
-			return nil, cs.Close()
+			return nil, semver.Version{}, err
 		}
-		return cs, cs.RegisterFilters(ctx)
+		return cs, version, nil
 	case ccipdata.V1_5_0:
 		cs, err := v1_5_0.NewCommitStore(lggr, evmAddr, ec, lp, feeEstimatorConfig)
 		if err != nil {
-			return nil, err
-		}
-		if closeReader {
-			return nil, cs.Close()
+			return nil, semver.Version{}, err
 		}
-		return cs, cs.RegisterFilters(ctx)
+		return cs, version, nil
 	default:
-		return nil, errors.Errorf("unsupported commit store version %v", version.String())
+		return nil, semver.Version{}, errors.Errorf("unsupported commit store version %v", version.String())
 	}
 }
 
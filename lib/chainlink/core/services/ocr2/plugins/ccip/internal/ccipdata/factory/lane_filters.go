@@ -0,0 +1,109 @@
+package factory
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/logpollerutil"
+)
+
+// LaneContracts are the contracts of a lane whose readers register log poller filters.
+type LaneContracts struct {
+	SourceSelector uint64
+	DestSelector   uint64
+	OnRamp         cciptypes.Address
+	OffRamp        cciptypes.Address
+	CommitStore    cciptypes.Address
+	// PriceRegistry is the dest price registry, its filters are not collected when empty.
+	PriceRegistry cciptypes.Address
+	// SourceLogs and DestLogs are the lookback and the finality depth of the logs read by the source and dest readers.
+	SourceLogs ccipdata.LogsConfig
+	DestLogs   ccipdata.LogsConfig
+}
+
+// LaneFilters are the log poller filters of the readers of a lane, so that they are registered and unregistered in a
+// single pass per chain instead of one per reader.
+type LaneFilters struct {
+	// Source are the filters of the source chain readers, i.e. the onRamp.
+	Source []logpoller.Filter
+	// Dest are the filters of the dest chain readers, i.e. the offRamp, the commitStore and the price registry.
+	Dest []logpoller.Filter
+	// DestLegacy are the dest filters registered by earlier node versions, they are only unregistered.
+	DestLegacy []logpoller.Filter
+}
+
+// legacyFiltersReader is implemented by the readers unregistering the filters registered by earlier node versions.
+type legacyFiltersReader interface {
+	LegacyFilters() []logpoller.Filter
+}
+
+// NewLaneFilters collects the filters of the onRamp, offRamp, commitStore and price registry readers of the lane. The
+// readers are only created to collect their filters, none of them is registered.
+func NewLaneFilters(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, lane LaneContracts, sourceLP, destLP logpoller.LogPoller, sourceClient, destClient client.Client, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) (LaneFilters, error) {
+	onRamp, _, err := newOnRampReader(lggr, versionFinder, lane.SourceSelector, lane.DestSelector, lane.OnRamp, sourceLP, sourceClient)
+	if err != nil {
+		return LaneFilters{}, errors.Wrap(err, "onRamp filters")
+	}
+	offRamp, _, err := newOffRampReader(lggr, versionFinder, lane.OffRamp, destClient, destLP, nil, nil, feeEstimatorConfig)
+	if err != nil {
+		return LaneFilters{}, errors.Wrap(err, "offRamp filters")
+	}
+	commitStore, _, err := newCommitStoreReader(lggr, versionFinder, lane.CommitStore, destClient, destLP, feeEstimatorConfig)
+	if err != nil {
+		return LaneFilters{}, errors.Wrap(err, "commitStore filters")
+	}
+	onRamp.SetLogsConfig(lane.SourceLogs)
+	offRamp.SetLogsConfig(lane.DestLogs)
+	commitStore.SetLogsConfig(lane.DestLogs)
+
+	filters := LaneFilters{
+		Source: onRamp.Filters(),
+		Dest:   append(append([]logpoller.Filter{}, offRamp.Filters()...), commitStore.Filters()...),
+	}
+	if legacy, ok := offRamp.(legacyFiltersReader); ok {
+		filters.DestLegacy = legacy.LegacyFilters()
+	}
+	if lane.PriceRegistry != "" {
+		priceRegistry, _, err := newPriceRegistryReader(ctx, lggr, versionFinder, lane.PriceRegistry, destLP, destClient, false)
+		if err != nil {
+			return LaneFilters{}, errors.Wrap(err, "priceRegistry filters")
+		}
+		filters.Dest = append(filters.Dest, priceRegistry.Filters()...)
+	}
+	return filters, nil
+}
+
+// RegisterLaneFilters registers the source and dest filters of the lane, the filters shared by the readers are only
+// registered once.
+func RegisterLaneFilters(ctx context.Context, sourceLP, destLP logpoller.LogPoller, filters LaneFilters) error {
+	if err := logpollerutil.RegisterLpFilters(ctx, sourceLP, logpollerutil.DedupFilters(filters.Source)); err != nil {
+		return errors.Wrap(err, "register source filters")
+	}
+	if err := logpollerutil.RegisterLpFilters(ctx, destLP, logpollerutil.DedupFilters(filters.Dest)); err != nil {
+		return errors.Wrap(err, "register dest filters")
+	}
+	return nil
+}
+
+// UnregisterLaneFilters unregisters the source, dest and legacy dest filters of the lane, the filters shared by the
+// readers are only unregistered once. The dest filters are unregistered even when the source ones fail to.
+func UnregisterLaneFilters(ctx context.Context, sourceLP, destLP logpoller.LogPoller, filters LaneFilters) error {
+	var err error
+	if sourceErr := logpollerutil.UnregisterLpFilters(ctx, sourceLP, logpollerutil.DedupFilters(filters.Source)); sourceErr != nil {
+		err = multierr.Append(err, errors.Wrap(sourceErr, "unregister source filters"))
+	}
+	destFilters := logpollerutil.DedupFilters(append(append([]logpoller.Filter{}, filters.Dest...), filters.DestLegacy...))
+	if destErr := logpollerutil.UnregisterLpFilters(ctx, destLP, destFilters); destErr != nil {
+		err = multierr.Append(err, errors.Wrap(destErr, "unregister dest filters"))
+	}
+	return err
+}
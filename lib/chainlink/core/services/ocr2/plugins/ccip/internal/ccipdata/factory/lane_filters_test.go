@@ -0,0 +1,96 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	mocks2 "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_5_0"
+)
+
+type laneVersionFinder map[cciptypes.Address]ccipconfig.ContractType
+
+func (f laneVersionFinder) TypeAndVersion(addr cciptypes.Address, _ bind.ContractBackend) (ccipconfig.ContractType, semver.Version, error) {
+	return f[addr], *semver.MustParse(ccipdata.V1_5_0), nil
+}
+
+func TestLaneFilters(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	lane := LaneContracts{
+		SourceSelector: 1000,
+		DestSelector:   2000,
+		OnRamp:         cciptypes.Address(utils.RandomAddress().String()),
+		OffRamp:        cciptypes.Address(utils.RandomAddress().String()),
+		CommitStore:    cciptypes.Address(utils.RandomAddress().String()),
+	}
+	versionFinder := laneVersionFinder{
+		lane.OnRamp:      ccipconfig.EVM2EVMOnRamp,
+		lane.OffRamp:     ccipconfig.EVM2EVMOffRamp,
+		lane.CommitStore: ccipconfig.CommitStore,
+	}
+	sourceLP := mocks2.NewLogPoller(t)
+	destLP := mocks2.NewLogPoller(t)
+
+	filters, err := NewLaneFilters(ctx, lggr, versionFinder, lane, sourceLP, destLP, nil, nil, ccipdatamocks.NewFeeEstimatorConfigReader(t))
+	require.NoError(t, err)
+
+	expSourceFilterNames := []string{
+		logpoller.FilterName(ccipdata.COMMIT_CCIP_SENDS, lane.OnRamp),
+		logpoller.FilterName(ccipdata.CONFIG_CHANGED, lane.OnRamp),
+	}
+	expDestFilterNames := []string{
+		logpoller.FilterName(v1_2_0.ExecExecutionStateChanges, lane.OffRamp),
+		logpoller.FilterName(v1_5_0.ExecRateLimitTokenAdded, lane.OffRamp),
+		logpoller.FilterName(v1_5_0.ExecRateLimitTokenRemoved, lane.OffRamp),
+		logpoller.FilterName(v1_2_0.ExecReportAccepts, lane.CommitStore),
+	}
+	expDestLegacyFilterNames := []string{
+		logpoller.FilterName(v1_2_0.ExecTokenPoolAdded, lane.OffRamp),
+		logpoller.FilterName(v1_2_0.ExecTokenPoolRemoved, lane.OffRamp),
+	}
+	assert.Equal(t, expSourceFilterNames, filterNames(filters.Source))
+	assert.Equal(t, expDestFilterNames, filterNames(filters.Dest))
+	assert.Equal(t, expDestLegacyFilterNames, filterNames(filters.DestLegacy))
+
+	// The filters shared by the readers are registered once
+	filters.Dest = append(filters.Dest, filters.Dest[0])
+	for _, f := range expSourceFilterNames {
+		sourceLP.On("RegisterFilter", mock.Anything, mock.MatchedBy(func(filter logpoller.Filter) bool { return filter.Name == f })).Return(nil).Once()
+	}
+	for _, f := range expDestFilterNames {
+		destLP.On("RegisterFilter", mock.Anything, mock.MatchedBy(func(filter logpoller.Filter) bool { return filter.Name == f })).Return(nil).Once()
+	}
+	require.NoError(t, RegisterLaneFilters(ctx, sourceLP, destLP, filters))
+
+	for _, f := range expSourceFilterNames {
+		sourceLP.On("UnregisterFilter", mock.Anything, f).Return(nil).Once()
+	}
+	for _, f := range append(expDestFilterNames, expDestLegacyFilterNames...) {
+		destLP.On("UnregisterFilter", mock.Anything, f).Return(nil).Once()
+	}
+	require.NoError(t, UnregisterLaneFilters(ctx, sourceLP, destLP, filters))
+}
+
+func filterNames(filters []logpoller.Filter) []string {
+	names := make([]string, len(filters))
+	for i, f := range filters {
+		names[i] = f.Name
+	}
+	return names
+}
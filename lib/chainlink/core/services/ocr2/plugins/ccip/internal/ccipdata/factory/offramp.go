@@ -3,6 +3,7 @@ package factory
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -21,58 +22,116 @@ import (
 	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/batchreader"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_5_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/logpollerutil"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/observability"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
 )
 
 func NewOffRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, addr cciptypes.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, registerFilters bool, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) (ccipdata.OffRampReader, error) {
-	return initOrCloseOffRampReader(ctx, lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, false, registerFilters, feeEstimatorConfig)
+	return initOrCloseOffRampReader(ctx, lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, false, registerFilters, feeEstimatorConfig, ccipdata.LogsConfig{})
+}
+
+// NewOffRampReaderWithLogsConfig is NewOffRampReader reading the logs with the lookback and the finality depth of
+// logsConfig.
+func NewOffRampReaderWithLogsConfig(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, addr cciptypes.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, registerFilters bool, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader, logsConfig ccipdata.LogsConfig) (ccipdata.OffRampReader, error) {
+	return initOrCloseOffRampReader(ctx, lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, false, registerFilters, feeEstimatorConfig, logsConfig)
 }
 
 func CloseOffRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, addr cciptypes.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) error {
-	_, err := initOrCloseOffRampReader(ctx, lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, true, false, feeEstimatorConfig)
+	_, err := initOrCloseOffRampReader(ctx, lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, true, false, feeEstimatorConfig, ccipdata.LogsConfig{})
 	return err
 }
 
-func initOrCloseOffRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, addr cciptypes.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, closeReader bool, registerFilters bool, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) (ccipdata.OffRampReader, error) {
+// deferredFilterRegistrationRetryInterval is the interval the failed deferred filter registrations are retried at.
+const deferredFilterRegistrationRetryInterval = 10 * time.Second
+
+// offRampReaderWithFilters is the OffRampReader of a given offRamp version, prior to the registration of its filters.
+type offRampReaderWithFilters interface {
+	ccipdata.OffRampReader
+	RegisterFilters(ctx context.Context) error
+	Filters() []logpoller.Filter
+	SetLogsConfig(cfg ccipdata.LogsConfig)
+	SetTokenPoolReaderFactory(newTokenPoolReader func(ctx context.Context) (cciptypes.TokenPoolBatchedReader, error))
+}
+
+// NewOffRampReaderWithDeferredFilters returns the OffRampReader without waiting for its log poller filters to be
+// registered, so that the job creation is not blocked on a slow RPC. The filters are registered in the background, the
+// returned DeferredRegistration signals when they are and must be closed before the reader. The logs are read with the
+// lookback and the finality depth of logsConfig.
+func NewOffRampReaderWithDeferredFilters(lggr logger.Logger, versionFinder VersionFinder, addr cciptypes.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader, logsConfig ccipdata.LogsConfig) (ccipdata.OffRampReader, *logpollerutil.DeferredRegistration, error) {
+	offRamp, version, err := newOffRampReader(lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, feeEstimatorConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	offRamp.SetLogsConfig(logsConfig)
+	registration := logpollerutil.RegisterDeferred(lggr, offRamp.RegisterFilters, deferredFilterRegistrationRetryInterval)
+	return observability.NewTracedOffRampReader(offRamp, version.String(), addr), registration, nil
+}
+
+func initOrCloseOffRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, addr cciptypes.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, closeReader bool, registerFilters bool, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader, logsConfig ccipdata.LogsConfig) (ccipdata.OffRampReader, error) {
+	if closeReader {
+		lp = closingLogPoller(lp)
+	}
+	offRamp, version, err := newOffRampReader(lggr, versionFinder, addr, destClient, lp, estimator, destMaxGasPrice, feeEstimatorConfig)
+	if err != nil {
+		return nil, err
+	}
+	if closeReader {
+		return nil, closeAndAssertReader(lggr, "OffRampReader", offRamp, lp)
+	}
+	offRamp.SetLogsConfig(logsConfig)
+	return observability.NewTracedOffRampReader(offRamp, version.String(), addr), offRamp.RegisterFilters(ctx)
+}
+
+func newOffRampReader(lggr logger.Logger, versionFinder VersionFinder, addr cciptypes.Address, destClient client.Client, lp logpoller.LogPoller, estimator gas.EvmFeeEstimator, destMaxGasPrice *big.Int, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) (offRampReaderWithFilters, semver.Version, error) {
 	contractType, version, err := versionFinder.TypeAndVersion(addr, destClient)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to read type and version")
+		return nil, semver.Version{}, errors.Wrapf(err, "unable to read type and version")
 	}
 	if contractType != ccipconfig.EVM2EVMOffRamp {
-		return nil, errors.Errorf("expected %v got %v", ccipconfig.EVM2EVMOffRamp, contractType)
+		return nil, semver.Version{}, errors.Errorf("expected %v got %v", ccipconfig.EVM2EVMOffRamp, contractType)
 	}
 
 	evmAddr, err := ccipcalc.GenericAddrToEvm(addr)
 	if err != nil {
-		return nil, err
+		return nil, semver.Version{}, err
 	}
 
 	lggr.Infow("Initializing OffRamp Reader", "version", version.String(), "destMaxGasPrice", destMaxGasPrice.String())
 
+	var offRamp offRampReaderWithFilters
 	switch version.String() {
 	case ccipdata.V1_2_0:
-		offRamp, err := v1_2_0.NewOffRamp(lggr, evmAddr, destClient, lp, estimator, destMaxGasPrice, feeEstimatorConfig)
-		if err != nil {
-			return nil, err
-		}
-		if closeReader {
-			return nil, offRamp.Close()
-		}
-		return offRamp, offRamp.RegisterFilters(ctx)
+		var v120 *v1_2_0.OffRamp
+		v120, err = v1_2_0.NewOffRamp(lggr, evmAddr, destClient, lp, estimator, destMaxGasPrice, feeEstimatorConfig)
+		offRamp = &v1_2_0.OffRampWithTokenPoolHistory{OffRamp: v120}
 	case ccipdata.V1_5_0:
-		offRamp, err := v1_5_0.NewOffRamp(lggr, evmAddr, destClient, lp, estimator, destMaxGasPrice, feeEstimatorConfig)
-		if err != nil {
-			return nil, err
-		}
-		if closeReader {
-			return nil, offRamp.Close()
-		}
-		return offRamp, offRamp.RegisterFilters(ctx)
+		offRamp, err = v1_5_0.NewOffRamp(lggr, evmAddr, destClient, lp, estimator, destMaxGasPrice, feeEstimatorConfig)
 	default:
-		return nil, errors.Errorf("unsupported offramp version %v", version.String())
+		return nil, semver.Version{}, errors.Errorf("unsupported offramp version %v", version.String())
 	}
 	// TODO can validate it pointing to the correct version
+	if err != nil {
+		return nil, semver.Version{}, err
+	}
+	offRamp.SetTokenPoolReaderFactory(func(ctx context.Context) (cciptypes.TokenPoolBatchedReader, error) {
+		staticConfig, err := offRamp.GetStaticConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		batchCaller := rpclib.NewDynamicLimitedBatchCaller(
+			lggr,
+			destClient,
+			rpclib.DefaultRpcBatchSizeLimit,
+			rpclib.DefaultRpcBatchBackOffMultiplier,
+			rpclib.DefaultMaxParallelRpcCalls,
+		)
+		return batchreader.NewEVMTokenPoolBatchedReader(lggr, staticConfig.SourceChainSelector, addr, batchCaller)
+	})
+	return offRamp, version, nil
 }
 
 func ExecReportToEthTxMeta(ctx context.Context, typ ccipconfig.ContractType, ver semver.Version) (func(report []byte) (*txmgr.TxMeta, error), error) {
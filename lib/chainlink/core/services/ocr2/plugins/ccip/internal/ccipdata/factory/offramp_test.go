@@ -2,10 +2,12 @@ package factory
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
@@ -18,11 +20,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_5_0"
 )
 
 func TestOffRamp(t *testing.T) {
 	ctx := tests.Context(t)
-	for _, versionStr := range []string{ccipdata.V1_2_0} {
+	for _, versionStr := range []string{ccipdata.V1_2_0, ccipdata.V1_5_0} {
 		lggr := logger.Test(t)
 		addr := cciptypes.Address(utils.RandomAddress().String())
 		lp := mocks2.NewLogPoller(t)
@@ -34,16 +37,67 @@ func TestOffRamp(t *testing.T) {
 			logpoller.FilterName(v1_2_0.ExecTokenPoolAdded, addr),
 			logpoller.FilterName(v1_2_0.ExecTokenPoolRemoved, addr),
 		}
+		// The token pool filters registered by earlier node versions are still unregistered
+		expUnregisteredFilterNames := expFilterNames
+		if versionStr != ccipdata.V1_2_0 {
+			expFilterNames = []string{
+				logpoller.FilterName(v1_2_0.ExecExecutionStateChanges, addr),
+				logpoller.FilterName(v1_5_0.ExecRateLimitTokenAdded, addr),
+				logpoller.FilterName(v1_5_0.ExecRateLimitTokenRemoved, addr),
+			}
+			expUnregisteredFilterNames = []string{
+				logpoller.FilterName(v1_2_0.ExecExecutionStateChanges, addr),
+				logpoller.FilterName(v1_5_0.ExecRateLimitTokenAdded, addr),
+				logpoller.FilterName(v1_5_0.ExecRateLimitTokenRemoved, addr),
+				logpoller.FilterName(v1_2_0.ExecTokenPoolAdded, addr),
+				logpoller.FilterName(v1_2_0.ExecTokenPoolRemoved, addr),
+			}
+		}
 		versionFinder := newMockVersionFinder(ccipconfig.EVM2EVMOffRamp, *semver.MustParse(versionStr), nil)
 
-		lp.On("RegisterFilter", mock.Anything, mock.Anything).Return(nil).Times(len(expFilterNames))
+		for _, f := range expFilterNames {
+			lp.On("RegisterFilter", mock.Anything, mock.MatchedBy(func(filter logpoller.Filter) bool { return filter.Name == f })).Return(nil).Once()
+		}
 		_, err := NewOffRampReader(ctx, lggr, versionFinder, addr, nil, lp, nil, nil, true, feeEstimatorConfig)
 		assert.NoError(t, err)
 
-		for _, f := range expFilterNames {
-			lp.On("UnregisterFilter", mock.Anything, f).Return(nil)
+		for _, f := range expUnregisteredFilterNames {
+			lp.On("UnregisterFilter", mock.Anything, f).Return(nil).Once()
 		}
 		err = CloseOffRampReader(ctx, lggr, versionFinder, addr, nil, lp, nil, nil, feeEstimatorConfig)
 		assert.NoError(t, err)
 	}
 }
+
+func TestOffRampWithDeferredFilters(t *testing.T) {
+	lggr := logger.Test(t)
+	addr := cciptypes.Address(utils.RandomAddress().String())
+	lp := mocks2.NewLogPoller(t)
+	feeEstimatorConfig := ccipdatamocks.NewFeeEstimatorConfigReader(t)
+	versionFinder := newMockVersionFinder(ccipconfig.EVM2EVMOffRamp, *semver.MustParse(ccipdata.V1_2_0), nil)
+
+	registered := make(chan struct{})
+	var retentions []time.Duration
+	lp.On("RegisterFilter", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		<-registered
+		retentions = append(retentions, args.Get(1).(logpoller.Filter).Retention)
+	}).Return(nil).Times(3)
+
+	logsConfig := ccipdata.LogsConfig{Lookback: 48 * time.Hour}
+	reader, registration, err := NewOffRampReaderWithDeferredFilters(lggr, versionFinder, addr, nil, lp, nil, nil, feeEstimatorConfig, logsConfig)
+	require.NoError(t, err)
+	require.NotNil(t, reader)
+	// The reader is returned while its filters are still being registered
+	assert.False(t, registration.IsReady())
+
+	close(registered)
+	select {
+	case <-registration.Ready():
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("filters were not registered")
+	}
+	// The filters of the logs processed by the plugins are registered with the lookback of the logs config
+	assert.Contains(t, retentions, logsConfig.Lookback)
+	assert.NotContains(t, retentions, ccipdata.CommitExecLogsRetention)
+	require.NoError(t, registration.Close())
+}
@@ -3,6 +3,7 @@ package factory
 import (
 	"context"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -15,30 +16,60 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_5_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/observability"
 )
 
 // NewOnRampReader determines the appropriate version of the onramp and returns a reader for it
 func NewOnRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, sourceSelector, destSelector uint64, onRampAddress cciptypes.Address, sourceLP logpoller.LogPoller, source client.Client) (ccipdata.OnRampReader, error) {
-	return initOrCloseOnRampReader(ctx, lggr, versionFinder, sourceSelector, destSelector, onRampAddress, sourceLP, source, false)
+	return initOrCloseOnRampReader(ctx, lggr, versionFinder, sourceSelector, destSelector, onRampAddress, sourceLP, source, false, ccipdata.LogsConfig{})
+}
+
+// NewOnRampReaderWithLogsConfig is NewOnRampReader reading the logs with the lookback and the finality depth of
+// logsConfig.
+func NewOnRampReaderWithLogsConfig(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, sourceSelector, destSelector uint64, onRampAddress cciptypes.Address, sourceLP logpoller.LogPoller, source client.Client, logsConfig ccipdata.LogsConfig) (ccipdata.OnRampReader, error) {
+	return initOrCloseOnRampReader(ctx, lggr, versionFinder, sourceSelector, destSelector, onRampAddress, sourceLP, source, false, logsConfig)
 }
 
 func CloseOnRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, sourceSelector, destSelector uint64, onRampAddress cciptypes.Address, sourceLP logpoller.LogPoller, source client.Client) error {
-	_, err := initOrCloseOnRampReader(ctx, lggr, versionFinder, sourceSelector, destSelector, onRampAddress, sourceLP, source, true)
+	_, err := initOrCloseOnRampReader(ctx, lggr, versionFinder, sourceSelector, destSelector, onRampAddress, sourceLP, source, true, ccipdata.LogsConfig{})
 	return err
 }
 
-func initOrCloseOnRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, sourceSelector, destSelector uint64, onRampAddress cciptypes.Address, sourceLP logpoller.LogPoller, source client.Client, closeReader bool) (ccipdata.OnRampReader, error) {
+func initOrCloseOnRampReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, sourceSelector, destSelector uint64, onRampAddress cciptypes.Address, sourceLP logpoller.LogPoller, source client.Client, closeReader bool, logsConfig ccipdata.LogsConfig) (ccipdata.OnRampReader, error) {
+	if closeReader {
+		sourceLP = closingLogPoller(sourceLP)
+	}
+	onRamp, version, err := newOnRampReader(lggr, versionFinder, sourceSelector, destSelector, onRampAddress, sourceLP, source)
+	if err != nil {
+		return nil, err
+	}
+	if closeReader {
+		return nil, closeAndAssertReader(lggr, "OnRampReader", onRamp, sourceLP)
+	}
+	onRamp.SetLogsConfig(logsConfig)
+	return observability.NewTracedOnRampReader(onRamp, version.String(), onRampAddress), onRamp.RegisterFilters(ctx)
+}
+
+// onRampReaderWithFilters is the OnRampReader of a given onRamp version, prior to the registration of its filters.
+type onRampReaderWithFilters interface {
+	ccipdata.OnRampReader
+	RegisterFilters(ctx context.Context) error
+	Filters() []logpoller.Filter
+	SetLogsConfig(cfg ccipdata.LogsConfig)
+}
+
+func newOnRampReader(lggr logger.Logger, versionFinder VersionFinder, sourceSelector, destSelector uint64, onRampAddress cciptypes.Address, sourceLP logpoller.LogPoller, source client.Client) (onRampReaderWithFilters, semver.Version, error) {
 	contractType, version, err := versionFinder.TypeAndVersion(onRampAddress, source)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to read type and version")
+		return nil, semver.Version{}, errors.Wrapf(err, "unable to read type and version")
 	}
 	if contractType != ccipconfig.EVM2EVMOnRamp {
-		return nil, errors.Errorf("expected %v got %v", ccipconfig.EVM2EVMOnRamp, contractType)
+		return nil, semver.Version{}, errors.Errorf("expected %v got %v", ccipconfig.EVM2EVMOnRamp, contractType)
 	}
 
 	onRampAddrEvm, err := ccipcalc.GenericAddrToEvm(onRampAddress)
 	if err != nil {
-		return nil, err
+		return nil, semver.Version{}, err
 	}
 
 	lggr.Infof("Initializing onRamp for version %v", version.String())
@@ -47,24 +78,18 @@ func initOrCloseOnRampReader(ctx context.Context, lggr logger.Logger, versionFin
 	case ccipdata.V1_2_0:
 		onRamp, err := v1_2_0.NewOnRamp(lggr, sourceSelector, destSelector, onRampAddrEvm, sourceLP, source)
 		if err != nil {
-			return nil, err
-		}
-		if closeReader {
-			return nil, onRamp.Close()
+			return nil, semver.Version{}, err
 		}
-		return onRamp, onRamp.RegisterFilters(ctx)
+		return onRamp, version, nil
 	case ccipdata.V1_5_0:
 		onRamp, err := v1_5_0.NewOnRamp(lggr, sourceSelector, destSelector, onRampAddrEvm, sourceLP, source)
 		if err != nil {
-			return nil, err
-		}
-		if closeReader {
-			return nil, onRamp.Close()
+			return nil, semver.Version{}, err
 		}
-		return onRamp, onRamp.RegisterFilters(ctx)
+		return onRamp, version, nil
 	// Adding a new version?
 	// Please update the public factory function in leafer.go if the new version updates the leaf hash function.
 	default:
-		return nil, errors.Errorf("unsupported onramp version %v", version.String())
+		return nil, semver.Version{}, errors.Errorf("unsupported onramp version %v", version.String())
 	}
 }
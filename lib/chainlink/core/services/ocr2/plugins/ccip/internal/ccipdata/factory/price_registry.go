@@ -3,6 +3,7 @@ package factory
 import (
 	"context"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -14,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/observability"
 )
 
 // NewPriceRegistryReader determines the appropriate version of the price registry and returns a reader for it.
@@ -28,39 +30,40 @@ func ClosePriceRegistryReader(ctx context.Context, lggr logger.Logger, versionFi
 
 func initOrClosePriceRegistryReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, priceRegistryAddress cciptypes.Address, lp logpoller.LogPoller, cl client.Client, closeReader bool) (ccipdata.PriceRegistryReader, error) {
 	registerFilters := !closeReader
+	if closeReader {
+		lp = closingLogPoller(lp)
+	}
+	pr, version, err := newPriceRegistryReader(ctx, lggr, versionFinder, priceRegistryAddress, lp, cl, registerFilters)
+	if err != nil {
+		return nil, err
+	}
+	if closeReader {
+		return nil, closeAndAssertReader(lggr, "PriceRegistryReader", pr, lp)
+	}
+	return observability.NewTracedPriceRegistryReader(pr, version.String(), priceRegistryAddress), nil
+}
 
+func newPriceRegistryReader(ctx context.Context, lggr logger.Logger, versionFinder VersionFinder, priceRegistryAddress cciptypes.Address, lp logpoller.LogPoller, cl client.Client, registerFilters bool) (*v1_2_0.PriceRegistry, semver.Version, error) {
 	priceRegistryEvmAddr, err := ccipcalc.GenericAddrToEvm(priceRegistryAddress)
 	if err != nil {
-		return nil, err
+		return nil, semver.Version{}, err
 	}
 
 	contractType, version, err := versionFinder.TypeAndVersion(priceRegistryAddress, cl)
 	if err != nil {
-		return nil, err
+		return nil, semver.Version{}, err
 	}
 	if contractType != ccipconfig.PriceRegistry {
-		return nil, errors.Errorf("expected %v got %v", ccipconfig.PriceRegistry, contractType)
+		return nil, semver.Version{}, errors.Errorf("expected %v got %v", ccipconfig.PriceRegistry, contractType)
 	}
 	switch version.String() {
-	case ccipdata.V1_2_0:
-		pr, err := v1_2_0.NewPriceRegistry(ctx, lggr, priceRegistryEvmAddr, lp, cl, registerFilters)
-		if err != nil {
-			return nil, err
-		}
-		if closeReader {
-			return nil, pr.Close()
-		}
-		return pr, nil
-	case ccipdata.V1_6_0:
+	case ccipdata.V1_2_0, ccipdata.V1_6_0:
 		pr, err := v1_2_0.NewPriceRegistry(ctx, lggr, priceRegistryEvmAddr, lp, cl, registerFilters)
 		if err != nil {
-			return nil, err
-		}
-		if closeReader {
-			return nil, pr.Close()
+			return nil, semver.Version{}, err
 		}
-		return pr, nil
+		return pr, version, nil
 	default:
-		return nil, errors.Errorf("unsupported price registry version %v", version.String())
+		return nil, semver.Version{}, errors.Errorf("unsupported price registry version %v", version.String())
 	}
 }
@@ -0,0 +1,21 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// AssertLaneReadersClose fails the test when closing the readers of the lane leaves filters registered, see
+// ValidateLaneReadersClose.
+func AssertLaneReadersClose(t testing.TB, versionFinder VersionFinder, lane LaneContracts, sourceClient, destClient client.Client, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) {
+	t.Helper()
+	err := ValidateLaneReadersClose(testutils.Context(t), logger.Test(t), versionFinder, lane, sourceClient, destClient, feeEstimatorConfig)
+	require.NoError(t, err, "closing the lane readers leaves filters registered")
+}
@@ -0,0 +1,105 @@
+package factory
+
+import (
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/lbtc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/usdc"
+)
+
+// TokenDataReaderType is the type of the token pools whose offchain token data, e.g. an attestation, must be provided
+// to the offRamp.
+type TokenDataReaderType string
+
+const (
+	USDCTokenDataReader TokenDataReaderType = "USDC"
+	LBTCTokenDataReader TokenDataReaderType = "LBTC"
+)
+
+// TokenDataReaderConfig is the config of the TokenDataReader of an attestation-backed token.
+type TokenDataReaderConfig struct {
+	Type               TokenDataReaderType
+	SourceTokenAddress common.Address
+	AttestationAPI     string
+	// AttestationAPITimeoutSeconds is the timeout of the attestation API requests, 0 selects the default timeout.
+	AttestationAPITimeoutSeconds uint
+	// AttestationAPIIntervalMilliseconds can be set to -1 to disable or 0 to use a default interval.
+	AttestationAPIIntervalMilliseconds int
+	// USDCReader reads the messages sent by the USDC message transmitter, it is only used by USDC token data readers.
+	USDCReader ccipdata.USDCReader
+}
+
+// TokenDataReaderFactory creates the TokenDataReader of a token pool type, attestationAPI is the parsed
+// AttestationAPI of cfg.
+type TokenDataReaderFactory func(lggr logger.Logger, cfg TokenDataReaderConfig, attestationAPI *url.URL) (tokendata.Reader, error)
+
+var (
+	tokenDataReaderFactoriesMu sync.RWMutex
+	tokenDataReaderFactories   = map[TokenDataReaderType]TokenDataReaderFactory{
+		USDCTokenDataReader: newUSDCTokenDataReader,
+		LBTCTokenDataReader: newLBTCTokenDataReader,
+	}
+)
+
+// RegisterTokenDataReaderFactory registers the TokenDataReaderFactory of a new token pool type, so that the token data
+// of attestation-backed tokens is read without changes to the exec plugin.
+func RegisterTokenDataReaderFactory(typ TokenDataReaderType, factory TokenDataReaderFactory) error {
+	tokenDataReaderFactoriesMu.Lock()
+	defer tokenDataReaderFactoriesMu.Unlock()
+	if _, exists := tokenDataReaderFactories[typ]; exists {
+		return errors.Errorf("token data reader factory of %s is already registered", typ)
+	}
+	tokenDataReaderFactories[typ] = factory
+	return nil
+}
+
+// NewTokenDataReader returns the TokenDataReader of the token pool type of cfg.
+func NewTokenDataReader(lggr logger.Logger, cfg TokenDataReaderConfig) (tokendata.Reader, error) {
+	tokenDataReaderFactoriesMu.RLock()
+	factory, exists := tokenDataReaderFactories[cfg.Type]
+	tokenDataReaderFactoriesMu.RUnlock()
+	if !exists {
+		return nil, errors.Errorf("unsupported token data reader type %q", cfg.Type)
+	}
+
+	attestationAPI, err := url.ParseRequestURI(cfg.AttestationAPI)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s attestation API", cfg.Type)
+	}
+	return factory(lggr, cfg, attestationAPI)
+}
+
+func newUSDCTokenDataReader(lggr logger.Logger, cfg TokenDataReaderConfig, attestationAPI *url.URL) (tokendata.Reader, error) {
+	if cfg.USDCReader == nil {
+		return nil, errors.New("USDC token data reader requires a USDC reader")
+	}
+	return usdc.NewUSDCTokenDataReader(
+		lggr,
+		cfg.USDCReader,
+		attestationAPI,
+		//nolint:gosec // integer overflow
+		int(cfg.AttestationAPITimeoutSeconds),
+		cfg.SourceTokenAddress,
+		time.Duration(cfg.AttestationAPIIntervalMilliseconds)*time.Millisecond,
+	), nil
+}
+
+func newLBTCTokenDataReader(lggr logger.Logger, cfg TokenDataReaderConfig, attestationAPI *url.URL) (tokendata.Reader, error) {
+	return lbtc.NewLBTCTokenDataReader(
+		lggr,
+		attestationAPI,
+		//nolint:gosec // integer overflow
+		int(cfg.AttestationAPITimeoutSeconds),
+		cfg.SourceTokenAddress,
+		time.Duration(cfg.AttestationAPIIntervalMilliseconds)*time.Millisecond,
+	), nil
+}
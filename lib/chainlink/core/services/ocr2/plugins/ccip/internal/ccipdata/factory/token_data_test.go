@@ -0,0 +1,68 @@
+package factory
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/lbtc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/usdc"
+)
+
+func TestNewTokenDataReader(t *testing.T) {
+	lggr := logger.Test(t)
+	cfg := TokenDataReaderConfig{
+		SourceTokenAddress: utils.RandomAddress(),
+		AttestationAPI:     "https://attestation.example.com",
+	}
+
+	t.Run("USDC", func(t *testing.T) {
+		usdcCfg := cfg
+		usdcCfg.Type = USDCTokenDataReader
+		_, err := NewTokenDataReader(lggr, usdcCfg)
+		require.ErrorContains(t, err, "requires a USDC reader")
+
+		usdcCfg.USDCReader = ccipdatamocks.NewUSDCReader(t)
+		reader, err := NewTokenDataReader(lggr, usdcCfg)
+		require.NoError(t, err)
+		assert.IsType(t, &usdc.TokenDataReader{}, reader)
+	})
+
+	t.Run("LBTC", func(t *testing.T) {
+		lbtcCfg := cfg
+		lbtcCfg.Type = LBTCTokenDataReader
+		reader, err := NewTokenDataReader(lggr, lbtcCfg)
+		require.NoError(t, err)
+		assert.IsType(t, &lbtc.TokenDataReader{}, reader)
+
+		lbtcCfg.AttestationAPI = "not a url"
+		_, err = NewTokenDataReader(lggr, lbtcCfg)
+		require.ErrorContains(t, err, "failed to parse LBTC attestation API")
+	})
+
+	t.Run("registered types", func(t *testing.T) {
+		customCfg := cfg
+		customCfg.Type = "TestAttestedToken"
+		_, err := NewTokenDataReader(lggr, customCfg)
+		require.ErrorContains(t, err, `unsupported token data reader type "TestAttestedToken"`)
+
+		var gotAttestationAPI *url.URL
+		require.NoError(t, RegisterTokenDataReaderFactory(customCfg.Type, func(_ logger.Logger, _ TokenDataReaderConfig, attestationAPI *url.URL) (tokendata.Reader, error) {
+			gotAttestationAPI = attestationAPI
+			return lbtc.NewLBTCTokenDataReader(lggr, attestationAPI, 0, customCfg.SourceTokenAddress, 0), nil
+		}))
+		reader, err := NewTokenDataReader(lggr, customCfg)
+		require.NoError(t, err)
+		assert.NotNil(t, reader)
+		assert.Equal(t, cfg.AttestationAPI, gotAttestationAPI.String())
+
+		require.ErrorContains(t, RegisterTokenDataReaderFactory(USDCTokenDataReader, newLBTCTokenDataReader), "already registered")
+	})
+}
@@ -1,8 +1,12 @@
 package factory
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/Masterminds/semver/v3"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
@@ -16,10 +20,44 @@ type VersionFinder interface {
 	TypeAndVersion(addr cciptypes.Address, client bind.ContractBackend) (config.ContractType, semver.Version, error)
 }
 
-type EvmVersionFinder struct{}
+// EvmVersionFinder reads the typeAndVersion of the contracts on-chain, the result is cached per address as it only
+// changes with a redeployment. A finder must therefore only be used with the contracts of a single chain.
+type EvmVersionFinder struct {
+	// overrides replace the on-chain typeAndVersion of the given contracts, e.g. of proxies returning the
+	// typeAndVersion of the proxy instead of the one of their implementation.
+	overrides map[common.Address]typeAndVersion
+	cache     *sync.Map
+}
+
+type typeAndVersion struct {
+	typ     config.ContractType
+	version semver.Version
+}
 
 func NewEvmVersionFinder() EvmVersionFinder {
-	return EvmVersionFinder{}
+	return EvmVersionFinder{cache: &sync.Map{}}
+}
+
+// NewEvmVersionFinderWithOverrides returns an EvmVersionFinder returning the given typeAndVersion strings, e.g.
+// "EVM2EVMOffRamp 1.5.0", instead of the on-chain ones for the given addresses.
+func NewEvmVersionFinderWithOverrides(overrides map[cciptypes.Address]string) (EvmVersionFinder, error) {
+	finder := NewEvmVersionFinder()
+	if len(overrides) == 0 {
+		return finder, nil
+	}
+	finder.overrides = make(map[common.Address]typeAndVersion, len(overrides))
+	for addr, tvStr := range overrides {
+		evmAddr, err := ccipcalc.GenericAddrToEvm(addr)
+		if err != nil {
+			return EvmVersionFinder{}, fmt.Errorf("typeAndVersion override of %s: %w", addr, err)
+		}
+		typ, version, err := config.ParseContractTypeAndVersion(tvStr)
+		if err != nil {
+			return EvmVersionFinder{}, fmt.Errorf("typeAndVersion override of %s: %w", addr, err)
+		}
+		finder.overrides[evmAddr] = typeAndVersion{typ: typ, version: version}
+	}
+	return finder, nil
 }
 
 func (e EvmVersionFinder) TypeAndVersion(addr cciptypes.Address, client bind.ContractBackend) (config.ContractType, semver.Version, error) {
@@ -27,7 +65,23 @@ func (e EvmVersionFinder) TypeAndVersion(addr cciptypes.Address, client bind.Con
 	if err != nil {
 		return "", semver.Version{}, err
 	}
-	return config.TypeAndVersion(evmAddr, client)
+	if tv, ok := e.overrides[evmAddr]; ok {
+		return tv.typ, tv.version, nil
+	}
+	if e.cache != nil {
+		if tv, ok := e.cache.Load(evmAddr); ok {
+			return tv.(typeAndVersion).typ, tv.(typeAndVersion).version, nil
+		}
+	}
+
+	typ, version, err := config.TypeAndVersion(evmAddr, client)
+	if err != nil {
+		return "", semver.Version{}, err
+	}
+	if e.cache != nil {
+		e.cache.Store(evmAddr, typeAndVersion{typ: typ, version: version})
+	}
+	return typ, version, nil
 }
 
 type mockVersionFinder struct {
@@ -0,0 +1,70 @@
+package factory
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+)
+
+func TestEvmVersionFinder(t *testing.T) {
+	onChainTypeAndVersion, err := utils.ABIEncode(`[{"type":"string"}]`, "EVM2EVMOffRamp 1.2.0")
+	require.NoError(t, err)
+
+	t.Run("typeAndVersion is read once per address", func(t *testing.T) {
+		c := clienttest.NewClient(t)
+		c.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(onChainTypeAndVersion, nil).Twice()
+		finder := NewEvmVersionFinder()
+
+		addr1 := cciptypes.Address(utils.RandomAddress().String())
+		addr2 := cciptypes.Address(utils.RandomAddress().String())
+		for _, addr := range []cciptypes.Address{addr1, addr2, addr1, addr2} {
+			typ, version, err := finder.TypeAndVersion(addr, c)
+			require.NoError(t, err)
+			assert.Equal(t, ccipconfig.EVM2EVMOffRamp, typ)
+			assert.Equal(t, *semver.MustParse("1.2.0"), version)
+		}
+	})
+
+	t.Run("overrides replace the on-chain typeAndVersion", func(t *testing.T) {
+		c := clienttest.NewClient(t)
+		c.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(onChainTypeAndVersion, nil).Once()
+
+		proxy := utils.RandomAddress()
+		finder, err := NewEvmVersionFinderWithOverrides(map[cciptypes.Address]string{
+			cciptypes.Address(proxy.String()): "EVM2EVMOffRamp 1.5.0",
+		})
+		require.NoError(t, err)
+
+		// The override applies regardless of the case of the address
+		typ, version, err := finder.TypeAndVersion(cciptypes.Address(strings.ToLower(proxy.Hex())), c)
+		require.NoError(t, err)
+		assert.Equal(t, ccipconfig.EVM2EVMOffRamp, typ)
+		assert.Equal(t, *semver.MustParse("1.5.0"), version)
+
+		_, version, err = finder.TypeAndVersion(cciptypes.Address(utils.RandomAddress().String()), c)
+		require.NoError(t, err)
+		assert.Equal(t, *semver.MustParse("1.2.0"), version)
+	})
+
+	t.Run("invalid overrides are rejected", func(t *testing.T) {
+		_, err := NewEvmVersionFinderWithOverrides(map[cciptypes.Address]string{
+			cciptypes.Address(utils.RandomAddress().String()): "TokenPool 1.5.0",
+		})
+		require.ErrorContains(t, err, "unrecognized contract type TokenPool")
+
+		_, err = NewEvmVersionFinderWithOverrides(map[cciptypes.Address]string{
+			"not an address": "EVM2EVMOffRamp 1.5.0",
+		})
+		require.Error(t, err)
+	})
+}
@@ -913,6 +913,67 @@ func (_c *CommitStoreReader_SetSourceMaxGasPrice_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// SubscribeAcceptedCommitReports provides a mock function with given fields: ctx, minSeqNum, confirmations, pollInterval
+func (_m *CommitStoreReader) SubscribeAcceptedCommitReports(ctx context.Context, minSeqNum uint64, confirmations int, pollInterval time.Duration) (<-chan ccip.CommitStoreReportWithTxMeta, error) {
+	ret := _m.Called(ctx, minSeqNum, confirmations, pollInterval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeAcceptedCommitReports")
+	}
+
+	var r0 <-chan ccip.CommitStoreReportWithTxMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int, time.Duration) (<-chan ccip.CommitStoreReportWithTxMeta, error)); ok {
+		return rf(ctx, minSeqNum, confirmations, pollInterval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int, time.Duration) <-chan ccip.CommitStoreReportWithTxMeta); ok {
+		r0 = rf(ctx, minSeqNum, confirmations, pollInterval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan ccip.CommitStoreReportWithTxMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, int, time.Duration) error); ok {
+		r1 = rf(ctx, minSeqNum, confirmations, pollInterval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommitStoreReader_SubscribeAcceptedCommitReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeAcceptedCommitReports'
+type CommitStoreReader_SubscribeAcceptedCommitReports_Call struct {
+	*mock.Call
+}
+
+// SubscribeAcceptedCommitReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - minSeqNum uint64
+//   - confirmations int
+//   - pollInterval time.Duration
+func (_e *CommitStoreReader_Expecter) SubscribeAcceptedCommitReports(ctx interface{}, minSeqNum interface{}, confirmations interface{}, pollInterval interface{}) *CommitStoreReader_SubscribeAcceptedCommitReports_Call {
+	return &CommitStoreReader_SubscribeAcceptedCommitReports_Call{Call: _e.mock.On("SubscribeAcceptedCommitReports", ctx, minSeqNum, confirmations, pollInterval)}
+}
+
+func (_c *CommitStoreReader_SubscribeAcceptedCommitReports_Call) Run(run func(ctx context.Context, minSeqNum uint64, confirmations int, pollInterval time.Duration)) *CommitStoreReader_SubscribeAcceptedCommitReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *CommitStoreReader_SubscribeAcceptedCommitReports_Call) Return(_a0 <-chan ccip.CommitStoreReportWithTxMeta, _a1 error) *CommitStoreReader_SubscribeAcceptedCommitReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommitStoreReader_SubscribeAcceptedCommitReports_Call) RunAndReturn(run func(context.Context, uint64, int, time.Duration) (<-chan ccip.CommitStoreReportWithTxMeta, error)) *CommitStoreReader_SubscribeAcceptedCommitReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyExecutionReport provides a mock function with given fields: ctx, report
 func (_m *CommitStoreReader) VerifyExecutionReport(ctx context.Context, report ccip.ExecReport) (bool, error) {
 	ret := _m.Called(ctx, report)
@@ -1,6 +1,8 @@
 package ccipdata
 
 import (
+	"context"
+
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 )
 
@@ -11,3 +13,52 @@ const (
 type OffRampReader interface {
 	cciptypes.OffRampReader
 }
+
+// OffRampTokenPoolHistoryReader is implemented by the OffRampReaders of the offRamps managing the token pools, i.e. the
+// 1.2.0 ones. It is kept out of OffRampReader as the other readers, including the ones served by the relayers, can't
+// provide it.
+type OffRampTokenPoolHistoryReader interface {
+	// GetTokenPoolChangesBetweenBlocks returns the token pools added to and removed from the offRamp within the inclusive
+	// block range, ordered by block and log index. The pool events must be indexed by the log poller, i.e. the filters of
+	// the reader must be registered.
+	GetTokenPoolChangesBetweenBlocks(ctx context.Context, fromBlock, toBlock uint64) ([]Event[TokenPoolChange], error)
+}
+
+// OffRampTokenBucketReader is implemented by the OffRampReaders able to read the rate limiters of the token pools
+// along with the aggregate rate limiter of the offRamp, it is kept out of OffRampReader as the readers served by the
+// relayers don't provide it.
+type OffRampTokenBucketReader interface {
+	// GetTokenBucketState returns the current state of the aggregate rate limiter of the lane and of the inbound rate
+	// limiters of the given dest token pools for the lane, so that batches exceeding them can be left out before they
+	// revert on-chain.
+	GetTokenBucketState(ctx context.Context, tokenPools []cciptypes.Address) (TokenBucketState, error)
+}
+
+// TokenBucketState is the state of the rate limiters applied to the token transfers of a lane.
+type TokenBucketState struct {
+	// Lane is the aggregate rate limiter of the offRamp, denominated in USD.
+	Lane cciptypes.TokenBucketRateLimit
+	// TokenPools are the inbound rate limiters of the token pools by pool address, denominated in the pool token.
+	TokenPools map[cciptypes.Address]cciptypes.TokenBucketRateLimit
+}
+
+// TokenPoolChange is a token pool added to or removed from the offRamp.
+type TokenPoolChange struct {
+	SourceToken cciptypes.Address
+	Pool        cciptypes.Address
+	Added       bool
+}
+
+// ApplyTokenPoolChanges applies the ordered token pool changes to the pools of the source tokens, e.g. to reconstruct
+// the pools of the offRamp at a past block by applying all changes from its deployment up to that block.
+func ApplyTokenPoolChanges(pools map[cciptypes.Address]cciptypes.Address, changes []Event[TokenPoolChange]) {
+	for _, change := range changes {
+		if change.Data.Added {
+			pools[change.Data.SourceToken] = change.Data.Pool
+			continue
+		}
+		if pools[change.Data.SourceToken] == change.Data.Pool {
+			delete(pools, change.Data.SourceToken)
+		}
+	}
+}
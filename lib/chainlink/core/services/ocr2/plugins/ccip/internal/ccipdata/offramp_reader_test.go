@@ -330,3 +330,20 @@ func TestNewOffRampReader(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyTokenPoolChanges(t *testing.T) {
+	token := ccipcalc.HexToAddress("0x1")
+	otherToken := ccipcalc.HexToAddress("0x2")
+	oldPool := ccipcalc.HexToAddress("0x10")
+	newPool := ccipcalc.HexToAddress("0x11")
+
+	pools := map[cciptypes.Address]cciptypes.Address{otherToken: oldPool}
+	ccipdata.ApplyTokenPoolChanges(pools, []ccipdata.Event[ccipdata.TokenPoolChange]{
+		{Data: ccipdata.TokenPoolChange{SourceToken: token, Pool: oldPool, Added: true}},
+		// the pool of the token is replaced by adding the new pool before removing the old one
+		{Data: ccipdata.TokenPoolChange{SourceToken: token, Pool: newPool, Added: true}},
+		{Data: ccipdata.TokenPoolChange{SourceToken: token, Pool: oldPool}},
+		{Data: ccipdata.TokenPoolChange{SourceToken: otherToken, Pool: oldPool}},
+	})
+	assert.Equal(t, map[cciptypes.Address]cciptypes.Address{token: newPool}, pools)
+}
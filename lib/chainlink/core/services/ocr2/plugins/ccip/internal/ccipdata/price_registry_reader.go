@@ -1,6 +1,10 @@
 package ccipdata
 
-import cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+import (
+	"context"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
 
 const (
 	COMMIT_PRICE_UPDATES = "Commit price updates"
@@ -12,3 +16,22 @@ const (
 type PriceRegistryReader interface {
 	cciptypes.PriceRegistryReader
 }
+
+// PriceRegistryUpdateSimulator is implemented by the PriceRegistryReaders able to simulate price updates, it is kept
+// out of PriceRegistryReader as the readers served by the relayers don't provide it.
+type PriceRegistryUpdateSimulator interface {
+	// SimulatePriceUpdates calls updatePrices of the price registry with the given prices from the given price updater,
+	// e.g. the commit store of a lane, against the latest block without sending a transaction. A reverted call is
+	// returned as a PriceUpdateRevertError.
+	SimulatePriceUpdates(ctx context.Context, priceUpdater cciptypes.Address, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice) error
+}
+
+// PriceUpdateRevertError is a simulated price update reverted by the price registry.
+type PriceUpdateRevertError struct {
+	// Reason is the decoded revert reason, or the raw revert data when it cannot be decoded.
+	Reason string
+}
+
+func (e *PriceUpdateRevertError) Error() string {
+	return "price update reverted: " + e.Reason
+}
@@ -10,6 +10,7 @@ import (
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 )
 
 const (
@@ -46,10 +47,58 @@ type Event[T any] struct {
 }
 
 func LogsConfirmations(finalized bool) evmtypes.Confirmations {
-	if finalized {
-		return evmtypes.Finalized
+	return LogsConfig{}.Confirmations(finalized)
+}
+
+// LogsConfig configures how far back the readers of a lane query the logs and the depth at which the logs are final,
+// so that the lanes of fast-finality chains aren't bound to the defaults. The zero value keeps the defaults.
+type LogsConfig struct {
+	// Lookback is the retention of the logs processed by the commit and exec plugins, zero selects
+	// CommitExecLogsRetention.
+	Lookback time.Duration
+	// FinalityDepth is the number of confirmations of the logs read as finalized, zero relies on the finality of the
+	// log poller.
+	FinalityDepth uint32
+}
+
+// NewLogsConfig returns the LogsConfig of the ReaderLogsConfig of a job spec, the defaults when it is nil.
+func NewLogsConfig(cfg *ccipconfig.ReaderLogsConfig) LogsConfig {
+	if cfg == nil {
+		return LogsConfig{}
+	}
+	return LogsConfig{
+		Lookback:      cfg.Lookback.Duration(),
+		FinalityDepth: cfg.FinalityDepth,
+	}
+}
+
+// Confirmations returns the confirmations of the logs read with or without finality.
+func (c LogsConfig) Confirmations(finalized bool) evmtypes.Confirmations {
+	if !finalized {
+		return evmtypes.Unconfirmed
+	}
+	return c.ConfirmationsOf(int(evmtypes.Finalized))
+}
+
+// ConfirmationsOf returns the confirmations of the logs read with confs, the finalized reads require FinalityDepth
+// confirmations when it is set.
+func (c LogsConfig) ConfirmationsOf(confs int) evmtypes.Confirmations {
+	if evmtypes.Confirmations(confs) == evmtypes.Finalized && c.FinalityDepth > 0 {
+		return evmtypes.Confirmations(c.FinalityDepth)
+	}
+	return evmtypes.Confirmations(confs)
+}
+
+// ApplyToFilters sets the retention of the filters of the logs processed by the commit and exec plugins to Lookback.
+func (c LogsConfig) ApplyToFilters(filters []logpoller.Filter) {
+	if c.Lookback == 0 {
+		return
+	}
+	for i := range filters {
+		if filters[i].Retention == CommitExecLogsRetention {
+			filters[i].Retention = c.Lookback
+		}
 	}
-	return evmtypes.Unconfirmed
 }
 
 func ParseLogs[T any](logs []logpoller.Log, lggr logger.Logger, parseFunc func(log types.Log) (*T, error)) ([]Event[T], error) {
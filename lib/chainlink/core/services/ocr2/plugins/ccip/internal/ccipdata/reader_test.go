@@ -10,8 +10,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 )
 
 func Test_parseLogs(t *testing.T) {
@@ -70,3 +74,30 @@ func Test_parseLogs_withErrors(t *testing.T) {
 		assert.Contains(t, contextMap["err"], fmt.Sprintf("cannot parse %d", (i+1)*2), "each error should be logged as a warning")
 	}
 }
+
+func TestLogsConfig(t *testing.T) {
+	var defaults LogsConfig
+	assert.Equal(t, evmtypes.Finalized, defaults.Confirmations(true))
+	assert.Equal(t, evmtypes.Unconfirmed, defaults.Confirmations(false))
+	assert.Equal(t, evmtypes.Confirmations(3), defaults.ConfirmationsOf(3))
+
+	fastFinality := NewLogsConfig(&ccipconfig.ReaderLogsConfig{
+		Lookback:      *commonconfig.MustNewDuration(24 * time.Hour),
+		FinalityDepth: 5,
+	})
+	assert.Equal(t, evmtypes.Confirmations(5), fastFinality.Confirmations(true))
+	assert.Equal(t, evmtypes.Unconfirmed, fastFinality.Confirmations(false))
+	assert.Equal(t, evmtypes.Confirmations(5), fastFinality.ConfirmationsOf(int(evmtypes.Finalized)))
+	assert.Equal(t, evmtypes.Confirmations(3), fastFinality.ConfirmationsOf(3))
+
+	// Only the retention of the logs processed by the plugins is overridden
+	filters := []logpoller.Filter{
+		{Name: "commit exec", Retention: CommitExecLogsRetention},
+		{Name: "cache eviction", Retention: CacheEvictionLogsRetention},
+	}
+	fastFinality.ApplyToFilters(filters)
+	assert.Equal(t, 24*time.Hour, filters[0].Retention)
+	assert.Equal(t, CacheEvictionLogsRetention, filters[1].Retention)
+
+	assert.Equal(t, LogsConfig{}, NewLogsConfig(nil))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
 	"time"
 
@@ -22,7 +23,6 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/client"
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
-	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 
 	commit_store_1_2_0 "github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_2_0/commit_store"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
@@ -49,6 +49,7 @@ type CommitStore struct {
 	estimator                 *gas.EvmFeeEstimator
 	sourceMaxGasPrice         *big.Int
 	filters                   []logpoller.Filter
+	logsConfig                ccipdata.LogsConfig
 	reportAcceptedSig         common.Hash
 	reportAcceptedMaxSeqIndex int
 	commitReportArgs          abi.Arguments
@@ -320,7 +321,7 @@ func (c *CommitStore) GetCommitReportMatchingSeqNum(ctx context.Context, seqNr u
 		c.reportAcceptedMaxSeqIndex-1,
 		c.reportAcceptedMaxSeqIndex,
 		logpoller.EvmWord(seqNr),
-		evmtypes.Confirmations(confs),
+		c.logsConfig.ConfirmationsOf(confs),
 	)
 	if err != nil {
 		return nil, err
@@ -361,7 +362,7 @@ func (c *CommitStore) GetAcceptedCommitReportsGteTimestamp(ctx context.Context,
 		logpoller.NewAddressFilter(c.address),
 		logpoller.NewEventSigFilter(c.reportAcceptedSig),
 		query.Timestamp(uint64(ts.Unix()), primitives.Gte),
-		logpoller.NewConfirmationsFilter(evmtypes.Confirmations(confs)),
+		logpoller.NewConfirmationsFilter(c.logsConfig.ConfirmationsOf(confs)),
 	)
 	if err != nil {
 		return nil, err
@@ -392,6 +393,81 @@ func (c *CommitStore) GetAcceptedCommitReportsGteTimestamp(ctx context.Context,
 	return res, nil
 }
 
+// SubscribeAcceptedCommitReports streams the commit reports accepted with an interval ending at or after minSeqNum,
+// in sequence number order. The channel is closed once ctx is done.
+func (c *CommitStore) SubscribeAcceptedCommitReports(ctx context.Context, minSeqNum uint64, confs int, pollInterval time.Duration) (<-chan cciptypes.CommitStoreReportWithTxMeta, error) {
+	filterName := logpoller.FilterName(ExecReportAccepts, c.address.String())
+	if !c.lp.HasFilter(filterName) {
+		return nil, fmt.Errorf("filter %s is not registered", filterName)
+	}
+
+	reports := make(chan cciptypes.CommitStoreReportWithTxMeta)
+	go func() {
+		defer close(reports)
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		nextSeqNum := minSeqNum
+		for {
+			accepted, err := c.getAcceptedCommitReportsGteSeqNum(ctx, nextSeqNum, confs)
+			if err != nil {
+				c.lggr.Warnw("Failed to read accepted commit reports", "nextSeqNum", nextSeqNum, "err", err)
+			}
+			for _, report := range accepted {
+				// Skip the reports overlapping the ones already streamed
+				if report.Interval.Max < nextSeqNum {
+					continue
+				}
+				select {
+				case reports <- report:
+					nextSeqNum = report.Interval.Max + 1
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return reports, nil
+}
+
+// getAcceptedCommitReportsGteSeqNum returns the commit reports accepted with an interval ending at or after seqNum.
+func (c *CommitStore) getAcceptedCommitReportsGteSeqNum(ctx context.Context, seqNum uint64, confs int) ([]cciptypes.CommitStoreReportWithTxMeta, error) {
+	logs, err := c.lp.LogsDataWordGreaterThan(
+		ctx,
+		c.reportAcceptedSig,
+		c.address,
+		c.reportAcceptedMaxSeqIndex,
+		logpoller.EvmWord(seqNum),
+		c.logsConfig.ConfirmationsOf(confs),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedLogs, err := ccipdata.ParseLogs[cciptypes.CommitStoreReport](logs, c.lggr, c.parseReport)
+	if err != nil {
+		return nil, fmt.Errorf("parse logs: %w", err)
+	}
+
+	res := make([]cciptypes.CommitStoreReportWithTxMeta, 0, len(parsedLogs))
+	for _, log := range parsedLogs {
+		res = append(res, cciptypes.CommitStoreReportWithTxMeta{
+			TxMeta:            log.TxMeta,
+			CommitStoreReport: log.Data,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Interval.Min < res[j].Interval.Min
+	})
+	return res, nil
+}
+
 func (c *CommitStore) GetExpectedNextSequenceNumber(ctx context.Context) (uint64, error) {
 	return c.commitStore.GetExpectedNextSequenceNumber(&bind.CallOpts{Context: ctx})
 }
@@ -437,6 +513,18 @@ func (c *CommitStore) RegisterFilters(ctx context.Context) error {
 	return logpollerutil.RegisterLpFilters(ctx, c.lp, c.filters)
 }
 
+// Filters returns the log poller filters registered by RegisterFilters.
+func (c *CommitStore) Filters() []logpoller.Filter {
+	return c.filters
+}
+
+// SetLogsConfig sets the lookback and the finality depth of the logs read by the reader, it must be called before
+// RegisterFilters.
+func (c *CommitStore) SetLogsConfig(cfg ccipdata.LogsConfig) {
+	c.logsConfig = cfg
+	cfg.ApplyToFilters(c.filters)
+}
+
 func NewCommitStore(lggr logger.Logger, addr common.Address, ec client.Client, lp logpoller.LogPoller, feeEstimatorConfig ccipdata.FeeEstimatorConfigReader) (*CommitStore, error) {
 	commitStore, err := commit_store_1_2_0.NewCommitStore(addr, ec)
 	if err != nil {
@@ -1,17 +1,21 @@
 package v1_2_0
 
 import (
+	"context"
 	"math/big"
 	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -62,6 +66,63 @@ func TestCommitReportEncoding(t *testing.T) {
 	require.Equal(t, report, decodedReport)
 }
 
+func TestCommitStoreSubscribeAcceptedCommitReports(t *testing.T) {
+	t.Parallel()
+	lp := mocks.NewLogPoller(t)
+	c, err := NewCommitStore(logger.TestLogger(t), utils.RandomAddress(), nil, lp, ccipdatamocks.NewFeeEstimatorConfigReader(t))
+	require.NoError(t, err)
+	filterName := logpoller.FilterName(ExecReportAccepts, c.address.String())
+
+	t.Run("filters must be registered", func(t *testing.T) {
+		lp.On("HasFilter", filterName).Return(false).Once()
+		_, err := c.SubscribeAcceptedCommitReports(testutils.Context(t), 1, 1, time.Millisecond)
+		require.ErrorContains(t, err, "is not registered")
+	})
+
+	t.Run("accepted reports are streamed in sequence number order", func(t *testing.T) {
+		acceptedLog := func(minSeqNum, maxSeqNum uint64) logpoller.Log {
+			data, err := EncodeCommitReport(c.commitReportArgs, cciptypes.CommitStoreReport{
+				MerkleRoot: [32]byte{byte(minSeqNum)},
+				Interval:   cciptypes.CommitStoreInterval{Min: minSeqNum, Max: maxSeqNum},
+			})
+			require.NoError(t, err)
+			return logpoller.Log{
+				Topics:   [][]byte{c.reportAcceptedSig[:]},
+				EventSig: c.reportAcceptedSig,
+				Address:  c.address,
+				Data:     data,
+			}
+		}
+		acceptedLogsGteSeqNum := func(seqNum uint64) *mock.Call {
+			return lp.On("LogsDataWordGreaterThan", mock.Anything, c.reportAcceptedSig, c.address, c.reportAcceptedMaxSeqIndex, logpoller.EvmWord(seqNum), evmtypes.Confirmations(1))
+		}
+
+		lp.On("HasFilter", filterName).Return(true).Once()
+		acceptedLogsGteSeqNum(5).Return([]logpoller.Log{acceptedLog(8, 10), acceptedLog(5, 7)}, nil).Once()
+		acceptedLogsGteSeqNum(11).Return(nil, assert.AnError).Once()
+		acceptedLogsGteSeqNum(11).Return([]logpoller.Log{acceptedLog(11, 12)}, nil).Once()
+		acceptedLogsGteSeqNum(13).Return(nil, nil).Maybe()
+
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		reports, err := c.SubscribeAcceptedCommitReports(ctx, 5, 1, time.Millisecond)
+		require.NoError(t, err)
+
+		for _, expInterval := range []cciptypes.CommitStoreInterval{{Min: 5, Max: 7}, {Min: 8, Max: 10}, {Min: 11, Max: 12}} {
+			select {
+			case report := <-reports:
+				assert.Equal(t, expInterval, report.Interval)
+			case <-time.After(testutils.WaitTimeout(t)):
+				t.Fatalf("report %v was not streamed", expInterval)
+			}
+		}
+
+		cancel()
+		for range reports {
+			t.Fatal("no more reports are expected")
+		}
+	})
+}
+
 func TestCommitStoreV120ffchainConfigEncoding(t *testing.T) {
 	t.Parallel()
 	validConfig := JSONCommitOffchainConfig{
@@ -21,7 +21,6 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/client"
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
-	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 
 	evm_2_evm_offramp_1_2_0 "github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_2_0/evm_2_evm_offramp"
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_2_0/router"
@@ -51,6 +50,11 @@ var (
 	offrampPoolAddedPoolRemovedEvents                        = []common.Hash{PoolAddedEvent, PoolRemovedEvent}
 )
 
+var (
+	_ ccipdata.OffRampTokenBucketReader      = &OffRamp{}
+	_ ccipdata.OffRampTokenPoolHistoryReader = &OffRampWithTokenPoolHistory{}
+)
+
 type ExecOnchainConfig evm_2_evm_offramp_1_2_0.EVM2EVMOffRampDynamicConfig
 
 func (d ExecOnchainConfig) AbiString() string {
@@ -146,6 +150,7 @@ type OffRamp struct {
 	Client                  client.Client
 	evmBatchCaller          rpclib.EvmBatchCaller
 	filters                 []logpoller.Filter
+	logsConfig              ccipdata.LogsConfig
 	Estimator               gas.EvmFeeEstimator
 	DestMaxGasPrice         *big.Int
 	ExecutionReportArgs     abi.Arguments
@@ -154,6 +159,12 @@ type OffRamp struct {
 	cachedOffRampTokens     cache.AutoSync[cciptypes.OffRampTokens]
 	sourceToDestTokensCache sync.Map
 
+	// newTokenPoolReader creates the reader of the token pool rate limits of GetTokenBucketState on first use, it is
+	// set by the reader factory as the token pool readers are versioned independently of the offRamp.
+	newTokenPoolReader func(ctx context.Context) (cciptypes.TokenPoolBatchedReader, error)
+	tokenPoolReader    cciptypes.TokenPoolBatchedReader
+	tokenPoolReaderMu  sync.Mutex
+
 	// Dynamic config
 	// configMu guards all the dynamic config fields.
 	configMu           sync.RWMutex
@@ -249,6 +260,61 @@ func (o *OffRamp) CurrentRateLimiterState(ctx context.Context) (cciptypes.TokenB
 	}, nil
 }
 
+// SetTokenPoolReaderFactory sets how the reader of the token pool rate limits of GetTokenBucketState is created.
+func (o *OffRamp) SetTokenPoolReaderFactory(newTokenPoolReader func(ctx context.Context) (cciptypes.TokenPoolBatchedReader, error)) {
+	o.tokenPoolReaderMu.Lock()
+	defer o.tokenPoolReaderMu.Unlock()
+	o.newTokenPoolReader = newTokenPoolReader
+	o.tokenPoolReader = nil
+}
+
+func (o *OffRamp) GetTokenBucketState(ctx context.Context, tokenPools []cciptypes.Address) (ccipdata.TokenBucketState, error) {
+	lane, err := o.CurrentRateLimiterState(ctx)
+	if err != nil {
+		return ccipdata.TokenBucketState{}, fmt.Errorf("get lane rate limiter state: %w", err)
+	}
+	state := ccipdata.TokenBucketState{
+		Lane:       lane,
+		TokenPools: make(map[cciptypes.Address]cciptypes.TokenBucketRateLimit, len(tokenPools)),
+	}
+	if len(tokenPools) == 0 {
+		return state, nil
+	}
+
+	tokenPoolReader, err := o.getTokenPoolReader(ctx)
+	if err != nil {
+		return ccipdata.TokenBucketState{}, err
+	}
+	rateLimits, err := tokenPoolReader.GetInboundTokenPoolRateLimits(ctx, tokenPools)
+	if err != nil {
+		return ccipdata.TokenBucketState{}, fmt.Errorf("get token pool rate limits: %w", err)
+	}
+	if len(rateLimits) != len(tokenPools) {
+		return ccipdata.TokenBucketState{}, fmt.Errorf("got %d token pool rate limits for %d token pools", len(rateLimits), len(tokenPools))
+	}
+	for i, tokenPool := range tokenPools {
+		state.TokenPools[tokenPool] = rateLimits[i]
+	}
+	return state, nil
+}
+
+func (o *OffRamp) getTokenPoolReader(ctx context.Context) (cciptypes.TokenPoolBatchedReader, error) {
+	o.tokenPoolReaderMu.Lock()
+	defer o.tokenPoolReaderMu.Unlock()
+	if o.tokenPoolReader != nil {
+		return o.tokenPoolReader, nil
+	}
+	if o.newTokenPoolReader == nil {
+		return nil, errors.New("token pool rate limits are not readable by this offRamp reader")
+	}
+	tokenPoolReader, err := o.newTokenPoolReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create token pool reader: %w", err)
+	}
+	o.tokenPoolReader = tokenPoolReader
+	return tokenPoolReader, nil
+}
+
 func (o *OffRamp) getDestinationTokensFromSourceTokens(ctx context.Context, tokenAddresses []cciptypes.Address) ([]cciptypes.Address, error) {
 	destTokens := make([]cciptypes.Address, len(tokenAddresses))
 	found := make(map[cciptypes.Address]bool)
@@ -436,6 +502,18 @@ func (o *OffRamp) RegisterFilters(ctx context.Context) error {
 	return logpollerutil.RegisterLpFilters(ctx, o.lp, o.filters)
 }
 
+// Filters returns the log poller filters registered by RegisterFilters.
+func (o *OffRamp) Filters() []logpoller.Filter {
+	return o.filters
+}
+
+// SetLogsConfig sets the lookback and the finality depth of the logs read by the reader, it must be called before
+// RegisterFilters.
+func (o *OffRamp) SetLogsConfig(cfg ccipdata.LogsConfig) {
+	o.logsConfig = cfg
+	cfg.ApplyToFilters(o.filters)
+}
+
 func (o *OffRamp) GetExecutionState(ctx context.Context, sequenceNumber uint64) (uint8, error) {
 	return o.offRampV120.GetExecutionState(&bind.CallOpts{Context: ctx}, sequenceNumber)
 }
@@ -453,7 +531,7 @@ func (o *OffRamp) GetExecutionStateChangesBetweenSeqNums(ctx context.Context, se
 		o.eventIndex,
 		logpoller.EvmWord(seqNumMin),
 		logpoller.EvmWord(seqNumMax),
-		evmtypes.Confirmations(confs),
+		o.logsConfig.ConfirmationsOf(confs),
 	)
 	if err != nil {
 		return nil, err
@@ -487,6 +565,58 @@ func (o *OffRamp) GetExecutionStateChangesBetweenSeqNums(ctx context.Context, se
 	return res, nil
 }
 
+// OffRampWithTokenPoolHistory is the OffRamp reader of 1.2.0 offRamps, which manage the token pools. The readers of
+// later versions embed OffRamp, but their offRamps no longer emit the token pool events, so OffRamp doesn't read them.
+type OffRampWithTokenPoolHistory struct {
+	*OffRamp
+}
+
+func (o *OffRampWithTokenPoolHistory) GetTokenPoolChangesBetweenBlocks(ctx context.Context, fromBlock, toBlock uint64) ([]ccipdata.Event[ccipdata.TokenPoolChange], error) {
+	if fromBlock > toBlock {
+		return nil, fmt.Errorf("from block %d is after to block %d", fromBlock, toBlock)
+	}
+
+	logs, err := o.lp.LogsWithSigs(ctx, int64(fromBlock), int64(toBlock), offrampPoolAddedPoolRemovedEvents, o.addr)
+	if err != nil {
+		return nil, fmt.Errorf("get token pool logs: %w", err)
+	}
+
+	parsedLogs, err := ccipdata.ParseLogs[ccipdata.TokenPoolChange](logs, o.Logger, o.parseTokenPoolChange)
+	if err != nil {
+		return nil, fmt.Errorf("parse logs: %w", err)
+	}
+	return parsedLogs, nil
+}
+
+func (o *OffRamp) parseTokenPoolChange(log types.Log) (*ccipdata.TokenPoolChange, error) {
+	if len(log.Topics) == 0 {
+		return nil, errors.New("log has no topics")
+	}
+	switch log.Topics[0] {
+	case PoolAddedEvent:
+		added, err := o.offRampV120.ParsePoolAdded(log)
+		if err != nil {
+			return nil, err
+		}
+		return &ccipdata.TokenPoolChange{
+			SourceToken: ccipcalc.EvmAddrToGeneric(added.Token),
+			Pool:        ccipcalc.EvmAddrToGeneric(added.Pool),
+			Added:       true,
+		}, nil
+	case PoolRemovedEvent:
+		removed, err := o.offRampV120.ParsePoolRemoved(log)
+		if err != nil {
+			return nil, err
+		}
+		return &ccipdata.TokenPoolChange{
+			SourceToken: ccipcalc.EvmAddrToGeneric(removed.Token),
+			Pool:        ccipcalc.EvmAddrToGeneric(removed.Pool),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unexpected event %s", log.Topics[0])
+	}
+}
+
 func EncodeExecutionReport(ctx context.Context, args abi.Arguments, report cciptypes.ExecReport) ([]byte, error) {
 	var msgs []evm_2_evm_offramp_1_2_0.InternalEVM2EVMMessage
 	for _, msg := range report.Messages {
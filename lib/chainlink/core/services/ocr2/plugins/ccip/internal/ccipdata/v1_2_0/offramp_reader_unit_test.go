@@ -1,8 +1,10 @@
 package v1_2_0
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
+	"math/big"
 	"math/rand"
 	"slices"
 	"testing"
@@ -26,6 +28,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/cache"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	batchreadermocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/batchreader/mocks"
 	mock_contracts "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks/contracts"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib/rpclibmocks"
@@ -211,6 +215,41 @@ func Test_LogsAreProperlyMarkedAsFinalized(t *testing.T) {
 	}
 }
 
+func TestGetTokenPoolChangesBetweenBlocks(t *testing.T) {
+	offrampAddress := utils.RandomAddress()
+	token := utils.RandomAddress()
+	oldPool := utils.RandomAddress()
+	newPool := utils.RandomAddress()
+
+	lp := mocks.NewLogPoller(t)
+	lp.On("LogsWithSigs", mock.Anything, int64(10), int64(20), []common.Hash{PoolAddedEvent, PoolRemovedEvent}, offrampAddress).
+		Return([]logpoller.Log{
+			createTokenPoolEventLog(t, "PoolRemoved", token, oldPool, 11),
+			createTokenPoolEventLog(t, "PoolAdded", token, newPool, 11),
+		}, nil)
+
+	v120, err := NewOffRamp(logger.Test(t), offrampAddress, clienttest.NewClient(t), lp, nil, nil, nil)
+	require.NoError(t, err)
+	offRamp := &OffRampWithTokenPoolHistory{OffRamp: v120}
+
+	changes, err := offRamp.GetTokenPoolChangesBetweenBlocks(testutils.Context(t), 10, 20)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, ccipdata.TokenPoolChange{
+		SourceToken: ccipcalc.EvmAddrToGeneric(token),
+		Pool:        ccipcalc.EvmAddrToGeneric(oldPool),
+	}, changes[0].Data)
+	assert.Equal(t, ccipdata.TokenPoolChange{
+		SourceToken: ccipcalc.EvmAddrToGeneric(token),
+		Pool:        ccipcalc.EvmAddrToGeneric(newPool),
+		Added:       true,
+	}, changes[1].Data)
+	assert.Equal(t, uint64(11), changes[1].BlockNumber)
+
+	_, err = offRamp.GetTokenPoolChangesBetweenBlocks(testutils.Context(t), 20, 10)
+	require.Error(t, err)
+}
+
 func TestGetRouter(t *testing.T) {
 	routerAddr := utils.RandomAddress()
 
@@ -232,6 +271,54 @@ func TestGetRouter(t *testing.T) {
 	assert.Equal(t, routerAddr, gotRouterEvmAddr)
 }
 
+func TestGetTokenBucketState(t *testing.T) {
+	ctx := testutils.Context(t)
+	pools := []cciptypes.Address{ccipcalc.EvmAddrToGeneric(utils.RandomAddress()), ccipcalc.EvmAddrToGeneric(utils.RandomAddress())}
+	poolRateLimits := []cciptypes.TokenBucketRateLimit{
+		{Tokens: big.NewInt(10), Capacity: big.NewInt(100), Rate: big.NewInt(1), IsEnabled: true},
+		{Tokens: big.NewInt(20), Capacity: big.NewInt(200), Rate: big.NewInt(2), IsEnabled: true},
+	}
+
+	mockOffRamp := mock_contracts.NewEVM2EVMOffRampInterface(t)
+	mockOffRamp.On("CurrentRateLimiterState", mock.Anything).Return(evm_2_evm_offramp_1_2_0.RateLimiterTokenBucket{
+		Tokens:      big.NewInt(1000),
+		LastUpdated: 123,
+		IsEnabled:   true,
+		Capacity:    big.NewInt(5000),
+		Rate:        big.NewInt(5),
+	}, nil)
+
+	offRamp := OffRamp{offRampV120: mockOffRamp}
+
+	// Token pool rate limits can't be read without a token pool reader, the lane rate limiter still can
+	state, err := offRamp.GetTokenBucketState(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000), state.Lane.Tokens)
+	assert.Empty(t, state.TokenPools)
+	_, err = offRamp.GetTokenBucketState(ctx, pools)
+	require.Error(t, err)
+
+	tokenPoolReader := batchreadermocks.NewTokenPoolBatchedReader(t)
+	tokenPoolReader.On("GetInboundTokenPoolRateLimits", mock.Anything, pools).Return(poolRateLimits, nil).Twice()
+	readersCreated := 0
+	offRamp.SetTokenPoolReaderFactory(func(context.Context) (cciptypes.TokenPoolBatchedReader, error) {
+		readersCreated++
+		return tokenPoolReader, nil
+	})
+
+	for range 2 {
+		state, err = offRamp.GetTokenBucketState(ctx, pools)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(123), state.Lane.LastUpdated)
+		assert.Equal(t, map[cciptypes.Address]cciptypes.TokenBucketRateLimit{
+			pools[0]: poolRateLimits[0],
+			pools[1]: poolRateLimits[1],
+		}, state.TokenPools)
+	}
+	// The token pool reader is created once
+	assert.Equal(t, 1, readersCreated)
+}
+
 func CreateExecutionStateChangeEventLog(t *testing.T, seqNr uint64, blockNumber int64, messageID common.Hash) logpoller.Log {
 	tAbi, err := evm_2_evm_offramp.EVM2EVMOffRampMetaData.GetAbi()
 	require.NoError(t, err)
@@ -260,3 +347,34 @@ func CreateExecutionStateChangeEventLog(t *testing.T, seqNr uint64, blockNumber
 		TxHash:      utils.RandomBytes32(),
 	}
 }
+
+func createTokenPoolEventLog(t *testing.T, eventName string, token, pool common.Address, blockNumber int64) logpoller.Log {
+	tAbi, err := evm_2_evm_offramp_1_2_0.EVM2EVMOffRampMetaData.GetAbi()
+	require.NoError(t, err)
+	event, ok := tAbi.Events[eventName]
+	require.True(t, ok)
+
+	topics := [][]byte{event.ID[:]}
+	var nonIndexed []interface{}
+	for i, value := range []common.Address{token, pool} {
+		if event.Inputs[i].Indexed {
+			topic := common.BytesToHash(value.Bytes())
+			topics = append(topics, topic[:])
+			continue
+		}
+		nonIndexed = append(nonIndexed, value)
+	}
+	logData, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	require.NoError(t, err)
+
+	return logpoller.Log{
+		Topics:      topics,
+		Data:        logData,
+		LogIndex:    1,
+		BlockHash:   utils.RandomBytes32(),
+		BlockNumber: blockNumber,
+		EventSig:    event.ID,
+		Address:     testutils.NewAddress(),
+		TxHash:      utils.RandomBytes32(),
+	}
+}
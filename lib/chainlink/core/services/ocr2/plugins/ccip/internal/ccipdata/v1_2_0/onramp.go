@@ -60,6 +60,7 @@ type OnRamp struct {
 	sendRequestedEventSig      common.Hash
 	sendRequestedSeqNumberWord int
 	filters                    []logpoller.Filter
+	logsConfig                 ccipdata.LogsConfig
 	cachedOnRampDynamicConfig  cache.AutoSync[cciptypes.OnRampDynamicConfig]
 	// Static config can be cached, because it's never expected to change.
 	// The only way to change that is through the contract's constructor (redeployment)
@@ -164,7 +165,7 @@ func (o *OnRamp) GetSendRequestsBetweenSeqNums(ctx context.Context, seqNumMin, s
 		o.sendRequestedSeqNumberWord,
 		logpoller.EvmWord(seqNumMin),
 		logpoller.EvmWord(seqNumMax),
-		ccipdata.LogsConfirmations(finalized),
+		o.logsConfig.Confirmations(finalized),
 	)
 	if err != nil {
 		return nil, err
@@ -222,6 +223,18 @@ func (o *OnRamp) RegisterFilters(ctx context.Context) error {
 	return logpollerutil.RegisterLpFilters(ctx, o.lp, o.filters)
 }
 
+// Filters returns the log poller filters registered by RegisterFilters.
+func (o *OnRamp) Filters() []logpoller.Filter {
+	return o.filters
+}
+
+// SetLogsConfig sets the lookback and the finality depth of the logs read by the reader, it must be called before
+// RegisterFilters.
+func (o *OnRamp) SetLogsConfig(cfg ccipdata.LogsConfig) {
+	o.logsConfig = cfg
+	cfg.ApplyToFilters(o.filters)
+}
+
 func (o *OnRamp) logToMessage(log types.Log) (*cciptypes.EVM2EVMMessage, error) {
 	msg, err := o.onRamp.ParseCCIPSendRequested(log)
 	if err != nil {
@@ -1,14 +1,18 @@
 package v1_2_0
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -29,8 +33,10 @@ import (
 )
 
 var (
-	_        ccipdata.PriceRegistryReader = &PriceRegistry{}
-	abiERC20                              = abihelpers.MustParseABI(erc20.ERC20ABI)
+	_                ccipdata.PriceRegistryReader          = &PriceRegistry{}
+	_                ccipdata.PriceRegistryUpdateSimulator = &PriceRegistry{}
+	abiERC20                                               = abihelpers.MustParseABI(erc20.ERC20ABI)
+	abiPriceRegistry                                       = abihelpers.MustParseABI(price_registry_1_2_0.PriceRegistryABI)
 	// Exposed only for backwards compatibility with tests.
 	UsdPerUnitGasUpdated = abihelpers.MustGetEventID("UsdPerUnitGasUpdated", abihelpers.MustParseABI(price_registry_1_2_0.PriceRegistryABI))
 )
@@ -49,6 +55,9 @@ type PriceRegistry struct {
 
 	feeTokensCache     cache.AutoSync[[]common.Address]
 	tokenDecimalsCache sync.Map
+	// tokenDecimalsInvalidation clears the tokenDecimalsCache on the FeeTokenAdded and FeeTokenRemoved events, so that
+	// the decimals are refetched when the tokens of the price registry change.
+	tokenDecimalsInvalidation cache.AutoSync[struct{}]
 }
 
 func NewPriceRegistry(ctx context.Context, lggr logger.Logger, priceRegistryAddr common.Address, lp logpoller.LogPoller, ec client.Client, registerFilters bool) (*PriceRegistry, error) {
@@ -56,10 +65,9 @@ func NewPriceRegistry(ctx context.Context, lggr logger.Logger, priceRegistryAddr
 	if err != nil {
 		return nil, err
 	}
-	priceRegABI := abihelpers.MustParseABI(price_registry_1_2_0.PriceRegistryABI)
-	usdPerTokenUpdated := abihelpers.MustGetEventID("UsdPerTokenUpdated", priceRegABI)
-	feeTokenRemoved := abihelpers.MustGetEventID("FeeTokenRemoved", priceRegABI)
-	feeTokenAdded := abihelpers.MustGetEventID("FeeTokenAdded", priceRegABI)
+	usdPerTokenUpdated := abihelpers.MustGetEventID("UsdPerTokenUpdated", abiPriceRegistry)
+	feeTokenRemoved := abihelpers.MustGetEventID("FeeTokenRemoved", abiPriceRegistry)
+	feeTokenAdded := abihelpers.MustGetEventID("FeeTokenAdded", abiPriceRegistry)
 	var filters = []logpoller.Filter{
 		{
 			Name:      logpoller.FilterName(ccipdata.COMMIT_PRICE_UPDATES, priceRegistryAddr.String()),
@@ -107,6 +115,11 @@ func NewPriceRegistry(ctx context.Context, lggr logger.Logger, priceRegistryAddr
 			[]common.Hash{feeTokenAdded, feeTokenRemoved},
 			priceRegistryAddr,
 		),
+		tokenDecimalsInvalidation: cache.NewLogpollerEventsBased[struct{}](
+			lp,
+			[]common.Hash{feeTokenAdded, feeTokenRemoved},
+			priceRegistryAddr,
+		),
 	}, nil
 }
 
@@ -140,6 +153,78 @@ func (p *PriceRegistry) Address(ctx context.Context) (cciptypes.Address, error)
 	return cciptypes.Address(p.address.String()), nil
 }
 
+// SimulatePriceUpdates eth_calls updatePrices, the price updater must be allowed to update the prices of the price
+// registry, otherwise the call reverts with OnlyCallableByUpdaterOrOwner.
+func (p *PriceRegistry) SimulatePriceUpdates(ctx context.Context, priceUpdater cciptypes.Address, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice) error {
+	from, err := ccipcalc.GenericAddrToEvm(priceUpdater)
+	if err != nil {
+		return err
+	}
+
+	priceUpdates := price_registry_1_2_0.InternalPriceUpdates{
+		TokenPriceUpdates: make([]price_registry_1_2_0.InternalTokenPriceUpdate, 0, len(tokenPrices)),
+		GasPriceUpdates:   make([]price_registry_1_2_0.InternalGasPriceUpdate, 0, len(gasPrices)),
+	}
+	for _, tokenPrice := range tokenPrices {
+		token, err2 := ccipcalc.GenericAddrToEvm(tokenPrice.Token)
+		if err2 != nil {
+			return err2
+		}
+		priceUpdates.TokenPriceUpdates = append(priceUpdates.TokenPriceUpdates, price_registry_1_2_0.InternalTokenPriceUpdate{
+			SourceToken: token,
+			UsdPerToken: tokenPrice.Value,
+		})
+	}
+	for _, gasPrice := range gasPrices {
+		priceUpdates.GasPriceUpdates = append(priceUpdates.GasPriceUpdates, price_registry_1_2_0.InternalGasPriceUpdate{
+			DestChainSelector: gasPrice.DestChainSelector,
+			UsdPerUnitGas:     gasPrice.Value,
+		})
+	}
+
+	caller := price_registry_1_2_0.PriceRegistryCallerRaw{Contract: &p.priceRegistry.PriceRegistryCaller}
+	err = caller.Call(&bind.CallOpts{Context: ctx, From: from}, nil, "updatePrices", priceUpdates)
+	if err == nil {
+		return nil
+	}
+	if revertErr := priceUpdateRevertError(err); revertErr != nil {
+		return revertErr
+	}
+	return fmt.Errorf("simulate price updates: %w", err)
+}
+
+// priceUpdateRevertError decodes the revert reason returned by the RPC for a reverted updatePrices call, either a
+// revert string or a custom error of the price registry. It returns nil when the error is not a reverted call.
+func priceUpdateRevertError(err error) *ccipdata.PriceUpdateRevertError {
+	rpcErr := client.ExtractRPCErrorOrNil(err)
+	if rpcErr == nil {
+		return nil
+	}
+	data, ok := rpcErr.Data.(string)
+	if !ok {
+		return &ccipdata.PriceUpdateRevertError{Reason: rpcErr.Message}
+	}
+	// Some RPCs prefix the revert data, see client.ExtractRPCError
+	revertData, decodeErr := hexutil.Decode(strings.TrimPrefix(data, "Reverted "))
+	if decodeErr != nil || len(revertData) < 4 {
+		return &ccipdata.PriceUpdateRevertError{Reason: rpcErr.String()}
+	}
+	if reason, unpackErr := abi.UnpackRevert(revertData); unpackErr == nil {
+		return &ccipdata.PriceUpdateRevertError{Reason: reason}
+	}
+	for _, abiErr := range abiPriceRegistry.Errors {
+		if !bytes.Equal(abiErr.ID[:4], revertData[:4]) {
+			continue
+		}
+		args, unpackErr := abiErr.Unpack(revertData)
+		if unpackErr != nil {
+			break
+		}
+		return &ccipdata.PriceUpdateRevertError{Reason: fmt.Sprintf("%s%v", abiErr.Name, args)}
+	}
+	return &ccipdata.PriceUpdateRevertError{Reason: data}
+}
+
 func (p *PriceRegistry) GetFeeTokens(ctx context.Context) ([]cciptypes.Address, error) {
 	feeTokens, err := p.feeTokensCache.Get(ctx, func(ctx context.Context) ([]common.Address, error) {
 		return p.priceRegistry.GetFeeTokens(&bind.CallOpts{Context: ctx})
@@ -155,6 +240,11 @@ func (p *PriceRegistry) Close() error {
 	return logpollerutil.UnregisterLpFilters(context.Background(), p.lp, p.filters)
 }
 
+// Filters returns the log poller filters registered when the reader is created with registerFilters.
+func (p *PriceRegistry) Filters() []logpoller.Filter {
+	return p.filters
+}
+
 func (p *PriceRegistry) GetTokenPriceUpdatesCreatedAfter(ctx context.Context, ts time.Time, confs int) ([]cciptypes.TokenPriceUpdateWithTxMeta, error) {
 	logs, err := p.lp.LogsCreatedAfter(
 		ctx,
@@ -266,6 +356,8 @@ func (p *PriceRegistry) GetTokensDecimals(ctx context.Context, tokenAddresses []
 		return nil, err
 	}
 
+	p.invalidateTokenDecimalsOnFeeTokenChanges(ctx)
+
 	found := make(map[common.Address]bool)
 	tokenDecimals := make([]uint8, len(evmAddrs))
 	for i, tokenAddress := range evmAddrs {
@@ -311,3 +403,15 @@ func (p *PriceRegistry) GetTokensDecimals(ctx context.Context, tokenAddresses []
 	}
 	return tokenDecimals, nil
 }
+
+// invalidateTokenDecimalsOnFeeTokenChanges clears the token decimals cache when fee tokens were added to or removed from
+// the price registry since the last call. The cache is kept when the events cannot be read.
+func (p *PriceRegistry) invalidateTokenDecimalsOnFeeTokenChanges(ctx context.Context) {
+	_, err := p.tokenDecimalsInvalidation.Get(ctx, func(context.Context) (struct{}, error) {
+		p.tokenDecimalsCache.Clear()
+		return struct{}{}, nil
+	})
+	if err != nil {
+		p.lggr.Warnw("Failed to check the fee token changes, token decimals cache is kept", "err", err)
+	}
+}
@@ -0,0 +1,65 @@
+package v1_2_0
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/rpclib/rpclibmocks"
+)
+
+// feeTokenEventsCache syncs on the first call and whenever fee token events were emitted since the last call.
+type feeTokenEventsCache struct {
+	synced    bool
+	newEvents bool
+}
+
+func (c *feeTokenEventsCache) Get(ctx context.Context, syncFunc func(ctx context.Context) (struct{}, error)) (struct{}, error) {
+	if c.synced && !c.newEvents {
+		return struct{}{}, nil
+	}
+	c.synced, c.newEvents = true, false
+	return syncFunc(ctx)
+}
+
+func TestPriceRegistryGetTokensDecimals(t *testing.T) {
+	ctx := testutils.Context(t)
+	tokens := ccipcalc.EvmAddrsToGeneric(utils.RandomAddress(), utils.RandomAddress())
+	decimalsOutputs := []rpclib.DataAndErr{{Outputs: []any{uint8(18)}}, {Outputs: []any{uint8(6)}}}
+
+	batchCaller := rpclibmocks.NewEvmBatchCaller(t)
+	eventsCache := &feeTokenEventsCache{}
+	p := &PriceRegistry{
+		evmBatchCaller:            batchCaller,
+		lggr:                      logger.Test(t),
+		tokenDecimalsInvalidation: eventsCache,
+	}
+
+	batchCaller.On("BatchCall", mock.Anything, mock.Anything, mock.Anything).Return(decimalsOutputs, nil).Once()
+	decimals, err := p.GetTokensDecimals(ctx, tokens)
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{18, 6}, decimals)
+
+	// The decimals are served from the cache while the fee tokens do not change
+	decimals, err = p.GetTokensDecimals(ctx, tokens)
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{18, 6}, decimals)
+
+	// The decimals are refetched once fee tokens were added or removed
+	eventsCache.newEvents = true
+	batchCaller.On("BatchCall", mock.Anything, mock.Anything, mock.Anything).Return(decimalsOutputs, nil).Once()
+	decimals, err = p.GetTokensDecimals(ctx, tokens)
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{18, 6}, decimals)
+	batchCaller.AssertNumberOfCalls(t, "BatchCall", 2)
+}
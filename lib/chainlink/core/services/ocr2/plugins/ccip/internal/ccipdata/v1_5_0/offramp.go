@@ -24,9 +24,15 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/v1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/logpollerutil"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 )
 
+const (
+	ExecRateLimitTokenAdded   = "Rate limit token added"
+	ExecRateLimitTokenRemoved = "Rate limit token removed"
+)
+
 var (
 	abiOffRamp                                        = abihelpers.MustParseABI(evm_2_evm_offramp.EVM2EVMOffRampABI)
 	_                          ccipdata.OffRampReader = &OffRamp{}
@@ -73,6 +79,36 @@ type OffRamp struct {
 	offRampV150           evm_2_evm_offramp.EVM2EVMOffRampInterface
 	cachedRateLimitTokens cache.AutoSync[cciptypes.OffRampTokens]
 	feeEstimatorConfig    ccipdata.FeeEstimatorConfigReader
+	lp                    logpoller.LogPoller
+	// filters replace the filters of the v1.2.0 reader, as the token pool events are no longer emitted by the offRamp.
+	// legacyFilters are the token pool filters registered by earlier node versions, they are only unregistered.
+	filters       []logpoller.Filter
+	legacyFilters []logpoller.Filter
+}
+
+func (o *OffRamp) RegisterFilters(ctx context.Context) error {
+	return logpollerutil.RegisterLpFilters(ctx, o.lp, o.filters)
+}
+
+// Filters returns the log poller filters registered by RegisterFilters.
+func (o *OffRamp) Filters() []logpoller.Filter {
+	return o.filters
+}
+
+// SetLogsConfig sets the lookback and the finality depth of the logs read by the reader, it must be called before
+// RegisterFilters.
+func (o *OffRamp) SetLogsConfig(cfg ccipdata.LogsConfig) {
+	o.OffRamp.SetLogsConfig(cfg)
+	cfg.ApplyToFilters(o.filters)
+}
+
+func (o *OffRamp) Close() error {
+	return logpollerutil.UnregisterLpFilters(context.Background(), o.lp, append(o.filters, o.legacyFilters...))
+}
+
+// LegacyFilters returns the log poller filters registered by earlier node versions, which are only unregistered.
+func (o *OffRamp) LegacyFilters() []logpoller.Filter {
+	return o.legacyFilters
 }
 
 // GetTokens Returns no data as the offRamps no longer have this information.
@@ -190,6 +226,37 @@ func NewOffRamp(
 
 	v120.ExecutionReportArgs = abihelpers.MustGetMethodInputs("manuallyExecute", abiOffRamp)[:1]
 
+	filters := []logpoller.Filter{
+		{
+			Name:      logpoller.FilterName(v1_2_0.ExecExecutionStateChanges, addr.String()),
+			EventSigs: []common.Hash{v1_2_0.ExecutionStateChangedEvent},
+			Addresses: []common.Address{addr},
+			Retention: ccipdata.CommitExecLogsRetention,
+		},
+		{
+			Name:      logpoller.FilterName(ExecRateLimitTokenAdded, addr.String()),
+			EventSigs: []common.Hash{RateLimitTokenAddedEvent},
+			Addresses: []common.Address{addr},
+			Retention: ccipdata.CacheEvictionLogsRetention,
+		},
+		{
+			Name:      logpoller.FilterName(ExecRateLimitTokenRemoved, addr.String()),
+			EventSigs: []common.Hash{RateLimitTokenRemovedEvent},
+			Addresses: []common.Address{addr},
+			Retention: ccipdata.CacheEvictionLogsRetention,
+		},
+	}
+	legacyFilters := []logpoller.Filter{
+		{
+			Name:      logpoller.FilterName(v1_2_0.ExecTokenPoolAdded, addr.String()),
+			Addresses: []common.Address{addr},
+		},
+		{
+			Name:      logpoller.FilterName(v1_2_0.ExecTokenPoolRemoved, addr.String()),
+			Addresses: []common.Address{addr},
+		},
+	}
+
 	return &OffRamp{
 		feeEstimatorConfig: feeEstimatorConfig,
 		OffRamp:            v120,
@@ -199,5 +266,8 @@ func NewOffRamp(
 			[]common.Hash{RateLimitTokenAddedEvent, RateLimitTokenRemovedEvent},
 			offRamp.Address(),
 		),
+		lp:            lp,
+		filters:       filters,
+		legacyFilters: legacyFilters,
 	}, nil
 }
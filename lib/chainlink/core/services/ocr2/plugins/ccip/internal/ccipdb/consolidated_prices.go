@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
+)
+
+// The consolidated prices table stores gas and token prices in a single table keyed by dest chain selector and asset.
+// Migrating to it happens in two steps, first dual writing the prices to both the legacy gas and token price tables
+// and the consolidated one, then switching reads to the consolidated table once it is populated. Writes to the legacy
+// tables are kept while reading the consolidated one, so that reads can be switched back at any time.
+
+// writeConsolidatedGasPrices dual writes the gas prices to the consolidated prices table. Failures are only logged,
+// the legacy gas price table remains the source of truth until reads are switched.
+func (p *priceService) writeConsolidatedGasPrices(ctx context.Context, gasPrices []cciporm.GasPrice) {
+	consolidatedPrices := make([]cciporm.Price, 0, len(gasPrices))
+	for _, gasPrice := range gasPrices {
+		consolidatedPrices = append(consolidatedPrices, cciporm.Price{
+			AssetType: cciporm.GasPriceAsset,
+			Asset:     strconv.FormatUint(gasPrice.SourceChainSelector, 10),
+			Price:     gasPrice.GasPrice,
+		})
+	}
+	p.writeConsolidatedPrices(ctx, gasPriceUpdate, consolidatedPrices)
+}
+
+// writeConsolidatedTokenPrices dual writes the token prices to the consolidated prices table. Unlike the legacy token
+// price table, all given prices are written regardless of their token update interval.
+func (p *priceService) writeConsolidatedTokenPrices(ctx context.Context, tokenPrices []cciporm.TokenPrice) {
+	consolidatedPrices := make([]cciporm.Price, 0, len(tokenPrices))
+	for _, tokenPrice := range tokenPrices {
+		consolidatedPrices = append(consolidatedPrices, cciporm.Price{
+			AssetType: cciporm.TokenPriceAsset,
+			Asset:     tokenPrice.TokenAddr,
+			Price:     tokenPrice.TokenPrice,
+		})
+	}
+	p.writeConsolidatedPrices(ctx, tokenPriceUpdate, consolidatedPrices)
+}
+
+func (p *priceService) writeConsolidatedPrices(ctx context.Context, updateType priceUpdateType, consolidatedPrices []cciporm.Price) {
+	if !p.dualWritePrices || len(consolidatedPrices) == 0 {
+		return
+	}
+	if _, err := p.orm.UpsertPrices(ctx, p.destChainSelector, consolidatedPrices); err != nil {
+		p.lggr.Errorw("Failed to dual write prices to the consolidated prices table", "updateType", updateType, "err", err)
+	}
+}
+
+// getConsolidatedPricesFromDB reads the gas and token prices from the consolidated prices table, in the same format
+// as the legacy tables. Gas price components are decoded from the encoded gas price, as they are not stored.
+func (p *priceService) getConsolidatedPricesFromDB(ctx context.Context, destChainSelector uint64) (map[uint64]TimestampedPrice, map[cciptypes.Address]TimestampedPrice, error) {
+	pricesInDB, err := p.orm.GetPricesByDestChain(ctx, destChainSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get consolidated prices from db: %w", err)
+	}
+
+	gasPrices := make(map[uint64]TimestampedPrice)
+	tokenPrices := make(map[cciptypes.Address]TimestampedPrice)
+	for _, price := range pricesInDB {
+		if price.Price == nil {
+			continue
+		}
+		timestampedPrice := TimestampedPrice{
+			Value:     price.Price.ToInt(),
+			UpdatedAt: price.UpdatedAt,
+		}
+
+		switch price.AssetType {
+		case cciporm.GasPriceAsset:
+			sourceChainSelector, err := strconv.ParseUint(price.Asset, 10, 64)
+			if err != nil {
+				p.lggr.Warnw("Skipping gas price with an invalid source chain selector", "asset", price.Asset, "err", err)
+				continue
+			}
+			timestampedPrice.GasPriceComponents = decodeGasPriceComponents(price.Price)
+			gasPrices[sourceChainSelector] = timestampedPrice
+		case cciporm.TokenPriceAsset:
+			tokenPrices[cciptypes.Address(price.Asset)] = timestampedPrice
+		default:
+			p.lggr.Warnw("Skipping price with an unknown asset type", "assetType", price.AssetType, "asset", price.Asset)
+		}
+	}
+	return gasPrices, tokenPrices, nil
+}
+
+func decodeGasPriceComponents(gasPrice *assets.Wei) *GasPriceComponents {
+	execGasPrice, daGasPrice, err := prices.DecodeGasPriceComponents(gasPrice.ToInt())
+	if err != nil {
+		return nil
+	}
+	return &GasPriceComponents{ExecGasPrice: execGasPrice, DAGasPrice: daGasPrice}
+}
@@ -0,0 +1,101 @@
+package db
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
+)
+
+func TestPriceService_consolidatedPrices(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.TestLogger(t)
+	destChain := chainselectors.TEST_1338
+	sourceChain := chainselectors.TEST_1000
+	token := cciptypes.Address("0x0002")
+
+	newPriceService := func(mockOrm cciporm.ORM, opts PriceServiceOptions) *priceService {
+		return NewPriceService(
+			lggr,
+			mockOrm,
+			1,
+			destChain.Selector,
+			sourceChain.Selector,
+			"0x0001",
+			nil,
+			nil,
+			opts,
+		).(*priceService)
+	}
+
+	t.Run("dual writes gas and token prices", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("UpsertGasPricesForDestChain", ctx, destChain.Selector, mock.Anything).Return(int64(1), nil).Once()
+		mockOrm.On("UpsertTokenPricesForDestChain", ctx, destChain.Selector, mock.Anything, tokenPriceUpdateInterval).Return(int64(1), nil).Once()
+		mockOrm.On("UpsertPrices", ctx, destChain.Selector, []cciporm.Price{
+			{AssetType: cciporm.GasPriceAsset, Asset: strconv.FormatUint(sourceChain.Selector, 10), Price: assets.NewWei(big.NewInt(1e9))},
+		}).Return(int64(1), nil).Once()
+		// Failed dual writes don't fail the update, the legacy tables remain the source of truth
+		mockOrm.On("UpsertPrices", ctx, destChain.Selector, []cciporm.Price{
+			{AssetType: cciporm.TokenPriceAsset, Asset: string(token), Price: assets.NewWei(val1e18(2))},
+		}).Return(int64(0), errors.New("db error")).Once()
+
+		priceService := newPriceService(mockOrm, PriceServiceOptions{DualWritePrices: true})
+		_, err := priceService.writeGasPricesToDB(ctx, map[uint64]*big.Int{sourceChain.Selector: big.NewInt(1e9)}, nil)
+		require.NoError(t, err)
+		_, err = priceService.writeTokenPricesToDB(ctx, map[cciptypes.Address]*big.Int{token: val1e18(2)}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("no dual writes by default", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("UpsertGasPricesForDestChain", ctx, destChain.Selector, mock.Anything).Return(int64(1), nil).Once()
+
+		priceService := newPriceService(mockOrm, PriceServiceOptions{})
+		_, err := priceService.writeGasPricesToDB(ctx, map[uint64]*big.Int{sourceChain.Selector: big.NewInt(1e9)}, nil)
+		require.NoError(t, err)
+		mockOrm.AssertNotCalled(t, "UpsertPrices", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("reads the consolidated prices", func(t *testing.T) {
+		updatedAt := time.Now().UTC()
+		encodedGasPrice, err := prices.EncodeGasPriceComponents(big.NewInt(1e9), big.NewInt(2e9))
+		require.NoError(t, err)
+
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("GetPricesByDestChain", ctx, destChain.Selector).Return([]cciporm.Price{
+			{AssetType: cciporm.GasPriceAsset, Asset: strconv.FormatUint(sourceChain.Selector, 10), Price: assets.NewWei(encodedGasPrice), UpdatedAt: updatedAt},
+			{AssetType: cciporm.GasPriceAsset, Asset: "invalid", Price: assets.NewWei(big.NewInt(1))},
+			{AssetType: cciporm.TokenPriceAsset, Asset: string(token), Price: assets.NewWei(val1e18(2)), UpdatedAt: updatedAt},
+		}, nil).Once()
+
+		priceService := newPriceService(mockOrm, PriceServiceOptions{DualWritePrices: true, ReadConsolidatedPrices: true})
+		gasPrices, tokenPrices, err := priceService.getGasAndTokenPricesFromDB(ctx, destChain.Selector)
+		require.NoError(t, err)
+		assert.Equal(t, map[uint64]TimestampedPrice{
+			sourceChain.Selector: {
+				Value:              encodedGasPrice,
+				UpdatedAt:          updatedAt,
+				GasPriceComponents: &GasPriceComponents{ExecGasPrice: big.NewInt(1e9), DAGasPrice: big.NewInt(2e9)},
+			},
+		}, gasPrices)
+		assert.Equal(t, map[cciptypes.Address]TimestampedPrice{
+			token: {Value: val1e18(2), UpdatedAt: updatedAt},
+		}, tokenPrices)
+	})
+}
@@ -0,0 +1,179 @@
+package db
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type priceUpdateType string
+
+const (
+	gasPriceUpdate   priceUpdateType = "gas"
+	tokenPriceUpdate priceUpdateType = "token"
+)
+
+var (
+	observationLatencyBuckets = []float64{
+		float64(50 * time.Millisecond),
+		float64(100 * time.Millisecond),
+		float64(250 * time.Millisecond),
+		float64(500 * time.Millisecond),
+		float64(750 * time.Millisecond),
+		float64(1 * time.Second),
+		float64(2 * time.Second),
+		float64(5 * time.Second),
+		float64(10 * time.Second),
+		float64(30 * time.Second),
+	}
+	labels             = []string{"updateType", "source", "dest"}
+	priceUpdatesResult = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_updates",
+		Help: "Number of background price updates run by the PriceService",
+	}, append(labels, "success"))
+	priceObservationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ccip_price_service_observation_duration",
+		Help:    "Duration of observing the latest prices by the PriceService",
+		Buckets: observationLatencyBuckets,
+	}, append(labels, "success"))
+	priceRowsUpserted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_rows_upserted",
+		Help: "Number of price rows upserted into the DB by the PriceService",
+	}, labels)
+	priceTokenFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_token_failures",
+		Help: "Number of tokens skipped by partial token price updates of the PriceService because they could not be priced",
+	}, []string{"source", "dest"})
+	gasPriceSourceFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_gas_price_source_failures",
+		Help: "Number of gas price updates of the PriceService which skipped an additional gas price source because it could not be observed",
+	}, []string{"source", "dest", "gasPriceSource"})
+	priceSinkWrites = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_sink_writes",
+		Help: "Number of price writes to the additional sinks of the PriceService",
+	}, append([]string{"sink"}, append(labels, "success")...))
+	priceWritesSuppressed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_writes_suppressed",
+		Help: "Number of price writes skipped by the PriceService because the prices did not deviate from the stored ones",
+	}, labels)
+	pricesOutOfBounds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_prices_out_of_bounds",
+		Help: "Number of prices rejected by the PriceService because they were out of the configured bounds",
+	}, labels)
+	gasPricesDampened = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_gas_prices_dampened",
+		Help: "Number of gas prices capped or floored by the PriceService because they deviated too much from the trailing median",
+	}, []string{"source", "dest"})
+	priceInvalidSignatures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_invalid_signed_prices",
+		Help: "Number of signed prices rejected by the PriceService because their signature could not be verified",
+	}, []string{"source", "dest"})
+	nativePricesDiverged = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_price_service_native_prices_diverged",
+		Help: "Number of source native prices which diverged from the on-chain native price in the dest price registry",
+	}, []string{"source", "dest", "rejected"})
+	priceLastSuccessfulUpdate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ccip_price_service_last_successful_update_timestamp",
+		Help: "Unix timestamp of the last successful price update run by the PriceService",
+	}, labels)
+	priceLastWrite = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ccip_price_service_last_write_timestamp",
+		Help: "Unix timestamp of the last price rows written into the DB by the PriceService of a job, the job with the latest timestamp of a dest chain is its price leader",
+	}, append(labels, "job"))
+)
+
+// priceServiceMetrics records PriceService metrics for a single lane.
+type priceServiceMetrics struct {
+	source, dest string
+}
+
+func newPriceServiceMetrics(sourceChainSelector, destChainSelector uint64) *priceServiceMetrics {
+	return &priceServiceMetrics{
+		source: strconv.FormatUint(sourceChainSelector, 10),
+		dest:   strconv.FormatUint(destChainSelector, 10),
+	}
+}
+
+func (m *priceServiceMetrics) updateResult(updateType priceUpdateType, err error) {
+	priceUpdatesResult.
+		WithLabelValues(string(updateType), m.source, m.dest, strconv.FormatBool(err == nil)).
+		Inc()
+	if err == nil {
+		priceLastSuccessfulUpdate.
+			WithLabelValues(string(updateType), m.source, m.dest).
+			Set(float64(time.Now().Unix()))
+	}
+}
+
+func (m *priceServiceMetrics) observationDuration(updateType priceUpdateType, duration time.Duration, err error) {
+	priceObservationDuration.
+		WithLabelValues(string(updateType), m.source, m.dest, strconv.FormatBool(err == nil)).
+		Observe(float64(duration))
+}
+
+func (m *priceServiceMetrics) rowsUpserted(updateType priceUpdateType, rows int64) {
+	priceRowsUpserted.
+		WithLabelValues(string(updateType), m.source, m.dest).
+		Add(float64(rows))
+}
+
+// priceRowsWritten records the job as the last writer of the prices of the update type, writes which didn't change any
+// row are ignored so that the job whose prices are current stays the leader.
+func (m *priceServiceMetrics) priceRowsWritten(updateType priceUpdateType, jobID int32, rows int64) {
+	if rows <= 0 {
+		return
+	}
+	priceLastWrite.
+		WithLabelValues(string(updateType), m.source, m.dest, strconv.FormatInt(int64(jobID), 10)).
+		Set(float64(time.Now().Unix()))
+}
+
+func (m *priceServiceMetrics) tokenPriceFailures(failedTokens int) {
+	priceTokenFailures.
+		WithLabelValues(m.source, m.dest).
+		Add(float64(failedTokens))
+}
+
+func (m *priceServiceMetrics) gasPriceSourceFailure(gasPriceSourceChainSelector uint64) {
+	gasPriceSourceFailures.
+		WithLabelValues(m.source, m.dest, strconv.FormatUint(gasPriceSourceChainSelector, 10)).
+		Inc()
+}
+
+func (m *priceServiceMetrics) sinkWriteResult(sink string, updateType priceUpdateType, err error) {
+	priceSinkWrites.
+		WithLabelValues(sink, string(updateType), m.source, m.dest, strconv.FormatBool(err == nil)).
+		Inc()
+}
+
+func (m *priceServiceMetrics) writesSuppressed(updateType priceUpdateType, prices int) {
+	priceWritesSuppressed.
+		WithLabelValues(string(updateType), m.source, m.dest).
+		Add(float64(prices))
+}
+
+func (m *priceServiceMetrics) pricesOutOfBounds(updateType priceUpdateType, prices int) {
+	pricesOutOfBounds.
+		WithLabelValues(string(updateType), m.source, m.dest).
+		Add(float64(prices))
+}
+
+func (m *priceServiceMetrics) gasPricesDampened(prices int) {
+	gasPricesDampened.
+		WithLabelValues(m.source, m.dest).
+		Add(float64(prices))
+}
+
+func (m *priceServiceMetrics) invalidSignedPrices(prices int) {
+	priceInvalidSignatures.
+		WithLabelValues(m.source, m.dest).
+		Add(float64(prices))
+}
+
+func (m *priceServiceMetrics) nativePriceDiverged(rejected bool) {
+	nativePricesDiverged.
+		WithLabelValues(m.source, m.dest, strconv.FormatBool(rejected)).
+		Inc()
+}
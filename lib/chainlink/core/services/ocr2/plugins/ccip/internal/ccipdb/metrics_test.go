@@ -0,0 +1,45 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PriceServiceUpdateResults(t *testing.T) {
+	t.Parallel()
+	metrics := newPriceServiceMetrics(1000, 2000)
+
+	metrics.updateResult(gasPriceUpdate, nil)
+	metrics.updateResult(gasPriceUpdate, nil)
+	metrics.updateResult(gasPriceUpdate, errors.New("failed"))
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(priceUpdatesResult.WithLabelValues("gas", "1000", "2000", "true")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(priceUpdatesResult.WithLabelValues("gas", "1000", "2000", "false")))
+	assert.InDelta(t, float64(time.Now().Unix()), testutil.ToFloat64(priceLastSuccessfulUpdate.WithLabelValues("gas", "1000", "2000")), 5)
+	assert.Equal(t, float64(0), testutil.ToFloat64(priceLastSuccessfulUpdate.WithLabelValues("token", "1000", "2000")))
+}
+
+func Test_PriceServiceRowsUpserted(t *testing.T) {
+	t.Parallel()
+	metrics := newPriceServiceMetrics(3000, 4000)
+
+	metrics.rowsUpserted(tokenPriceUpdate, 5)
+	metrics.rowsUpserted(tokenPriceUpdate, 3)
+	assert.Equal(t, float64(8), testutil.ToFloat64(priceRowsUpserted.WithLabelValues("token", "3000", "4000")))
+}
+
+func Test_PriceServicePriceRowsWritten(t *testing.T) {
+	t.Parallel()
+	metrics := newPriceServiceMetrics(5000, 6000)
+
+	metrics.priceRowsWritten(gasPriceUpdate, 7, 0)
+	assert.Equal(t, float64(0), testutil.ToFloat64(priceLastWrite.WithLabelValues("gas", "5000", "6000", "7")))
+
+	metrics.priceRowsWritten(gasPriceUpdate, 7, 2)
+	assert.InDelta(t, float64(time.Now().Unix()), testutil.ToFloat64(priceLastWrite.WithLabelValues("gas", "5000", "6000", "7")), 5)
+	assert.Equal(t, float64(0), testutil.ToFloat64(priceLastWrite.WithLabelValues("token", "5000", "6000", "7")))
+}
@@ -10,8 +10,12 @@ import (
 
 	context "context"
 
+	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
+
 	mock "github.com/stretchr/testify/mock"
 
+	pricegetter "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
+
 	prices "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 )
 
@@ -73,6 +77,62 @@ func (_c *PriceService_Close_Call) RunAndReturn(run func() error) *PriceService_
 	return _c
 }
 
+// GetDiagnostics provides a mock function with given fields: ctx
+func (_m *PriceService) GetDiagnostics(ctx context.Context) (db.PriceDiagnostics, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDiagnostics")
+	}
+
+	var r0 db.PriceDiagnostics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (db.PriceDiagnostics, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) db.PriceDiagnostics); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(db.PriceDiagnostics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceService_GetDiagnostics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiagnostics'
+type PriceService_GetDiagnostics_Call struct {
+	*mock.Call
+}
+
+// GetDiagnostics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PriceService_Expecter) GetDiagnostics(ctx interface{}) *PriceService_GetDiagnostics_Call {
+	return &PriceService_GetDiagnostics_Call{Call: _e.mock.On("GetDiagnostics", ctx)}
+}
+
+func (_c *PriceService_GetDiagnostics_Call) Run(run func(ctx context.Context)) *PriceService_GetDiagnostics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PriceService_GetDiagnostics_Call) Return(_a0 db.PriceDiagnostics, _a1 error) *PriceService_GetDiagnostics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_GetDiagnostics_Call) RunAndReturn(run func(context.Context) (db.PriceDiagnostics, error)) *PriceService_GetDiagnostics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGasAndTokenPrices provides a mock function with given fields: ctx, destChainSelector
 func (_m *PriceService) GetGasAndTokenPrices(ctx context.Context, destChainSelector uint64) (map[uint64]*big.Int, map[ccip.Address]*big.Int, error) {
 	ret := _m.Called(ctx, destChainSelector)
@@ -141,6 +201,501 @@ func (_c *PriceService_GetGasAndTokenPrices_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetGasAndTokenPricesWithTimestamps provides a mock function with given fields: ctx, destChainSelector
+func (_m *PriceService) GetGasAndTokenPricesWithTimestamps(ctx context.Context, destChainSelector uint64) (map[uint64]db.TimestampedPrice, map[ccip.Address]db.TimestampedPrice, error) {
+	ret := _m.Called(ctx, destChainSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGasAndTokenPricesWithTimestamps")
+	}
+
+	var r0 map[uint64]db.TimestampedPrice
+	var r1 map[ccip.Address]db.TimestampedPrice
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (map[uint64]db.TimestampedPrice, map[ccip.Address]db.TimestampedPrice, error)); ok {
+		return rf(ctx, destChainSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) map[uint64]db.TimestampedPrice); ok {
+		r0 = rf(ctx, destChainSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64]db.TimestampedPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) map[ccip.Address]db.TimestampedPrice); ok {
+		r1 = rf(ctx, destChainSelector)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[ccip.Address]db.TimestampedPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64) error); ok {
+		r2 = rf(ctx, destChainSelector)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PriceService_GetGasAndTokenPricesWithTimestamps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGasAndTokenPricesWithTimestamps'
+type PriceService_GetGasAndTokenPricesWithTimestamps_Call struct {
+	*mock.Call
+}
+
+// GetGasAndTokenPricesWithTimestamps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+func (_e *PriceService_Expecter) GetGasAndTokenPricesWithTimestamps(ctx interface{}, destChainSelector interface{}) *PriceService_GetGasAndTokenPricesWithTimestamps_Call {
+	return &PriceService_GetGasAndTokenPricesWithTimestamps_Call{Call: _e.mock.On("GetGasAndTokenPricesWithTimestamps", ctx, destChainSelector)}
+}
+
+func (_c *PriceService_GetGasAndTokenPricesWithTimestamps_Call) Run(run func(ctx context.Context, destChainSelector uint64)) *PriceService_GetGasAndTokenPricesWithTimestamps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *PriceService_GetGasAndTokenPricesWithTimestamps_Call) Return(_a0 map[uint64]db.TimestampedPrice, _a1 map[ccip.Address]db.TimestampedPrice, _a2 error) *PriceService_GetGasAndTokenPricesWithTimestamps_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *PriceService_GetGasAndTokenPricesWithTimestamps_Call) RunAndReturn(run func(context.Context, uint64) (map[uint64]db.TimestampedPrice, map[ccip.Address]db.TimestampedPrice, error)) *PriceService_GetGasAndTokenPricesWithTimestamps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceSnapshot provides a mock function with given fields: ctx
+func (_m *PriceService) GetPriceSnapshot(ctx context.Context) (db.PriceSnapshot, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceSnapshot")
+	}
+
+	var r0 db.PriceSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (db.PriceSnapshot, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) db.PriceSnapshot); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(db.PriceSnapshot)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceService_GetPriceSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceSnapshot'
+type PriceService_GetPriceSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetPriceSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PriceService_Expecter) GetPriceSnapshot(ctx interface{}) *PriceService_GetPriceSnapshot_Call {
+	return &PriceService_GetPriceSnapshot_Call{Call: _e.mock.On("GetPriceSnapshot", ctx)}
+}
+
+func (_c *PriceService_GetPriceSnapshot_Call) Run(run func(ctx context.Context)) *PriceService_GetPriceSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PriceService_GetPriceSnapshot_Call) Return(_a0 db.PriceSnapshot, _a1 error) *PriceService_GetPriceSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_GetPriceSnapshot_Call) RunAndReturn(run func(context.Context) (db.PriceSnapshot, error)) *PriceService_GetPriceSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokenMetadata provides a mock function with given fields: ctx
+func (_m *PriceService) GetTokenMetadata(ctx context.Context) (map[ccip.Address]db.TokenMetadata, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenMetadata")
+	}
+
+	var r0 map[ccip.Address]db.TokenMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[ccip.Address]db.TokenMetadata, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[ccip.Address]db.TokenMetadata); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccip.Address]db.TokenMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceService_GetTokenMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenMetadata'
+type PriceService_GetTokenMetadata_Call struct {
+	*mock.Call
+}
+
+// GetTokenMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PriceService_Expecter) GetTokenMetadata(ctx interface{}) *PriceService_GetTokenMetadata_Call {
+	return &PriceService_GetTokenMetadata_Call{Call: _e.mock.On("GetTokenMetadata", ctx)}
+}
+
+func (_c *PriceService_GetTokenMetadata_Call) Run(run func(ctx context.Context)) *PriceService_GetTokenMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PriceService_GetTokenMetadata_Call) Return(_a0 map[ccip.Address]db.TokenMetadata, _a1 error) *PriceService_GetTokenMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_GetTokenMetadata_Call) RunAndReturn(run func(context.Context) (map[ccip.Address]db.TokenMetadata, error)) *PriceService_GetTokenMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HealthReport provides a mock function with no fields
+func (_m *PriceService) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HealthReport")
+	}
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// PriceService_HealthReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HealthReport'
+type PriceService_HealthReport_Call struct {
+	*mock.Call
+}
+
+// HealthReport is a helper method to define mock.On call
+func (_e *PriceService_Expecter) HealthReport() *PriceService_HealthReport_Call {
+	return &PriceService_HealthReport_Call{Call: _e.mock.On("HealthReport")}
+}
+
+func (_c *PriceService_HealthReport_Call) Run(run func()) *PriceService_HealthReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceService_HealthReport_Call) Return(_a0 map[string]error) *PriceService_HealthReport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceService_HealthReport_Call) RunAndReturn(run func() map[string]error) *PriceService_HealthReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function with no fields
+func (_m *PriceService) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PriceService_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type PriceService_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *PriceService_Expecter) Name() *PriceService_Name_Call {
+	return &PriceService_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *PriceService_Name_Call) Run(run func()) *PriceService_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceService_Name_Call) Return(_a0 string) *PriceService_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceService_Name_Call) RunAndReturn(run func() string) *PriceService_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ObserveOnly provides a mock function with given fields: ctx
+func (_m *PriceService) ObserveOnly(ctx context.Context) (db.ObservedPrices, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ObserveOnly")
+	}
+
+	var r0 db.ObservedPrices
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (db.ObservedPrices, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) db.ObservedPrices); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(db.ObservedPrices)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceService_ObserveOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObserveOnly'
+type PriceService_ObserveOnly_Call struct {
+	*mock.Call
+}
+
+// ObserveOnly is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PriceService_Expecter) ObserveOnly(ctx interface{}) *PriceService_ObserveOnly_Call {
+	return &PriceService_ObserveOnly_Call{Call: _e.mock.On("ObserveOnly", ctx)}
+}
+
+func (_c *PriceService_ObserveOnly_Call) Run(run func(ctx context.Context)) *PriceService_ObserveOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PriceService_ObserveOnly_Call) Return(_a0 db.ObservedPrices, _a1 error) *PriceService_ObserveOnly_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_ObserveOnly_Call) RunAndReturn(run func(context.Context) (db.ObservedPrices, error)) *PriceService_ObserveOnly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pause provides a mock function with no fields
+func (_m *PriceService) Pause() {
+	_m.Called()
+}
+
+// PriceService_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
+type PriceService_Pause_Call struct {
+	*mock.Call
+}
+
+// Pause is a helper method to define mock.On call
+func (_e *PriceService_Expecter) Pause() *PriceService_Pause_Call {
+	return &PriceService_Pause_Call{Call: _e.mock.On("Pause")}
+}
+
+func (_c *PriceService_Pause_Call) Run(run func()) *PriceService_Pause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceService_Pause_Call) Return() *PriceService_Pause_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceService_Pause_Call) RunAndReturn(run func()) *PriceService_Pause_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Ready provides a mock function with no fields
+func (_m *PriceService) Ready() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ready")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceService_Ready_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ready'
+type PriceService_Ready_Call struct {
+	*mock.Call
+}
+
+// Ready is a helper method to define mock.On call
+func (_e *PriceService_Expecter) Ready() *PriceService_Ready_Call {
+	return &PriceService_Ready_Call{Call: _e.mock.On("Ready")}
+}
+
+func (_c *PriceService_Ready_Call) Run(run func()) *PriceService_Ready_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceService_Ready_Call) Return(_a0 error) *PriceService_Ready_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceService_Ready_Call) RunAndReturn(run func() error) *PriceService_Ready_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function with no fields
+func (_m *PriceService) Resume() {
+	_m.Called()
+}
+
+// PriceService_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type PriceService_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+func (_e *PriceService_Expecter) Resume() *PriceService_Resume_Call {
+	return &PriceService_Resume_Call{Call: _e.mock.On("Resume")}
+}
+
+func (_c *PriceService_Resume_Call) Run(run func()) *PriceService_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceService_Resume_Call) Return() *PriceService_Resume_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceService_Resume_Call) RunAndReturn(run func()) *PriceService_Resume_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SimulatePriceUpdate provides a mock function with given fields: ctx
+func (_m *PriceService) SimulatePriceUpdate(ctx context.Context) (db.PriceUpdateSimulation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulatePriceUpdate")
+	}
+
+	var r0 db.PriceUpdateSimulation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (db.PriceUpdateSimulation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) db.PriceUpdateSimulation); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(db.PriceUpdateSimulation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceService_SimulatePriceUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulatePriceUpdate'
+type PriceService_SimulatePriceUpdate_Call struct {
+	*mock.Call
+}
+
+// SimulatePriceUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PriceService_Expecter) SimulatePriceUpdate(ctx interface{}) *PriceService_SimulatePriceUpdate_Call {
+	return &PriceService_SimulatePriceUpdate_Call{Call: _e.mock.On("SimulatePriceUpdate", ctx)}
+}
+
+func (_c *PriceService_SimulatePriceUpdate_Call) Run(run func(ctx context.Context)) *PriceService_SimulatePriceUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PriceService_SimulatePriceUpdate_Call) Return(_a0 db.PriceUpdateSimulation, _a1 error) *PriceService_SimulatePriceUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_SimulatePriceUpdate_Call) RunAndReturn(run func(context.Context) (db.PriceUpdateSimulation, error)) *PriceService_SimulatePriceUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *PriceService) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -187,6 +742,65 @@ func (_c *PriceService_Start_Call) RunAndReturn(run func(context.Context) error)
 	return _c
 }
 
+// SubscribePriceUpdates provides a mock function with no fields
+func (_m *PriceService) SubscribePriceUpdates() (<-chan db.PriceUpdateEvent, func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePriceUpdates")
+	}
+
+	var r0 <-chan db.PriceUpdateEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan db.PriceUpdateEvent, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan db.PriceUpdateEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan db.PriceUpdateEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// PriceService_SubscribePriceUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribePriceUpdates'
+type PriceService_SubscribePriceUpdates_Call struct {
+	*mock.Call
+}
+
+// SubscribePriceUpdates is a helper method to define mock.On call
+func (_e *PriceService_Expecter) SubscribePriceUpdates() *PriceService_SubscribePriceUpdates_Call {
+	return &PriceService_SubscribePriceUpdates_Call{Call: _e.mock.On("SubscribePriceUpdates")}
+}
+
+func (_c *PriceService_SubscribePriceUpdates_Call) Run(run func()) *PriceService_SubscribePriceUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceService_SubscribePriceUpdates_Call) Return(_a0 <-chan db.PriceUpdateEvent, _a1 func()) *PriceService_SubscribePriceUpdates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_SubscribePriceUpdates_Call) RunAndReturn(run func() (<-chan db.PriceUpdateEvent, func())) *PriceService_SubscribePriceUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDynamicConfig provides a mock function with given fields: ctx, gasPriceEstimator, destPriceRegistryReader
 func (_m *PriceService) UpdateDynamicConfig(ctx context.Context, gasPriceEstimator prices.GasPriceEstimatorCommit, destPriceRegistryReader ccipdata.PriceRegistryReader) error {
 	ret := _m.Called(ctx, gasPriceEstimator, destPriceRegistryReader)
@@ -235,6 +849,53 @@ func (_c *PriceService_UpdateDynamicConfig_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// UpdatePriceGetter provides a mock function with given fields: ctx, priceGetter
+func (_m *PriceService) UpdatePriceGetter(ctx context.Context, priceGetter pricegetter.AllTokensPriceGetter) error {
+	ret := _m.Called(ctx, priceGetter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePriceGetter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pricegetter.AllTokensPriceGetter) error); ok {
+		r0 = rf(ctx, priceGetter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceService_UpdatePriceGetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePriceGetter'
+type PriceService_UpdatePriceGetter_Call struct {
+	*mock.Call
+}
+
+// UpdatePriceGetter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - priceGetter pricegetter.AllTokensPriceGetter
+func (_e *PriceService_Expecter) UpdatePriceGetter(ctx interface{}, priceGetter interface{}) *PriceService_UpdatePriceGetter_Call {
+	return &PriceService_UpdatePriceGetter_Call{Call: _e.mock.On("UpdatePriceGetter", ctx, priceGetter)}
+}
+
+func (_c *PriceService_UpdatePriceGetter_Call) Run(run func(ctx context.Context, priceGetter pricegetter.AllTokensPriceGetter)) *PriceService_UpdatePriceGetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pricegetter.AllTokensPriceGetter))
+	})
+	return _c
+}
+
+func (_c *PriceService_UpdatePriceGetter_Call) Return(_a0 error) *PriceService_UpdatePriceGetter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceService_UpdatePriceGetter_Call) RunAndReturn(run func(context.Context, pricegetter.AllTokensPriceGetter) error) *PriceService_UpdatePriceGetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPriceService creates a new instance of PriceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPriceService(t interface {
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"math/big"
+
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+)
+
+// PriceBounds are the inclusive absolute bounds of the prices written into the DB, a nil bound is not checked.
+// They guard against unit scaling bugs, e.g. a 1e36 price where 1e18 was expected, reaching the fee calculation.
+type PriceBounds struct {
+	Min *big.Int
+	Max *big.Int
+}
+
+func (b *PriceBounds) contains(price *big.Int) bool {
+	if b == nil {
+		return true
+	}
+	if b.Min != nil && price.Cmp(b.Min) < 0 {
+		return false
+	}
+	if b.Max != nil && price.Cmp(b.Max) > 0 {
+		return false
+	}
+	return true
+}
+
+// filterGasPricesWithinBounds drops the gas prices outside of gasPriceBounds. The execution component of gas prices
+// with a known breakdown is checked, as the encoded gas price of data availability chains is not a price by itself.
+func (p *priceService) filterGasPricesWithinBounds(gasPrices []cciporm.GasPrice) []cciporm.GasPrice {
+	if p.gasPriceBounds == nil {
+		return gasPrices
+	}
+	withinBounds := make([]cciporm.GasPrice, 0, len(gasPrices))
+	for _, gasPrice := range gasPrices {
+		price := gasPrice.GasPrice
+		if gasPrice.ExecGasPrice != nil {
+			price = gasPrice.ExecGasPrice
+		}
+		if !p.gasPriceBounds.contains(price.ToInt()) {
+			p.lggr.Errorw("Rejecting gas price out of bounds", "sourceChainSelector", gasPrice.SourceChainSelector,
+				"gasPrice", price, "min", p.gasPriceBounds.Min, "max", p.gasPriceBounds.Max)
+			continue
+		}
+		withinBounds = append(withinBounds, gasPrice)
+	}
+	p.metrics.pricesOutOfBounds(gasPriceUpdate, len(gasPrices)-len(withinBounds))
+	return withinBounds
+}
+
+// filterTokenPricesWithinBounds drops the token prices outside of tokenPriceBounds.
+func (p *priceService) filterTokenPricesWithinBounds(tokenPrices []cciporm.TokenPrice) []cciporm.TokenPrice {
+	if p.tokenPriceBounds == nil {
+		return tokenPrices
+	}
+	withinBounds := make([]cciporm.TokenPrice, 0, len(tokenPrices))
+	for _, tokenPrice := range tokenPrices {
+		if !p.tokenPriceBounds.contains(tokenPrice.TokenPrice.ToInt()) {
+			p.lggr.Errorw("Rejecting token price out of bounds", "token", tokenPrice.TokenAddr,
+				"tokenPrice", tokenPrice.TokenPrice, "min", p.tokenPriceBounds.Min, "max", p.tokenPriceBounds.Max)
+			continue
+		}
+		withinBounds = append(withinBounds, tokenPrice)
+	}
+	p.metrics.pricesOutOfBounds(tokenPriceUpdate, len(tokenPrices)-len(withinBounds))
+	return withinBounds
+}
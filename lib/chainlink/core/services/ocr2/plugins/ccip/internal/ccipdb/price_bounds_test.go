@@ -0,0 +1,89 @@
+package db
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+)
+
+func TestPriceService_writePricesWithinBounds(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(42345)
+	sourceChainSelector := uint64(47890)
+	otherSourceChainSelector := uint64(47891)
+
+	newPriceService := func(t *testing.T, mockOrm *ccipmocks.ORM) *priceService {
+		return NewPriceService(
+			logger.TestLogger(t),
+			mockOrm,
+			1,
+			destChainSelector,
+			sourceChainSelector,
+			"",
+			nil,
+			nil,
+			PriceServiceOptions{
+				GasPriceBounds:   &PriceBounds{Max: big.NewInt(1e18)},
+				TokenPriceBounds: &PriceBounds{Min: big.NewInt(1e9), Max: new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))},
+			},
+		).(*priceService)
+	}
+
+	t.Run("gas prices", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("UpsertGasPricesForDestChain", ctx, destChainSelector, []cciporm.GasPrice{
+			{SourceChainSelector: sourceChainSelector, GasPrice: assets.NewWei(big.NewInt(1e18)), ExecGasPrice: assets.NewWei(big.NewInt(1e18)), DAGasPrice: assets.NewWeiI(0), JobID: 1},
+		}).Return(int64(1), nil).Once()
+
+		priceService := newPriceService(t, mockOrm)
+		written, err := priceService.writeGasPricesToDB(ctx, map[uint64]*big.Int{
+			sourceChainSelector:      big.NewInt(1e18),
+			otherSourceChainSelector: new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e9)),
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[uint64]*big.Int{sourceChainSelector: big.NewInt(1e18)}, written)
+		assert.Equal(t, float64(1), testutil.ToFloat64(pricesOutOfBounds.WithLabelValues("gas", "47890", "42345")))
+	})
+
+	t.Run("token prices", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("UpsertTokenPricesForDestChain", ctx, destChainSelector, []cciporm.TokenPrice{
+			{TokenAddr: "0x2002", TokenPrice: assets.NewWei(big.NewInt(2e18)), JobID: 1},
+		}, tokenPriceUpdateInterval).Return(int64(1), nil).Once()
+
+		priceService := newPriceService(t, mockOrm)
+		written, err := priceService.writeTokenPricesToDB(ctx, map[cciptypes.Address]*big.Int{
+			"0x2001": big.NewInt(1),
+			"0x2002": big.NewInt(2e18),
+			"0x2003": new(big.Int).Mul(big.NewInt(2e18), big.NewInt(1e18)),
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[cciptypes.Address]*big.Int{"0x2002": big.NewInt(2e18)}, written)
+		assert.Equal(t, float64(2), testutil.ToFloat64(pricesOutOfBounds.WithLabelValues("token", "47890", "42345")))
+	})
+}
+
+func TestPriceBounds_contains(t *testing.T) {
+	var noBounds *PriceBounds
+	assert.True(t, noBounds.contains(big.NewInt(1)))
+
+	bounds := &PriceBounds{Min: big.NewInt(10), Max: big.NewInt(20)}
+	assert.False(t, bounds.contains(big.NewInt(9)))
+	assert.True(t, bounds.contains(big.NewInt(10)))
+	assert.True(t, bounds.contains(big.NewInt(20)))
+	assert.False(t, bounds.contains(big.NewInt(21)))
+
+	assert.True(t, (&PriceBounds{Min: big.NewInt(10)}).contains(big.NewInt(1e18)))
+	assert.True(t, (&PriceBounds{Max: big.NewInt(10)}).contains(big.NewInt(0)))
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
+)
+
+// defaultGasPriceDampeningWindow is the trailing period of written gas prices the observed gas prices are compared to.
+const defaultGasPriceDampeningWindow = 1 * time.Hour
+
+// GasPriceDampening caps and floors the observed gas prices to multiples of the median of the gas prices written within
+// the trailing window, so that a single RPC returning an absurd gas price cannot inflate the fees across the whole lane.
+// The execution and the data availability components are dampened separately, gas prices are kept as observed when
+// no gas prices were written within the window.
+type GasPriceDampening struct {
+	// Window is the trailing period the median is taken over, defaults to 1 hour.
+	Window time.Duration
+	// MaxMultiplier caps the gas price to the given multiple of the median, e.g. 3, no cap when zero.
+	MaxMultiplier float64
+	// MinMultiplier floors the gas price to the given fraction of the median, e.g. 0.5, no floor when zero.
+	MinMultiplier float64
+}
+
+func (d *GasPriceDampening) window() time.Duration {
+	if d.Window <= 0 {
+		return defaultGasPriceDampeningWindow
+	}
+	return d.Window
+}
+
+// dampen returns the price capped and floored relative to the median, and whether it was changed.
+func (d *GasPriceDampening) dampen(price, median *big.Int) (*big.Int, bool) {
+	if median == nil || median.Sign() == 0 {
+		return price, false
+	}
+	if d.MaxMultiplier > 0 {
+		if upper := multiplyBps(median, d.MaxMultiplier); price.Cmp(upper) > 0 {
+			return upper, true
+		}
+	}
+	if d.MinMultiplier > 0 {
+		if lower := multiplyBps(median, d.MinMultiplier); price.Cmp(lower) < 0 {
+			return lower, true
+		}
+	}
+	return price, false
+}
+
+// multiplyBps multiplies the value with the multiplier at a basis points precision.
+func multiplyBps(value *big.Int, multiplier float64) *big.Int {
+	res := new(big.Int).Mul(value, big.NewInt(int64(math.Round(multiplier*10_000))))
+	return res.Div(res, big.NewInt(10_000))
+}
+
+// dampenGasPriceSpikes dampens the observed gas prices in place, the EIP-1559 fees of a dampened execution gas price are
+// scaled along with it. The native gas prices are kept as returned by the gas price estimators. Gas prices whose history
+// cannot be read are kept as observed.
+func (p *priceService) dampenGasPriceSpikes(
+	ctx context.Context,
+	sourceGasPricesUSD map[uint64]*big.Int,
+	observedGasPrices map[uint64]observedGasPrice,
+) {
+	if p.gasPriceDampening == nil {
+		return
+	}
+	now := time.Now()
+	dampened := 0
+	for sourceChainSelector, gasPriceUSD := range sourceGasPricesUSD {
+		if gasPriceUSD == nil {
+			continue
+		}
+		history, err := p.orm.GetGasPriceHistory(ctx, p.destChainSelector, sourceChainSelector, now.Add(-p.gasPriceDampening.window()), now)
+		if err != nil {
+			p.lggr.Warnw("Failed to read gas price history, gas price is not dampened",
+				"sourceChainSelector", sourceChainSelector, "err", err)
+			continue
+		}
+		if len(history) == 0 {
+			continue
+		}
+
+		historicalExec := make([]*big.Int, 0, len(history))
+		historicalDA := make([]*big.Int, 0, len(history))
+		for _, gasPrice := range history {
+			if gasPrice.ExecGasPrice != nil && gasPrice.DAGasPrice != nil {
+				historicalExec = append(historicalExec, gasPrice.ExecGasPrice.ToInt())
+				historicalDA = append(historicalDA, gasPrice.DAGasPrice.ToInt())
+				continue
+			}
+			execGasPrice, daGasPrice, err := prices.DecodeGasPriceComponents(gasPrice.GasPrice.ToInt())
+			if err != nil {
+				continue
+			}
+			historicalExec = append(historicalExec, execGasPrice)
+			historicalDA = append(historicalDA, daGasPrice)
+		}
+
+		execGasPrice, daGasPrice, err := prices.DecodeGasPriceComponents(gasPriceUSD)
+		if err != nil {
+			p.lggr.Warnw("Failed to decode gas price components, gas price is not dampened",
+				"sourceChainSelector", sourceChainSelector, "gasPrice", gasPriceUSD, "err", err)
+			continue
+		}
+		dampenedExec, execChanged := p.gasPriceDampening.dampen(execGasPrice, ccipcalc.BigIntSortedMiddle(historicalExec))
+		dampenedDA, daChanged := p.gasPriceDampening.dampen(daGasPrice, ccipcalc.BigIntSortedMiddle(historicalDA))
+		if !execChanged && !daChanged {
+			continue
+		}
+		dampenedGasPriceUSD, err := prices.EncodeGasPriceComponents(dampenedExec, dampenedDA)
+		if err != nil {
+			p.lggr.Warnw("Failed to encode dampened gas price, gas price is not dampened",
+				"sourceChainSelector", sourceChainSelector, "err", err)
+			continue
+		}
+
+		p.lggr.Warnw("Dampening gas price deviating from the trailing median",
+			"sourceChainSelector", sourceChainSelector, "gasPrice", gasPriceUSD, "dampenedGasPrice", dampenedGasPriceUSD,
+			"maxMultiplier", p.gasPriceDampening.MaxMultiplier, "minMultiplier", p.gasPriceDampening.MinMultiplier)
+		sourceGasPricesUSD[sourceChainSelector] = dampenedGasPriceUSD
+		if observed, ok := observedGasPrices[sourceChainSelector]; ok && observed.feesUSD != nil && execChanged && execGasPrice.Sign() > 0 {
+			observed.feesUSD = &prices.EIP1559Fees{
+				BaseFee:     scale(observed.feesUSD.BaseFee, dampenedExec, execGasPrice),
+				PriorityFee: scale(observed.feesUSD.PriorityFee, dampenedExec, execGasPrice),
+			}
+			observedGasPrices[sourceChainSelector] = observed
+		}
+		dampened++
+	}
+	p.metrics.gasPricesDampened(dampened)
+}
+
+// scale returns value * numerator / denominator.
+func scale(value, numerator, denominator *big.Int) *big.Int {
+	if value == nil {
+		return nil
+	}
+	res := new(big.Int).Mul(value, numerator)
+	return res.Div(res, denominator)
+}
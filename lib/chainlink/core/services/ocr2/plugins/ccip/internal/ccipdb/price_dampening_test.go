@@ -0,0 +1,115 @@
+package db
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
+)
+
+func TestPriceService_dampenGasPriceSpikes(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(52345)
+	spikingSourceChainSelector := uint64(57890)
+	droppingSourceChainSelector := uint64(57891)
+	stableSourceChainSelector := uint64(57892)
+	newSourceChainSelector := uint64(57893)
+	failingSourceChainSelector := uint64(57894)
+
+	history := []cciporm.GasPrice{
+		{GasPrice: assets.NewWeiI(90), ExecGasPrice: assets.NewWeiI(90), DAGasPrice: assets.NewWeiI(0)},
+		{GasPrice: assets.NewWeiI(100)},
+		{GasPrice: assets.NewWeiI(1000), ExecGasPrice: assets.NewWeiI(1000), DAGasPrice: assets.NewWeiI(0)},
+	}
+	mockOrm := ccipmocks.NewORM(t)
+	for _, sourceChainSelector := range []uint64{spikingSourceChainSelector, droppingSourceChainSelector, stableSourceChainSelector} {
+		mockOrm.On("GetGasPriceHistory", ctx, destChainSelector, sourceChainSelector, mock.Anything, mock.Anything).Return(history, nil).Once()
+	}
+	mockOrm.On("GetGasPriceHistory", ctx, destChainSelector, newSourceChainSelector, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	mockOrm.On("GetGasPriceHistory", ctx, destChainSelector, failingSourceChainSelector, mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		1,
+		destChainSelector,
+		spikingSourceChainSelector,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{GasPriceDampening: &GasPriceDampening{MaxMultiplier: 3, MinMultiplier: 0.5}},
+	).(*priceService)
+
+	gasPricesUSD := map[uint64]*big.Int{
+		spikingSourceChainSelector:  big.NewInt(10_000),
+		droppingSourceChainSelector: big.NewInt(10),
+		stableSourceChainSelector:   big.NewInt(120),
+		newSourceChainSelector:      big.NewInt(10_000),
+		failingSourceChainSelector:  big.NewInt(10_000),
+	}
+	observedGasPrices := map[uint64]observedGasPrice{
+		spikingSourceChainSelector: {
+			gasPriceWei: big.NewInt(5000),
+			feesUSD:     &prices.EIP1559Fees{BaseFee: big.NewInt(9000), PriorityFee: big.NewInt(1000)},
+		},
+	}
+	priceService.dampenGasPriceSpikes(ctx, gasPricesUSD, observedGasPrices)
+
+	// The median of the history is 100, gas prices are capped to 300 and floored to 50
+	assert.Equal(t, map[uint64]*big.Int{
+		spikingSourceChainSelector:  big.NewInt(300),
+		droppingSourceChainSelector: big.NewInt(50),
+		stableSourceChainSelector:   big.NewInt(120),
+		newSourceChainSelector:      big.NewInt(10_000),
+		failingSourceChainSelector:  big.NewInt(10_000),
+	}, gasPricesUSD)
+	assert.Equal(t, observedGasPrice{
+		gasPriceWei: big.NewInt(5000),
+		feesUSD:     &prices.EIP1559Fees{BaseFee: big.NewInt(270), PriorityFee: big.NewInt(30)},
+	}, observedGasPrices[spikingSourceChainSelector])
+	assert.Equal(t, float64(2), testutil.ToFloat64(gasPricesDampened.WithLabelValues("57890", "52345")))
+}
+
+func TestGasPriceDampening_dampen(t *testing.T) {
+	dampening := &GasPriceDampening{MaxMultiplier: 3}
+	assert.Equal(t, defaultGasPriceDampeningWindow, dampening.window())
+
+	price, changed := dampening.dampen(big.NewInt(1), big.NewInt(100))
+	assert.False(t, changed)
+	assert.Equal(t, big.NewInt(1), price)
+
+	price, changed = dampening.dampen(big.NewInt(301), big.NewInt(100))
+	assert.True(t, changed)
+	assert.Equal(t, big.NewInt(300), price)
+
+	// Without a median the price is kept
+	price, changed = dampening.dampen(big.NewInt(301), nil)
+	assert.False(t, changed)
+	assert.Equal(t, big.NewInt(301), price)
+
+	t.Run("data availability component", func(t *testing.T) {
+		gasPrice, err := prices.EncodeGasPriceComponents(big.NewInt(100), big.NewInt(1000))
+		require.NoError(t, err)
+		execGasPrice, daGasPrice, err := prices.DecodeGasPriceComponents(gasPrice)
+		require.NoError(t, err)
+
+		dampenedDA, changed := dampening.dampen(daGasPrice, big.NewInt(200))
+		assert.True(t, changed)
+		assert.Equal(t, big.NewInt(600), dampenedDA)
+		dampenedExec, changed := dampening.dampen(execGasPrice, big.NewInt(200))
+		assert.False(t, changed)
+		assert.Equal(t, big.NewInt(100), dampenedExec)
+	})
+}
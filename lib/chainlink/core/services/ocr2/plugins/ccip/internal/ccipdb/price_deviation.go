@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+)
+
+// Prices which did not deviate are still written once per heartbeat by default, well within the stale price retention.
+const defaultWriteHeartbeat = 1 * time.Hour
+
+// storedPrice is a price as last written into the DB.
+type storedPrice struct {
+	value     *assets.Wei
+	updatedAt time.Time
+}
+
+// filterDeviatedGasPrices drops the gas prices which deviate by at most writeDeviationBps from the prices stored in the DB
+// and were written within writeHeartbeat. All gas prices are kept when write suppression is disabled or the stored prices
+// cannot be read.
+func (p *priceService) filterDeviatedGasPrices(ctx context.Context, gasPrices []cciporm.GasPrice) []cciporm.GasPrice {
+	if p.writeDeviationBps == 0 {
+		return gasPrices
+	}
+	dbGasPrices, err := p.orm.GetGasPricesByDestChain(ctx, p.destChainSelector)
+	if err != nil {
+		p.lggr.Warnw("Failed to read stored gas prices, writing all gas prices", "err", err)
+		return gasPrices
+	}
+	stored := make(map[uint64]storedPrice, len(dbGasPrices))
+	for _, gasPrice := range dbGasPrices {
+		stored[gasPrice.SourceChainSelector] = storedPrice{value: gasPrice.GasPrice, updatedAt: gasPrice.UpdatedAt}
+	}
+
+	deviated := make([]cciporm.GasPrice, 0, len(gasPrices))
+	for _, gasPrice := range gasPrices {
+		if p.mustWritePrice(stored[gasPrice.SourceChainSelector], gasPrice.GasPrice) {
+			deviated = append(deviated, gasPrice)
+		}
+	}
+	p.metrics.writesSuppressed(gasPriceUpdate, len(gasPrices)-len(deviated))
+	return deviated
+}
+
+// filterDeviatedTokenPrices is the token price counterpart of filterDeviatedGasPrices.
+func (p *priceService) filterDeviatedTokenPrices(ctx context.Context, tokenPrices []cciporm.TokenPrice) []cciporm.TokenPrice {
+	if p.writeDeviationBps == 0 {
+		return tokenPrices
+	}
+	dbTokenPrices, err := p.orm.GetTokenPricesByDestChain(ctx, p.destChainSelector)
+	if err != nil {
+		p.lggr.Warnw("Failed to read stored token prices, writing all token prices", "err", err)
+		return tokenPrices
+	}
+	stored := make(map[string]storedPrice, len(dbTokenPrices))
+	for _, tokenPrice := range dbTokenPrices {
+		stored[tokenPrice.TokenAddr] = storedPrice{value: tokenPrice.TokenPrice, updatedAt: tokenPrice.UpdatedAt}
+	}
+
+	deviated := make([]cciporm.TokenPrice, 0, len(tokenPrices))
+	for _, tokenPrice := range tokenPrices {
+		if p.mustWritePrice(stored[tokenPrice.TokenAddr], tokenPrice.TokenPrice) {
+			deviated = append(deviated, tokenPrice)
+		}
+	}
+	p.metrics.writesSuppressed(tokenPriceUpdate, len(tokenPrices)-len(deviated))
+	return deviated
+}
+
+// mustWritePrice reports whether the price is missing from the DB, was stored longer than writeHeartbeat ago or
+// deviates by more than writeDeviationBps from the stored price.
+func (p *priceService) mustWritePrice(stored storedPrice, price *assets.Wei) bool {
+	if stored.value == nil || time.Since(stored.updatedAt) >= p.writeHeartbeat {
+		return true
+	}
+	return deviatesMoreThanBps(stored.value.ToInt(), price.ToInt(), p.writeDeviationBps)
+}
+
+// deviatesMoreThanBps reports whether price deviates by more than bps basis points from base.
+func deviatesMoreThanBps(base, price *big.Int, bps uint32) bool {
+	if base.Sign() == 0 {
+		return price.Sign() != 0
+	}
+	diff := new(big.Int).Sub(price, base)
+	diff.Abs(diff).Mul(diff, big.NewInt(10_000))
+	threshold := new(big.Int).Mul(new(big.Int).Abs(base), big.NewInt(int64(bps)))
+	return diff.Cmp(threshold) > 0
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+)
+
+func TestPriceService_writeDeviatedPrices(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(32345)
+	sourceChainSelector := uint64(37890)
+	otherSourceChainSelector := uint64(37891)
+
+	newPriceService := func(t *testing.T, mockOrm *ccipmocks.ORM) *priceService {
+		return NewPriceService(
+			logger.TestLogger(t),
+			mockOrm,
+			1,
+			destChainSelector,
+			sourceChainSelector,
+			"",
+			nil,
+			nil,
+			PriceServiceOptions{WriteDeviationBps: 50, WriteHeartbeat: time.Hour},
+		).(*priceService)
+	}
+
+	t.Run("gas prices", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return([]cciporm.GasPrice{
+			{SourceChainSelector: sourceChainSelector, GasPrice: assets.NewWeiI(1000), UpdatedAt: time.Now()},
+			{SourceChainSelector: otherSourceChainSelector, GasPrice: assets.NewWeiI(1000), UpdatedAt: time.Now()},
+		}, nil).Once()
+		mockOrm.On("UpsertGasPricesForDestChain", ctx, destChainSelector, []cciporm.GasPrice{
+			{SourceChainSelector: otherSourceChainSelector, GasPrice: assets.NewWeiI(1006), ExecGasPrice: assets.NewWeiI(1006), DAGasPrice: assets.NewWeiI(0), JobID: 1},
+		}).Return(int64(1), nil).Once()
+
+		priceService := newPriceService(t, mockOrm)
+		written, err := priceService.writeGasPricesToDB(ctx, map[uint64]*big.Int{
+			sourceChainSelector:      big.NewInt(1005),
+			otherSourceChainSelector: big.NewInt(1006),
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[uint64]*big.Int{otherSourceChainSelector: big.NewInt(1006)}, written)
+		assert.Equal(t, float64(1), testutil.ToFloat64(priceWritesSuppressed.WithLabelValues("gas", "37890", "32345")))
+	})
+
+	t.Run("token prices", func(t *testing.T) {
+		stablecoin := cciptypes.Address("0x1001")
+		staleStablecoin := cciptypes.Address("0x1002")
+		volatileToken := cciptypes.Address("0x1003")
+		newToken := cciptypes.Address("0x1004")
+
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return([]cciporm.TokenPrice{
+			{TokenAddr: string(stablecoin), TokenPrice: assets.NewWei(big.NewInt(1e18)), UpdatedAt: time.Now().Add(-time.Minute)},
+			{TokenAddr: string(staleStablecoin), TokenPrice: assets.NewWei(big.NewInt(1e18)), UpdatedAt: time.Now().Add(-2 * time.Hour)},
+			{TokenAddr: string(volatileToken), TokenPrice: assets.NewWei(big.NewInt(2e18)), UpdatedAt: time.Now()},
+		}, nil).Once()
+		mockOrm.On("UpsertTokenPricesForDestChain", ctx, destChainSelector, []cciporm.TokenPrice{
+			{TokenAddr: string(staleStablecoin), TokenPrice: assets.NewWei(big.NewInt(1e18)), JobID: 1},
+			{TokenAddr: string(volatileToken), TokenPrice: assets.NewWei(big.NewInt(2_200_000_000_000_000_000)), JobID: 1},
+			{TokenAddr: string(newToken), TokenPrice: assets.NewWei(big.NewInt(5e18)), JobID: 1},
+		}, tokenPriceUpdateInterval).Return(int64(3), nil).Once()
+
+		priceService := newPriceService(t, mockOrm)
+		written, err := priceService.writeTokenPricesToDB(ctx, map[cciptypes.Address]*big.Int{
+			stablecoin:      big.NewInt(1.001e18),
+			staleStablecoin: big.NewInt(1e18),
+			volatileToken:   big.NewInt(2_200_000_000_000_000_000),
+			newToken:        big.NewInt(5e18),
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[cciptypes.Address]*big.Int{
+			staleStablecoin: big.NewInt(1e18),
+			volatileToken:   big.NewInt(2_200_000_000_000_000_000),
+			newToken:        big.NewInt(5e18),
+		}, written)
+		assert.Equal(t, float64(1), testutil.ToFloat64(priceWritesSuppressed.WithLabelValues("token", "37890", "32345")))
+	})
+
+	t.Run("all prices are written when stored prices cannot be read", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return(nil, errors.New("db error")).Once()
+		mockOrm.On("UpsertGasPricesForDestChain", ctx, destChainSelector, []cciporm.GasPrice{
+			{SourceChainSelector: sourceChainSelector, GasPrice: assets.NewWeiI(1005), ExecGasPrice: assets.NewWeiI(1005), DAGasPrice: assets.NewWeiI(0), JobID: 1},
+		}).Return(int64(1), nil).Once()
+
+		priceService := newPriceService(t, mockOrm)
+		_, err := priceService.writeGasPricesToDB(ctx, map[uint64]*big.Int{sourceChainSelector: big.NewInt(1005)}, nil)
+		require.NoError(t, err)
+	})
+}
+
+func Test_deviatesMoreThanBps(t *testing.T) {
+	testCases := []struct {
+		name     string
+		base     int64
+		price    int64
+		bps      uint32
+		expected bool
+	}{
+		{name: "unchanged", base: 10000, price: 10000, bps: 10, expected: false},
+		{name: "increase at threshold", base: 10000, price: 10010, bps: 10, expected: false},
+		{name: "increase above threshold", base: 10000, price: 10011, bps: 10, expected: true},
+		{name: "decrease above threshold", base: 10000, price: 9989, bps: 10, expected: true},
+		{name: "zero base and price", base: 0, price: 0, bps: 10, expected: false},
+		{name: "zero base", base: 0, price: 1, bps: 10, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, deviatesMoreThanBps(big.NewInt(tc.base), big.NewInt(tc.price), tc.bps))
+		})
+	}
+}
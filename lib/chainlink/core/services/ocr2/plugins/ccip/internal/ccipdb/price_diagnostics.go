@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+// PriceDiagnostics describes which tokens the latest token price observation expected, priced and missed.
+type PriceDiagnostics struct {
+	// LastObservationAt is the time of the latest token price observation, zero if there was none yet.
+	LastObservationAt time.Time
+	// LastObservationErr is the error of the latest token price observation, empty if it succeeded.
+	LastObservationErr string
+	// ExpectedTokens are the dest tokens returned by the job spec price getter.
+	ExpectedTokens []cciptypes.Address
+	// PricedTokens are the dest tokens priced by the latest observation.
+	PricedTokens []cciptypes.Address
+	// MissingTokens are the expected tokens which were not priced, along with the reason.
+	MissingTokens map[cciptypes.Address]string
+	// LastWrites is the time every token price of the dest chain was last written into the DB.
+	LastWrites map[cciptypes.Address]time.Time
+}
+
+// tokenObservation is the outcome of a token price observation.
+type tokenObservation struct {
+	observedAt     time.Time
+	expectedTokens []cciptypes.Address
+	pricedTokens   []cciptypes.Address
+	missingTokens  map[cciptypes.Address]string
+	err            error
+}
+
+// recordTokenObservation stores the outcome of the latest token price observation for GetDiagnostics.
+func (p *priceService) recordTokenObservation(
+	observedAt time.Time,
+	expectedTokens []cciptypes.Address,
+	filteredTokens []cciptypes.Address,
+	failedTokens map[cciptypes.Address]error,
+	tokenPricesUSD map[cciptypes.Address]*big.Int,
+	err error,
+) {
+	observation := tokenObservation{
+		observedAt:     observedAt,
+		expectedTokens: sortedTokens(expectedTokens),
+		pricedTokens:   make([]cciptypes.Address, 0, len(tokenPricesUSD)),
+		missingTokens:  make(map[cciptypes.Address]string),
+		err:            err,
+	}
+	for token := range tokenPricesUSD {
+		observation.pricedTokens = append(observation.pricedTokens, token)
+	}
+	observation.pricedTokens = sortedTokens(observation.pricedTokens)
+
+	for _, token := range expectedTokens {
+		if _, ok := tokenPricesUSD[token]; ok {
+			continue
+		}
+		switch {
+		case slices.Contains(filteredTokens, token):
+			observation.missingTokens[token] = "filtered out by the token allowlist or denylist"
+		case failedTokens[token] != nil:
+			observation.missingTokens[token] = failedTokens[token].Error()
+		case err != nil:
+			observation.missingTokens[token] = err.Error()
+		default:
+			observation.missingTokens[token] = "not priced"
+		}
+	}
+
+	p.lastTokenObservationMu.Lock()
+	defer p.lastTokenObservationMu.Unlock()
+	p.lastTokenObservation = observation
+}
+
+func (p *priceService) GetDiagnostics(ctx context.Context) (PriceDiagnostics, error) {
+	p.lastTokenObservationMu.RLock()
+	observation := p.lastTokenObservation
+	p.lastTokenObservationMu.RUnlock()
+
+	diagnostics := PriceDiagnostics{
+		LastObservationAt: observation.observedAt,
+		ExpectedTokens:    observation.expectedTokens,
+		PricedTokens:      observation.pricedTokens,
+		MissingTokens:     observation.missingTokens,
+	}
+	if observation.err != nil {
+		diagnostics.LastObservationErr = observation.err.Error()
+	}
+
+	// Read the DB directly, diagnostics must not be hidden by the prices cache
+	_, tokenPrices, err := p.getGasAndTokenPricesFromDB(ctx, p.destChainSelector)
+	if err != nil {
+		return PriceDiagnostics{}, fmt.Errorf("get token prices from DB: %w", err)
+	}
+	diagnostics.LastWrites = make(map[cciptypes.Address]time.Time, len(tokenPrices))
+	for token, price := range tokenPrices {
+		diagnostics.LastWrites[token] = price.UpdatedAt
+	}
+	return diagnostics, nil
+}
+
+func sortedTokens(tokens []cciptypes.Address) []cciptypes.Address {
+	sorted := slices.Clone(tokens)
+	slices.Sort(sorted)
+	return sorted
+}
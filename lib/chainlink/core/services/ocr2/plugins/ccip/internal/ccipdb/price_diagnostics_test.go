@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
+)
+
+func TestPriceService_GetDiagnostics(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.TestLogger(t)
+	destChain := chainselectors.TEST_1338
+	sourceChain := chainselectors.TEST_1000
+
+	sourceNative := ccipcommon.TokenID{TokenAddress: "0x0001", ChainSelector: sourceChain.Selector}
+	pricedToken := ccipcommon.TokenID{TokenAddress: "0x0002", ChainSelector: destChain.Selector}
+	nilPriceToken := ccipcommon.TokenID{TokenAddress: "0x0003", ChainSelector: destChain.Selector}
+	deniedToken := ccipcommon.TokenID{TokenAddress: "0x0004", ChainSelector: destChain.Selector}
+	lastWrite := time.Now().Add(-time.Minute).UTC()
+
+	newPriceService := func(t *testing.T, decimalsErr error) *priceService {
+		priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(map[ccipcommon.TokenID]*big.Int{
+			sourceNative:  val1e18(2),
+			pricedToken:   val1e18(3),
+			nilPriceToken: nil,
+			deniedToken:   val1e18(5),
+		}, nil)
+
+		offRampReader := ccipdatamocks.NewOffRampReader(t)
+		offRampReader.EXPECT().GetTokens(mock.Anything).Return(cciptypes.OffRampTokens{}, nil).Maybe()
+
+		destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+		destPriceReg.EXPECT().GetFeeTokens(mock.Anything).Return(nil, nil).Maybe()
+		destPriceReg.EXPECT().GetTokensDecimals(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, tokens []cciptypes.Address) ([]uint8, error) {
+				if decimalsErr != nil {
+					return nil, decimalsErr
+				}
+				return []uint8{18}, nil
+			})
+
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("GetGasPricesByDestChain", mock.Anything, destChain.Selector).Return(nil, nil).Maybe()
+		mockOrm.On("GetTokenPricesByDestChain", mock.Anything, destChain.Selector).Return([]cciporm.TokenPrice{
+			{TokenAddr: string(pricedToken.TokenAddress), TokenPrice: assets.NewWei(val1e18(3)), UpdatedAt: lastWrite},
+		}, nil).Maybe()
+
+		priceService := NewPriceService(
+			lggr,
+			mockOrm,
+			1,
+			destChain.Selector,
+			sourceChain.Selector,
+			sourceNative.TokenAddress,
+			priceGetter,
+			offRampReader,
+			PriceServiceOptions{
+				AllowPartialTokenPriceUpdates: true,
+				TokenDenylist:                 []cciptypes.Address{deniedToken.TokenAddress},
+			},
+		).(*priceService)
+		priceService.destPriceRegistryReader = destPriceReg
+		return priceService
+	}
+
+	t.Run("no observation yet", func(t *testing.T) {
+		diagnostics, err := newPriceService(t, nil).GetDiagnostics(ctx)
+		require.NoError(t, err)
+		assert.True(t, diagnostics.LastObservationAt.IsZero())
+		assert.Equal(t, map[cciptypes.Address]time.Time{pricedToken.TokenAddress: lastWrite}, diagnostics.LastWrites)
+	})
+
+	t.Run("partial observation", func(t *testing.T) {
+		priceService := newPriceService(t, nil)
+		_, _, err := priceService.observeTokenPriceUpdates(ctx, lggr)
+		require.NoError(t, err)
+
+		diagnostics, err := priceService.GetDiagnostics(ctx)
+		require.NoError(t, err)
+		assert.False(t, diagnostics.LastObservationAt.IsZero())
+		assert.Empty(t, diagnostics.LastObservationErr)
+		assert.Equal(t, []cciptypes.Address{pricedToken.TokenAddress, nilPriceToken.TokenAddress, deniedToken.TokenAddress}, diagnostics.ExpectedTokens)
+		assert.Equal(t, []cciptypes.Address{pricedToken.TokenAddress}, diagnostics.PricedTokens)
+		assert.Equal(t, map[cciptypes.Address]string{
+			nilPriceToken.TokenAddress: "token price is nil",
+			deniedToken.TokenAddress:   "filtered out by the token allowlist or denylist",
+		}, diagnostics.MissingTokens)
+		assert.Equal(t, map[cciptypes.Address]time.Time{pricedToken.TokenAddress: lastWrite}, diagnostics.LastWrites)
+	})
+
+	t.Run("failed observation", func(t *testing.T) {
+		priceService := newPriceService(t, errors.New("rpc error"))
+		_, _, err := priceService.observeTokenPriceUpdates(ctx, lggr)
+		require.Error(t, err)
+
+		diagnostics, err := priceService.GetDiagnostics(ctx)
+		require.NoError(t, err)
+		assert.NotEmpty(t, diagnostics.LastObservationErr)
+		assert.Empty(t, diagnostics.PricedTokens)
+		assert.Contains(t, diagnostics.MissingTokens[pricedToken.TokenAddress], "rpc error")
+	})
+}
@@ -0,0 +1,145 @@
+package db
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+// priceUpdateEventsBufferSize is the capacity of every subscription channel, events are dropped for subscribers
+// which fall behind by more than that.
+const priceUpdateEventsBufferSize = 100
+
+// PriceUpdateEvent is emitted every time the PriceService writes a gas or token price into the DB.
+type PriceUpdateEvent struct {
+	SourceChainSelector uint64
+	DestChainSelector   uint64
+	// IsGasPrice is true for source chain gas price updates, false for token price updates.
+	IsGasPrice bool
+	// Token is the address of the updated dest chain token, empty for gas price updates.
+	Token cciptypes.Address
+	// OldValue is the previous value written by this PriceService, nil if there is none.
+	OldValue  *big.Int
+	NewValue  *big.Int
+	Timestamp time.Time
+}
+
+// priceUpdateEvents keeps track of the last written prices and broadcasts PriceUpdateEvents to the subscribers.
+type priceUpdateEvents struct {
+	mu              sync.Mutex
+	closed          bool
+	nextID          int
+	subscribers     map[int]chan PriceUpdateEvent
+	lastGasPrices   map[uint64]*big.Int
+	lastTokenPrices map[cciptypes.Address]*big.Int
+}
+
+func newPriceUpdateEvents() *priceUpdateEvents {
+	return &priceUpdateEvents{
+		subscribers:     make(map[int]chan PriceUpdateEvent),
+		lastGasPrices:   make(map[uint64]*big.Int),
+		lastTokenPrices: make(map[cciptypes.Address]*big.Int),
+	}
+}
+
+// subscribe returns a channel of price update events and a function to unsubscribe, which closes the channel.
+func (e *priceUpdateEvents) subscribe() (<-chan PriceUpdateEvent, func()) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ch := make(chan PriceUpdateEvent, priceUpdateEventsBufferSize)
+	if e.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	id := e.nextID
+	e.nextID++
+	e.subscribers[id] = ch
+
+	return ch, func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		if sub, ok := e.subscribers[id]; ok {
+			delete(e.subscribers, id)
+			close(sub)
+		}
+	}
+}
+
+// close closes all the subscription channels, no events are published afterwards.
+func (e *priceUpdateEvents) close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.closed = true
+	for id, sub := range e.subscribers {
+		delete(e.subscribers, id)
+		close(sub)
+	}
+}
+
+// gasPriceWritten publishes a gas price update event and returns the number of dropped events.
+func (e *priceUpdateEvents) gasPriceWritten(sourceChainSelector, destChainSelector uint64, gasPrice *big.Int) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	event := PriceUpdateEvent{
+		SourceChainSelector: sourceChainSelector,
+		DestChainSelector:   destChainSelector,
+		IsGasPrice:          true,
+		OldValue:            e.lastGasPrices[sourceChainSelector],
+		NewValue:            new(big.Int).Set(gasPrice),
+		Timestamp:           time.Now(),
+	}
+	e.lastGasPrices[sourceChainSelector] = event.NewValue
+	return e.publish(event)
+}
+
+// tokenPricesWritten publishes a token price update event per token and returns the number of dropped events.
+func (e *priceUpdateEvents) tokenPricesWritten(sourceChainSelector, destChainSelector uint64, tokenPrices map[cciptypes.Address]*big.Int) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	tokens := make([]cciptypes.Address, 0, len(tokenPrices))
+	for token := range tokenPrices {
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i] < tokens[j] })
+
+	now := time.Now()
+	dropped := 0
+	for _, token := range tokens {
+		event := PriceUpdateEvent{
+			SourceChainSelector: sourceChainSelector,
+			DestChainSelector:   destChainSelector,
+			Token:               token,
+			OldValue:            e.lastTokenPrices[token],
+			NewValue:            new(big.Int).Set(tokenPrices[token]),
+			Timestamp:           now,
+		}
+		e.lastTokenPrices[token] = event.NewValue
+		dropped += e.publish(event)
+	}
+	return dropped
+}
+
+// publish sends the event to all subscribers without blocking, it must be called with mu held.
+func (e *priceUpdateEvents) publish(event PriceUpdateEvent) int {
+	if e.closed {
+		return 0
+	}
+
+	dropped := 0
+	for _, sub := range e.subscribers {
+		select {
+		case sub <- event:
+		default:
+			dropped++
+		}
+	}
+	return dropped
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+func TestPriceUpdateEvents(t *testing.T) {
+	events := newPriceUpdateEvents()
+	ch, unsubscribe := events.subscribe()
+
+	assert.Equal(t, 0, events.gasPriceWritten(1, 2, big.NewInt(10)))
+	assert.Equal(t, 0, events.gasPriceWritten(1, 2, big.NewInt(20)))
+	assert.Equal(t, 0, events.tokenPricesWritten(1, 2, map[cciptypes.Address]*big.Int{
+		"0x2": big.NewInt(200),
+		"0x1": big.NewInt(100),
+	}))
+	assert.Equal(t, 0, events.tokenPricesWritten(1, 2, map[cciptypes.Address]*big.Int{"0x1": big.NewInt(150)}))
+
+	expected := []struct {
+		isGasPrice bool
+		token      cciptypes.Address
+		oldValue   *big.Int
+		newValue   *big.Int
+	}{
+		{isGasPrice: true, newValue: big.NewInt(10)},
+		{isGasPrice: true, oldValue: big.NewInt(10), newValue: big.NewInt(20)},
+		{token: "0x1", newValue: big.NewInt(100)},
+		{token: "0x2", newValue: big.NewInt(200)},
+		{token: "0x1", oldValue: big.NewInt(100), newValue: big.NewInt(150)},
+	}
+	for _, exp := range expected {
+		event := <-ch
+		assert.Equal(t, uint64(1), event.SourceChainSelector)
+		assert.Equal(t, uint64(2), event.DestChainSelector)
+		assert.Equal(t, exp.isGasPrice, event.IsGasPrice)
+		assert.Equal(t, exp.token, event.Token)
+		assert.Equal(t, exp.oldValue, event.OldValue)
+		assert.Equal(t, exp.newValue, event.NewValue)
+		assert.False(t, event.Timestamp.IsZero())
+	}
+
+	unsubscribe()
+	_, open := <-ch
+	assert.False(t, open)
+	// unsubscribing twice is a no-op
+	unsubscribe()
+}
+
+func TestPriceUpdateEvents_slowSubscriber(t *testing.T) {
+	events := newPriceUpdateEvents()
+	_, unsubscribe := events.subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < priceUpdateEventsBufferSize; i++ {
+		require.Equal(t, 0, events.gasPriceWritten(1, 2, big.NewInt(int64(i))))
+	}
+	assert.Equal(t, 1, events.gasPriceWritten(1, 2, big.NewInt(1)))
+}
+
+func TestPriceUpdateEvents_close(t *testing.T) {
+	events := newPriceUpdateEvents()
+	ch, unsubscribe := events.subscribe()
+
+	events.close()
+	_, open := <-ch
+	assert.False(t, open)
+	unsubscribe()
+
+	assert.Equal(t, 0, events.gasPriceWritten(1, 2, big.NewInt(10)))
+	lateCh, _ := events.subscribe()
+	_, open = <-lateCh
+	assert.False(t, open)
+}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+// PriceLeader reports which jobs wrote the current gas and token prices of a dest chain. All lanes of a dest chain
+// write the prices they observe, the leader is the job which wrote the most recently updated price.
+type PriceLeader struct {
+	DestChainSelector uint64 `json:"destChainSelector,string"`
+	// LeaderJobID is the job which wrote the most recently updated price, zero when there are no prices or when the
+	// writer of the price is unknown.
+	LeaderJobID int32 `json:"leaderJobID"`
+	// Writers are the jobs which wrote the current prices, the most recent writer first.
+	Writers []PriceWriter `json:"writers"`
+	ReadAt  time.Time     `json:"readAt"`
+}
+
+// PriceWriter is a job which wrote some of the current prices of a dest chain.
+type PriceWriter struct {
+	// JobID is zero for the prices whose writer is unknown, e.g. written before the writers were recorded.
+	JobID int32 `json:"jobID"`
+	// SourceChainSelector is the source chain of the lane of the job, zero when the job doesn't run on this node.
+	SourceChainSelector uint64 `json:"sourceChainSelector,string"`
+	// GasPrices are the source chain selectors of the gas prices written by the job.
+	GasPrices   []uint64            `json:"gasPrices"`
+	TokenPrices []cciptypes.Address `json:"tokenPrices"`
+	LastWriteAt time.Time           `json:"lastWriteAt"`
+}
+
+// priceLeader reads the writers of the prices of the dest chain from the DB directly, so that the leader is not hidden
+// by the prices cache. sourceChainSelectors maps the jobs running on this node to the source chain of their lane.
+func (p *priceService) priceLeader(ctx context.Context, sourceChainSelectors map[int32]uint64) (PriceLeader, error) {
+	readAt := time.Now().UTC()
+	gasPrices, err := p.orm.GetGasPricesByDestChain(ctx, p.destChainSelector)
+	if err != nil {
+		return PriceLeader{}, fmt.Errorf("get gas prices of dest chain %d from DB: %w", p.destChainSelector, err)
+	}
+	tokenPrices, err := p.orm.GetTokenPricesByDestChain(ctx, p.destChainSelector)
+	if err != nil {
+		return PriceLeader{}, fmt.Errorf("get token prices of dest chain %d from DB: %w", p.destChainSelector, err)
+	}
+
+	writers := make(map[int32]*PriceWriter)
+	writerOf := func(jobID int32, updatedAt time.Time) *PriceWriter {
+		writer, ok := writers[jobID]
+		if !ok {
+			writer = &PriceWriter{
+				JobID:               jobID,
+				SourceChainSelector: sourceChainSelectors[jobID],
+				GasPrices:           []uint64{},
+				TokenPrices:         []cciptypes.Address{},
+			}
+			writers[jobID] = writer
+		}
+		if updatedAt.After(writer.LastWriteAt) {
+			writer.LastWriteAt = updatedAt
+		}
+		return writer
+	}
+	for _, gasPrice := range gasPrices {
+		writer := writerOf(gasPrice.JobID, gasPrice.UpdatedAt)
+		writer.GasPrices = append(writer.GasPrices, gasPrice.SourceChainSelector)
+	}
+	for _, tokenPrice := range tokenPrices {
+		writer := writerOf(tokenPrice.JobID, tokenPrice.UpdatedAt)
+		writer.TokenPrices = append(writer.TokenPrices, cciptypes.Address(tokenPrice.TokenAddr))
+	}
+
+	leader := PriceLeader{
+		DestChainSelector: p.destChainSelector,
+		Writers:           make([]PriceWriter, 0, len(writers)),
+		ReadAt:            readAt,
+	}
+	for _, writer := range writers {
+		slices.Sort(writer.GasPrices)
+		slices.Sort(writer.TokenPrices)
+		leader.Writers = append(leader.Writers, *writer)
+	}
+	// Ties are broken by job ID, so that the leader is deterministic
+	slices.SortFunc(leader.Writers, func(a, b PriceWriter) int {
+		if c := b.LastWriteAt.Compare(a.LastWriteAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.JobID, b.JobID)
+	})
+	if len(leader.Writers) > 0 {
+		leader.LeaderJobID = leader.Writers[0].JobID
+	}
+	return leader, nil
+}
+
+// GetPriceLeaders returns the PriceLeader of every dest chain fed by the registered PriceService instances, ordered by
+// dest chain selector. Every dest chain is read once, no matter how many of its lanes run on this node.
+func (r *PriceServiceRegistry) GetPriceLeaders(ctx context.Context) ([]PriceLeader, error) {
+	services := r.runningServices()
+	servicesByDestChain := make(map[uint64][]*priceService)
+	sourceChainSelectors := make(map[int32]uint64, len(services))
+	for _, p := range services {
+		servicesByDestChain[p.destChainSelector] = append(servicesByDestChain[p.destChainSelector], p)
+		sourceChainSelectors[p.jobId] = p.sourceChainSelector
+	}
+
+	destChainSelectors := make([]uint64, 0, len(servicesByDestChain))
+	for destChainSelector := range servicesByDestChain {
+		destChainSelectors = append(destChainSelectors, destChainSelector)
+	}
+	slices.Sort(destChainSelectors)
+
+	leaders := make([]PriceLeader, 0, len(destChainSelectors))
+	for _, destChainSelector := range destChainSelectors {
+		services := servicesByDestChain[destChainSelector]
+		slices.SortFunc(services, func(a, b *priceService) int { return cmp.Compare(a.jobId, b.jobId) })
+
+		leader, err := services[0].priceLeader(ctx, sourceChainSelectors)
+		if err != nil {
+			return nil, err
+		}
+		leaders = append(leaders, leader)
+	}
+	return leaders, nil
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+)
+
+func TestPriceServiceRegistry_GetPriceLeaders(t *testing.T) {
+	ctx := tests.Context(t)
+	registry := NewPriceServiceRegistry()
+	destChainA := uint64(9_000_101)
+	destChainB := uint64(9_000_102)
+	sourceChainA1 := uint64(67890)
+	sourceChainA2 := uint64(67891)
+	earlier := time.Now().Add(-2 * time.Minute).UTC()
+	later := time.Now().Add(-time.Minute).UTC()
+
+	newPriceService := func(jobID int32, destChainSelector, sourceChainSelector uint64, mockOrm *ccipmocks.ORM) *priceService {
+		p := NewPriceService(logger.TestLogger(t), mockOrm, jobID, destChainSelector, sourceChainSelector, "", nil, nil, PriceServiceOptions{}).(*priceService)
+		registry.register(p)
+		return p
+	}
+
+	price := assets.NewWei(big.NewInt(1e18))
+	ormA := ccipmocks.NewORM(t)
+	ormA.On("GetGasPricesByDestChain", ctx, destChainA).Return([]cciporm.GasPrice{
+		{SourceChainSelector: sourceChainA1, GasPrice: price, UpdatedAt: earlier, JobID: 21},
+		{SourceChainSelector: sourceChainA2, GasPrice: price, UpdatedAt: later, JobID: 22},
+	}, nil).Once()
+	ormA.On("GetTokenPricesByDestChain", ctx, destChainA).Return([]cciporm.TokenPrice{
+		{TokenAddr: "0x0002", TokenPrice: price, UpdatedAt: earlier, JobID: 21},
+		{TokenAddr: "0x0001", TokenPrice: price, UpdatedAt: earlier, JobID: 21},
+		{TokenAddr: "0x0003", TokenPrice: price, UpdatedAt: earlier, JobID: 99},
+	}, nil).Once()
+	ormB := ccipmocks.NewORM(t)
+	ormB.On("GetGasPricesByDestChain", ctx, destChainB).Return(nil, nil).Once()
+	ormB.On("GetTokenPricesByDestChain", ctx, destChainB).Return(nil, nil).Once()
+
+	// Two lanes of dest chain A run on this node, only the one with the lowest job ID reads the prices
+	newPriceService(22, destChainA, sourceChainA2, ccipmocks.NewORM(t))
+	newPriceService(21, destChainA, sourceChainA1, ormA)
+	newPriceService(23, destChainB, sourceChainA1, ormB)
+
+	leaders, err := registry.GetPriceLeaders(ctx)
+	require.NoError(t, err)
+	require.Len(t, leaders, 2)
+	leadersByDestChain := make(map[uint64]PriceLeader)
+	for _, leader := range leaders {
+		leadersByDestChain[leader.DestChainSelector] = leader
+	}
+
+	leaderA := leadersByDestChain[destChainA]
+	assert.Equal(t, int32(22), leaderA.LeaderJobID)
+	assert.Equal(t, []PriceWriter{
+		{JobID: 22, SourceChainSelector: sourceChainA2, GasPrices: []uint64{sourceChainA2}, TokenPrices: []cciptypes.Address{}, LastWriteAt: later},
+		{JobID: 21, SourceChainSelector: sourceChainA1, GasPrices: []uint64{sourceChainA1}, TokenPrices: []cciptypes.Address{"0x0001", "0x0002"}, LastWriteAt: earlier},
+		// The job doesn't run on this node, its lane is unknown
+		{JobID: 99, GasPrices: []uint64{}, TokenPrices: []cciptypes.Address{"0x0003"}, LastWriteAt: earlier},
+	}, leaderA.Writers)
+
+	leaderB := leadersByDestChain[destChainB]
+	assert.Zero(t, leaderB.LeaderJobID)
+	assert.Empty(t, leaderB.Writers)
+
+	encoded, err := json.Marshal(leaderA)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"destChainSelector":"9000101"`)
+	assert.Contains(t, string(encoded), `"leaderJobID":22`)
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+// NativePriceCheck cross-checks the source native price returned by the price getter against the price of a dest token
+// stored in the dest price registry, e.g. WETH on the dest chain for an Ethereum source chain, before the gas price is
+// converted to USD with it. It catches a broken price feed of the source native token, which would misprice the fees of
+// the lane while the gas price looks sane. Only the native price of the lane's source chain is checked.
+type NativePriceCheck struct {
+	// DestToken is the dest token priced like the source native token.
+	DestToken cciptypes.Address
+	// MaxDeviationBps is the deviation from the on-chain price above which the native price is considered diverged.
+	MaxDeviationBps uint32
+	// RejectOnDeviation fails the gas price update when the native price diverged, it is only reported otherwise.
+	RejectOnDeviation bool
+	// MaxOnChainPriceAge skips the check when the on-chain price is older, as it may legitimately diverge by then.
+	// The check is never skipped when zero.
+	MaxOnChainPriceAge time.Duration
+}
+
+// checkSourceNativePrice compares the source native price to the on-chain price of the dest token of the
+// nativePriceCheck, both as written into the dest price registry, i.e. per 1e18 of the smallest token unit and
+// denominated in the quote currency when set. The check is skipped when the on-chain price cannot be read, so that an
+// RPC failure does not block the gas price updates. It only returns an error for a diverged price with RejectOnDeviation.
+func (p *priceService) checkSourceNativePrice(
+	ctx context.Context,
+	rawTokenPricesUSD map[ccipcommon.TokenID]*big.Int,
+	sourceNativePriceUSD *big.Int,
+) error {
+	check := p.nativePriceCheck
+	if check == nil {
+		return nil
+	}
+	if p.destPriceRegistryReader == nil {
+		p.lggr.Debug("Skipping native price check due to destPriceRegistry not ready")
+		return nil
+	}
+
+	onChainPrices, err := p.destPriceRegistryReader.GetTokenPrices(ctx, []cciptypes.Address{check.DestToken})
+	if err != nil || len(onChainPrices) != 1 || onChainPrices[0].Value == nil || onChainPrices[0].Value.Sign() == 0 {
+		p.lggr.Warnw("Failed to read on-chain native price, native price is not checked",
+			"destToken", check.DestToken, "err", err)
+		return nil
+	}
+	onChainPrice := onChainPrices[0]
+	if check.MaxOnChainPriceAge > 0 && onChainPrice.TimestampUnixSec != nil {
+		updatedAt := time.Unix(onChainPrice.TimestampUnixSec.Int64(), 0)
+		if time.Since(updatedAt) > check.MaxOnChainPriceAge {
+			p.lggr.Debugw("Skipping native price check due to stale on-chain native price",
+				"destToken", check.DestToken, "updatedAt", updatedAt)
+			return nil
+		}
+	}
+
+	decimals, err := p.getDestTokensDecimals(ctx, []cciptypes.Address{check.DestToken})
+	if err != nil {
+		p.lggr.Warnw("Failed to get dest token decimals, native price is not checked",
+			"destToken", check.DestToken, "err", err)
+		return nil
+	}
+	usdPerQuoteUnit, err := p.getUsdPerQuoteUnit(ctx, rawTokenPricesUSD)
+	if err != nil {
+		return err
+	}
+	sourceNativePrice := calculateUsdPer1e18TokenAmount(toQuoteCurrency(sourceNativePriceUSD, usdPerQuoteUnit), decimals[0])
+
+	if !deviatesMoreThanBps(onChainPrice.Value, sourceNativePrice, check.MaxDeviationBps) {
+		return nil
+	}
+	p.metrics.nativePriceDiverged(check.RejectOnDeviation)
+	p.lggr.Errorw("Source native price diverged from the on-chain native price",
+		"sourceChainSelector", p.sourceChainSelector, "destToken", check.DestToken,
+		"sourceNativePrice", sourceNativePrice, "onChainNativePrice", onChainPrice.Value,
+		"maxDeviationBps", check.MaxDeviationBps, "rejected", check.RejectOnDeviation)
+	if check.RejectOnDeviation {
+		return fmt.Errorf("source native price %s diverged by more than %d bps from the on-chain price %s of %s",
+			sourceNativePrice, check.MaxDeviationBps, onChainPrice.Value, check.DestToken)
+	}
+	return nil
+}
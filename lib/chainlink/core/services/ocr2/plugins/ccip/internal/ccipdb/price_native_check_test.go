@@ -0,0 +1,123 @@
+package db
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+)
+
+func TestPriceService_checkSourceNativePrice(t *testing.T) {
+	destChainSelector := uint64(62345)
+	destToken := ccipcalc.HexToAddress("0xaa")
+	onChainPrice := val1e18(2000)
+
+	testCases := []struct {
+		name                 string
+		sourceChainSelector  uint64
+		sourceNativePriceUSD *big.Int
+		rejectOnDeviation    bool
+		onChainPriceAge      time.Duration
+		onChainPriceErr      error
+		expErr               bool
+		expDiverged          bool
+	}{
+		{
+			name:                 "price within deviation",
+			sourceChainSelector:  67001,
+			sourceNativePriceUSD: val1e18(2100),
+			rejectOnDeviation:    true,
+		},
+		{
+			name:                 "diverged price is reported",
+			sourceChainSelector:  67002,
+			sourceNativePriceUSD: val1e18(3000),
+			expDiverged:          true,
+		},
+		{
+			name:                 "diverged price is rejected",
+			sourceChainSelector:  67003,
+			sourceNativePriceUSD: val1e18(1000),
+			rejectOnDeviation:    true,
+			expErr:               true,
+			expDiverged:          true,
+		},
+		{
+			name:                 "stale on-chain price is not checked",
+			sourceChainSelector:  67004,
+			sourceNativePriceUSD: val1e18(3000),
+			rejectOnDeviation:    true,
+			onChainPriceAge:      2 * time.Hour,
+		},
+		{
+			name:                 "unreadable on-chain price is not checked",
+			sourceChainSelector:  67005,
+			sourceNativePriceUSD: val1e18(3000),
+			rejectOnDeviation:    true,
+			onChainPriceErr:      errors.New("rpc error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tests.Context(t)
+
+			destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+			destPriceReg.EXPECT().GetTokenPrices(mock.Anything, []cciptypes.Address{destToken}).Return([]cciptypes.TokenPriceUpdate{{
+				TokenPrice:       cciptypes.TokenPrice{Token: destToken, Value: onChainPrice},
+				TimestampUnixSec: big.NewInt(time.Now().Add(-tc.onChainPriceAge).Unix()),
+			}}, tc.onChainPriceErr)
+			destPriceReg.EXPECT().GetTokensDecimals(mock.Anything, []cciptypes.Address{destToken}).Return([]uint8{18}, nil).Maybe()
+
+			priceService := NewPriceService(
+				logger.TestLogger(t),
+				ccipmocks.NewORM(t),
+				1,
+				destChainSelector,
+				tc.sourceChainSelector,
+				"",
+				nil,
+				nil,
+				PriceServiceOptions{NativePriceCheck: &NativePriceCheck{
+					DestToken:          destToken,
+					MaxDeviationBps:    1000,
+					RejectOnDeviation:  tc.rejectOnDeviation,
+					MaxOnChainPriceAge: time.Hour,
+				}},
+			).(*priceService)
+			priceService.destPriceRegistryReader = destPriceReg
+
+			err := priceService.checkSourceNativePrice(ctx, nil, tc.sourceNativePriceUSD)
+			if tc.expErr {
+				require.ErrorContains(t, err, "diverged")
+			} else {
+				require.NoError(t, err)
+			}
+
+			diverged := testutil.ToFloat64(nativePricesDiverged.WithLabelValues(
+				strconv.FormatUint(tc.sourceChainSelector, 10),
+				strconv.FormatUint(destChainSelector, 10),
+				strconv.FormatBool(tc.rejectOnDeviation),
+			))
+			if tc.expDiverged {
+				assert.Equal(t, float64(1), diverged)
+			} else {
+				assert.Equal(t, float64(0), diverged)
+			}
+		})
+	}
+}
@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math/big"
 	"slices"
 	"sort"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/avast/retry-go/v4"
+	"github.com/patrickmn/go-cache"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -33,13 +39,98 @@ import (
 // This enables all lanes connected to a chain to feed price data to the leader lane's Commit plugin for that chain.
 type PriceService interface {
 	job.ServiceCtx
+	services.HealthReporter
 
 	// UpdateDynamicConfig updates gasPriceEstimator and destPriceRegistryReader during Commit plugin dynamic config change.
 	UpdateDynamicConfig(ctx context.Context, gasPriceEstimator prices.GasPriceEstimatorCommit, destPriceRegistryReader ccipdata.PriceRegistryReader) error
 
+	// UpdatePriceGetter replaces the price getter at runtime, e.g. after tokens or price sources were added to the job
+	// spec, and refreshes the token prices right away. The previous price getter is kept if the token prices can't be
+	// updated with the new one, so that a broken price getter config doesn't stop the price updates of the lane. While
+	// paused, the new price getter must return the job spec token prices to be installed. The discarded price getter
+	// is closed.
+	UpdatePriceGetter(ctx context.Context, priceGetter pricegetter.AllTokensPriceGetter) error
+
 	// GetGasAndTokenPrices fetches source chain gas prices and relevant token prices from all lanes that touch the given dest chain.
 	// The prices have been written into the DB by each lane's PriceService in the background. The prices are denoted in USD.
+	// Prices older than the configured max price age and token prices below the configured min confidence are left out.
 	GetGasAndTokenPrices(ctx context.Context, destChainSelector uint64) (map[uint64]*big.Int, map[cciptypes.Address]*big.Int, error)
+
+	// GetGasAndTokenPricesWithTimestamps is the same as GetGasAndTokenPrices, but every price also carries the time it was
+	// last written into the DB. It allows callers to detect and discard stale prices. Gas prices also carry their
+	// execution and data availability components, so that fee estimation can re-weigh them. Token prices also carry the
+	// confidence of the price getter in them, so that callers can prefer high confidence prices.
+	GetGasAndTokenPricesWithTimestamps(ctx context.Context, destChainSelector uint64) (map[uint64]TimestampedPrice, map[cciptypes.Address]TimestampedPrice, error)
+
+	// ObserveOnly runs the full gas and token price observation pipeline of this lane and returns the results
+	// without writing them into the DB. It allows validating the price configuration of a job spec before enabling writes.
+	ObserveOnly(ctx context.Context) (ObservedPrices, error)
+
+	// SubscribePriceUpdates returns a channel receiving an event for every gas and token price written by this PriceService,
+	// and a function to unsubscribe. Events are dropped for subscribers which do not keep up, the channel is closed
+	// on unsubscribe or when the PriceService is closed.
+	SubscribePriceUpdates() (<-chan PriceUpdateEvent, func())
+
+	// Pause suspends the background price writes and stale price cleanups without stopping the service, e.g. during
+	// maintenance windows. Reading prices and ObserveOnly keep working while paused.
+	Pause()
+
+	// Resume continues the background price writes suspended by Pause, prices are refreshed right away.
+	Resume()
+
+	// GetDiagnostics reports the outcome of the latest token price observation and when each token price was last
+	// written into the DB, to help debugging missing or wrong token prices.
+	GetDiagnostics(ctx context.Context) (PriceDiagnostics, error)
+
+	// GetTokenMetadata returns the metadata of the dest chain tokens, as written by all lanes of the dest chain.
+	// Price getters can use it to map token addresses to external price API identifiers.
+	GetTokenMetadata(ctx context.Context) (map[cciptypes.Address]TokenMetadata, error)
+
+	// GetPriceSnapshot returns the full gas and token price state of the dest chain, read from the DB bypassing the cache.
+	GetPriceSnapshot(ctx context.Context) (PriceSnapshot, error)
+
+	// SimulatePriceUpdate simulates the price update the Commit plugin would submit to the dest price registry with the
+	// current DB prices, without sending a transaction, so that reverting price updates are surfaced early.
+	SimulatePriceUpdate(ctx context.Context) (PriceUpdateSimulation, error)
+}
+
+// TimestampedPrice is a USD denominated price along with the time it was last updated in the DB.
+type TimestampedPrice struct {
+	Value     *big.Int
+	UpdatedAt time.Time
+	// GasPriceComponents is the breakdown of a gas price, nil for token prices and gas prices without a known breakdown.
+	GasPriceComponents *GasPriceComponents
+	// Confidence is the confidence in a token price, nil for gas prices and token prices without a known confidence.
+	Confidence *pricegetter.PriceConfidence
+	// GasPriceWei is the gas price denominated in the native token of the source chain, as returned by the gas price
+	// estimator, so that gas price changes can be told apart from native token price changes. It is nil for token
+	// prices and gas prices written before it was stored.
+	GasPriceWei *big.Int
+}
+
+// GasPriceComponents are the USD denominated execution and data availability fee components of an encoded gas price.
+// DAGasPrice is zero for chains without a data availability fee. BaseFee and PriorityFee make up ExecGasPrice, they are
+// nil unless the gas price estimator knows the EIP-1559 fees.
+type GasPriceComponents struct {
+	ExecGasPrice *big.Int
+	DAGasPrice   *big.Int
+	BaseFee      *big.Int
+	PriorityFee  *big.Int
+}
+
+// ObservedPrices contains the prices observed by a single run of the PriceService observation pipeline, denoted in USD.
+type ObservedPrices struct {
+	SourceGasPriceUSD *big.Int
+	// AdditionalGasPricesUSD contains the gas prices of the additional sources by source chain selector.
+	AdditionalGasPricesUSD map[uint64]*big.Int
+	TokenPricesUSD         map[cciptypes.Address]*big.Int
+	// GasPriceFeesUSD contains the EIP-1559 fees of the gas prices by source chain selector, for the gas price estimators
+	// which know them.
+	GasPriceFeesUSD map[uint64]prices.EIP1559Fees
+	// GasPricesWei contains the gas prices denominated in the native token of their source chain by source chain selector.
+	GasPricesWei map[uint64]*big.Int
+	// TokenPriceConfidence contains the confidence in the token prices, for the price getters which know it.
+	TokenPriceConfidence map[cciptypes.Address]pricegetter.PriceConfidence
 }
 
 var _ PriceService = (*priceService)(nil)
@@ -50,11 +141,117 @@ const (
 	// Token prices are refreshed every 10 minutes, we only report prices for blue chip tokens, DS&A simulation show
 	// their prices are stable, 10-minute resolution is accurate enough.
 	tokenPriceUpdateInterval = 10 * time.Minute
+	// Prices read from the DB are cached for a short period of time. Every lane calls GetGasAndTokenPrices during each
+	// Observation round, the cache prevents hitting the DB on every call while still picking up writes from other lanes quickly.
+	pricesCacheExpiration = 10 * time.Second
+	// With push-based price refresh the cache is invalidated by DB notifications, the expiration only bounds how long
+	// prices are served from the cache should a notification get lost.
+	pushRefreshPricesCacheExpiration = 5 * time.Minute
+	// A lost price updates subscription is re-established after this delay, prices are cached for the default
+	// expiration in the meantime.
+	priceUpdatesResubscribeDelay = 10 * time.Second
+	// Token metadata rarely changes, it is refreshed once a day.
+	tokenMetadataRefreshInterval = 24 * time.Hour
+	// Consistent price reads give up retrying after this many attempts, prices written every few seconds by many lanes
+	// must not starve the readers.
+	maxConsistentPriceReadAttempts = 3
+	// PriceService is reported as unhealthy once gas or token price updates fail this many times in a row.
+	maxConsecutiveUpdateFailures = 3
+	// Prices not updated for longer than the retention are deleted, so that decommissioned lanes don't leave zombie
+	// prices behind. The cleanup is cheap, running it hourly is enough.
+	defaultStalePriceRetention = 24 * time.Hour
+	stalePricesCleanupInterval = 1 * time.Hour
 )
 
+// defaultUpdateRetryConfig retries failed background price updates within the same tick, so that transient RPC or
+// price feed errors are recovered from in seconds rather than after a full update interval. The total retry time
+// (1s + 2s + 4s + 8s) is kept well below gasPriceUpdateInterval.
+var defaultUpdateRetryConfig = ccipdata.RetryConfig{
+	InitialDelay: time.Second,
+	MaxDelay:     10 * time.Second,
+	MaxRetries:   5,
+}
+
+// cachedPrices holds the result of a single GetGasAndTokenPricesWithTimestamps DB read.
+type cachedPrices struct {
+	gasPrices   map[uint64]TimestampedPrice
+	tokenPrices map[cciptypes.Address]TimestampedPrice
+}
+
 type priceService struct {
 	gasUpdateInterval   time.Duration
 	tokenUpdateInterval time.Duration
+	cleanupInterval     time.Duration
+	stalePriceRetention time.Duration
+	maxPriceAge         time.Duration
+	// minTokenPriceConfidence leaves out the token prices with a lower confidence when reading them
+	minTokenPriceConfidence float64
+	// tokenUpdateIntervals overrides tokenUpdateInterval for specific tokens
+	tokenUpdateIntervals map[cciptypes.Address]time.Duration
+	// allowPartialTokenPriceUpdates writes the successfully priced tokens instead of failing the whole update
+	allowPartialTokenPriceUpdates bool
+	// sinks receive the written prices in addition to the DB
+	sinks []PriceSink
+	// additionalGasPriceSources are observed in addition to the lane's source chain
+	additionalGasPriceSources []GasPriceSource
+	// quoteCurrency denominates the prices instead of USD when set
+	quoteCurrency *QuoteCurrency
+	// tokenAllowlist and tokenDenylist filter the dest tokens returned by the price getter
+	tokenAllowlist []cciptypes.Address
+	tokenDenylist  []cciptypes.Address
+	// dualWritePrices also writes the prices to the consolidated prices table,
+	// readConsolidatedPrices reads them from it instead of the gas and token price tables
+	dualWritePrices        bool
+	readConsolidatedPrices bool
+	// priceWriter upserts the gas and token prices, it is either the ORM or a PriceWriteBatcher shared with other lanes
+	priceWriter priceWriter
+	// pushPriceRefresh subscribes to DB price update notifications, pushRefreshActive is set while subscribed
+	pushPriceRefresh  bool
+	pushRefreshActive atomic.Bool
+	// consistentPriceReads re-reads the prices when they were written while being read
+	consistentPriceReads bool
+	// tokenMetadata is written into the DB, tokenSymbols are the symbols of all dest tokens read back from it
+	tokenMetadata  map[cciptypes.Address]TokenMetadata
+	tokenSymbols   map[cciptypes.Address]string
+	tokenSymbolsMu sync.RWMutex
+	// writeDeviationBps suppresses writes of prices deviating less from the stored prices, unless stored longer than
+	// writeHeartbeat ago
+	writeDeviationBps uint32
+	writeHeartbeat    time.Duration
+	// gasPriceBounds and tokenPriceBounds reject prices out of bounds at write time
+	gasPriceBounds   *PriceBounds
+	tokenPriceBounds *PriceBounds
+	// gasPriceDampening caps and floors the observed gas prices relative to the trailing median
+	gasPriceDampening *GasPriceDampening
+	// gasPriceEndpoints are queried along with the gas price estimator of the source chain
+	gasPriceEndpoints *GasPriceEndpoints
+	// nativePriceCheck cross-checks the source native price against the dest price registry
+	nativePriceCheck *NativePriceCheck
+	// priceVerifier verifies the signatures of the prices returned by the price getter, prices are trusted when nil
+	priceVerifier pricegetter.PriceVerifier
+	// priceUpdater sends the simulated price updates to the dest price registry
+	priceUpdater cciptypes.Address
+	// registry tracks the service while it is running, nil when it is not tracked
+	registry *PriceServiceRegistry
+
+	events *priceUpdateEvents
+	// dynamicConfigUpdated re-arms the update tickers after UpdateDynamicConfig refreshed the prices
+	dynamicConfigUpdated chan struct{}
+	// paused suspends the background writes, resumed triggers a price refresh once they continue
+	paused  atomic.Bool
+	resumed chan struct{}
+
+	// lastTokenObservation is reported by GetDiagnostics
+	lastTokenObservation   tokenObservation
+	lastTokenObservationMu sync.RWMutex
+
+	// tokenDecimals caches the decimals of the dest tokens, they never change so each token is fetched only once
+	tokenDecimals   map[cciptypes.Address]uint8
+	tokenDecimalsMu sync.RWMutex
+
+	updateRetryConfig ccipdata.RetryConfig
+	pricesCache       *cache.Cache
+	metrics           *priceServiceMetrics
 
 	lggr              logger.Logger
 	orm               cciporm.ORM
@@ -72,6 +269,116 @@ type priceService struct {
 	wg              sync.WaitGroup
 	stopChan        services.StopChan
 	dynamicConfigMu sync.RWMutex
+
+	gasUpdateHealth   updateHealth
+	tokenUpdateHealth updateHealth
+}
+
+// PriceServiceOptions contains the optional settings of the PriceService, the zero value keeps the defaults.
+type PriceServiceOptions struct {
+	// TokenUpdateIntervals overrides the default token price update interval for specific tokens.
+	TokenUpdateIntervals map[cciptypes.Address]time.Duration
+	// AllowPartialTokenPriceUpdates makes token price updates skip and report tokens which could not be priced,
+	// instead of failing the update of all tokens.
+	AllowPartialTokenPriceUpdates bool
+	// Sinks receive the written prices alongside the DB, e.g. to feed downstream analytics pipelines.
+	Sinks []PriceSink
+	// StalePriceRetention overrides how long prices of the dest chain are kept without being updated, defaults to 24 hours.
+	StalePriceRetention time.Duration
+	// MaxPriceAge makes GetGasAndTokenPrices ignore prices which were not updated within that period, disabled when zero.
+	MaxPriceAge time.Duration
+	// MinTokenPriceConfidence makes GetGasAndTokenPrices ignore token prices with a lower confidence score, so that the
+	// Commit plugin observes only high confidence prices. Prices without a known confidence are never ignored, disabled
+	// when zero.
+	MinTokenPriceConfidence float64
+	// AdditionalGasPriceSources enables the multi-source mode, the gas prices of these source chains are observed and
+	// written alongside the gas price of the lane's source chain, so that a single job can feed all lanes of a dest chain.
+	// The price getter must return the prices of their native tokens.
+	AdditionalGasPriceSources []GasPriceSource
+	// QuoteCurrency denominates all observed gas and token prices in the given asset instead of USD, USD when nil.
+	// Gas and token prices are always converted together, as the fees computed from them must use the same currency.
+	QuoteCurrency *QuoteCurrency
+	// TokenAllowlist restricts the observed dest tokens to the listed ones, all tokens are observed when empty.
+	TokenAllowlist []cciptypes.Address
+	// TokenDenylist excludes the listed dest tokens from the observed token prices.
+	TokenDenylist []cciptypes.Address
+	// DualWritePrices writes the prices to the consolidated prices table in addition to the gas and token price tables.
+	DualWritePrices bool
+	// ReadConsolidatedPrices reads the prices from the consolidated prices table instead of the gas and token price tables.
+	ReadConsolidatedPrices bool
+	// WriteBatcher writes the gas and token prices in batches with the prices of the other PriceService instances
+	// sharing it, prices are written directly through the ORM when nil.
+	WriteBatcher *PriceWriteBatcher
+	// PushPriceRefresh invalidates the cached prices of the dest chain when the DB notifies about price writes,
+	// instead of re-reading the prices every few seconds. It falls back to polling while the notifications are unavailable.
+	PushPriceRefresh bool
+	// ConsistentPriceReads re-reads the gas and token prices when prices were written in between reading them,
+	// at the cost of an additional query per read.
+	ConsistentPriceReads bool
+	// TokenMetadata is written into the DB on start and refreshed daily, so that logs can show token symbols and
+	// price getters can look up external API identifiers. Token decimals are fetched from the dest price registry.
+	TokenMetadata map[cciptypes.Address]TokenMetadata
+	// WriteDeviationBps skips writing gas and token prices which deviate by at most the given basis points from the
+	// prices stored in the DB, e.g. to avoid rewriting stablecoin prices every update. All prices are written when zero.
+	WriteDeviationBps uint32
+	// WriteHeartbeat forces writing prices which did not deviate once they are stored for that long, defaults to 1 hour.
+	// It must be shorter than MaxPriceAge, so that stable prices are not ignored as too old.
+	WriteHeartbeat time.Duration
+	// GasPriceBounds and TokenPriceBounds reject gas and token prices out of bounds instead of writing them, the bounds
+	// apply to the prices as written, i.e. denominated in the QuoteCurrency when set. No bounds are checked when nil.
+	GasPriceBounds   *PriceBounds
+	TokenPriceBounds *PriceBounds
+	// GasPriceDampening caps and floors the observed gas prices relative to the median of the gas prices written within
+	// a trailing window, before they are written, published and sent to the sinks. Gas prices are not dampened when nil.
+	GasPriceDampening *GasPriceDampening
+	// GasPriceEndpoints queries the execution gas price of the lane's source chain from additional endpoints, e.g. RPCs
+	// of other providers, along with the gas price estimator, see prices.MultiEndpointGasPriceEstimator.
+	// Only the gas price estimator is queried when nil.
+	GasPriceEndpoints *GasPriceEndpoints
+	// NativePriceCheck cross-checks the source native price returned by the price getter against the price of a dest
+	// token in the dest price registry before converting the gas price to USD, reporting or rejecting diverged prices.
+	// The native price is not checked when nil.
+	NativePriceCheck *NativePriceCheck
+	// PriceVerifier verifies the signed payloads of all prices before using them, the price getter must implement
+	// pricegetter.SignedPriceGetter. Prices with an invalid signature are rejected, prices are trusted when nil.
+	PriceVerifier pricegetter.PriceVerifier
+	// PriceUpdater is the address the Commit plugin price updates are sent from, i.e. the commit store of the lane.
+	// SimulatePriceUpdate fails when it is empty.
+	PriceUpdater cciptypes.Address
+	// Registry tracks the PriceService while it is running, so that the node reports its prices. It is not tracked
+	// when nil.
+	Registry *PriceServiceRegistry
+}
+
+// priceWriter is implemented by both the ORM and the PriceWriteBatcher.
+type priceWriter interface {
+	UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []cciporm.GasPrice) (int64, error)
+	UpsertTokenPricesForDestChain(ctx context.Context, destChainSelector uint64, tokenPrices []cciporm.TokenPrice, interval time.Duration) (int64, error)
+}
+
+// QuoteCurrency is the asset the PriceService denominates prices in, instead of USD.
+type QuoteCurrency struct {
+	// Symbol identifies the quote currency in logs, e.g. "EUR" or "ETH".
+	Symbol string
+	// Token is the token whose USD price, as returned by the price getter, is the price of one quote currency unit.
+	Token ccipcommon.TokenID
+}
+
+// GasPriceEndpoints are the additional endpoints the execution gas price of the source chain is queried from, the
+// median of all responses is used after discarding the ones deviating more than MaxDeviationBps from it.
+type GasPriceEndpoints struct {
+	Endpoints []prices.NamedGasPriceEndpoint
+	// MaxDeviationBps defaults to 20% when zero.
+	MaxDeviationBps uint32
+	// MinResponses counts the gas price estimator, it defaults to a majority of all endpoints when zero.
+	MinResponses int
+}
+
+// GasPriceSource is a source chain whose gas price is observed by the PriceService.
+type GasPriceSource struct {
+	SourceChainSelector uint64
+	SourceNative        cciptypes.Address
+	GasPriceEstimator   prices.GasPriceEstimatorCommit
 }
 
 func NewPriceService(
@@ -84,10 +391,47 @@ func NewPriceService(
 	sourceNative cciptypes.Address,
 	priceGetter pricegetter.AllTokensPriceGetter,
 	offRampReader ccipdata.OffRampReader,
+	opts PriceServiceOptions,
 ) PriceService {
 	pw := &priceService{
-		gasUpdateInterval:   gasPriceUpdateInterval,
-		tokenUpdateInterval: tokenPriceUpdateInterval,
+		gasUpdateInterval:    gasPriceUpdateInterval,
+		tokenUpdateInterval:  tokenPriceUpdateInterval,
+		cleanupInterval:      stalePricesCleanupInterval,
+		stalePriceRetention:  defaultStalePriceRetention,
+		maxPriceAge:          opts.MaxPriceAge,
+		tokenUpdateIntervals: opts.TokenUpdateIntervals,
+
+		allowPartialTokenPriceUpdates: opts.AllowPartialTokenPriceUpdates,
+		minTokenPriceConfidence:       opts.MinTokenPriceConfidence,
+		sinks:                         opts.Sinks,
+		additionalGasPriceSources:     opts.AdditionalGasPriceSources,
+		quoteCurrency:                 opts.QuoteCurrency,
+		tokenAllowlist:                opts.TokenAllowlist,
+		tokenDenylist:                 opts.TokenDenylist,
+		dualWritePrices:               opts.DualWritePrices,
+		readConsolidatedPrices:        opts.ReadConsolidatedPrices,
+		pushPriceRefresh:              opts.PushPriceRefresh,
+		consistentPriceReads:          opts.ConsistentPriceReads,
+		tokenMetadata:                 opts.TokenMetadata,
+		writeDeviationBps:             opts.WriteDeviationBps,
+		writeHeartbeat:                defaultWriteHeartbeat,
+		gasPriceBounds:                opts.GasPriceBounds,
+		tokenPriceBounds:              opts.TokenPriceBounds,
+		gasPriceDampening:             opts.GasPriceDampening,
+		gasPriceEndpoints:             opts.GasPriceEndpoints,
+		nativePriceCheck:              opts.NativePriceCheck,
+		priceVerifier:                 opts.PriceVerifier,
+		priceUpdater:                  opts.PriceUpdater,
+		registry:                      opts.Registry,
+
+		events:               newPriceUpdateEvents(),
+		dynamicConfigUpdated: make(chan struct{}, 1),
+		resumed:              make(chan struct{}, 1),
+		tokenDecimals:        make(map[cciptypes.Address]uint8),
+
+		updateRetryConfig: defaultUpdateRetryConfig,
+		pricesCache:       cache.New(pricesCacheExpiration, 2*pricesCacheExpiration),
+		metrics:           newPriceServiceMetrics(sourceChainSelector, destChainSelector),
 
 		lggr:              lggr,
 		orm:               orm,
@@ -100,6 +444,16 @@ func NewPriceService(
 		offRampReader:       offRampReader,
 		stopChan:            make(services.StopChan),
 	}
+	if opts.WriteHeartbeat > 0 {
+		pw.writeHeartbeat = opts.WriteHeartbeat
+	} else if opts.MaxPriceAge > 0 && opts.MaxPriceAge <= defaultWriteHeartbeat {
+		// Prices which did not deviate must still be written before they are ignored as too old
+		pw.writeHeartbeat = opts.MaxPriceAge / 2
+	}
+	pw.priceWriter = orm
+	if opts.WriteBatcher != nil {
+		pw.priceWriter = opts.WriteBatcher
+	}
 	return pw
 }
 
@@ -108,6 +462,13 @@ func (p *priceService) Start(context.Context) error {
 		p.lggr.Info("Starting PriceService")
 		p.wg.Add(1)
 		p.run()
+		if p.pushPriceRefresh {
+			p.wg.Add(1)
+			go p.runPriceUpdatesSubscription()
+		}
+		if p.registry != nil {
+			p.registry.register(p)
+		}
 		return nil
 	})
 }
@@ -115,107 +476,558 @@ func (p *priceService) Start(context.Context) error {
 func (p *priceService) Close() error {
 	return p.StateMachine.StopOnce("PriceService", func() error {
 		p.lggr.Info("Closing PriceService")
+		if p.registry != nil {
+			p.registry.unregister(p)
+		}
 		close(p.stopChan)
 		p.wg.Wait()
+		p.events.close()
 		return nil
 	})
 }
 
+func (p *priceService) Name() string {
+	return fmt.Sprintf("PriceService.%d", p.jobId)
+}
+
+func (p *priceService) Pause() {
+	if p.paused.CompareAndSwap(false, true) {
+		p.lggr.Info("Pausing PriceService background price writes")
+	}
+}
+
+func (p *priceService) Resume() {
+	if !p.paused.CompareAndSwap(true, false) {
+		return
+	}
+	p.lggr.Info("Resuming PriceService background price writes")
+	select {
+	case p.resumed <- struct{}{}:
+	default:
+	}
+}
+
+// HealthReport reports the service as unhealthy when the background gas or token price updates keep failing.
+func (p *priceService) HealthReport() map[string]error {
+	return map[string]error{p.Name(): errors.Join(
+		p.Healthy(),
+		p.gasUpdateHealth.err("gas price update"),
+		p.tokenUpdateHealth.err("token price update"),
+	)}
+}
+
 func (p *priceService) run() {
 	ctx, cancel := p.stopChan.NewCtx()
 	defer cancel()
 
 	gasUpdateTicker := time.NewTicker(utils.WithJitter(p.gasUpdateInterval))
-	tokenUpdateTicker := time.NewTicker(utils.WithJitter(p.tokenUpdateInterval))
+	tokenUpdateTicker := time.NewTicker(utils.WithJitter(p.tokenTickInterval()))
+	cleanupTicker := time.NewTicker(utils.WithJitter(p.cleanupInterval))
+	tokenMetadataTicker := time.NewTicker(utils.WithJitter(tokenMetadataRefreshInterval))
 
 	go func() {
 		defer p.wg.Done()
 		defer gasUpdateTicker.Stop()
 		defer tokenUpdateTicker.Stop()
+		defer cleanupTicker.Stop()
+		defer tokenMetadataTicker.Stop()
+
+		p.runInitialUpdates(ctx)
 
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-gasUpdateTicker.C:
-				err := p.runGasPriceUpdate(ctx)
-				if err != nil {
-					p.lggr.Errorw("Error when updating gas prices in the background", "err", err)
-				}
+				p.runBackgroundGasPriceUpdate(ctx)
 			case <-tokenUpdateTicker.C:
-				err := p.runTokenPriceUpdate(ctx)
-				if err != nil {
-					p.lggr.Errorw("Error when updating token prices in the background", "err", err)
+				p.runBackgroundTokenPriceUpdate(ctx)
+			case <-p.resumed:
+				p.runBackgroundGasPriceUpdate(ctx)
+				p.runBackgroundTokenPriceUpdate(ctx)
+			case <-p.dynamicConfigUpdated:
+				// Prices were just refreshed by UpdateDynamicConfig, count the update intervals from now on
+				gasUpdateTicker.Reset(utils.WithJitter(p.gasUpdateInterval))
+				tokenUpdateTicker.Reset(utils.WithJitter(p.tokenTickInterval()))
+			case <-cleanupTicker.C:
+				if p.paused.Load() {
+					p.lggr.Debug("PriceService is paused, skipping stale prices cleanup")
+					continue
+				}
+				if err := p.cleanupStalePrices(ctx); err != nil {
+					p.lggr.Errorw("Error when cleaning up stale prices in the background", "err", err)
+				}
+			case <-tokenMetadataTicker.C:
+				if err := p.refreshTokenMetadata(ctx); err != nil {
+					p.lggr.Errorw("Error when refreshing token metadata in the background", "err", err)
 				}
 			}
 		}
 	}()
 }
 
+// runPriceUpdatesSubscription drops the cached prices of the dest chain whenever the DB notifies about price writes,
+// the subscription is re-established when it is lost.
+func (p *priceService) runPriceUpdatesSubscription() {
+	defer p.wg.Done()
+	ctx, cancel := p.stopChan.NewCtx()
+	defer cancel()
+
+	cacheKey := strconv.FormatUint(p.destChainSelector, 10)
+	for {
+		updates, err := p.orm.SubscribePriceUpdates(ctx, p.destChainSelector)
+		if err != nil {
+			p.lggr.Warnw("Failed to subscribe to price updates, polling the DB for prices", "err", err)
+		} else {
+			// Prices could have been written before the subscription was established
+			p.pricesCache.Delete(cacheKey)
+			p.pushRefreshActive.Store(true)
+			for range updates {
+				p.pricesCache.Delete(cacheKey)
+			}
+			p.pushRefreshActive.Store(false)
+			p.pricesCache.Delete(cacheKey)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(priceUpdatesResubscribeDelay):
+		}
+	}
+}
+
+// runInitialUpdates updates the prices right after start, so that lanes don't wait a full update interval for prices
+// after a node restart. The updates are skipped when the dynamic config is not set yet, in that case
+// UpdateDynamicConfig runs them as soon as it is.
+func (p *priceService) runInitialUpdates(ctx context.Context) {
+	p.dynamicConfigMu.RLock()
+	dynamicConfigSet := p.gasPriceEstimator != nil && p.destPriceRegistryReader != nil
+	p.dynamicConfigMu.RUnlock()
+
+	if !dynamicConfigSet {
+		p.lggr.Debug("Dynamic config is not set yet, skipping initial price updates")
+		return
+	}
+	if err := p.refreshTokenMetadata(ctx); err != nil {
+		p.lggr.Errorw("Error when refreshing token metadata on start", "err", err)
+	}
+	p.runBackgroundGasPriceUpdate(ctx)
+	p.runBackgroundTokenPriceUpdate(ctx)
+}
+
+func (p *priceService) runBackgroundGasPriceUpdate(ctx context.Context) {
+	if p.paused.Load() {
+		p.lggr.Debug("PriceService is paused, skipping gas price update")
+		return
+	}
+	err := p.runWithRetry(ctx, gasPriceUpdate, p.runGasPriceUpdate)
+	p.recordUpdateResult(gasPriceUpdate, err)
+	if err != nil {
+		p.lggr.Errorw("Error when updating gas prices in the background", "err", err)
+	}
+}
+
+func (p *priceService) runBackgroundTokenPriceUpdate(ctx context.Context) {
+	if p.paused.Load() {
+		p.lggr.Debug("PriceService is paused, skipping token price update")
+		return
+	}
+	err := p.runWithRetry(ctx, tokenPriceUpdate, p.runTokenPriceUpdate)
+	p.recordUpdateResult(tokenPriceUpdate, err)
+	if err != nil {
+		p.lggr.Errorw("Error when updating token prices in the background", "err", err)
+	}
+}
+
+// runWithRetry runs the price update and retries it with exponential backoff according to updateRetryConfig.
+// Only the error of the last attempt is returned.
+func (p *priceService) runWithRetry(ctx context.Context, updateType priceUpdateType, update func(context.Context) error) error {
+	return retry.Do(
+		func() error { return update(ctx) },
+		retry.Context(ctx),
+		retry.Delay(p.updateRetryConfig.InitialDelay),
+		retry.MaxDelay(p.updateRetryConfig.MaxDelay),
+		retry.DelayType(retry.BackOffDelay),
+		// Attempts(0) means retrying forever, always do at least a single attempt
+		retry.Attempts(max(p.updateRetryConfig.MaxRetries, 1)),
+		retry.LastErrorOnly(true),
+		retry.OnRetry(func(attempt uint, err error) {
+			p.lggr.Warnw("Price update failed, retrying", "updateType", updateType, "attempt", attempt+1, "err", err)
+		}),
+	)
+}
+
+// recordUpdateResult tracks the outcome of a price update for health reporting and metrics.
+func (p *priceService) recordUpdateResult(updateType priceUpdateType, err error) {
+	p.metrics.updateResult(updateType, err)
+	switch updateType {
+	case gasPriceUpdate:
+		p.gasUpdateHealth.record(err)
+	case tokenPriceUpdate:
+		p.tokenUpdateHealth.record(err)
+	}
+}
+
 func (p *priceService) UpdateDynamicConfig(ctx context.Context, gasPriceEstimator prices.GasPriceEstimatorCommit, destPriceRegistryReader ccipdata.PriceRegistryReader) error {
+	if p.gasPriceEndpoints != nil && gasPriceEstimator != nil {
+		gasPriceEstimator = prices.NewMultiEndpointGasPriceEstimator(gasPriceEstimator, p.sourceChainSelector,
+			p.gasPriceEndpoints.Endpoints, p.gasPriceEndpoints.MaxDeviationBps, p.gasPriceEndpoints.MinResponses)
+	}
+
 	p.dynamicConfigMu.Lock()
 	p.gasPriceEstimator = gasPriceEstimator
 	p.destPriceRegistryReader = destPriceRegistryReader
 	p.dynamicConfigMu.Unlock()
 
+	if p.paused.Load() {
+		p.lggr.Info("PriceService is paused, prices will be refreshed with the new dynamic config on resume")
+		return nil
+	}
+
+	// Token metadata could not be refreshed on start while the dest price registry was not known
+	if err := p.refreshTokenMetadata(ctx); err != nil {
+		p.lggr.Errorw("Error when refreshing token metadata after dynamic config update", "err", err)
+	}
+
 	// Config update may substantially change the prices, refresh the prices immediately, this also makes testing easier
 	// for not having to wait to the full update interval.
-	if err := p.runGasPriceUpdate(ctx); err != nil {
+	err := p.runGasPriceUpdate(ctx)
+	p.recordUpdateResult(gasPriceUpdate, err)
+	if err != nil {
 		p.lggr.Errorw("Error when updating gas prices after dynamic config update", "err", err)
 	}
-	if err := p.runTokenPriceUpdate(ctx); err != nil {
+	err = p.runTokenPriceUpdate(ctx)
+	p.recordUpdateResult(tokenPriceUpdate, err)
+	if err != nil {
 		p.lggr.Errorw("Error when updating token prices after dynamic config update", "err", err)
 	}
 
+	// Non-blocking, a pending signal already re-arms the tickers
+	select {
+	case p.dynamicConfigUpdated <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (p *priceService) UpdatePriceGetter(ctx context.Context, priceGetter pricegetter.AllTokensPriceGetter) error {
+	if priceGetter == nil {
+		return errors.New("price getter is nil")
+	}
+
+	if p.paused.Load() {
+		// Prices can't be written while paused, the new price getter is checked without writing the prices it returns
+		if _, err := priceGetter.GetJobSpecTokenPricesUSD(ctx); err != nil {
+			p.closePriceGetter(priceGetter)
+			return fmt.Errorf("failed to get token prices with the new price getter, keeping the previous one: %w", err)
+		}
+		p.closePriceGetter(p.swapPriceGetter(priceGetter))
+		p.lggr.Info("PriceService is paused, prices will be refreshed with the new price getter on resume")
+		return nil
+	}
+
+	previousPriceGetter := p.swapPriceGetter(priceGetter)
+
+	// Price the tokens added to the price getter right away instead of waiting for the next token price update
+	if err := p.runTokenPriceUpdate(ctx); err != nil {
+		p.dynamicConfigMu.Lock()
+		restored := p.priceGetter == priceGetter
+		if restored {
+			p.priceGetter = previousPriceGetter
+		}
+		p.dynamicConfigMu.Unlock()
+		// A concurrent update which replaced the new price getter already closed it
+		if restored {
+			p.closePriceGetter(priceGetter)
+		}
+		return fmt.Errorf("failed to update token prices with the new price getter, keeping the previous one: %w", err)
+	}
+	p.closePriceGetter(previousPriceGetter)
+	p.recordUpdateResult(tokenPriceUpdate, nil)
+	p.lggr.Info("PriceService price getter updated")
 	return nil
 }
 
+// swapPriceGetter installs the price getter and returns the previous one.
+func (p *priceService) swapPriceGetter(priceGetter pricegetter.AllTokensPriceGetter) pricegetter.AllTokensPriceGetter {
+	p.dynamicConfigMu.Lock()
+	defer p.dynamicConfigMu.Unlock()
+
+	previousPriceGetter := p.priceGetter
+	p.priceGetter = priceGetter
+	return previousPriceGetter
+}
+
+// closePriceGetter closes a price getter discarded by UpdatePriceGetter, a failure to close it only leaks its resources.
+func (p *priceService) closePriceGetter(priceGetter pricegetter.AllTokensPriceGetter) {
+	if priceGetter == nil {
+		return
+	}
+	if err := priceGetter.Close(); err != nil {
+		p.lggr.Warnw("Failed to close discarded price getter", "err", err)
+	}
+}
+
 func (p *priceService) GetGasAndTokenPrices(ctx context.Context, destChainSelector uint64) (map[uint64]*big.Int, map[cciptypes.Address]*big.Int, error) {
-	eg := new(errgroup.Group)
+	gasPricesWithTs, tokenPricesWithTs, err := p.GetGasAndTokenPricesWithTimestamps(ctx, destChainSelector)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	var gasPricesInDB []cciporm.GasPrice
-	var tokenPricesInDB []cciporm.TokenPrice
+	// Prices older than maxPriceAge are not returned, zero maxPriceAge disables the check
+	now := time.Now()
+	isStale := func(price TimestampedPrice) bool {
+		return p.maxPriceAge > 0 && !price.UpdatedAt.IsZero() && now.Sub(price.UpdatedAt) > p.maxPriceAge
+	}
 
-	eg.Go(func() error {
-		gasPrices, err := p.orm.GetGasPricesByDestChain(ctx, destChainSelector)
-		if err != nil {
-			return fmt.Errorf("failed to get gas prices from db: %w", err)
+	gasPrices := make(map[uint64]*big.Int, len(gasPricesWithTs))
+	staleGasPrices := make(map[uint64]time.Time)
+	for sourceChainSelector, gasPrice := range gasPricesWithTs {
+		if isStale(gasPrice) {
+			staleGasPrices[sourceChainSelector] = gasPrice.UpdatedAt
+			continue
 		}
-		gasPricesInDB = gasPrices
-		return nil
-	})
+		gasPrices[sourceChainSelector] = gasPrice.Value
+	}
+
+	tokenPrices := make(map[cciptypes.Address]*big.Int, len(tokenPricesWithTs))
+	staleTokenPrices := make(map[cciptypes.Address]time.Time)
+	lowConfidenceTokenPrices := make(map[cciptypes.Address]float64)
+	for token, tokenPrice := range tokenPricesWithTs {
+		if isStale(tokenPrice) {
+			staleTokenPrices[token] = tokenPrice.UpdatedAt
+			continue
+		}
+		if tokenPrice.Confidence != nil && tokenPrice.Confidence.Score < p.minTokenPriceConfidence {
+			lowConfidenceTokenPrices[token] = tokenPrice.Confidence.Score
+			continue
+		}
+		tokenPrices[token] = tokenPrice.Value
+	}
+
+	if len(staleGasPrices) > 0 || len(staleTokenPrices) > 0 {
+		p.lggr.Warnw("Ignoring stale prices, lanes are not contributing fresh prices",
+			"destChainSelector", destChainSelector,
+			"maxPriceAge", p.maxPriceAge,
+			"staleGasPricesBySourceChain", staleGasPrices,
+			"staleTokenPrices", staleTokenPrices,
+		)
+	}
+	if len(lowConfidenceTokenPrices) > 0 {
+		p.lggr.Warnw("Ignoring token prices with a low confidence",
+			"destChainSelector", destChainSelector,
+			"minTokenPriceConfidence", p.minTokenPriceConfidence,
+			"lowConfidenceTokenPrices", lowConfidenceTokenPrices,
+		)
+	}
+
+	return gasPrices, tokenPrices, nil
+}
+
+func (p *priceService) GetGasAndTokenPricesWithTimestamps(ctx context.Context, destChainSelector uint64) (map[uint64]TimestampedPrice, map[cciptypes.Address]TimestampedPrice, error) {
+	cacheKey := strconv.FormatUint(destChainSelector, 10)
+	if cached, found := p.pricesCache.Get(cacheKey); found {
+		cachedResult := cached.(cachedPrices)
+		// Return copies so that callers can't modify cached results
+		return maps.Clone(cachedResult.gasPrices), maps.Clone(cachedResult.tokenPrices), nil
+	}
+
+	gasPrices, tokenPrices, err := p.getGasAndTokenPricesFromDB(ctx, destChainSelector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cacheExpiration := cache.DefaultExpiration
+	if p.pushRefreshActive.Load() {
+		cacheExpiration = pushRefreshPricesCacheExpiration
+	}
+	p.pricesCache.Set(cacheKey, cachedPrices{gasPrices: gasPrices, tokenPrices: tokenPrices}, cacheExpiration)
+	return maps.Clone(gasPrices), maps.Clone(tokenPrices), nil
+}
+
+// readGasAndTokenPrices reads the gas and token prices of the dest chain concurrently. With consistentPriceReads, the
+// reads are retried when prices were written in between them, so that gas and token prices come from the same writes.
+func (p *priceService) readGasAndTokenPrices(ctx context.Context, destChainSelector uint64) ([]cciporm.GasPrice, []cciporm.TokenPrice, error) {
+	for attempt := 1; ; attempt++ {
+		eg := new(errgroup.Group)
+
+		var gasPricesInDB []cciporm.GasPrice
+		var tokenPricesInDB []cciporm.TokenPrice
 
-	eg.Go(func() error {
-		tokenPrices, err := p.orm.GetTokenPricesByDestChain(ctx, destChainSelector)
+		eg.Go(func() error {
+			gasPrices, err := p.orm.GetGasPricesByDestChain(ctx, destChainSelector)
+			if err != nil {
+				return fmt.Errorf("failed to get gas prices from db: %w", err)
+			}
+			gasPricesInDB = gasPrices
+			return nil
+		})
+
+		eg.Go(func() error {
+			tokenPrices, err := p.orm.GetTokenPricesByDestChain(ctx, destChainSelector)
+			if err != nil {
+				return fmt.Errorf("failed to get token prices from db: %w", err)
+			}
+			tokenPricesInDB = tokenPrices
+			return nil
+		})
+
+		if err := eg.Wait(); err != nil {
+			return nil, nil, err
+		}
+		if !p.consistentPriceReads {
+			return gasPricesInDB, tokenPricesInDB, nil
+		}
+
+		readVersion := maxPriceVersion(gasPricesInDB, tokenPricesInDB)
+		dbVersion, err := p.orm.GetPriceVersion(ctx, destChainSelector)
 		if err != nil {
-			return fmt.Errorf("failed to get token prices from db: %w", err)
+			return nil, nil, fmt.Errorf("failed to get price version from db: %w", err)
 		}
-		tokenPricesInDB = tokenPrices
-		return nil
-	})
+		if dbVersion <= readVersion {
+			return gasPricesInDB, tokenPricesInDB, nil
+		}
+		if attempt >= maxConsistentPriceReadAttempts {
+			p.lggr.Warnw("Prices kept being written while reading them, using the last read prices",
+				"destChainSelector", destChainSelector, "attempts", attempt)
+			return gasPricesInDB, tokenPricesInDB, nil
+		}
+		p.lggr.Debugw("Prices were written while reading them, reading again",
+			"destChainSelector", destChainSelector, "readVersion", readVersion, "dbVersion", dbVersion)
+	}
+}
+
+// maxPriceVersion returns the highest version of the given prices.
+func maxPriceVersion(gasPrices []cciporm.GasPrice, tokenPrices []cciporm.TokenPrice) int64 {
+	var version int64
+	for _, gasPrice := range gasPrices {
+		version = max(version, gasPrice.Version)
+	}
+	for _, tokenPrice := range tokenPrices {
+		version = max(version, tokenPrice.Version)
+	}
+	return version
+}
+
+func (p *priceService) getGasAndTokenPricesFromDB(ctx context.Context, destChainSelector uint64) (map[uint64]TimestampedPrice, map[cciptypes.Address]TimestampedPrice, error) {
+	if p.readConsolidatedPrices {
+		return p.getConsolidatedPricesFromDB(ctx, destChainSelector)
+	}
 
-	if err := eg.Wait(); err != nil {
+	gasPricesInDB, tokenPricesInDB, err := p.readGasAndTokenPrices(ctx, destChainSelector)
+	if err != nil {
 		return nil, nil, err
 	}
 
-	gasPrices := make(map[uint64]*big.Int, len(gasPricesInDB))
-	tokenPrices := make(map[cciptypes.Address]*big.Int, len(tokenPricesInDB))
+	gasPrices := make(map[uint64]TimestampedPrice, len(gasPricesInDB))
+	tokenPrices := make(map[cciptypes.Address]TimestampedPrice, len(tokenPricesInDB))
 
 	for _, gasPrice := range gasPricesInDB {
 		if gasPrice.GasPrice != nil {
-			gasPrices[gasPrice.SourceChainSelector] = gasPrice.GasPrice.ToInt()
+			timestampedPrice := TimestampedPrice{
+				Value:     gasPrice.GasPrice.ToInt(),
+				UpdatedAt: gasPrice.UpdatedAt,
+			}
+			if gasPrice.ExecGasPrice != nil && gasPrice.DAGasPrice != nil {
+				timestampedPrice.GasPriceComponents = &GasPriceComponents{
+					ExecGasPrice: gasPrice.ExecGasPrice.ToInt(),
+					DAGasPrice:   gasPrice.DAGasPrice.ToInt(),
+				}
+				if gasPrice.BaseFee != nil && gasPrice.PriorityFee != nil {
+					timestampedPrice.GasPriceComponents.BaseFee = gasPrice.BaseFee.ToInt()
+					timestampedPrice.GasPriceComponents.PriorityFee = gasPrice.PriorityFee.ToInt()
+				}
+			}
+			if gasPrice.GasPriceWei != nil {
+				timestampedPrice.GasPriceWei = gasPrice.GasPriceWei.ToInt()
+			}
+			gasPrices[gasPrice.SourceChainSelector] = timestampedPrice
 		}
 	}
 
 	for _, tokenPrice := range tokenPricesInDB {
 		if tokenPrice.TokenPrice != nil {
-			tokenPrices[cciptypes.Address(tokenPrice.TokenAddr)] = tokenPrice.TokenPrice.ToInt()
+			timestampedPrice := TimestampedPrice{
+				Value:     tokenPrice.TokenPrice.ToInt(),
+				UpdatedAt: tokenPrice.UpdatedAt,
+			}
+			if tokenPrice.Confidence != nil && tokenPrice.SourceCount != nil {
+				timestampedPrice.Confidence = &pricegetter.PriceConfidence{
+					SourceCount: int(*tokenPrice.SourceCount),
+					Score:       *tokenPrice.Confidence,
+				}
+			}
+			tokenPrices[cciptypes.Address(tokenPrice.TokenAddr)] = timestampedPrice
 		}
 	}
 
 	return gasPrices, tokenPrices, nil
 }
 
+func (p *priceService) ObserveOnly(ctx context.Context) (ObservedPrices, error) {
+	p.dynamicConfigMu.RLock()
+	defer p.dynamicConfigMu.RUnlock()
+
+	lggr := logger.With(p.lggr, "observeOnly", true)
+
+	sourceGasPriceUSD, sourceGasPrice, err := p.observeGasPriceUpdates(ctx, lggr)
+	if err != nil {
+		return ObservedPrices{}, fmt.Errorf("failed to observe gas price updates: %w", err)
+	}
+
+	additionalGasPricesUSD, observedGasPrices, err := p.observeAdditionalGasPriceUpdates(ctx, lggr)
+	if err != nil {
+		return ObservedPrices{}, fmt.Errorf("failed to observe gas price updates of additional sources: %w", err)
+	}
+	observedGasPrices[p.sourceChainSelector] = sourceGasPrice
+
+	var gasPriceFeesUSD map[uint64]prices.EIP1559Fees
+	gasPricesWei := make(map[uint64]*big.Int, len(observedGasPrices))
+	for sourceChainSelector, observed := range observedGasPrices {
+		gasPricesWei[sourceChainSelector] = observed.gasPriceWei
+		if observed.feesUSD != nil {
+			if gasPriceFeesUSD == nil {
+				gasPriceFeesUSD = make(map[uint64]prices.EIP1559Fees)
+			}
+			gasPriceFeesUSD[sourceChainSelector] = *observed.feesUSD
+		}
+	}
+
+	tokenPricesUSD, tokenPriceConfidence, err := p.observeTokenPriceUpdates(ctx, lggr)
+	if err != nil {
+		return ObservedPrices{}, fmt.Errorf("failed to observe token price updates: %w", err)
+	}
+
+	return ObservedPrices{
+		SourceGasPriceUSD:      sourceGasPriceUSD,
+		AdditionalGasPricesUSD: additionalGasPricesUSD,
+		TokenPricesUSD:         tokenPricesUSD,
+		GasPriceFeesUSD:        gasPriceFeesUSD,
+		GasPricesWei:           gasPricesWei,
+		TokenPriceConfidence:   tokenPriceConfidence,
+	}, nil
+}
+
+func (p *priceService) SubscribePriceUpdates() (<-chan PriceUpdateEvent, func()) {
+	return p.events.subscribe()
+}
+
+func (p *priceService) publishGasPriceUpdate(sourceChainSelector uint64, sourceGasPriceUSD *big.Int) {
+	if sourceGasPriceUSD == nil {
+		return
+	}
+	if dropped := p.events.gasPriceWritten(sourceChainSelector, p.destChainSelector, sourceGasPriceUSD); dropped > 0 {
+		p.lggr.Warnw("Dropped gas price update events for slow subscribers", "dropped", dropped)
+	}
+}
+
+func (p *priceService) publishTokenPriceUpdates(tokenPricesUSD map[cciptypes.Address]*big.Int) {
+	if dropped := p.events.tokenPricesWritten(p.sourceChainSelector, p.destChainSelector, tokenPricesUSD); dropped > 0 {
+		p.lggr.Warnw("Dropped token price update events for slow subscribers", "dropped", dropped)
+	}
+}
+
 func (p *priceService) runGasPriceUpdate(ctx context.Context) error {
 	// Protect against concurrent updates of `gasPriceEstimator` and `destPriceRegistryReader`
 	// Price updates happen infrequently - once every `gasPriceUpdateInterval` seconds.
@@ -230,16 +1042,31 @@ func (p *priceService) runGasPriceUpdate(ctx context.Context) error {
 		return nil
 	}
 
-	sourceGasPriceUSD, err := p.observeGasPriceUpdates(ctx, p.lggr)
+	observationStarted := time.Now()
+	sourceGasPriceUSD, sourceGasPrice, err := p.observeGasPriceUpdates(ctx, p.lggr)
+	p.metrics.observationDuration(gasPriceUpdate, time.Since(observationStarted), err)
 	if err != nil {
 		return fmt.Errorf("failed to observe gas price updates: %w", err)
 	}
 
-	err = p.writeGasPricesToDB(ctx, sourceGasPriceUSD)
+	// A failing additional source must not prevent writing the gas prices of the other sources, nor fail the update
+	// once they are written, as retrying it would observe and write all of them again
+	sourceGasPricesUSD, observedGasPrices, additionalErr := p.observeAdditionalGasPriceUpdates(ctx, p.lggr)
+	if additionalErr != nil {
+		p.lggr.Warnw("Skipping gas prices of additional sources which could not be observed", "err", additionalErr)
+	}
+	sourceGasPricesUSD[p.sourceChainSelector] = sourceGasPriceUSD
+	observedGasPrices[p.sourceChainSelector] = sourceGasPrice
+	p.dampenGasPriceSpikes(ctx, sourceGasPricesUSD, observedGasPrices)
+
+	writtenGasPricesUSD, err := p.writeGasPricesToDB(ctx, sourceGasPricesUSD, observedGasPrices)
 	if err != nil {
 		return fmt.Errorf("failed to write gas prices to db: %w", err)
 	}
-
+	for sourceChainSelector, gasPriceUSD := range writtenGasPricesUSD {
+		p.writeGasPriceToSinks(ctx, sourceChainSelector, gasPriceUSD)
+		p.publishGasPriceUpdate(sourceChainSelector, gasPriceUSD)
+	}
 	return nil
 }
 
@@ -255,15 +1082,19 @@ func (p *priceService) runTokenPriceUpdate(ctx context.Context) error {
 		return nil
 	}
 
-	tokenPricesUSD, err := p.observeTokenPriceUpdates(ctx, p.lggr)
+	observationStarted := time.Now()
+	tokenPricesUSD, tokenPriceConfidence, err := p.observeTokenPriceUpdates(ctx, p.lggr)
+	p.metrics.observationDuration(tokenPriceUpdate, time.Since(observationStarted), err)
 	if err != nil {
 		return fmt.Errorf("failed to observe token price updates: %w", err)
 	}
 
-	err = p.writeTokenPricesToDB(ctx, tokenPricesUSD)
+	writtenTokenPricesUSD, err := p.writeTokenPricesToDB(ctx, tokenPricesUSD, tokenPriceConfidence)
 	if err != nil {
 		return fmt.Errorf("failed to write token prices to db: %w", err)
 	}
+	p.writeTokenPricesToSinks(ctx, writtenTokenPricesUSD)
+	p.publishTokenPriceUpdates(writtenTokenPricesUSD)
 
 	return nil
 }
@@ -271,84 +1102,203 @@ func (p *priceService) runTokenPriceUpdate(ctx context.Context) error {
 func (p *priceService) observeGasPriceUpdates(
 	ctx context.Context,
 	lggr logger.Logger,
-) (sourceGasPriceUSD *big.Int, err error) {
+) (sourceGasPriceUSD *big.Int, sourceGasPrice observedGasPrice, err error) {
 	if p.gasPriceEstimator == nil {
-		return nil, errors.New("gasPriceEstimator is not set yet")
+		return nil, observedGasPrice{}, errors.New("gasPriceEstimator is not set yet")
+	}
+
+	return p.observeSourceGasPrice(ctx, lggr, GasPriceSource{
+		SourceChainSelector: p.sourceChainSelector,
+		SourceNative:        p.sourceNative,
+		GasPriceEstimator:   p.gasPriceEstimator,
+	})
+}
+
+// observeAdditionalGasPriceUpdates observes the gas prices of the additional sources. Prices of the sources observed
+// successfully are returned even if other sources fail, the failures are returned as a joined error. Both returned
+// maps are never nil, so that the gas price of the lane's source chain can be added.
+func (p *priceService) observeAdditionalGasPriceUpdates(
+	ctx context.Context,
+	lggr logger.Logger,
+) (map[uint64]*big.Int, map[uint64]observedGasPrice, error) {
+	sourceGasPricesUSD := make(map[uint64]*big.Int, len(p.additionalGasPriceSources)+1)
+	observedGasPrices := make(map[uint64]observedGasPrice, len(p.additionalGasPriceSources)+1)
+	var errs []error
+	for _, source := range p.additionalGasPriceSources {
+		sourceGasPriceUSD, observed, err := p.observeSourceGasPrice(ctx, lggr, source)
+		if err != nil {
+			p.metrics.gasPriceSourceFailure(source.SourceChainSelector)
+			errs = append(errs, fmt.Errorf("source chain %d: %w", source.SourceChainSelector, err))
+			continue
+		}
+		sourceGasPricesUSD[source.SourceChainSelector] = sourceGasPriceUSD
+		observedGasPrices[source.SourceChainSelector] = observed
 	}
+	return sourceGasPricesUSD, observedGasPrices, errors.Join(errs...)
+}
 
+// observedGasPrice holds what is stored alongside an observed gas price.
+type observedGasPrice struct {
+	// gasPriceWei is the gas price as returned by the gas price estimator, in the native token of the source chain.
+	gasPriceWei *big.Int
+	// feesUSD are the EIP-1559 fees making up the execution gas price, nil when the gas price estimator doesn't know them.
+	feesUSD *prices.EIP1559Fees
+}
+
+// observeSourceGasPrice observes the gas price of the source, along with the native gas price and the EIP-1559 fees
+// when the gas price estimator knows them. The fees are converted the same way as the execution component of the gas price.
+func (p *priceService) observeSourceGasPrice(
+	ctx context.Context,
+	lggr logger.Logger,
+	source GasPriceSource,
+) (sourceGasPriceUSD *big.Int, observed observedGasPrice, err error) {
 	sourceNativeTokenID := ccipcommon.TokenID{
-		TokenAddress:  p.sourceNative,
-		ChainSelector: p.sourceChainSelector,
+		TokenAddress:  source.SourceNative,
+		ChainSelector: source.SourceChainSelector,
 	}
 
 	// Include wrapped native to identify the source native USD price, notice USD is in 1e18 scale, i.e. $1 = 1e18
-	rawTokenPricesUSD, err := p.priceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{sourceNativeTokenID})
+	tokenIDs := []ccipcommon.TokenID{sourceNativeTokenID}
+	if p.quoteCurrency != nil {
+		tokenIDs = append(tokenIDs, p.quoteCurrency.Token)
+	}
+	rawTokenPricesUSD, err := p.getTokenPricesUSD(ctx, tokenIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch source native price (%v): %w", sourceNativeTokenID, err)
+		return nil, observedGasPrice{}, fmt.Errorf("failed to fetch source native price (%v): %w", sourceNativeTokenID, err)
 	}
 
 	sourceNativePriceUSD, exists := rawTokenPricesUSD[sourceNativeTokenID]
 	if !exists {
-		return nil, fmt.Errorf("missing source native (%v) price", sourceNativeTokenID)
+		return nil, observedGasPrice{}, fmt.Errorf("missing source native (%v) price", sourceNativeTokenID)
+	}
+	if source.SourceChainSelector == p.sourceChainSelector {
+		if err = p.checkSourceNativePrice(ctx, rawTokenPricesUSD, sourceNativePriceUSD); err != nil {
+			return nil, observedGasPrice{}, err
+		}
 	}
 
-	sourceGasPrice, err := p.gasPriceEstimator.GetGasPrice(ctx)
+	var sourceGasPrice *big.Int
+	var sourceGasPriceFees *prices.EIP1559Fees
+	if eip1559Estimator, ok := source.GasPriceEstimator.(prices.EIP1559GasPriceEstimatorCommit); ok {
+		var fees prices.EIP1559Fees
+		sourceGasPrice, fees, err = eip1559Estimator.GetGasPriceWithFees(ctx)
+		sourceGasPriceFees = &fees
+	} else {
+		sourceGasPrice, err = source.GasPriceEstimator.GetGasPrice(ctx)
+	}
 	if err != nil {
-		return nil, err
+		return nil, observedGasPrice{}, err
 	}
 	if sourceGasPrice == nil {
-		return nil, errors.New("missing gas price")
+		return nil, observedGasPrice{}, errors.New("missing gas price")
 	}
-	sourceGasPriceUSD, err = p.gasPriceEstimator.DenoteInUSD(ctx, sourceGasPrice, sourceNativePriceUSD)
+	sourceGasPriceUSD, err = source.GasPriceEstimator.DenoteInUSD(ctx, sourceGasPrice, sourceNativePriceUSD)
 	if err != nil {
-		return nil, err
+		return nil, observedGasPrice{}, err
+	}
+
+	usdPerQuoteUnit, err := p.getUsdPerQuoteUnit(ctx, rawTokenPricesUSD)
+	if err != nil {
+		return nil, observedGasPrice{}, err
+	}
+	sourceGasPriceUSD, err = gasPriceToQuoteCurrency(sourceGasPriceUSD, usdPerQuoteUnit)
+	if err != nil {
+		return nil, observedGasPrice{}, fmt.Errorf("convert gas price to quote currency: %w", err)
+	}
+	observed.gasPriceWei = sourceGasPrice
+	if sourceGasPriceFees != nil && sourceGasPriceFees.BaseFee != nil && sourceGasPriceFees.PriorityFee != nil {
+		observed.feesUSD = &prices.EIP1559Fees{
+			BaseFee:     toQuoteCurrency(ccipcalc.CalculateUsdPerUnitGas(sourceGasPriceFees.BaseFee, sourceNativePriceUSD), usdPerQuoteUnit),
+			PriorityFee: toQuoteCurrency(ccipcalc.CalculateUsdPerUnitGas(sourceGasPriceFees.PriorityFee, sourceNativePriceUSD), usdPerQuoteUnit),
+		}
 	}
 
 	lggr.Infow("PriceService observed latest gas price",
-		"sourceChainSelector", p.sourceChainSelector,
+		"sourceChainSelector", source.SourceChainSelector,
 		"destChainSelector", p.destChainSelector,
-		"sourceNative", p.sourceNative,
+		"sourceNative", source.SourceNative,
 		"gasPriceWei", sourceGasPrice,
+		"gasPriceFeesWei", sourceGasPriceFees,
 		"sourceNativePriceUSD", sourceNativePriceUSD,
 		"sourceGasPriceUSD", sourceGasPriceUSD,
+		"quoteCurrency", p.quoteCurrencySymbol(),
 	)
-	return sourceGasPriceUSD, nil
+	return sourceGasPriceUSD, observed, nil
 }
 
-// All prices are USD ($1=1e18) denominated. All prices must be not nil.
+// All prices are USD ($1=1e18) denominated, or quote currency denominated in the same scale when set. All prices must be not nil.
 // It observes only destination chain tokens.
 // Return token prices should contain the exact same tokens as in tokenDecimals.
 func (p *priceService) observeTokenPriceUpdates(
 	ctx context.Context,
 	lggr logger.Logger,
-) (map[cciptypes.Address]*big.Int, error) {
+) (tokenPricesUSD map[cciptypes.Address]*big.Int, tokenPriceConfidence map[cciptypes.Address]pricegetter.PriceConfidence, err error) {
+	var expectedTokens, filteredTokens []cciptypes.Address
+	failedTokens := make(map[cciptypes.Address]error)
+	observedAt := time.Now()
+	defer func() {
+		p.recordTokenObservation(observedAt, expectedTokens, filteredTokens, failedTokens, tokenPricesUSD, err)
+	}()
+
 	if p.destPriceRegistryReader == nil {
-		return nil, errors.New("destPriceRegistry is not set yet")
+		return nil, nil, errors.New("destPriceRegistry is not set yet")
 	}
 
-	rawTokenPricesUSD, err := p.priceGetter.GetJobSpecTokenPricesUSD(ctx)
+	rawTokenPricesUSD, rawTokenPriceConfidence, invalidTokenPrices, err := p.getJobSpecTokenPricesUSD(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch token prices: %w", err)
+		return nil, nil, fmt.Errorf("failed to fetch token prices: %w", err)
+	}
+	if len(invalidTokenPrices) > 0 {
+		if !p.allowPartialTokenPriceUpdates {
+			return nil, nil, fmt.Errorf("invalid signed token prices: %v", invalidTokenPrices)
+		}
+		for tokenID, verifyErr := range invalidTokenPrices {
+			if tokenID.ChainSelector == p.destChainSelector {
+				failedTokens[tokenID.TokenAddress] = verifyErr
+			}
+		}
 	}
 
 	missingDestNativePrice, err := p.findMissingDestNativeTokenPrice(ctx, rawTokenPricesUSD)
 	if err != nil {
-		return nil, fmt.Errorf("find missing dest native token price: %w", err)
+		return nil, nil, fmt.Errorf("find missing dest native token price: %w", err)
 	}
 	if missingDestNativePrice != nil {
 		destNativeTokenID := ccipcommon.TokenID{TokenAddress: p.sourceNative, ChainSelector: p.destChainSelector}
 		rawTokenPricesUSD[destNativeTokenID] = missingDestNativePrice
+		if confidence, ok := rawTokenPriceConfidence[ccipcommon.TokenID{TokenAddress: p.sourceNative, ChainSelector: p.sourceChainSelector}]; ok {
+			rawTokenPriceConfidence[destNativeTokenID] = confidence
+		}
+	}
+
+	for tokenID := range rawTokenPricesUSD {
+		if tokenID.ChainSelector == p.destChainSelector {
+			expectedTokens = append(expectedTokens, tokenID.TokenAddress)
+		}
 	}
 
+	filteredTokens = p.filterTokens(lggr, rawTokenPricesUSD)
+
 	// Verify no price returned by price getter is nil
 	for tokenID, price := range rawTokenPricesUSD {
 		if price == nil {
-			return nil, fmt.Errorf("token price is nil for token %v", tokenID)
+			if !p.allowPartialTokenPriceUpdates {
+				return nil, nil, fmt.Errorf("token price is nil for token %v", tokenID)
+			}
+			if tokenID.ChainSelector == p.destChainSelector {
+				failedTokens[tokenID.TokenAddress] = errors.New("token price is nil")
+			}
+			delete(rawTokenPricesUSD, tokenID)
 		}
 	}
 
 	lggr.Infow("Raw token prices", "rawTokenPrices", rawTokenPricesUSD)
 
+	usdPerQuoteUnit, err := p.getUsdPerQuoteUnit(ctx, rawTokenPricesUSD)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// at this point the rawTokenPricesUSD contains both source native and dest tokens, we only want to observe
 	// destination chain tokens.
 
@@ -359,31 +1309,53 @@ func (p *priceService) observeTokenPriceUpdates(
 		}
 	}
 	sort.Slice(destTokens, func(i, j int) bool { return destTokens[i] < destTokens[j] })
-	destTokensDecimals, err := p.destPriceRegistryReader.GetTokensDecimals(ctx, destTokens)
+	destTokensDecimals, err := p.getDestTokensDecimals(ctx, destTokens)
 	if err != nil {
-		return nil, fmt.Errorf("get tokens decimals: %w", err)
-	}
-
-	if len(destTokensDecimals) != len(destTokens) {
-		return nil, errors.New("mismatched token decimals and tokens")
+		if !p.allowPartialTokenPriceUpdates {
+			return nil, nil, err
+		}
+		// Fall back to fetching decimals one by one to isolate the failing tokens
+		destTokens, destTokensDecimals = p.getDestTokensDecimalsOneByOne(ctx, destTokens, failedTokens)
 	}
 
 	tokenPricesUSDPer1e18 := make(map[cciptypes.Address]*big.Int, len(rawTokenPricesUSD))
+	// The confidence is left nil when the price getter doesn't know it
+	if len(rawTokenPriceConfidence) > 0 {
+		tokenPriceConfidence = make(map[cciptypes.Address]pricegetter.PriceConfidence, len(rawTokenPriceConfidence))
+	}
 	for i, token := range destTokens {
 		tokenID := ccipcommon.TokenID{TokenAddress: token, ChainSelector: p.destChainSelector}
 		tokenPriceUSD, ok := rawTokenPricesUSD[tokenID]
 		if !ok {
-			return nil, fmt.Errorf("internal bug rawTokenPricesUSD %v", tokenID)
+			return nil, nil, fmt.Errorf("internal bug rawTokenPricesUSD %v", tokenID)
+		}
+		tokenPricesUSDPer1e18[token] = calculateUsdPer1e18TokenAmount(toQuoteCurrency(tokenPriceUSD, usdPerQuoteUnit), destTokensDecimals[i])
+		if confidence, ok := rawTokenPriceConfidence[tokenID]; ok {
+			tokenPriceConfidence[token] = confidence
+		}
+	}
+
+	if len(failedTokens) > 0 {
+		p.metrics.tokenPriceFailures(len(failedTokens))
+		if len(tokenPricesUSDPer1e18) == 0 {
+			return nil, nil, fmt.Errorf("failed to price all %d tokens: %v", len(failedTokens), failedTokens)
 		}
-		tokenPricesUSDPer1e18[token] = calculateUsdPer1e18TokenAmount(tokenPriceUSD, destTokensDecimals[i])
+		lggr.Warnw("Skipping tokens which could not be priced, updating the remaining token prices",
+			"failedTokens", failedTokens,
+			"failedTokenSymbols", p.tokenSymbolsOf(slices.Collect(maps.Keys(failedTokens))),
+			"numPricedTokens", len(tokenPricesUSDPer1e18),
+		)
 	}
 
 	lggr.Infow("PriceService observed latest token prices",
 		"sourceChainSelector", p.sourceChainSelector,
 		"destChainSelector", p.destChainSelector,
 		"tokenPricesUSD", tokenPricesUSDPer1e18,
+		"tokenSymbols", p.tokenSymbolsOf(slices.Collect(maps.Keys(tokenPricesUSDPer1e18))),
+		"quoteCurrency", p.quoteCurrencySymbol(),
+		"tokenPriceConfidence", tokenPriceConfidence,
 	)
-	return tokenPricesUSDPer1e18, nil
+	return tokenPricesUSDPer1e18, tokenPriceConfidence, nil
 }
 
 // findMissingDestNativeTokenPrice is for backwards compatibility related to token addresses collisions.
@@ -433,41 +1405,320 @@ func (p *priceService) findMissingDestNativeTokenPrice(
 	return sourcePrice, nil
 }
 
-func (p *priceService) writeGasPricesToDB(ctx context.Context, sourceGasPriceUSD *big.Int) error {
-	if sourceGasPriceUSD == nil {
-		return nil
+// writeGasPricesToDB writes the gas prices along with their native gas prices and EIP-1559 fees, observedGasPrices is
+// nil or lacks the source chains whose native gas prices and fees are unknown. It returns the gas prices left after
+// the bounds and deviation filters, which are the ones written.
+func (p *priceService) writeGasPricesToDB(
+	ctx context.Context,
+	sourceGasPricesUSD map[uint64]*big.Int,
+	observedGasPrices map[uint64]observedGasPrice,
+) (map[uint64]*big.Int, error) {
+	gasPrices := make([]cciporm.GasPrice, 0, len(sourceGasPricesUSD))
+	for sourceChainSelector, sourceGasPriceUSD := range sourceGasPricesUSD {
+		if sourceGasPriceUSD == nil {
+			continue
+		}
+		gasPrice := cciporm.GasPrice{
+			SourceChainSelector: sourceChainSelector,
+			GasPrice:            assets.NewWei(sourceGasPriceUSD),
+			JobID:               p.jobId,
+		}
+		// Encoded gas prices of chains without a data availability fee decode to a zero DA component
+		execGasPriceUSD, daGasPriceUSD, err := prices.DecodeGasPriceComponents(sourceGasPriceUSD)
+		if err != nil {
+			p.lggr.Warnw("Failed to decode gas price components, storing the encoded gas price only",
+				"sourceChainSelector", sourceChainSelector, "gasPrice", sourceGasPriceUSD, "err", err)
+		} else {
+			gasPrice.ExecGasPrice = assets.NewWei(execGasPriceUSD)
+			gasPrice.DAGasPrice = assets.NewWei(daGasPriceUSD)
+		}
+		if observed, ok := observedGasPrices[sourceChainSelector]; ok {
+			if observed.gasPriceWei != nil {
+				gasPrice.GasPriceWei = assets.NewWei(observed.gasPriceWei)
+			}
+			if observed.feesUSD != nil {
+				gasPrice.BaseFee = assets.NewWei(observed.feesUSD.BaseFee)
+				gasPrice.PriorityFee = assets.NewWei(observed.feesUSD.PriorityFee)
+			}
+		}
+		gasPrices = append(gasPrices, gasPrice)
+	}
+	gasPrices = p.filterDeviatedGasPrices(ctx, p.filterGasPricesWithinBounds(gasPrices))
+	if len(gasPrices) == 0 {
+		return nil, nil
 	}
 
-	_, err := p.orm.UpsertGasPricesForDestChain(ctx, p.destChainSelector, []cciporm.GasPrice{
-		{
-			SourceChainSelector: p.sourceChainSelector,
-			GasPrice:            assets.NewWei(sourceGasPriceUSD),
-		},
+	// Sort by source chain to make price updates ordering deterministic, easier for testing and debugging
+	sort.Slice(gasPrices, func(i, j int) bool {
+		return gasPrices[i].SourceChainSelector < gasPrices[j].SourceChainSelector
 	})
-	return err
+
+	rowsUpserted, err := p.priceWriter.UpsertGasPricesForDestChain(ctx, p.destChainSelector, gasPrices)
+	if err != nil {
+		return nil, err
+	}
+	p.writeConsolidatedGasPrices(ctx, gasPrices)
+
+	p.metrics.rowsUpserted(gasPriceUpdate, rowsUpserted)
+	p.metrics.priceRowsWritten(gasPriceUpdate, p.jobId, rowsUpserted)
+	p.invalidatePricesCache()
+
+	writtenGasPricesUSD := make(map[uint64]*big.Int, len(gasPrices))
+	for _, gasPrice := range gasPrices {
+		writtenGasPricesUSD[gasPrice.SourceChainSelector] = gasPrice.GasPrice.ToInt()
+	}
+	return writtenGasPricesUSD, nil
 }
 
-func (p *priceService) writeTokenPricesToDB(ctx context.Context, tokenPricesUSD map[cciptypes.Address]*big.Int) error {
+// writeTokenPricesToDB writes the token prices along with the confidence in them, tokenPriceConfidence is nil or lacks
+// the tokens whose confidence is unknown. It returns the token prices left after the bounds and deviation filters,
+// which are the ones written.
+func (p *priceService) writeTokenPricesToDB(
+	ctx context.Context,
+	tokenPricesUSD map[cciptypes.Address]*big.Int,
+	tokenPriceConfidence map[cciptypes.Address]pricegetter.PriceConfidence,
+) (map[cciptypes.Address]*big.Int, error) {
 	if tokenPricesUSD == nil {
-		return nil
+		return nil, nil
 	}
 
-	var tokenPrices []cciporm.TokenPrice
-
+	observedTokenPrices := make([]cciporm.TokenPrice, 0, len(tokenPricesUSD))
 	for token, price := range tokenPricesUSD {
-		tokenPrices = append(tokenPrices, cciporm.TokenPrice{
+		tokenPrice := cciporm.TokenPrice{
 			TokenAddr:  string(token),
 			TokenPrice: assets.NewWei(price),
+			JobID:      p.jobId,
+		}
+		if confidence, ok := tokenPriceConfidence[token]; ok {
+			sourceCount := int32(confidence.SourceCount)
+			tokenPrice.Confidence = &confidence.Score
+			tokenPrice.SourceCount = &sourceCount
+		}
+		observedTokenPrices = append(observedTokenPrices, tokenPrice)
+	}
+	observedTokenPrices = p.filterDeviatedTokenPrices(ctx, p.filterTokenPricesWithinBounds(observedTokenPrices))
+
+	// Tokens are grouped by their update interval, the ORM skips tokens updated more recently than the interval
+	tokenPricesByInterval := make(map[time.Duration][]cciporm.TokenPrice)
+	for _, tokenPrice := range observedTokenPrices {
+		interval := p.tokenUpdateIntervalOf(cciptypes.Address(tokenPrice.TokenAddr))
+		tokenPricesByInterval[interval] = append(tokenPricesByInterval[interval], tokenPrice)
+	}
+
+	var totalRowsUpserted int64
+	var allTokenPrices []cciporm.TokenPrice
+	for _, interval := range slices.Sorted(maps.Keys(tokenPricesByInterval)) {
+		tokenPrices := tokenPricesByInterval[interval]
+
+		// Sort token by addr to make price updates ordering deterministic, easier for testing and debugging
+		sort.Slice(tokenPrices, func(i, j int) bool {
+			return tokenPrices[i].TokenAddr < tokenPrices[j].TokenAddr
 		})
+
+		rowsUpserted, err := p.priceWriter.UpsertTokenPricesForDestChain(ctx, p.destChainSelector, tokenPrices, interval)
+		if err != nil {
+			return nil, err
+		}
+		totalRowsUpserted += rowsUpserted
+		allTokenPrices = append(allTokenPrices, tokenPrices...)
 	}
+	p.writeConsolidatedTokenPrices(ctx, allTokenPrices)
 
-	// Sort token by addr to make price updates ordering deterministic, easier for testing and debugging
-	sort.Slice(tokenPrices, func(i, j int) bool {
-		return tokenPrices[i].TokenAddr < tokenPrices[j].TokenAddr
-	})
+	p.metrics.rowsUpserted(tokenPriceUpdate, totalRowsUpserted)
+	p.metrics.priceRowsWritten(tokenPriceUpdate, p.jobId, totalRowsUpserted)
+	p.invalidatePricesCache()
+	if len(allTokenPrices) == 0 {
+		return nil, nil
+	}
+
+	writtenTokenPricesUSD := make(map[cciptypes.Address]*big.Int, len(allTokenPrices))
+	for _, tokenPrice := range allTokenPrices {
+		writtenTokenPricesUSD[cciptypes.Address(tokenPrice.TokenAddr)] = tokenPrice.TokenPrice.ToInt()
+	}
+	return writtenTokenPricesUSD, nil
+}
+
+// getDestTokensDecimals returns the decimals of the given tokens. Only the tokens missing from the decimals cache are
+// fetched from the dest price registry, successfully fetched decimals are cached for the lifetime of the service.
+func (p *priceService) getDestTokensDecimals(ctx context.Context, destTokens []cciptypes.Address) ([]uint8, error) {
+	destTokensDecimals := make([]uint8, len(destTokens))
+	missingTokens := make([]cciptypes.Address, 0)
+	missingTokensIdx := make([]int, 0)
+
+	p.tokenDecimalsMu.RLock()
+	for i, token := range destTokens {
+		if decimals, ok := p.tokenDecimals[token]; ok {
+			destTokensDecimals[i] = decimals
+			continue
+		}
+		missingTokens = append(missingTokens, token)
+		missingTokensIdx = append(missingTokensIdx, i)
+	}
+	p.tokenDecimalsMu.RUnlock()
+
+	if len(missingTokens) == 0 {
+		return destTokensDecimals, nil
+	}
 
-	_, err := p.orm.UpsertTokenPricesForDestChain(ctx, p.destChainSelector, tokenPrices, p.tokenUpdateInterval)
-	return err
+	missingTokensDecimals, err := p.destPriceRegistryReader.GetTokensDecimals(ctx, missingTokens)
+	if err != nil {
+		return nil, fmt.Errorf("get tokens decimals: %w", err)
+	}
+
+	if len(missingTokensDecimals) != len(missingTokens) {
+		return nil, errors.New("mismatched token decimals and tokens")
+	}
+
+	p.tokenDecimalsMu.Lock()
+	defer p.tokenDecimalsMu.Unlock()
+	for i, decimals := range missingTokensDecimals {
+		destTokensDecimals[missingTokensIdx[i]] = decimals
+		p.tokenDecimals[missingTokens[i]] = decimals
+	}
+	return destTokensDecimals, nil
+}
+
+// getDestTokensDecimalsOneByOne returns the tokens whose decimals were fetched successfully along with their decimals,
+// the errors of the remaining tokens are added to failedTokens.
+func (p *priceService) getDestTokensDecimalsOneByOne(
+	ctx context.Context,
+	destTokens []cciptypes.Address,
+	failedTokens map[cciptypes.Address]error,
+) ([]cciptypes.Address, []uint8) {
+	tokens := make([]cciptypes.Address, 0, len(destTokens))
+	decimals := make([]uint8, 0, len(destTokens))
+	for _, token := range destTokens {
+		tokenDecimals, err := p.getDestTokensDecimals(ctx, []cciptypes.Address{token})
+		if err != nil {
+			failedTokens[token] = err
+			continue
+		}
+		tokens = append(tokens, token)
+		decimals = append(decimals, tokenDecimals[0])
+	}
+	return tokens, decimals
+}
+
+// filterTokens removes the dest tokens which are not allowlisted or are denylisted from rawTokenPricesUSD and returns them.
+// Tokens of other chains are kept, e.g. the source native price is required to find the missing dest native price.
+func (p *priceService) filterTokens(lggr logger.Logger, rawTokenPricesUSD map[ccipcommon.TokenID]*big.Int) []cciptypes.Address {
+	if len(p.tokenAllowlist) == 0 && len(p.tokenDenylist) == 0 {
+		return nil
+	}
+
+	filteredTokens := make([]cciptypes.Address, 0)
+	for tokenID := range rawTokenPricesUSD {
+		if tokenID.ChainSelector != p.destChainSelector {
+			continue
+		}
+		allowed := len(p.tokenAllowlist) == 0 || slices.Contains(p.tokenAllowlist, tokenID.TokenAddress)
+		if !allowed || slices.Contains(p.tokenDenylist, tokenID.TokenAddress) {
+			filteredTokens = append(filteredTokens, tokenID.TokenAddress)
+			delete(rawTokenPricesUSD, tokenID)
+		}
+	}
+	if len(filteredTokens) > 0 {
+		lggr.Infow("Skipping tokens filtered out by the token allowlist or denylist", "filteredTokens", filteredTokens)
+	}
+	return filteredTokens
+}
+
+// getUsdPerQuoteUnit returns the USD price of one quote currency unit, taken from rawTokenPricesUSD when already fetched.
+// It returns nil when prices are USD denominated.
+func (p *priceService) getUsdPerQuoteUnit(ctx context.Context, rawTokenPricesUSD map[ccipcommon.TokenID]*big.Int) (*big.Int, error) {
+	if p.quoteCurrency == nil {
+		return nil, nil
+	}
+
+	usdPerQuoteUnit, ok := rawTokenPricesUSD[p.quoteCurrency.Token]
+	if !ok {
+		quotePricesUSD, err := p.getTokenPricesUSD(ctx, []ccipcommon.TokenID{p.quoteCurrency.Token})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s quote currency price (%v): %w", p.quoteCurrency.Symbol, p.quoteCurrency.Token, err)
+		}
+		usdPerQuoteUnit = quotePricesUSD[p.quoteCurrency.Token]
+	}
+	if usdPerQuoteUnit == nil || usdPerQuoteUnit.Sign() <= 0 {
+		return nil, fmt.Errorf("missing %s quote currency price (%v)", p.quoteCurrency.Symbol, p.quoteCurrency.Token)
+	}
+	return usdPerQuoteUnit, nil
+}
+
+func (p *priceService) quoteCurrencySymbol() string {
+	if p.quoteCurrency == nil {
+		return "USD"
+	}
+	return p.quoteCurrency.Symbol
+}
+
+// tokenUpdateIntervalOf returns the update interval of the token, taking the per-token overrides into account.
+func (p *priceService) tokenUpdateIntervalOf(token cciptypes.Address) time.Duration {
+	if interval, ok := p.tokenUpdateIntervals[token]; ok {
+		return interval
+	}
+	return p.tokenUpdateInterval
+}
+
+// tokenTickInterval returns how often token prices are observed, it is the shortest of all token update intervals,
+// so that the tokens with a custom cadence are refreshed in time.
+func (p *priceService) tokenTickInterval() time.Duration {
+	tickInterval := p.tokenUpdateInterval
+	for _, interval := range p.tokenUpdateIntervals {
+		tickInterval = min(tickInterval, interval)
+	}
+	return tickInterval
+}
+
+// cleanupStalePrices deletes the prices of the dest chain which were not updated within the retention period.
+// The retention is much longer than any update interval, so only prices no lane writes anymore are deleted.
+func (p *priceService) cleanupStalePrices(ctx context.Context) error {
+	deleted, err := p.orm.CleanupStalePrices(ctx, p.destChainSelector, p.stalePriceRetention)
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		p.lggr.Infow("Deleted stale prices", "rows", deleted, "retention", p.stalePriceRetention)
+		p.invalidatePricesCache()
+	}
+	return nil
+}
+
+// invalidatePricesCache drops cached prices of the lane's dest chain, so that the next read picks up the latest write.
+func (p *priceService) invalidatePricesCache() {
+	p.pricesCache.Delete(strconv.FormatUint(p.destChainSelector, 10))
+}
+
+// updateHealth tracks consecutive failures of a background price update.
+type updateHealth struct {
+	mu                  sync.Mutex
+	consecutiveFailures int
+	lastErr             error
+}
+
+// record stores the result of a price update, a successful update resets the failure count.
+func (h *updateHealth) record(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err == nil {
+		h.consecutiveFailures = 0
+		h.lastErr = nil
+		return
+	}
+	h.consecutiveFailures++
+	h.lastErr = err
+}
+
+// err returns the last update error if the update has been failing for at least maxConsecutiveUpdateFailures times.
+func (h *updateHealth) err(name string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.consecutiveFailures < maxConsecutiveUpdateFailures {
+		return nil
+	}
+	return fmt.Errorf("%s failed %d consecutive times, last error: %w", name, h.consecutiveFailures, h.lastErr)
 }
 
 // Input price is USD per full token, with 18 decimal precision
@@ -477,3 +1728,27 @@ func calculateUsdPer1e18TokenAmount(price *big.Int, decimals uint8) *big.Int {
 	tmp := big.NewInt(0).Mul(price, big.NewInt(1e18))
 	return tmp.Div(tmp, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
 }
+
+// toQuoteCurrency converts a USD price to the quote currency, the price is returned as is when usdPerQuoteUnit is nil.
+func toQuoteCurrency(priceUSD *big.Int, usdPerQuoteUnit *big.Int) *big.Int {
+	if usdPerQuoteUnit == nil {
+		return priceUSD
+	}
+	return ccipcalc.ConvertUsdToQuote(priceUSD, usdPerQuoteUnit)
+}
+
+// gasPriceToQuoteCurrency converts a USD gas price to the quote currency. The execution and data availability components
+// of encoded gas prices are converted separately, as converting the encoded value would mix them up.
+func gasPriceToQuoteCurrency(gasPriceUSD *big.Int, usdPerQuoteUnit *big.Int) (*big.Int, error) {
+	if usdPerQuoteUnit == nil {
+		return gasPriceUSD, nil
+	}
+	execGasPriceUSD, daGasPriceUSD, err := prices.DecodeGasPriceComponents(gasPriceUSD)
+	if err != nil {
+		return nil, err
+	}
+	return prices.EncodeGasPriceComponents(
+		ccipcalc.ConvertUsdToQuote(execGasPriceUSD, usdPerQuoteUnit),
+		ccipcalc.ConvertUsdToQuote(daGasPriceUSD, usdPerQuoteUnit),
+	)
+}
@@ -5,10 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	chainselectors "github.com/smartcontractkit/chain-selectors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -25,7 +29,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	ccipconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
@@ -43,6 +49,9 @@ func TestPriceService_writeGasPrices(t *testing.T) {
 		{
 			SourceChainSelector: sourceChainSelector,
 			GasPrice:            assets.NewWei(gasPrice),
+			ExecGasPrice:        assets.NewWei(gasPrice),
+			DAGasPrice:          assets.NewWei(big.NewInt(0)),
+			JobID:               jobId,
 		},
 	}
 
@@ -84,8 +93,9 @@ func TestPriceService_writeGasPrices(t *testing.T) {
 				"",
 				nil,
 				nil,
+				PriceServiceOptions{},
 			).(*priceService)
-			err := priceService.writeGasPricesToDB(ctx, gasPrice)
+			_, err := priceService.writeGasPricesToDB(ctx, map[uint64]*big.Int{sourceChainSelector: gasPrice}, nil)
 			if tc.expectedErr {
 				assert.Error(t, err)
 			} else {
@@ -110,10 +120,12 @@ func TestPriceService_writeTokenPrices(t *testing.T) {
 		{
 			TokenAddr:  "0x123",
 			TokenPrice: assets.NewWei(big.NewInt(2e18)),
+			JobID:      jobId,
 		},
 		{
 			TokenAddr:  "0x234",
 			TokenPrice: assets.NewWei(big.NewInt(3e18)),
+			JobID:      jobId,
 		},
 	}
 
@@ -156,8 +168,9 @@ func TestPriceService_writeTokenPrices(t *testing.T) {
 				"",
 				nil,
 				nil,
+				PriceServiceOptions{},
 			).(*priceService)
-			err := priceService.writeTokenPricesToDB(ctx, tokenPrices)
+			_, err := priceService.writeTokenPricesToDB(ctx, tokenPrices, nil)
 			if tc.expectedErr {
 				assert.Error(t, err)
 			} else {
@@ -167,6 +180,114 @@ func TestPriceService_writeTokenPrices(t *testing.T) {
 	}
 }
 
+func TestPriceService_writeTokenPricesWithCustomIntervals(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(12345)
+	sourceChainSelector := uint64(67890)
+
+	tokenPrices := map[cciptypes.Address]*big.Int{
+		"0x123": big.NewInt(2e18),
+		"0x234": big.NewInt(3e18),
+		"0x345": big.NewInt(4e18),
+	}
+	tokenUpdateIntervals := map[cciptypes.Address]time.Duration{
+		"0x234": time.Minute,
+		"0x345": time.Minute,
+	}
+
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("UpsertTokenPricesForDestChain", ctx, destChainSelector, []cciporm.TokenPrice{
+		{TokenAddr: "0x234", TokenPrice: assets.NewWei(big.NewInt(3e18)), JobID: 1},
+		{TokenAddr: "0x345", TokenPrice: assets.NewWei(big.NewInt(4e18)), JobID: 1},
+	}, time.Minute).Return(int64(2), nil).Once()
+	mockOrm.On("UpsertTokenPricesForDestChain", ctx, destChainSelector, []cciporm.TokenPrice{
+		{TokenAddr: "0x123", TokenPrice: assets.NewWei(big.NewInt(2e18)), JobID: 1},
+	}, tokenPriceUpdateInterval).Return(int64(1), nil).Once()
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		1,
+		destChainSelector,
+		sourceChainSelector,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{TokenUpdateIntervals: tokenUpdateIntervals},
+	).(*priceService)
+
+	_, err := priceService.writeTokenPricesToDB(ctx, tokenPrices, nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, priceService.tokenTickInterval())
+}
+
+func TestPriceService_writeTokenPriceConfidence(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(12345)
+	confidence, sourceCount := 0.8, int32(4)
+
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("UpsertTokenPricesForDestChain", ctx, destChainSelector, []cciporm.TokenPrice{
+		{TokenAddr: "0x123", TokenPrice: assets.NewWei(big.NewInt(2e18)), Confidence: &confidence, SourceCount: &sourceCount, JobID: 1},
+		{TokenAddr: "0x234", TokenPrice: assets.NewWei(big.NewInt(3e18)), JobID: 1},
+	}, tokenPriceUpdateInterval).Return(int64(2), nil).Once()
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		1,
+		destChainSelector,
+		1,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{},
+	).(*priceService)
+
+	_, err := priceService.writeTokenPricesToDB(ctx, map[cciptypes.Address]*big.Int{
+		"0x123": big.NewInt(2e18),
+		"0x234": big.NewInt(3e18),
+	}, map[cciptypes.Address]pricegetter.PriceConfidence{
+		"0x123": {SourceCount: 4, Score: 0.8},
+	})
+	require.NoError(t, err)
+}
+
+func TestPriceService_tokenTickInterval(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		tokenUpdateIntervals map[cciptypes.Address]time.Duration
+		expectedInterval     time.Duration
+	}{
+		{
+			name:             "no overrides",
+			expectedInterval: tokenPriceUpdateInterval,
+		},
+		{
+			name: "shortest override is used",
+			tokenUpdateIntervals: map[cciptypes.Address]time.Duration{
+				"0x123": 30 * time.Second,
+				"0x234": 2 * time.Minute,
+			},
+			expectedInterval: 30 * time.Second,
+		},
+		{
+			name: "longer overrides do not slow down the default cadence",
+			tokenUpdateIntervals: map[cciptypes.Address]time.Duration{
+				"0x123": time.Hour,
+			},
+			expectedInterval: tokenPriceUpdateInterval,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			priceService := NewPriceService(logger.TestLogger(t), nil, 1, 1, 2, "", nil, nil, PriceServiceOptions{TokenUpdateIntervals: tc.tokenUpdateIntervals}).(*priceService)
+			assert.Equal(t, tc.expectedInterval, priceService.tokenTickInterval())
+		})
+	}
+}
+
 func TestPriceService_observeGasPriceUpdates(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	jobId := int32(1)
@@ -267,10 +388,11 @@ func TestPriceService_observeGasPriceUpdates(t *testing.T) {
 				sourceNativeTokenID.TokenAddress,
 				priceGetter,
 				nil,
+				PriceServiceOptions{},
 			).(*priceService)
 			priceService.gasPriceEstimator = gasPriceEstimator
 
-			sourceGasPriceUSD, err := priceService.observeGasPriceUpdates(context.Background(), lggr)
+			sourceGasPriceUSD, _, err := priceService.observeGasPriceUpdates(context.Background(), lggr)
 			if tc.expErr {
 				assert.Error(t, err)
 				return
@@ -488,10 +610,116 @@ func TestPriceService_observeTokenPriceUpdates(t *testing.T) {
 				tc.sourceNativeToken.TokenAddress,
 				priceGetter,
 				offRampReader,
+				PriceServiceOptions{},
+			).(*priceService)
+			priceService.destPriceRegistryReader = destPriceReg
+
+			tokenPricesUSD, _, err := priceService.observeTokenPriceUpdates(context.Background(), lggr)
+			if tc.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expTokenPricesUSD, tokenPricesUSD)
+		})
+	}
+}
+
+func TestPriceService_observeTokenPriceUpdatesPartial(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	destChain := chainselectors.TEST_1338
+	sourceChain := chainselectors.TEST_1000
+
+	sourceNative := ccipcommon.TokenID{TokenAddress: "0x0001", ChainSelector: sourceChain.Selector}
+	token1 := ccipcommon.TokenID{TokenAddress: "0x0002", ChainSelector: destChain.Selector}
+	token2 := ccipcommon.TokenID{TokenAddress: "0x0003", ChainSelector: destChain.Selector}
+	token3 := ccipcommon.TokenID{TokenAddress: "0x0004", ChainSelector: destChain.Selector}
+
+	testCases := []struct {
+		name                string
+		priceGetterRespData map[ccipcommon.TokenID]*big.Int
+		batchDecimalsErr    bool
+		failingDecimals     []cciptypes.Address
+		expTokenPricesUSD   map[cciptypes.Address]*big.Int
+		expErr              bool
+	}{
+		{
+			name: "token with nil price is skipped",
+			priceGetterRespData: map[ccipcommon.TokenID]*big.Int{
+				sourceNative: val1e18(100),
+				token1:       nil,
+				token2:       val1e18(200),
+				token3:       val1e18(300),
+			},
+			expTokenPricesUSD: map[cciptypes.Address]*big.Int{
+				token2.TokenAddress: val1e18(200),
+				token3.TokenAddress: val1e18(300),
+			},
+		},
+		{
+			name: "token with failing decimals is skipped",
+			priceGetterRespData: map[ccipcommon.TokenID]*big.Int{
+				sourceNative: val1e18(100),
+				token1:       val1e18(100),
+				token2:       val1e18(200),
+				token3:       val1e18(300),
+			},
+			batchDecimalsErr: true,
+			failingDecimals:  []cciptypes.Address{token2.TokenAddress},
+			expTokenPricesUSD: map[cciptypes.Address]*big.Int{
+				token1.TokenAddress: val1e18(100),
+				token3.TokenAddress: val1e18(300),
+			},
+		},
+		{
+			name: "all tokens failing returns an error",
+			priceGetterRespData: map[ccipcommon.TokenID]*big.Int{
+				sourceNative: val1e18(100),
+				token1:       nil,
+				token2:       val1e18(200),
+			},
+			batchDecimalsErr: true,
+			failingDecimals:  []cciptypes.Address{token2.TokenAddress},
+			expErr:           true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+			priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(tc.priceGetterRespData, nil)
+
+			offRampReader := ccipdatamocks.NewOffRampReader(t)
+			offRampReader.EXPECT().GetTokens(mock.Anything).Return(cciptypes.OffRampTokens{}, nil).Maybe()
+
+			destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+			destPriceReg.EXPECT().GetFeeTokens(mock.Anything).Return(nil, nil).Maybe()
+			destPriceReg.EXPECT().GetTokensDecimals(mock.Anything, mock.Anything).RunAndReturn(
+				func(ctx context.Context, tokens []cciptypes.Address) ([]uint8, error) {
+					decimals := make([]uint8, 0, len(tokens))
+					for _, token := range tokens {
+						if slices.Contains(tc.failingDecimals, token) || (tc.batchDecimalsErr && len(tokens) > 1) {
+							return nil, errors.New("token not found")
+						}
+						decimals = append(decimals, 18)
+					}
+					return decimals, nil
+				})
+
+			priceService := NewPriceService(
+				lggr,
+				nil,
+				1,
+				destChain.Selector,
+				sourceChain.Selector,
+				sourceNative.TokenAddress,
+				priceGetter,
+				offRampReader,
+				PriceServiceOptions{AllowPartialTokenPriceUpdates: true},
 			).(*priceService)
 			priceService.destPriceRegistryReader = destPriceReg
 
-			tokenPricesUSD, err := priceService.observeTokenPriceUpdates(context.Background(), lggr)
+			tokenPricesUSD, _, err := priceService.observeTokenPriceUpdates(tests.Context(t), lggr)
 			if tc.expErr {
 				assert.Error(t, err)
 				return
@@ -502,6 +730,119 @@ func TestPriceService_observeTokenPriceUpdates(t *testing.T) {
 	}
 }
 
+func TestPriceService_observeTokenPriceUpdatesFiltered(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	destChain := chainselectors.TEST_1338
+	sourceChain := chainselectors.TEST_1000
+
+	sourceNative := ccipcommon.TokenID{TokenAddress: "0x0001", ChainSelector: sourceChain.Selector}
+	token1 := ccipcommon.TokenID{TokenAddress: "0x0002", ChainSelector: destChain.Selector}
+	token2 := ccipcommon.TokenID{TokenAddress: "0x0003", ChainSelector: destChain.Selector}
+	token3 := ccipcommon.TokenID{TokenAddress: "0x0004", ChainSelector: destChain.Selector}
+
+	testCases := []struct {
+		name              string
+		opts              PriceServiceOptions
+		expTokenPricesUSD map[cciptypes.Address]*big.Int
+	}{
+		{
+			name: "allowlist",
+			opts: PriceServiceOptions{TokenAllowlist: []cciptypes.Address{token1.TokenAddress}},
+			expTokenPricesUSD: map[cciptypes.Address]*big.Int{
+				token1.TokenAddress: val1e18(3),
+			},
+		},
+		{
+			name: "denylisted token with a nil price does not fail the update",
+			opts: PriceServiceOptions{TokenDenylist: []cciptypes.Address{token3.TokenAddress}},
+			expTokenPricesUSD: map[cciptypes.Address]*big.Int{
+				token1.TokenAddress: val1e18(3),
+				token2.TokenAddress: val1e18(4),
+			},
+		},
+		{
+			name: "allowlist and denylist",
+			opts: PriceServiceOptions{
+				TokenAllowlist: []cciptypes.Address{token1.TokenAddress, token2.TokenAddress},
+				TokenDenylist:  []cciptypes.Address{token1.TokenAddress},
+			},
+			expTokenPricesUSD: map[cciptypes.Address]*big.Int{
+				token2.TokenAddress: val1e18(4),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+			priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(map[ccipcommon.TokenID]*big.Int{
+				sourceNative: val1e18(2),
+				token1:       val1e18(3),
+				token2:       val1e18(4),
+				token3:       nil,
+			}, nil)
+
+			offRampReader := ccipdatamocks.NewOffRampReader(t)
+			offRampReader.EXPECT().GetTokens(mock.Anything).Return(cciptypes.OffRampTokens{}, nil).Maybe()
+
+			destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+			destPriceReg.EXPECT().GetFeeTokens(mock.Anything).Return(nil, nil).Maybe()
+			destPriceReg.EXPECT().GetTokensDecimals(mock.Anything, mock.Anything).RunAndReturn(
+				func(ctx context.Context, tokens []cciptypes.Address) ([]uint8, error) {
+					return slices.Repeat([]uint8{18}, len(tokens)), nil
+				})
+
+			priceService := NewPriceService(
+				lggr,
+				nil,
+				1,
+				destChain.Selector,
+				sourceChain.Selector,
+				sourceNative.TokenAddress,
+				priceGetter,
+				offRampReader,
+				tc.opts,
+			).(*priceService)
+			priceService.destPriceRegistryReader = destPriceReg
+
+			tokenPricesUSD, _, err := priceService.observeTokenPriceUpdates(tests.Context(t), lggr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expTokenPricesUSD, tokenPricesUSD)
+		})
+	}
+}
+
+func TestPriceService_getDestTokensDecimalsCached(t *testing.T) {
+	ctx := tests.Context(t)
+	tokenA, tokenB, tokenC := cciptypes.Address("0x0001"), cciptypes.Address("0x0002"), cciptypes.Address("0x0003")
+
+	destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+	destPriceReg.EXPECT().GetTokensDecimals(mock.Anything, []cciptypes.Address{tokenA, tokenB}).Return([]uint8{18, 6}, nil).Once()
+	destPriceReg.EXPECT().GetTokensDecimals(mock.Anything, []cciptypes.Address{tokenC}).Return(nil, errors.New("rpc error")).Once()
+	destPriceReg.EXPECT().GetTokensDecimals(mock.Anything, []cciptypes.Address{tokenC}).Return([]uint8{8}, nil).Once()
+
+	priceService := NewPriceService(logger.TestLogger(t), nil, 1, 12345, 67890, "", nil, nil, PriceServiceOptions{}).(*priceService)
+	priceService.destPriceRegistryReader = destPriceReg
+
+	decimals, err := priceService.getDestTokensDecimals(ctx, []cciptypes.Address{tokenA, tokenB})
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{18, 6}, decimals)
+
+	// Failed fetches are not cached
+	_, err = priceService.getDestTokensDecimals(ctx, []cciptypes.Address{tokenA, tokenB, tokenC})
+	require.Error(t, err)
+
+	// Only the token missing from the cache is fetched
+	decimals, err = priceService.getDestTokensDecimals(ctx, []cciptypes.Address{tokenA, tokenB, tokenC})
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{18, 6, 8}, decimals)
+
+	// Everything is served from the cache
+	decimals, err = priceService.getDestTokensDecimals(ctx, []cciptypes.Address{tokenC, tokenA})
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{8, 18}, decimals)
+}
+
 func TestPriceService_calculateUsdPer1e18TokenAmount(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -718,6 +1059,7 @@ func TestPriceService_GetGasAndTokenPrices(t *testing.T) {
 				"",
 				nil,
 				nil,
+				PriceServiceOptions{},
 			).(*priceService)
 			gasPricesResult, tokenPricesResult, err := priceService.GetGasAndTokenPrices(ctx, destChainSelector)
 			if tc.expectedErr {
@@ -731,6 +1073,724 @@ func TestPriceService_GetGasAndTokenPrices(t *testing.T) {
 	}
 }
 
+func TestPriceService_GetGasAndTokenPricesWithTimestamps(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	jobId := int32(1)
+	destChainSelector := uint64(12345)
+	sourceChainSelector := uint64(67890)
+	ctx := tests.Context(t)
+
+	token1 := ccipcalc.HexToAddress("0x123")
+	token2 := ccipcalc.HexToAddress("0x234")
+
+	gasUpdatedAt := time.Now().Add(-time.Minute).UTC()
+	token1UpdatedAt := time.Now().Add(-time.Hour).UTC()
+
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return([]cciporm.GasPrice{
+		{
+			SourceChainSelector: sourceChainSelector,
+			GasPrice:            assets.NewWei(big.NewInt(1e18)),
+			UpdatedAt:           gasUpdatedAt,
+		},
+	}, nil).Once()
+	mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return([]cciporm.TokenPrice{
+		{
+			TokenAddr:  string(token1),
+			TokenPrice: assets.NewWei(big.NewInt(2e18)),
+			UpdatedAt:  token1UpdatedAt,
+		},
+		{
+			TokenAddr:  string(token2),
+			TokenPrice: nil,
+			UpdatedAt:  time.Now(),
+		},
+	}, nil).Once()
+
+	priceService := NewPriceService(
+		lggr,
+		mockOrm,
+		jobId,
+		destChainSelector,
+		sourceChainSelector,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{},
+	).(*priceService)
+
+	gasPrices, tokenPrices, err := priceService.GetGasAndTokenPricesWithTimestamps(ctx, destChainSelector)
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]TimestampedPrice{
+		sourceChainSelector: {Value: big.NewInt(1e18), UpdatedAt: gasUpdatedAt},
+	}, gasPrices)
+	assert.Equal(t, map[cciptypes.Address]TimestampedPrice{
+		token1: {Value: big.NewInt(2e18), UpdatedAt: token1UpdatedAt},
+	}, tokenPrices)
+}
+
+func TestPriceService_GetGasAndTokenPricesCached(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	jobId := int32(1)
+	destChainSelector := uint64(12345)
+	sourceChainSelector := uint64(67890)
+	ctx := tests.Context(t)
+
+	gasPrice := big.NewInt(1e18)
+	ormGasPrices := []cciporm.GasPrice{{SourceChainSelector: sourceChainSelector, GasPrice: assets.NewWei(gasPrice)}}
+
+	mockOrm := ccipmocks.NewORM(t)
+	// the first two reads are served by a single DB query
+	mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return(ormGasPrices, nil).Once()
+	mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return(nil, nil).Once()
+
+	priceService := NewPriceService(
+		lggr,
+		mockOrm,
+		jobId,
+		destChainSelector,
+		sourceChainSelector,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{},
+	).(*priceService)
+
+	for i := 0; i < 2; i++ {
+		gasPrices, tokenPrices, err := priceService.GetGasAndTokenPrices(ctx, destChainSelector)
+		require.NoError(t, err)
+		assert.Equal(t, map[uint64]*big.Int{sourceChainSelector: gasPrice}, gasPrices)
+		assert.Empty(t, tokenPrices)
+	}
+
+	// successful write invalidates the cache
+	newGasPrice := big.NewInt(2e18)
+	mockOrm.On("UpsertGasPricesForDestChain", ctx, destChainSelector, mock.Anything).Return(int64(1), nil).Once()
+	_, err := priceService.writeGasPricesToDB(ctx, map[uint64]*big.Int{sourceChainSelector: newGasPrice}, nil)
+	require.NoError(t, err)
+
+	mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).
+		Return([]cciporm.GasPrice{{SourceChainSelector: sourceChainSelector, GasPrice: assets.NewWei(newGasPrice)}}, nil).Once()
+	mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return(nil, nil).Once()
+
+	gasPrices, _, err := priceService.GetGasAndTokenPrices(ctx, destChainSelector)
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]*big.Int{sourceChainSelector: newGasPrice}, gasPrices)
+}
+
+func TestPriceService_GetGasAndTokenPricesConsistentReads(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(12345)
+	sourceChainSelector := uint64(67890)
+	token := cciptypes.Address("0x1")
+
+	newPriceService := func(mockOrm *ccipmocks.ORM) *priceService {
+		return NewPriceService(
+			logger.TestLogger(t),
+			mockOrm,
+			1,
+			destChainSelector,
+			sourceChainSelector,
+			"",
+			nil,
+			nil,
+			PriceServiceOptions{ConsistentPriceReads: true},
+		).(*priceService)
+	}
+	gasPricesV := func(price int64, version int64) []cciporm.GasPrice {
+		return []cciporm.GasPrice{{SourceChainSelector: sourceChainSelector, GasPrice: assets.NewWei(big.NewInt(price)), Version: version}}
+	}
+	tokenPricesV := func(price int64, version int64) []cciporm.TokenPrice {
+		return []cciporm.TokenPrice{{TokenAddr: string(token), TokenPrice: assets.NewWei(big.NewInt(price)), Version: version}}
+	}
+
+	t.Run("prices written in between reads are read again", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		// The gas price was written after the token price was read
+		mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return(gasPricesV(1, 1), nil).Once()
+		mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return(tokenPricesV(1, 2), nil).Once()
+		mockOrm.On("GetPriceVersion", ctx, destChainSelector).Return(int64(3), nil).Once()
+		mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return(gasPricesV(2, 3), nil).Once()
+		mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return(tokenPricesV(1, 2), nil).Once()
+		mockOrm.On("GetPriceVersion", ctx, destChainSelector).Return(int64(3), nil).Once()
+
+		gasPrices, tokenPrices, err := newPriceService(mockOrm).GetGasAndTokenPrices(ctx, destChainSelector)
+		require.NoError(t, err)
+		assert.Equal(t, map[uint64]*big.Int{sourceChainSelector: big.NewInt(2)}, gasPrices)
+		assert.Equal(t, map[cciptypes.Address]*big.Int{token: big.NewInt(1)}, tokenPrices)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return(gasPricesV(1, 1), nil).Times(maxConsistentPriceReadAttempts)
+		mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return(tokenPricesV(1, 2), nil).Times(maxConsistentPriceReadAttempts)
+		mockOrm.On("GetPriceVersion", ctx, destChainSelector).Return(int64(10), nil).Times(maxConsistentPriceReadAttempts)
+
+		gasPrices, _, err := newPriceService(mockOrm).GetGasAndTokenPrices(ctx, destChainSelector)
+		require.NoError(t, err)
+		assert.Equal(t, map[uint64]*big.Int{sourceChainSelector: big.NewInt(1)}, gasPrices)
+	})
+
+	t.Run("version error", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return(gasPricesV(1, 1), nil).Once()
+		mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return(tokenPricesV(1, 2), nil).Once()
+		mockOrm.On("GetPriceVersion", ctx, destChainSelector).Return(int64(0), errors.New("db error")).Once()
+
+		_, _, err := newPriceService(mockOrm).GetGasAndTokenPrices(ctx, destChainSelector)
+		require.ErrorContains(t, err, "db error")
+	})
+}
+
+func TestPriceService_GetGasAndTokenPricesMaxPriceAge(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(12345)
+	now := time.Now()
+
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return([]cciporm.GasPrice{
+		{SourceChainSelector: 1, GasPrice: assets.NewWei(big.NewInt(1e18)), UpdatedAt: now.Add(-time.Minute)},
+		{SourceChainSelector: 2, GasPrice: assets.NewWei(big.NewInt(2e18)), UpdatedAt: now.Add(-2 * time.Hour)},
+	}, nil).Once()
+	mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return([]cciporm.TokenPrice{
+		{TokenAddr: "0x123", TokenPrice: assets.NewWei(big.NewInt(3e18)), UpdatedAt: now.Add(-time.Minute)},
+		{TokenAddr: "0x234", TokenPrice: assets.NewWei(big.NewInt(4e18)), UpdatedAt: now.Add(-2 * time.Hour)},
+	}, nil).Once()
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		1,
+		destChainSelector,
+		1,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{MaxPriceAge: time.Hour},
+	).(*priceService)
+
+	gasPrices, tokenPrices, err := priceService.GetGasAndTokenPrices(ctx, destChainSelector)
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]*big.Int{1: big.NewInt(1e18)}, gasPrices)
+	assert.Equal(t, map[cciptypes.Address]*big.Int{"0x123": big.NewInt(3e18)}, tokenPrices)
+
+	// timestamps are returned regardless of their age, callers decide what to do with them
+	gasPricesWithTs, tokenPricesWithTs, err := priceService.GetGasAndTokenPricesWithTimestamps(ctx, destChainSelector)
+	require.NoError(t, err)
+	assert.Len(t, gasPricesWithTs, 2)
+	assert.Len(t, tokenPricesWithTs, 2)
+}
+
+func TestPriceService_GetGasAndTokenPricesMinConfidence(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(12345)
+	lowConfidence, highConfidence, sourceCount := 0.4, 0.9, int32(2)
+
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return([]cciporm.GasPrice{
+		{SourceChainSelector: 1, GasPrice: assets.NewWei(big.NewInt(1e18))},
+	}, nil).Once()
+	mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return([]cciporm.TokenPrice{
+		{TokenAddr: "0x123", TokenPrice: assets.NewWei(big.NewInt(3e18)), Confidence: &lowConfidence, SourceCount: &sourceCount},
+		{TokenAddr: "0x234", TokenPrice: assets.NewWei(big.NewInt(4e18)), Confidence: &highConfidence, SourceCount: &sourceCount},
+		{TokenAddr: "0x345", TokenPrice: assets.NewWei(big.NewInt(5e18))},
+	}, nil).Once()
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		1,
+		destChainSelector,
+		1,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{MinTokenPriceConfidence: 0.5},
+	).(*priceService)
+
+	// Prices without a known confidence are kept
+	_, tokenPrices, err := priceService.GetGasAndTokenPrices(ctx, destChainSelector)
+	require.NoError(t, err)
+	assert.Equal(t, map[cciptypes.Address]*big.Int{"0x234": big.NewInt(4e18), "0x345": big.NewInt(5e18)}, tokenPrices)
+
+	_, tokenPricesWithTs, err := priceService.GetGasAndTokenPricesWithTimestamps(ctx, destChainSelector)
+	require.NoError(t, err)
+	require.Len(t, tokenPricesWithTs, 3)
+	assert.Equal(t, &pricegetter.PriceConfidence{SourceCount: 2, Score: 0.4}, tokenPricesWithTs["0x123"].Confidence)
+	assert.Nil(t, tokenPricesWithTs["0x345"].Confidence)
+}
+
+func TestPriceService_runGasPriceUpdateMultipleSources(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(1)
+	sources := []ccipcommon.TokenID{
+		{TokenAddress: "0x0001", ChainSelector: 100},
+		{TokenAddress: "0x0002", ChainSelector: 200},
+		{TokenAddress: "0x0003", ChainSelector: 300},
+	}
+
+	priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+	estimators := make([]*prices.MockGasPriceEstimatorCommit, len(sources))
+	for i, source := range sources {
+		priceGetter.EXPECT().GetTokenPricesUSD(mock.Anything, []ccipcommon.TokenID{source}).
+			Return(map[ccipcommon.TokenID]*big.Int{source: val1e18(int64(i + 1))}, nil)
+
+		estimators[i] = prices.NewMockGasPriceEstimatorCommit(t)
+		if i == 2 {
+			estimators[i].On("GetGasPrice", mock.Anything).Return(nil, errors.New("rpc error"))
+			continue
+		}
+		estimators[i].On("GetGasPrice", mock.Anything).Return(big.NewInt(10), nil)
+		estimators[i].On("DenoteInUSD", mock.Anything, big.NewInt(10), val1e18(int64(i+1))).Return(big.NewInt(int64(10*(i+1))), nil)
+	}
+
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("UpsertGasPricesForDestChain", ctx, destChainSelector, []cciporm.GasPrice{
+		{SourceChainSelector: 100, GasPrice: assets.NewWei(big.NewInt(10)), ExecGasPrice: assets.NewWei(big.NewInt(10)), DAGasPrice: assets.NewWei(big.NewInt(0)), GasPriceWei: assets.NewWei(big.NewInt(10)), JobID: 1},
+		{SourceChainSelector: 200, GasPrice: assets.NewWei(big.NewInt(20)), ExecGasPrice: assets.NewWei(big.NewInt(20)), DAGasPrice: assets.NewWei(big.NewInt(0)), GasPriceWei: assets.NewWei(big.NewInt(10)), JobID: 1},
+	}).Return(int64(2), nil).Once()
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		1,
+		destChainSelector,
+		sources[0].ChainSelector,
+		sources[0].TokenAddress,
+		priceGetter,
+		nil,
+		PriceServiceOptions{
+			AdditionalGasPriceSources: []GasPriceSource{
+				{SourceChainSelector: sources[1].ChainSelector, SourceNative: sources[1].TokenAddress, GasPriceEstimator: estimators[1]},
+				{SourceChainSelector: sources[2].ChainSelector, SourceNative: sources[2].TokenAddress, GasPriceEstimator: estimators[2]},
+			},
+		},
+	).(*priceService)
+	priceService.gasPriceEstimator = estimators[0]
+
+	events, unsubscribe := priceService.SubscribePriceUpdates()
+	defer unsubscribe()
+
+	// prices of the healthy sources are written, the failing source is skipped without failing the update
+	require.NoError(t, priceService.runGasPriceUpdate(ctx))
+	assert.Equal(t, float64(1), testutil.ToFloat64(gasPriceSourceFailures.WithLabelValues("100", "1", "300")))
+
+	publishedSources := make([]uint64, 0, 2)
+	for range 2 {
+		publishedSources = append(publishedSources, (<-events).SourceChainSelector)
+	}
+	assert.ElementsMatch(t, []uint64{100, 200}, publishedSources)
+}
+
+func TestPriceService_gasPriceComponents(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(1)
+	sourceChainSelector := uint64(2)
+
+	execGasPrice := big.NewInt(1e9)
+	daGasPrice := big.NewInt(2e9)
+	encodedGasPrice := new(big.Int).Add(new(big.Int).Lsh(daGasPrice, 112), execGasPrice)
+
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("UpsertGasPricesForDestChain", ctx, destChainSelector, []cciporm.GasPrice{{
+		SourceChainSelector: sourceChainSelector,
+		GasPrice:            assets.NewWei(encodedGasPrice),
+		ExecGasPrice:        assets.NewWei(execGasPrice),
+		DAGasPrice:          assets.NewWei(daGasPrice),
+		JobID:               1,
+	}}).Return(int64(1), nil).Once()
+	mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return([]cciporm.GasPrice{
+		{
+			SourceChainSelector: sourceChainSelector,
+			GasPrice:            assets.NewWei(encodedGasPrice),
+			ExecGasPrice:        assets.NewWei(execGasPrice),
+			DAGasPrice:          assets.NewWei(daGasPrice),
+			GasPriceWei:         assets.NewWei(encodedGasPrice),
+		},
+		{
+			// written before the breakdown was stored
+			SourceChainSelector: sourceChainSelector + 1,
+			GasPrice:            assets.NewWei(execGasPrice),
+		},
+	}, nil).Once()
+	mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return(nil, nil).Once()
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		1,
+		destChainSelector,
+		sourceChainSelector,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{},
+	).(*priceService)
+
+	_, err := priceService.writeGasPricesToDB(ctx, map[uint64]*big.Int{sourceChainSelector: encodedGasPrice}, nil)
+	require.NoError(t, err)
+
+	gasPrices, _, err := priceService.GetGasAndTokenPricesWithTimestamps(ctx, destChainSelector)
+	require.NoError(t, err)
+	assert.Equal(t, &GasPriceComponents{ExecGasPrice: execGasPrice, DAGasPrice: daGasPrice}, gasPrices[sourceChainSelector].GasPriceComponents)
+	assert.Equal(t, encodedGasPrice, gasPrices[sourceChainSelector].GasPriceWei)
+	assert.Nil(t, gasPrices[sourceChainSelector+1].GasPriceComponents)
+	assert.Nil(t, gasPrices[sourceChainSelector+1].GasPriceWei)
+}
+
+func TestPriceService_gasPriceFees(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(1)
+	sourceChainSelector := uint64(2)
+	sourceNativeTokenID := ccipcommon.TokenID{TokenAddress: "0x123", ChainSelector: sourceChainSelector}
+
+	priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+	priceGetter.EXPECT().GetTokenPricesUSD(mock.Anything, []ccipcommon.TokenID{sourceNativeTokenID}).
+		Return(map[ccipcommon.TokenID]*big.Int{sourceNativeTokenID: big.NewInt(2e18)}, nil)
+
+	feeHistory := prices.NewMockFeeHistoryReader(t)
+	feeHistory.EXPECT().FeeHistory(mock.Anything, mock.Anything, mock.Anything, []float64{50}).Return(&ethereum.FeeHistory{
+		BaseFee: []*big.Int{big.NewInt(9e8), big.NewInt(1e9)},
+		Reward:  [][]*big.Int{{big.NewInt(1e8)}},
+	}, nil)
+	underlying := prices.NewMockGasPriceEstimatorCommit(t)
+	underlying.EXPECT().GetGasPrice(mock.Anything).Return(big.NewInt(5e8), nil)
+	underlying.EXPECT().DenoteInUSD(mock.Anything, big.NewInt(11e8), big.NewInt(2e18)).Return(big.NewInt(22e8), nil)
+	gasPriceEstimator, err := prices.NewEIP1559GasPriceEstimator(underlying, feeHistory, nil,
+		ccipconfig.EIP1559GasPriceEstimatorConfig{BaseFeeMultiplier: 1, PriorityFeePercentile: 50})
+	require.NoError(t, err)
+
+	// The fees are denominated in USD like the gas price
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("UpsertGasPricesForDestChain", ctx, destChainSelector, []cciporm.GasPrice{{
+		SourceChainSelector: sourceChainSelector,
+		GasPrice:            assets.NewWei(big.NewInt(22e8)),
+		ExecGasPrice:        assets.NewWei(big.NewInt(22e8)),
+		DAGasPrice:          assets.NewWei(big.NewInt(0)),
+		BaseFee:             assets.NewWei(big.NewInt(2e9)),
+		PriorityFee:         assets.NewWei(big.NewInt(2e8)),
+		GasPriceWei:         assets.NewWei(big.NewInt(11e8)),
+		JobID:               1,
+	}}).Return(int64(1), nil).Once()
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		1,
+		destChainSelector,
+		sourceChainSelector,
+		sourceNativeTokenID.TokenAddress,
+		priceGetter,
+		nil,
+		PriceServiceOptions{},
+	).(*priceService)
+	priceService.gasPriceEstimator = gasPriceEstimator
+
+	require.NoError(t, priceService.runGasPriceUpdate(ctx))
+}
+
+func TestPriceService_HealthReport(t *testing.T) {
+	ctx := tests.Context(t)
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		ccipmocks.NewORM(t),
+		int32(1),
+		uint64(12345),
+		uint64(67890),
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{},
+	).(*priceService)
+
+	require.NoError(t, priceService.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, priceService.Close()) })
+	require.NoError(t, priceService.HealthReport()[priceService.Name()])
+
+	gasErr := errors.New("gas price estimator unavailable")
+	for i := 0; i < maxConsecutiveUpdateFailures-1; i++ {
+		priceService.gasUpdateHealth.record(gasErr)
+	}
+	// a few failures are tolerated
+	require.NoError(t, priceService.HealthReport()[priceService.Name()])
+
+	priceService.gasUpdateHealth.record(gasErr)
+	err := priceService.HealthReport()[priceService.Name()]
+	require.Error(t, err)
+	assert.ErrorIs(t, err, gasErr)
+
+	// a successful update makes the service healthy again
+	priceService.gasUpdateHealth.record(nil)
+	require.NoError(t, priceService.HealthReport()[priceService.Name()])
+}
+
+func TestPriceService_runWithRetry(t *testing.T) {
+	ctx := tests.Context(t)
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		nil,
+		int32(1),
+		uint64(12345),
+		uint64(67890),
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{},
+	).(*priceService)
+	priceService.updateRetryConfig = ccipdata.RetryConfig{
+		InitialDelay: time.Millisecond,
+		MaxDelay:     10 * time.Millisecond,
+		MaxRetries:   3,
+	}
+
+	t.Run("recovers from transient errors", func(t *testing.T) {
+		attempts := 0
+		err := priceService.runWithRetry(ctx, gasPriceUpdate, func(context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return errors.New("transient error")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("returns last error once retries are exhausted", func(t *testing.T) {
+		attempts := 0
+		err := priceService.runWithRetry(ctx, tokenPriceUpdate, func(context.Context) error {
+			attempts++
+			return fmt.Errorf("attempt %d failed", attempts)
+		})
+		require.EqualError(t, err, "attempt 3 failed")
+		assert.Equal(t, 3, attempts)
+	})
+}
+
+func TestPriceService_ObserveOnly(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.TestLogger(t)
+	destChain := chainselectors.TEST_1338
+	sourceChain := chainselectors.TEST_1000
+
+	sourceNative := ccipcommon.TokenID{TokenAddress: "0x0001", ChainSelector: sourceChain.Selector}
+	destToken := ccipcommon.TokenID{TokenAddress: "0x0002", ChainSelector: destChain.Selector}
+	gasPrice := big.NewInt(10)
+
+	newPriceService := func(t *testing.T) *priceService {
+		priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceGetter.EXPECT().GetTokenPricesUSD(mock.Anything, []ccipcommon.TokenID{sourceNative}).
+			Return(map[ccipcommon.TokenID]*big.Int{sourceNative: val1e18(2)}, nil).Maybe()
+		priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(map[ccipcommon.TokenID]*big.Int{
+			sourceNative: val1e18(2),
+			destToken:    val1e18(3),
+		}, nil).Maybe()
+
+		offRampReader := ccipdatamocks.NewOffRampReader(t)
+		offRampReader.EXPECT().GetTokens(mock.Anything).Return(cciptypes.OffRampTokens{}, nil).Maybe()
+
+		// No ORM expectations, observing must not write anything into the DB
+		return NewPriceService(
+			lggr,
+			ccipmocks.NewORM(t),
+			1,
+			destChain.Selector,
+			sourceChain.Selector,
+			sourceNative.TokenAddress,
+			priceGetter,
+			offRampReader,
+			PriceServiceOptions{},
+		).(*priceService)
+	}
+
+	t.Run("observes gas and token prices without writing them", func(t *testing.T) {
+		priceService := newPriceService(t)
+
+		gasPriceEstimator := prices.NewMockGasPriceEstimatorCommit(t)
+		gasPriceEstimator.On("GetGasPrice", mock.Anything).Return(gasPrice, nil)
+		pUSD := ccipcalc.CalculateUsdPerUnitGas(gasPrice, val1e18(2))
+		gasPriceEstimator.On("DenoteInUSD", mock.Anything, mock.Anything, mock.Anything).Return(pUSD, nil)
+		priceService.gasPriceEstimator = gasPriceEstimator
+
+		destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+		destPriceReg.On("GetFeeTokens", mock.Anything).Return([]cciptypes.Address{destToken.TokenAddress}, nil)
+		destPriceReg.On("GetTokensDecimals", mock.Anything, []cciptypes.Address{destToken.TokenAddress}).Return([]uint8{18}, nil)
+		priceService.destPriceRegistryReader = destPriceReg
+
+		observedPrices, err := priceService.ObserveOnly(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, ObservedPrices{
+			SourceGasPriceUSD:      pUSD,
+			AdditionalGasPricesUSD: map[uint64]*big.Int{},
+			TokenPricesUSD:         map[cciptypes.Address]*big.Int{destToken.TokenAddress: val1e18(3)},
+		}, observedPrices)
+	})
+
+	t.Run("fails before dynamic config is set", func(t *testing.T) {
+		_, err := newPriceService(t).ObserveOnly(ctx)
+		require.ErrorContains(t, err, "gasPriceEstimator is not set yet")
+	})
+}
+
+func TestPriceService_quoteCurrency(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.TestLogger(t)
+	destChain := chainselectors.TEST_1338
+	sourceChain := chainselectors.TEST_1000
+
+	sourceNative := ccipcommon.TokenID{TokenAddress: "0x0001", ChainSelector: sourceChain.Selector}
+	destToken := ccipcommon.TokenID{TokenAddress: "0x0002", ChainSelector: destChain.Selector}
+	// 1 EUR = 2 USD
+	eurToken := ccipcommon.TokenID{TokenAddress: "0x0003", ChainSelector: chainselectors.TEST_90000001.Selector}
+
+	newPriceService := func(t *testing.T, eurPriceUSD *big.Int) *priceService {
+		priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceGetter.EXPECT().GetTokenPricesUSD(mock.Anything, []ccipcommon.TokenID{sourceNative, eurToken}).
+			Return(map[ccipcommon.TokenID]*big.Int{sourceNative: val1e18(2), eurToken: eurPriceUSD}, nil).Maybe()
+		priceGetter.EXPECT().GetTokenPricesUSD(mock.Anything, []ccipcommon.TokenID{eurToken}).
+			Return(map[ccipcommon.TokenID]*big.Int{eurToken: eurPriceUSD}, nil).Maybe()
+		priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(map[ccipcommon.TokenID]*big.Int{
+			sourceNative: val1e18(2),
+			destToken:    val1e18(3),
+		}, nil).Maybe()
+
+		offRampReader := ccipdatamocks.NewOffRampReader(t)
+		offRampReader.EXPECT().GetTokens(mock.Anything).Return(cciptypes.OffRampTokens{}, nil).Maybe()
+
+		priceService := NewPriceService(
+			lggr,
+			ccipmocks.NewORM(t),
+			1,
+			destChain.Selector,
+			sourceChain.Selector,
+			sourceNative.TokenAddress,
+			priceGetter,
+			offRampReader,
+			PriceServiceOptions{QuoteCurrency: &QuoteCurrency{Symbol: "EUR", Token: eurToken}},
+		).(*priceService)
+
+		// Gas price with both execution and data availability components, the components must be converted separately
+		gasPriceEstimator := prices.NewMockGasPriceEstimatorCommit(t)
+		gasPriceEstimator.On("GetGasPrice", mock.Anything).Return(big.NewInt(10), nil).Maybe()
+		gasPriceUSD, err := prices.EncodeGasPriceComponents(big.NewInt(20), big.NewInt(40))
+		require.NoError(t, err)
+		gasPriceEstimator.On("DenoteInUSD", mock.Anything, mock.Anything, mock.Anything).Return(gasPriceUSD, nil).Maybe()
+		priceService.gasPriceEstimator = gasPriceEstimator
+
+		destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+		destPriceReg.On("GetFeeTokens", mock.Anything).Return([]cciptypes.Address{destToken.TokenAddress}, nil).Maybe()
+		destPriceReg.On("GetTokensDecimals", mock.Anything, []cciptypes.Address{destToken.TokenAddress}).Return([]uint8{18}, nil).Maybe()
+		priceService.destPriceRegistryReader = destPriceReg
+		return priceService
+	}
+
+	t.Run("prices are denominated in the quote currency", func(t *testing.T) {
+		observedPrices, err := newPriceService(t, val1e18(2)).ObserveOnly(ctx)
+		require.NoError(t, err)
+
+		expGasPrice, err := prices.EncodeGasPriceComponents(big.NewInt(10), big.NewInt(20))
+		require.NoError(t, err)
+		assert.Equal(t, expGasPrice, observedPrices.SourceGasPriceUSD)
+		assert.Equal(t, map[cciptypes.Address]*big.Int{destToken.TokenAddress: big.NewInt(15e17)}, observedPrices.TokenPricesUSD)
+	})
+
+	t.Run("missing quote currency price", func(t *testing.T) {
+		_, err := newPriceService(t, nil).ObserveOnly(ctx)
+		require.ErrorContains(t, err, "missing EUR quote currency price")
+	})
+}
+
+func TestPriceService_cleanupStalePrices(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(12345)
+
+	t.Run("default retention", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("CleanupStalePrices", ctx, destChainSelector, defaultStalePriceRetention).Return(int64(3), nil).Once()
+
+		priceService := NewPriceService(logger.TestLogger(t), mockOrm, 1, destChainSelector, 67890, "", nil, nil, PriceServiceOptions{}).(*priceService)
+		priceService.pricesCache.Set(strconv.FormatUint(destChainSelector, 10), cachedPrices{}, 0)
+
+		require.NoError(t, priceService.cleanupStalePrices(ctx))
+		_, found := priceService.pricesCache.Get(strconv.FormatUint(destChainSelector, 10))
+		assert.False(t, found)
+	})
+
+	t.Run("custom retention and ORM error", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("CleanupStalePrices", ctx, destChainSelector, time.Hour).Return(int64(0), errors.New("db error")).Once()
+
+		priceService := NewPriceService(
+			logger.TestLogger(t),
+			mockOrm,
+			1,
+			destChainSelector,
+			67890,
+			"",
+			nil,
+			nil,
+			PriceServiceOptions{StalePriceRetention: time.Hour},
+		).(*priceService)
+
+		require.ErrorContains(t, priceService.cleanupStalePrices(ctx), "db error")
+	})
+}
+
+func TestPriceService_pushPriceRefresh(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(12345)
+	cacheKey := strconv.FormatUint(destChainSelector, 10)
+
+	updates := make(chan struct{})
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("SubscribePriceUpdates", mock.Anything, destChainSelector).Return((<-chan struct{})(updates), nil).Once()
+	mockOrm.On("GetGasPricesByDestChain", ctx, destChainSelector).Return(nil, nil)
+	mockOrm.On("GetTokenPricesByDestChain", ctx, destChainSelector).Return(nil, nil)
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		mockOrm,
+		1,
+		destChainSelector,
+		67890,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{PushPriceRefresh: true},
+	).(*priceService)
+	require.NoError(t, priceService.Start(ctx))
+	require.Eventually(t, priceService.pushRefreshActive.Load, tests.WaitTimeout(t), 10*time.Millisecond)
+
+	// Prices are cached for longer while notifications are delivered
+	_, _, err := priceService.GetGasAndTokenPricesWithTimestamps(ctx, destChainSelector)
+	require.NoError(t, err)
+	_, expiration, found := priceService.pricesCache.GetWithExpiration(cacheKey)
+	require.True(t, found)
+	assert.WithinDuration(t, time.Now().Add(pushRefreshPricesCacheExpiration), expiration, time.Minute)
+
+	// A notification drops the cached prices
+	updates <- struct{}{}
+	require.Eventually(t, func() bool {
+		_, found := priceService.pricesCache.Get(cacheKey)
+		return !found
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+
+	// Losing the subscription falls back to the default cache expiration
+	close(updates)
+	require.Eventually(t, func() bool {
+		return !priceService.pushRefreshActive.Load()
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+	_, _, err = priceService.GetGasAndTokenPricesWithTimestamps(ctx, destChainSelector)
+	require.NoError(t, err)
+	_, expiration, found = priceService.pricesCache.GetWithExpiration(cacheKey)
+	require.True(t, found)
+	assert.WithinDuration(t, time.Now().Add(pricesCacheExpiration), expiration, 5*time.Second)
+
+	require.NoError(t, priceService.Close())
+}
+
 func val1e18(val int64) *big.Int {
 	return new(big.Int).Mul(big.NewInt(1e18), big.NewInt(val))
 }
@@ -761,6 +1821,227 @@ func checkResultLen(t *testing.T, priceService PriceService, destChainSelector u
 	return nil
 }
 
+func TestPriceService_initialUpdatesOnStart(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.TestLogger(t)
+	destChain := chainselectors.TEST_1338
+	sourceChain := chainselectors.TEST_1000
+
+	sourceNative := ccipcommon.TokenID{TokenAddress: "0x0001", ChainSelector: sourceChain.Selector}
+	destToken := ccipcommon.TokenID{TokenAddress: "0x0002", ChainSelector: destChain.Selector}
+
+	t.Run("prices are updated right after start", func(t *testing.T) {
+		priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceGetter.EXPECT().GetTokenPricesUSD(mock.Anything, []ccipcommon.TokenID{sourceNative}).
+			Return(map[ccipcommon.TokenID]*big.Int{sourceNative: val1e18(2)}, nil)
+		priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(map[ccipcommon.TokenID]*big.Int{
+			sourceNative: val1e18(2),
+			destToken:    val1e18(3),
+		}, nil)
+
+		offRampReader := ccipdatamocks.NewOffRampReader(t)
+		offRampReader.EXPECT().GetTokens(mock.Anything).Return(cciptypes.OffRampTokens{}, nil).Maybe()
+
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("UpsertGasPricesForDestChain", mock.Anything, destChain.Selector, mock.Anything).Return(int64(1), nil).Once()
+		mockOrm.On("UpsertTokenPricesForDestChain", mock.Anything, destChain.Selector, mock.Anything, tokenPriceUpdateInterval).
+			Return(int64(1), nil).Once()
+
+		priceService := NewPriceService(
+			lggr,
+			mockOrm,
+			1,
+			destChain.Selector,
+			sourceChain.Selector,
+			sourceNative.TokenAddress,
+			priceGetter,
+			offRampReader,
+			PriceServiceOptions{},
+		).(*priceService)
+		// Intervals far longer than the test, only the initial updates can write the prices
+		priceService.gasUpdateInterval = time.Hour
+		priceService.tokenUpdateInterval = time.Hour
+
+		gasPriceEstimator := prices.NewMockGasPriceEstimatorCommit(t)
+		gasPriceEstimator.On("GetGasPrice", mock.Anything).Return(big.NewInt(10), nil)
+		gasPriceEstimator.On("DenoteInUSD", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(20), nil)
+		priceService.gasPriceEstimator = gasPriceEstimator
+
+		destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+		destPriceReg.On("GetFeeTokens", mock.Anything).Return([]cciptypes.Address{destToken.TokenAddress}, nil)
+		destPriceReg.On("GetTokensDecimals", mock.Anything, []cciptypes.Address{destToken.TokenAddress}).Return([]uint8{18}, nil)
+		priceService.destPriceRegistryReader = destPriceReg
+
+		events, unsubscribe := priceService.SubscribePriceUpdates()
+		defer unsubscribe()
+
+		require.NoError(t, priceService.Start(ctx))
+		defer func() { require.NoError(t, priceService.Close()) }()
+
+		var gasPriceUpdated, tokenPriceUpdated bool
+		for !gasPriceUpdated || !tokenPriceUpdated {
+			select {
+			case event := <-events:
+				gasPriceUpdated = gasPriceUpdated || event.IsGasPrice
+				tokenPriceUpdated = tokenPriceUpdated || !event.IsGasPrice
+			case <-time.After(tests.WaitTimeout(t)):
+				t.Fatal("prices were not updated after start")
+			}
+		}
+	})
+
+	t.Run("initial updates are skipped until dynamic config is set", func(t *testing.T) {
+		// No expectations, nothing must be observed nor written
+		priceService := NewPriceService(
+			lggr,
+			ccipmocks.NewORM(t),
+			1,
+			destChain.Selector,
+			sourceChain.Selector,
+			sourceNative.TokenAddress,
+			pricegetter.NewMockAllTokensPriceGetter(t),
+			ccipdatamocks.NewOffRampReader(t),
+			PriceServiceOptions{},
+		).(*priceService)
+		priceService.gasUpdateInterval = time.Hour
+		priceService.tokenUpdateInterval = time.Hour
+
+		require.NoError(t, priceService.Start(ctx))
+		require.NoError(t, priceService.Close())
+	})
+}
+
+func TestPriceService_PauseResume(t *testing.T) {
+	ctx := tests.Context(t)
+
+	// No expectations, nothing must be observed nor written while paused
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		ccipmocks.NewORM(t),
+		1,
+		12345,
+		67890,
+		"",
+		pricegetter.NewMockAllTokensPriceGetter(t),
+		nil,
+		PriceServiceOptions{},
+	).(*priceService)
+
+	priceService.Pause()
+	priceService.Pause()
+
+	err := priceService.UpdateDynamicConfig(ctx, prices.NewMockGasPriceEstimatorCommit(t), ccipdatamocks.NewPriceRegistryReader(t))
+	require.NoError(t, err)
+	priceService.runBackgroundGasPriceUpdate(ctx)
+	priceService.runBackgroundTokenPriceUpdate(ctx)
+	require.Empty(t, priceService.resumed)
+
+	// Resuming triggers a single price refresh
+	priceService.Resume()
+	priceService.Resume()
+	require.Len(t, priceService.resumed, 1)
+	assert.False(t, priceService.paused.Load())
+}
+
+func TestPriceService_UpdatePriceGetter(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.TestLogger(t)
+	destChain := chainselectors.TEST_1338
+	sourceChain := chainselectors.TEST_1000
+
+	sourceNative := ccipcommon.TokenID{TokenAddress: "0x0001", ChainSelector: sourceChain.Selector}
+	destToken := ccipcommon.TokenID{TokenAddress: "0x0002", ChainSelector: destChain.Selector}
+	addedDestToken := ccipcommon.TokenID{TokenAddress: "0x0003", ChainSelector: destChain.Selector}
+
+	newPriceService := func(t *testing.T, orm *ccipmocks.ORM, priceGetter pricegetter.AllTokensPriceGetter) *priceService {
+		offRampReader := ccipdatamocks.NewOffRampReader(t)
+		offRampReader.EXPECT().GetTokens(mock.Anything).Return(cciptypes.OffRampTokens{}, nil).Maybe()
+
+		priceService := NewPriceService(
+			lggr,
+			orm,
+			1,
+			destChain.Selector,
+			sourceChain.Selector,
+			sourceNative.TokenAddress,
+			priceGetter,
+			offRampReader,
+			PriceServiceOptions{},
+		).(*priceService)
+
+		destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+		destPriceReg.On("GetTokensDecimals", mock.Anything, mock.Anything).Return([]uint8{18, 18}, nil).Maybe()
+		priceService.destPriceRegistryReader = destPriceReg
+		return priceService
+	}
+
+	t.Run("tokens added to the price getter are priced right away", func(t *testing.T) {
+		orm := ccipmocks.NewORM(t)
+		orm.On("UpsertTokenPricesForDestChain", ctx, destChain.Selector, []cciporm.TokenPrice{
+			{TokenAddr: string(destToken.TokenAddress), TokenPrice: assets.NewWei(val1e18(3)), JobID: 1},
+			{TokenAddr: string(addedDestToken.TokenAddress), TokenPrice: assets.NewWei(val1e18(4)), JobID: 1},
+		}, tokenPriceUpdateInterval).Return(int64(2), nil).Once()
+		previousPriceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		previousPriceGetter.EXPECT().Close().Return(nil).Once()
+		priceService := newPriceService(t, orm, previousPriceGetter)
+
+		priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(map[ccipcommon.TokenID]*big.Int{
+			sourceNative:   val1e18(2),
+			destToken:      val1e18(3),
+			addedDestToken: val1e18(4),
+		}, nil).Once()
+
+		require.NoError(t, priceService.UpdatePriceGetter(ctx, priceGetter))
+		assert.Equal(t, priceGetter, priceService.priceGetter)
+	})
+
+	t.Run("previous price getter is kept when the new one fails", func(t *testing.T) {
+		previousPriceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceService := newPriceService(t, ccipmocks.NewORM(t), previousPriceGetter)
+
+		priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(nil, errors.New("unknown price source")).Once()
+		priceGetter.EXPECT().Close().Return(nil).Once()
+
+		require.ErrorContains(t, priceService.UpdatePriceGetter(ctx, priceGetter), "unknown price source")
+		assert.Equal(t, previousPriceGetter, priceService.priceGetter)
+	})
+
+	t.Run("prices are not written while paused", func(t *testing.T) {
+		previousPriceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		previousPriceGetter.EXPECT().Close().Return(nil).Once()
+		priceService := newPriceService(t, ccipmocks.NewORM(t), previousPriceGetter)
+		priceService.Pause()
+
+		priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(map[ccipcommon.TokenID]*big.Int{
+			sourceNative: val1e18(2),
+		}, nil).Once()
+
+		require.NoError(t, priceService.UpdatePriceGetter(ctx, priceGetter))
+		assert.Equal(t, priceGetter, priceService.priceGetter)
+	})
+
+	t.Run("previous price getter is kept when the new one fails while paused", func(t *testing.T) {
+		previousPriceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceService := newPriceService(t, ccipmocks.NewORM(t), previousPriceGetter)
+		priceService.Pause()
+
+		priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceGetter.EXPECT().GetJobSpecTokenPricesUSD(mock.Anything).Return(nil, errors.New("unknown price source")).Once()
+		priceGetter.EXPECT().Close().Return(nil).Once()
+
+		require.ErrorContains(t, priceService.UpdatePriceGetter(ctx, priceGetter), "unknown price source")
+		assert.Equal(t, previousPriceGetter, priceService.priceGetter)
+	})
+
+	t.Run("nil price getter", func(t *testing.T) {
+		priceService := newPriceService(t, ccipmocks.NewORM(t), pricegetter.NewMockAllTokensPriceGetter(t))
+		require.Error(t, priceService.UpdatePriceGetter(ctx, nil))
+	})
+}
+
 func TestPriceService_priceWriteInBackground(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	jobId := int32(1)
@@ -834,6 +2115,7 @@ func TestPriceService_priceWriteInBackground(t *testing.T) {
 		tokens[0].TokenAddress,
 		priceGetter,
 		nil,
+		PriceServiceOptions{},
 	).(*priceService)
 
 	gasUpdateInterval := 2000 * time.Millisecond
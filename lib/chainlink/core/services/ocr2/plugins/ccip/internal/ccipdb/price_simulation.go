@@ -0,0 +1,110 @@
+package db
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// PriceUpdateSimulation is the outcome of simulating the price update the Commit plugin of a lane would submit with
+// the current DB prices of its dest chain.
+type PriceUpdateSimulation struct {
+	DestChainSelector uint64            `json:"destChainSelector,string"`
+	JobID             int32             `json:"jobID"`
+	PriceUpdater      cciptypes.Address `json:"priceUpdater"`
+	// GasPrices are keyed by source chain selector, prices are encoded as decimal strings to keep their precision.
+	GasPrices   map[uint64]string            `json:"gasPrices"`
+	TokenPrices map[cciptypes.Address]string `json:"tokenPrices"`
+	// RevertReason is the reason the dest price registry reverted the price update, empty when it succeeded.
+	RevertReason string    `json:"revertReason,omitempty"`
+	SimulatedAt  time.Time `json:"simulatedAt"`
+}
+
+// Reverted reports whether the dest price registry reverted the simulated price update.
+func (s PriceUpdateSimulation) Reverted() bool {
+	return s.RevertReason != ""
+}
+
+// SimulatePriceUpdate reads the prices of the dest chain the same way as the Commit plugin does and simulates writing
+// all of them into the dest price registry from the price updater of the lane. A reverted price update is reported
+// in the RevertReason of the simulation, errors are returned for the failures to run the simulation.
+func (p *priceService) SimulatePriceUpdate(ctx context.Context) (PriceUpdateSimulation, error) {
+	if p.priceUpdater == "" {
+		return PriceUpdateSimulation{}, errors.New("price updater of the lane is not configured")
+	}
+
+	p.dynamicConfigMu.RLock()
+	destPriceRegistryReader := p.destPriceRegistryReader
+	p.dynamicConfigMu.RUnlock()
+	if destPriceRegistryReader == nil {
+		return PriceUpdateSimulation{}, errors.New("dest price registry is not ready")
+	}
+	simulator, ok := destPriceRegistryReader.(ccipdata.PriceRegistryUpdateSimulator)
+	if !ok {
+		return PriceUpdateSimulation{}, errors.New("dest price registry reader does not support price update simulation")
+	}
+
+	gasPricesUSD, tokenPricesUSD, err := p.GetGasAndTokenPrices(ctx, p.destChainSelector)
+	if err != nil {
+		return PriceUpdateSimulation{}, fmt.Errorf("get prices of dest chain %d: %w", p.destChainSelector, err)
+	}
+
+	simulation := PriceUpdateSimulation{
+		DestChainSelector: p.destChainSelector,
+		JobID:             p.jobId,
+		PriceUpdater:      p.priceUpdater,
+		GasPrices:         make(map[uint64]string, len(gasPricesUSD)),
+		TokenPrices:       make(map[cciptypes.Address]string, len(tokenPricesUSD)),
+		SimulatedAt:       time.Now().UTC(),
+	}
+	gasPrices := make([]cciptypes.GasPrice, 0, len(gasPricesUSD))
+	for sourceChainSelector, price := range gasPricesUSD {
+		gasPrices = append(gasPrices, cciptypes.GasPrice{DestChainSelector: sourceChainSelector, Value: price})
+		simulation.GasPrices[sourceChainSelector] = price.String()
+	}
+	slices.SortFunc(gasPrices, func(a, b cciptypes.GasPrice) int { return cmp.Compare(a.DestChainSelector, b.DestChainSelector) })
+	tokenPrices := make([]cciptypes.TokenPrice, 0, len(tokenPricesUSD))
+	for token, price := range tokenPricesUSD {
+		tokenPrices = append(tokenPrices, cciptypes.TokenPrice{Token: token, Value: price})
+		simulation.TokenPrices[token] = price.String()
+	}
+	slices.SortFunc(tokenPrices, func(a, b cciptypes.TokenPrice) int { return cmp.Compare(a.Token, b.Token) })
+
+	err = simulator.SimulatePriceUpdates(ctx, p.priceUpdater, gasPrices, tokenPrices)
+	var revertErr *ccipdata.PriceUpdateRevertError
+	switch {
+	case errors.As(err, &revertErr):
+		p.lggr.Warnw("Simulated price update reverted", "priceUpdater", p.priceUpdater, "reason", revertErr.Reason,
+			"gasPrices", simulation.GasPrices, "tokenPrices", simulation.TokenPrices)
+		simulation.RevertReason = revertErr.Reason
+	case err != nil:
+		return PriceUpdateSimulation{}, fmt.Errorf("simulate price update of dest chain %d: %w", p.destChainSelector, err)
+	}
+	return simulation, nil
+}
+
+// SimulatePriceUpdates runs SimulatePriceUpdate for every registered PriceService, ordered by dest chain selector and
+// job ID. Every lane is simulated, as each lane submits the price updates from its own commit store.
+func (r *PriceServiceRegistry) SimulatePriceUpdates(ctx context.Context) ([]PriceUpdateSimulation, error) {
+	services := r.runningServices()
+	slices.SortFunc(services, func(a, b *priceService) int {
+		return cmp.Or(cmp.Compare(a.destChainSelector, b.destChainSelector), cmp.Compare(a.jobId, b.jobId))
+	})
+
+	simulations := make([]PriceUpdateSimulation, 0, len(services))
+	for _, p := range services {
+		simulation, err := p.SimulatePriceUpdate(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("job %d: %w", p.jobId, err)
+		}
+		simulations = append(simulations, simulation)
+	}
+	return simulations, nil
+}
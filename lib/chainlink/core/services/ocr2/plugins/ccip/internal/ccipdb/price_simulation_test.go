@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+)
+
+// simulatingPriceRegistryReader adds price update simulation to the PriceRegistryReader mock.
+type simulatingPriceRegistryReader struct {
+	*ccipdatamocks.PriceRegistryReader
+	simulate func(priceUpdater cciptypes.Address, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice) error
+}
+
+func (r *simulatingPriceRegistryReader) SimulatePriceUpdates(_ context.Context, priceUpdater cciptypes.Address, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice) error {
+	return r.simulate(priceUpdater, gasPrices, tokenPrices)
+}
+
+func TestPriceService_SimulatePriceUpdate(t *testing.T) {
+	destChainSelector := uint64(72345)
+	sourceChainSelector := uint64(77890)
+	priceUpdater := cciptypes.Address("0x00000000000000000000000000000000000000cc")
+	token := cciptypes.Address("0x00000000000000000000000000000000000000aa")
+	updatedAt := time.Now().Add(-time.Minute).UTC()
+
+	testCases := []struct {
+		name            string
+		priceUpdater    cciptypes.Address
+		destPriceReg    bool
+		simulateErr     error
+		expErr          string
+		expRevertReason string
+	}{
+		{
+			name:         "price update succeeds",
+			priceUpdater: priceUpdater,
+			destPriceReg: true,
+		},
+		{
+			name:            "reverted price update is reported",
+			priceUpdater:    priceUpdater,
+			destPriceReg:    true,
+			simulateErr:     &ccipdata.PriceUpdateRevertError{Reason: "OnlyCallableByUpdaterOrOwner[]"},
+			expRevertReason: "OnlyCallableByUpdaterOrOwner[]",
+		},
+		{
+			name:         "rpc failure is returned",
+			priceUpdater: priceUpdater,
+			destPriceReg: true,
+			simulateErr:  errors.New("rpc error"),
+			expErr:       "rpc error",
+		},
+		{
+			name:         "price updater not configured",
+			destPriceReg: true,
+			expErr:       "price updater",
+		},
+		{
+			name:         "dest price registry not ready",
+			priceUpdater: priceUpdater,
+			expErr:       "not ready",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tests.Context(t)
+
+			mockOrm := ccipmocks.NewORM(t)
+			mockOrm.On("GetGasPricesByDestChain", mock.Anything, destChainSelector).Return([]cciporm.GasPrice{
+				{SourceChainSelector: sourceChainSelector, GasPrice: assets.NewWei(big.NewInt(1e9)), UpdatedAt: updatedAt},
+			}, nil).Maybe()
+			mockOrm.On("GetTokenPricesByDestChain", mock.Anything, destChainSelector).Return([]cciporm.TokenPrice{
+				{TokenAddr: string(token), TokenPrice: assets.NewWei(val1e18(2000)), UpdatedAt: updatedAt},
+			}, nil).Maybe()
+
+			priceService := NewPriceService(logger.TestLogger(t), mockOrm, 1, destChainSelector, sourceChainSelector, "", nil, nil,
+				PriceServiceOptions{PriceUpdater: tc.priceUpdater}).(*priceService)
+			if tc.destPriceReg {
+				priceService.destPriceRegistryReader = &simulatingPriceRegistryReader{
+					PriceRegistryReader: ccipdatamocks.NewPriceRegistryReader(t),
+					simulate: func(from cciptypes.Address, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice) error {
+						assert.Equal(t, priceUpdater, from)
+						assert.Equal(t, []cciptypes.GasPrice{{DestChainSelector: sourceChainSelector, Value: big.NewInt(1e9)}}, gasPrices)
+						assert.Equal(t, []cciptypes.TokenPrice{{Token: token, Value: val1e18(2000)}}, tokenPrices)
+						return tc.simulateErr
+					},
+				}
+			}
+
+			simulation, err := priceService.SimulatePriceUpdate(ctx)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, destChainSelector, simulation.DestChainSelector)
+			assert.Equal(t, priceUpdater, simulation.PriceUpdater)
+			assert.Equal(t, map[uint64]string{sourceChainSelector: "1000000000"}, simulation.GasPrices)
+			assert.Equal(t, map[cciptypes.Address]string{token: val1e18(2000).String()}, simulation.TokenPrices)
+			assert.Equal(t, tc.expRevertReason, simulation.RevertReason)
+			assert.Equal(t, tc.expRevertReason != "", simulation.Reverted())
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"maps"
+	"math/big"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+// PriceSink receives the prices written by the PriceService, alongside the ORM upserts.
+// It allows exporting price updates to alternative destinations, e.g. a Kafka topic, a telemetry endpoint or a secondary DB.
+// The DB remains the source of truth, a failing sink is reported but does not fail the price update.
+type PriceSink interface {
+	// Name identifies the sink in logs and metrics.
+	Name() string
+	// WriteGasPrice is called after a source chain gas price has been written into the DB.
+	WriteGasPrice(ctx context.Context, sourceChainSelector, destChainSelector uint64, sourceGasPriceUSD *big.Int) error
+	// WriteTokenPrices is called after the dest chain token prices have been written into the DB.
+	WriteTokenPrices(ctx context.Context, destChainSelector uint64, tokenPricesUSD map[cciptypes.Address]*big.Int) error
+}
+
+func (p *priceService) writeGasPriceToSinks(ctx context.Context, sourceChainSelector uint64, sourceGasPriceUSD *big.Int) {
+	if sourceGasPriceUSD == nil {
+		return
+	}
+
+	for _, sink := range p.sinks {
+		err := sink.WriteGasPrice(ctx, sourceChainSelector, p.destChainSelector, new(big.Int).Set(sourceGasPriceUSD))
+		p.recordSinkResult(sink, gasPriceUpdate, err)
+	}
+}
+
+func (p *priceService) writeTokenPricesToSinks(ctx context.Context, tokenPricesUSD map[cciptypes.Address]*big.Int) {
+	if tokenPricesUSD == nil {
+		return
+	}
+
+	for _, sink := range p.sinks {
+		// Every sink gets its own copy, so that a sink cannot modify the prices seen by the others
+		err := sink.WriteTokenPrices(ctx, p.destChainSelector, maps.Clone(tokenPricesUSD))
+		p.recordSinkResult(sink, tokenPriceUpdate, err)
+	}
+}
+
+func (p *priceService) recordSinkResult(sink PriceSink, updateType priceUpdateType, err error) {
+	p.metrics.sinkWriteResult(sink.Name(), updateType, err)
+	if err != nil {
+		p.lggr.Errorw("Failed to write prices to sink", "sink", sink.Name(), "updateType", updateType, "err", err)
+	}
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type testPriceSink struct {
+	name        string
+	err         error
+	gasPrices   []*big.Int
+	tokenPrices []map[cciptypes.Address]*big.Int
+}
+
+func (s *testPriceSink) Name() string { return s.name }
+
+func (s *testPriceSink) WriteGasPrice(_ context.Context, _, _ uint64, sourceGasPriceUSD *big.Int) error {
+	s.gasPrices = append(s.gasPrices, sourceGasPriceUSD)
+	return s.err
+}
+
+func (s *testPriceSink) WriteTokenPrices(_ context.Context, _ uint64, tokenPricesUSD map[cciptypes.Address]*big.Int) error {
+	s.tokenPrices = append(s.tokenPrices, tokenPricesUSD)
+	// modifications must not be visible to other sinks
+	tokenPricesUSD["0xdead"] = big.NewInt(0)
+	return s.err
+}
+
+func TestPriceService_writeToSinks(t *testing.T) {
+	ctx := tests.Context(t)
+	failingSink := &testPriceSink{name: "failing", err: errors.New("sink unavailable")}
+	healthySink := &testPriceSink{name: "healthy"}
+
+	priceService := NewPriceService(
+		logger.TestLogger(t),
+		nil,
+		1,
+		5000,
+		6000,
+		"",
+		nil,
+		nil,
+		PriceServiceOptions{Sinks: []PriceSink{failingSink, healthySink}},
+	).(*priceService)
+
+	tokenPrices := map[cciptypes.Address]*big.Int{"0x123": big.NewInt(2e18)}
+	priceService.writeGasPriceToSinks(ctx, 6000, big.NewInt(100))
+	priceService.writeTokenPricesToSinks(ctx, tokenPrices)
+	priceService.writeGasPriceToSinks(ctx, 6000, nil)
+	priceService.writeTokenPricesToSinks(ctx, nil)
+
+	for _, sink := range []*testPriceSink{failingSink, healthySink} {
+		assert.Equal(t, []*big.Int{big.NewInt(100)}, sink.gasPrices)
+		assert.Len(t, sink.tokenPrices, 1)
+		assert.Equal(t, big.NewInt(2e18), sink.tokenPrices[0]["0x123"])
+	}
+	assert.Equal(t, map[cciptypes.Address]*big.Int{"0x123": big.NewInt(2e18)}, tokenPrices)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(priceSinkWrites.WithLabelValues("failing", "gas", "6000", "5000", "false")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(priceSinkWrites.WithLabelValues("failing", "token", "6000", "5000", "false")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(priceSinkWrites.WithLabelValues("healthy", "gas", "6000", "5000", "true")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(priceSinkWrites.WithLabelValues("healthy", "token", "6000", "5000", "true")))
+}
@@ -0,0 +1,127 @@
+package db
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+)
+
+// PriceSnapshot is the full gas and token price state of a dest chain as seen by this node, it is meant to be
+// exported as JSON so that external monitoring can compare the price views of the nodes of a DON.
+type PriceSnapshot struct {
+	DestChainSelector uint64 `json:"destChainSelector,string"`
+	// JobIDs are the jobs of this node whose PriceService writes prices of the dest chain.
+	JobIDs []int32 `json:"jobIDs"`
+	// GasPrices are keyed by source chain selector.
+	GasPrices   map[uint64]SnapshotPrice            `json:"gasPrices"`
+	TokenPrices map[cciptypes.Address]SnapshotPrice `json:"tokenPrices"`
+	TakenAt     time.Time                           `json:"takenAt"`
+}
+
+// SnapshotPrice is a price of a PriceSnapshot, the value is encoded as a decimal string to keep its precision.
+type SnapshotPrice struct {
+	Value     string    `json:"value"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// PriceServiceRegistry tracks the started PriceService instances of a node by job ID, so that the node can report the
+// prices of all of them, see PriceServiceOptions.Registry.
+type PriceServiceRegistry struct {
+	mu       sync.RWMutex
+	services map[int32]*priceService
+}
+
+func NewPriceServiceRegistry() *PriceServiceRegistry {
+	return &PriceServiceRegistry{services: make(map[int32]*priceService)}
+}
+
+func (r *PriceServiceRegistry) register(p *priceService) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.services[p.jobId] = p
+}
+
+func (r *PriceServiceRegistry) unregister(p *priceService) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.services[p.jobId] == p {
+		delete(r.services, p.jobId)
+	}
+}
+
+// runningServices returns the registered PriceService instances, in no particular order.
+func (r *PriceServiceRegistry) runningServices() []*priceService {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	services := make([]*priceService, 0, len(r.services))
+	for _, p := range r.services {
+		services = append(services, p)
+	}
+	return services
+}
+
+func (p *priceService) GetPriceSnapshot(ctx context.Context) (PriceSnapshot, error) {
+	return p.priceSnapshot(ctx, []int32{p.jobId})
+}
+
+// priceSnapshot reads the prices of the dest chain from the DB directly, so that the snapshot is not hidden by the
+// prices cache.
+func (p *priceService) priceSnapshot(ctx context.Context, jobIDs []int32) (PriceSnapshot, error) {
+	takenAt := time.Now().UTC()
+	gasPrices, tokenPrices, err := p.getGasAndTokenPricesFromDB(ctx, p.destChainSelector)
+	if err != nil {
+		return PriceSnapshot{}, fmt.Errorf("get prices of dest chain %d from DB: %w", p.destChainSelector, err)
+	}
+
+	snapshot := PriceSnapshot{
+		DestChainSelector: p.destChainSelector,
+		JobIDs:            jobIDs,
+		GasPrices:         make(map[uint64]SnapshotPrice, len(gasPrices)),
+		TokenPrices:       make(map[cciptypes.Address]SnapshotPrice, len(tokenPrices)),
+		TakenAt:           takenAt,
+	}
+	for sourceChainSelector, price := range gasPrices {
+		snapshot.GasPrices[sourceChainSelector] = SnapshotPrice{Value: price.Value.String(), UpdatedAt: price.UpdatedAt}
+	}
+	for token, price := range tokenPrices {
+		snapshot.TokenPrices[token] = SnapshotPrice{Value: price.Value.String(), UpdatedAt: price.UpdatedAt}
+	}
+	return snapshot, nil
+}
+
+// GetPriceSnapshots returns a PriceSnapshot of every dest chain fed by the registered PriceService instances, ordered
+// by dest chain selector. Every dest chain is read once, no matter how many of its lanes run on this node.
+func (r *PriceServiceRegistry) GetPriceSnapshots(ctx context.Context) ([]PriceSnapshot, error) {
+	servicesByDestChain := make(map[uint64][]*priceService)
+	for _, p := range r.runningServices() {
+		servicesByDestChain[p.destChainSelector] = append(servicesByDestChain[p.destChainSelector], p)
+	}
+
+	destChainSelectors := make([]uint64, 0, len(servicesByDestChain))
+	for destChainSelector := range servicesByDestChain {
+		destChainSelectors = append(destChainSelectors, destChainSelector)
+	}
+	slices.Sort(destChainSelectors)
+
+	snapshots := make([]PriceSnapshot, 0, len(destChainSelectors))
+	for _, destChainSelector := range destChainSelectors {
+		services := servicesByDestChain[destChainSelector]
+		slices.SortFunc(services, func(a, b *priceService) int { return cmp.Compare(a.jobId, b.jobId) })
+		jobIDs := make([]int32, 0, len(services))
+		for _, p := range services {
+			jobIDs = append(jobIDs, p.jobId)
+		}
+
+		snapshot, err := services[0].priceSnapshot(ctx, jobIDs)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
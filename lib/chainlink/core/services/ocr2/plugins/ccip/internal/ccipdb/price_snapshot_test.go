@@ -0,0 +1,74 @@
+package db
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+)
+
+func TestPriceServiceRegistry_GetPriceSnapshots(t *testing.T) {
+	ctx := tests.Context(t)
+	registry := NewPriceServiceRegistry()
+	destChainA := uint64(9_000_001)
+	destChainB := uint64(9_000_002)
+	sourceChainSelector := uint64(67890)
+	updatedAt := time.Now().Add(-time.Minute).UTC()
+
+	newPriceService := func(jobID int32, destChainSelector uint64, mockOrm *ccipmocks.ORM) *priceService {
+		p := NewPriceService(logger.TestLogger(t), mockOrm, jobID, destChainSelector, sourceChainSelector, "", nil, nil, PriceServiceOptions{}).(*priceService)
+		registry.register(p)
+		return p
+	}
+
+	ormA := ccipmocks.NewORM(t)
+	ormA.On("GetGasPricesByDestChain", ctx, destChainA).Return([]cciporm.GasPrice{
+		{SourceChainSelector: sourceChainSelector, GasPrice: assets.NewWei(big.NewInt(1e18)), UpdatedAt: updatedAt},
+	}, nil).Once()
+	ormA.On("GetTokenPricesByDestChain", ctx, destChainA).Return([]cciporm.TokenPrice{
+		{TokenAddr: "0x0001", TokenPrice: assets.NewWei(new(big.Int).Exp(big.NewInt(10), big.NewInt(36), nil)), UpdatedAt: updatedAt},
+	}, nil).Once()
+	ormB := ccipmocks.NewORM(t)
+	ormB.On("GetGasPricesByDestChain", ctx, destChainB).Return(nil, nil).Once()
+	ormB.On("GetTokenPricesByDestChain", ctx, destChainB).Return(nil, nil).Once()
+
+	// Two lanes of dest chain A run on this node, only the one with the lowest job ID reads the prices
+	newPriceService(12, destChainA, ccipmocks.NewORM(t))
+	newPriceService(11, destChainA, ormA)
+	newPriceService(13, destChainB, ormB)
+
+	snapshots, err := registry.GetPriceSnapshots(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	snapshotsByDestChain := make(map[uint64]PriceSnapshot)
+	for _, snapshot := range snapshots {
+		snapshotsByDestChain[snapshot.DestChainSelector] = snapshot
+	}
+
+	snapshotA := snapshotsByDestChain[destChainA]
+	assert.Equal(t, []int32{11, 12}, snapshotA.JobIDs)
+	assert.Equal(t, map[uint64]SnapshotPrice{sourceChainSelector: {Value: "1000000000000000000", UpdatedAt: updatedAt}}, snapshotA.GasPrices)
+	assert.Equal(t, map[cciptypes.Address]SnapshotPrice{"0x0001": {Value: "1000000000000000000000000000000000000", UpdatedAt: updatedAt}}, snapshotA.TokenPrices)
+
+	snapshotB := snapshotsByDestChain[destChainB]
+	assert.Equal(t, []int32{13}, snapshotB.JobIDs)
+	assert.Empty(t, snapshotB.GasPrices)
+	assert.Empty(t, snapshotB.TokenPrices)
+
+	// Prices are encoded as strings, so that JSON consumers don't lose precision
+	encoded, err := json.Marshal(snapshotA)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"value":"1000000000000000000000000000000000000"`)
+	assert.Contains(t, string(encoded), `"destChainSelector":"9000001"`)
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
+)
+
+var errUnsignedPrices = errors.New("price verification is enabled but the price getter does not provide signed prices")
+
+// getJobSpecTokenPricesUSD returns the job spec token prices of the price getter, along with the confidence in them when
+// the price getter knows it. With price verification enabled, only the prices with a valid signature are returned, the
+// verification errors of the others are returned separately.
+func (p *priceService) getJobSpecTokenPricesUSD(ctx context.Context) (
+	map[ccipcommon.TokenID]*big.Int,
+	map[ccipcommon.TokenID]pricegetter.PriceConfidence,
+	map[ccipcommon.TokenID]error,
+	error,
+) {
+	if p.priceVerifier == nil {
+		if confidencePriceGetter, ok := p.priceGetter.(pricegetter.ConfidencePriceGetter); ok {
+			pricesWithConfidence, err := confidencePriceGetter.GetJobSpecTokenPricesWithConfidence(ctx)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			prices, confidence := splitConfidence(pricesWithConfidence)
+			return prices, confidence, nil, nil
+		}
+		prices, err := p.priceGetter.GetJobSpecTokenPricesUSD(ctx)
+		return prices, nil, nil, err
+	}
+	signedPriceGetter, ok := p.priceGetter.(pricegetter.SignedPriceGetter)
+	if !ok {
+		return nil, nil, nil, errUnsignedPrices
+	}
+	signedPrices, err := signedPriceGetter.GetSignedJobSpecTokenPricesUSD(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	prices, invalidPrices := p.verifyPrices(signedPrices)
+	return prices, nil, invalidPrices, nil
+}
+
+// getTokenPricesUSD returns the prices of the given tokens, all of them must have a valid signature when price
+// verification is enabled.
+func (p *priceService) getTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	if p.priceVerifier == nil {
+		return p.priceGetter.GetTokenPricesUSD(ctx, tokens)
+	}
+	signedPriceGetter, ok := p.priceGetter.(pricegetter.SignedPriceGetter)
+	if !ok {
+		return nil, errUnsignedPrices
+	}
+	signedPrices, err := signedPriceGetter.GetSignedTokenPricesUSD(ctx, tokens)
+	if err != nil {
+		return nil, err
+	}
+	prices, invalidPrices := p.verifyPrices(signedPrices)
+	if len(invalidPrices) > 0 {
+		return nil, fmt.Errorf("invalid signed prices: %v", invalidPrices)
+	}
+	return prices, nil
+}
+
+func (p *priceService) verifyPrices(signedPrices map[ccipcommon.TokenID]pricegetter.SignedPrice) (map[ccipcommon.TokenID]*big.Int, map[ccipcommon.TokenID]error) {
+	prices := make(map[ccipcommon.TokenID]*big.Int, len(signedPrices))
+	invalidPrices := make(map[ccipcommon.TokenID]error)
+	for token, signedPrice := range signedPrices {
+		if err := p.priceVerifier.VerifyPrice(token, signedPrice); err != nil {
+			p.lggr.Errorw("Rejecting token price with invalid signature", "token", token, "price", signedPrice.Price, "err", err)
+			invalidPrices[token] = err
+			continue
+		}
+		prices[token] = signedPrice.Price
+	}
+	p.metrics.invalidSignedPrices(len(invalidPrices))
+	return prices, invalidPrices
+}
+
+func splitConfidence(pricesWithConfidence map[ccipcommon.TokenID]pricegetter.PriceWithConfidence) (map[ccipcommon.TokenID]*big.Int, map[ccipcommon.TokenID]pricegetter.PriceConfidence) {
+	prices := make(map[ccipcommon.TokenID]*big.Int, len(pricesWithConfidence))
+	confidence := make(map[ccipcommon.TokenID]pricegetter.PriceConfidence, len(pricesWithConfidence))
+	for token, price := range pricesWithConfidence {
+		prices[token] = price.Price
+		confidence[token] = price.PriceConfidence
+	}
+	return prices, confidence
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
+)
+
+// payloadPriceVerifier accepts the prices whose payload is "valid".
+type payloadPriceVerifier struct{}
+
+func (payloadPriceVerifier) VerifyPrice(_ ccipcommon.TokenID, price pricegetter.SignedPrice) error {
+	if string(price.Payload) != "valid" {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func TestPriceService_verifiedPrices(t *testing.T) {
+	ctx := tests.Context(t)
+	validToken := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x1"), ChainSelector: 1}
+	invalidToken := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x2"), ChainSelector: 1}
+	signedPrices := map[ccipcommon.TokenID]pricegetter.SignedPrice{
+		validToken:   {Price: big.NewInt(1e18), Payload: []byte("valid")},
+		invalidToken: {Price: big.NewInt(2e18), Payload: []byte("tampered")},
+	}
+
+	newPriceService := func(t *testing.T, priceGetter pricegetter.AllTokensPriceGetter, verifier pricegetter.PriceVerifier) *priceService {
+		return NewPriceService(
+			logger.TestLogger(t),
+			ccipmocks.NewORM(t),
+			1,
+			1,
+			2,
+			"",
+			priceGetter,
+			nil,
+			PriceServiceOptions{PriceVerifier: verifier},
+		).(*priceService)
+	}
+
+	t.Run("verification disabled", func(t *testing.T) {
+		priceGetter := pricegetter.NewMockAllTokensPriceGetter(t)
+		priceGetter.On("GetJobSpecTokenPricesUSD", mock.Anything).
+			Return(map[ccipcommon.TokenID]*big.Int{validToken: big.NewInt(1e18)}, nil).Once()
+
+		prices, _, invalidPrices, err := newPriceService(t, priceGetter, nil).getJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{validToken: big.NewInt(1e18)}, prices)
+		assert.Empty(t, invalidPrices)
+	})
+
+	t.Run("confidence of the price getter is returned", func(t *testing.T) {
+		priceGetter := pricegetter.NewMockConfidencePriceGetter(t)
+		priceGetter.On("GetJobSpecTokenPricesWithConfidence", mock.Anything).
+			Return(map[ccipcommon.TokenID]pricegetter.PriceWithConfidence{validToken: {
+				Price:           big.NewInt(1e18),
+				PriceConfidence: pricegetter.PriceConfidence{SourceCount: 3, Score: 0.9},
+			}}, nil).Once()
+
+		prices, confidence, invalidPrices, err := newPriceService(t, priceGetter, nil).getJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{validToken: big.NewInt(1e18)}, prices)
+		assert.Equal(t, map[ccipcommon.TokenID]pricegetter.PriceConfidence{validToken: {SourceCount: 3, Score: 0.9}}, confidence)
+		assert.Empty(t, invalidPrices)
+	})
+
+	t.Run("unsigned price getter", func(t *testing.T) {
+		priceService := newPriceService(t, pricegetter.NewMockAllTokensPriceGetter(t), payloadPriceVerifier{})
+		_, _, _, err := priceService.getJobSpecTokenPricesUSD(ctx)
+		require.ErrorIs(t, err, errUnsignedPrices)
+		_, err = priceService.getTokenPricesUSD(ctx, []ccipcommon.TokenID{validToken})
+		require.ErrorIs(t, err, errUnsignedPrices)
+	})
+
+	t.Run("invalid job spec token prices are returned separately", func(t *testing.T) {
+		priceGetter := pricegetter.NewMockSignedPriceGetter(t)
+		priceGetter.On("GetSignedJobSpecTokenPricesUSD", mock.Anything).Return(signedPrices, nil).Once()
+
+		prices, _, invalidPrices, err := newPriceService(t, priceGetter, payloadPriceVerifier{}).getJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{validToken: big.NewInt(1e18)}, prices)
+		assert.Len(t, invalidPrices, 1)
+		assert.Contains(t, invalidPrices, invalidToken)
+	})
+
+	t.Run("invalid token prices fail the lookup", func(t *testing.T) {
+		priceGetter := pricegetter.NewMockSignedPriceGetter(t)
+		tokens := []ccipcommon.TokenID{validToken, invalidToken}
+		priceGetter.On("GetSignedTokenPricesUSD", mock.Anything, tokens).Return(signedPrices, nil).Once()
+
+		_, err := newPriceService(t, priceGetter, payloadPriceVerifier{}).getTokenPricesUSD(ctx, tokens)
+		require.ErrorContains(t, err, "invalid signed prices")
+	})
+}
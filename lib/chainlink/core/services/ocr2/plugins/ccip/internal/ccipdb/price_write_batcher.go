@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+)
+
+// priceWriteBatchTimeout bounds the flush of a batch, it doesn't depend on the callers' contexts as a batch is shared
+// by multiple PriceService instances.
+const priceWriteBatchTimeout = 30 * time.Second
+
+// PriceWriteBatcher groups the gas and token price writes of the PriceService instances sharing it, the writes queued
+// within flushWindow are upserted together in a single transaction. This reduces the number of small transactions on
+// nodes running many lanes, as every lane writes its prices independently on the same cadence.
+type PriceWriteBatcher struct {
+	lggr        logger.Logger
+	orm         cciporm.ORM
+	flushWindow time.Duration
+
+	mu            sync.Mutex
+	pendingGas    []pendingPriceWrite[cciporm.GasPricesUpdate]
+	pendingTokens []pendingPriceWrite[cciporm.TokenPricesUpdate]
+	flushArmed    bool
+}
+
+type pendingPriceWrite[T any] struct {
+	update T
+	result chan priceWriteResult
+}
+
+type priceWriteResult struct {
+	rowsUpserted int64
+	err          error
+}
+
+func NewPriceWriteBatcher(lggr logger.Logger, orm cciporm.ORM, flushWindow time.Duration) (*PriceWriteBatcher, error) {
+	if flushWindow <= 0 {
+		return nil, errors.New("flush window must be positive")
+	}
+	return &PriceWriteBatcher{
+		lggr:        lggr.Named("PriceWriteBatcher"),
+		orm:         orm,
+		flushWindow: flushWindow,
+	}, nil
+}
+
+// UpsertGasPricesForDestChain queues the gas prices of the dest chain and waits until the batch containing them is
+// written. Cancelling ctx stops waiting, but doesn't remove the gas prices from the batch.
+func (b *PriceWriteBatcher) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []cciporm.GasPrice) (int64, error) {
+	result := make(chan priceWriteResult, 1)
+	b.mu.Lock()
+	b.pendingGas = append(b.pendingGas, pendingPriceWrite[cciporm.GasPricesUpdate]{
+		update: cciporm.GasPricesUpdate{DestChainSelector: destChainSelector, GasPrices: gasPrices},
+		result: result,
+	})
+	b.armFlushLocked()
+	b.mu.Unlock()
+	return waitForPriceWrite(ctx, result)
+}
+
+// UpsertTokenPricesForDestChain queues the token prices of the dest chain and waits until the batch containing them is
+// written. Cancelling ctx stops waiting, but doesn't remove the token prices from the batch.
+func (b *PriceWriteBatcher) UpsertTokenPricesForDestChain(ctx context.Context, destChainSelector uint64, tokenPrices []cciporm.TokenPrice, interval time.Duration) (int64, error) {
+	result := make(chan priceWriteResult, 1)
+	b.mu.Lock()
+	b.pendingTokens = append(b.pendingTokens, pendingPriceWrite[cciporm.TokenPricesUpdate]{
+		update: cciporm.TokenPricesUpdate{DestChainSelector: destChainSelector, TokenPrices: tokenPrices, Interval: interval},
+		result: result,
+	})
+	b.armFlushLocked()
+	b.mu.Unlock()
+	return waitForPriceWrite(ctx, result)
+}
+
+// armFlushLocked schedules the flush of the pending writes, the first write queued after a flush opens the window.
+func (b *PriceWriteBatcher) armFlushLocked() {
+	if b.flushArmed {
+		return
+	}
+	b.flushArmed = true
+	time.AfterFunc(b.flushWindow, b.flush)
+}
+
+func (b *PriceWriteBatcher) flush() {
+	b.mu.Lock()
+	gasWrites, tokenWrites := b.pendingGas, b.pendingTokens
+	b.pendingGas, b.pendingTokens = nil, nil
+	b.flushArmed = false
+	b.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), priceWriteBatchTimeout)
+	defer cancel()
+
+	if len(gasWrites) > 0 {
+		rowsUpserted, err := b.orm.UpsertGasPricesForDestChains(ctx, priceWriteUpdates(gasWrites))
+		if err != nil {
+			b.lggr.Errorw("Failed to write gas prices batch", "updates", len(gasWrites), "err", err)
+		}
+		deliverPriceWriteResults(gasWrites, rowsUpserted, err)
+	}
+	if len(tokenWrites) > 0 {
+		rowsUpserted, err := b.orm.UpsertTokenPricesForDestChains(ctx, priceWriteUpdates(tokenWrites))
+		if err != nil {
+			b.lggr.Errorw("Failed to write token prices batch", "updates", len(tokenWrites), "err", err)
+		}
+		deliverPriceWriteResults(tokenWrites, rowsUpserted, err)
+	}
+}
+
+func priceWriteUpdates[T any](writes []pendingPriceWrite[T]) []T {
+	updates := make([]T, len(writes))
+	for i, write := range writes {
+		updates[i] = write.update
+	}
+	return updates
+}
+
+func deliverPriceWriteResults[T any](writes []pendingPriceWrite[T], rowsUpserted []int64, err error) {
+	for i, write := range writes {
+		if err != nil {
+			write.result <- priceWriteResult{err: err}
+			continue
+		}
+		write.result <- priceWriteResult{rowsUpserted: rowsUpserted[i]}
+	}
+}
+
+func waitForPriceWrite(ctx context.Context, result <-chan priceWriteResult) (int64, error) {
+	select {
+	case res := <-result:
+		return res.rowsUpserted, res.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
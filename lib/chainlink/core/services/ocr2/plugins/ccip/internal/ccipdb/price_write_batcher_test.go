@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+)
+
+func TestPriceWriteBatcher(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := logger.TestLogger(t)
+	destChains := []uint64{1, 2, 3}
+	gasPrices := []cciporm.GasPrice{{SourceChainSelector: 10, GasPrice: assets.NewWei(big.NewInt(1e9))}}
+
+	t.Run("groups the writes of multiple dest chains", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("UpsertGasPricesForDestChains", mock.Anything, mock.MatchedBy(func(updates []cciporm.GasPricesUpdate) bool {
+			return len(updates) == len(destChains)
+		})).Return(func(_ context.Context, updates []cciporm.GasPricesUpdate) ([]int64, error) {
+			// The rows of each update are the dest chain selector, so that each caller can check its own result
+			rowsUpserted := make([]int64, len(updates))
+			for i, update := range updates {
+				rowsUpserted[i] = int64(update.DestChainSelector)
+			}
+			return rowsUpserted, nil
+		}).Once()
+
+		batcher, err := NewPriceWriteBatcher(lggr, mockOrm, 100*time.Millisecond)
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		for _, destChain := range destChains {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				rowsUpserted, err := batcher.UpsertGasPricesForDestChain(ctx, destChain, gasPrices)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(destChain), rowsUpserted)
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("reports batch failures to every caller", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("UpsertTokenPricesForDestChains", mock.Anything, []cciporm.TokenPricesUpdate{
+			{DestChainSelector: destChains[0], Interval: time.Minute},
+		}).Return(nil, errors.New("db error")).Once()
+
+		batcher, err := NewPriceWriteBatcher(lggr, mockOrm, 10*time.Millisecond)
+		require.NoError(t, err)
+
+		_, err = batcher.UpsertTokenPricesForDestChain(ctx, destChains[0], nil, time.Minute)
+		require.ErrorContains(t, err, "db error")
+	})
+
+	t.Run("invalid flush window", func(t *testing.T) {
+		_, err := NewPriceWriteBatcher(lggr, nil, 0)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+)
+
+// TokenMetadata describes a dest token, so that it can be referred to by symbol rather than by address.
+type TokenMetadata struct {
+	Symbol string
+	// Decimals are fetched from the dest price registry, they are ignored when configuring the metadata.
+	Decimals uint8
+	// CoinGeckoID identifies the token in external price APIs, empty when unknown.
+	CoinGeckoID string
+}
+
+// refreshTokenMetadata writes the configured token metadata along with the token decimals into the DB, and reloads
+// the metadata of all tokens of the dest chain, including those written by other lanes.
+func (p *priceService) refreshTokenMetadata(ctx context.Context) error {
+	if len(p.tokenMetadata) == 0 {
+		return nil
+	}
+
+	p.dynamicConfigMu.RLock()
+	defer p.dynamicConfigMu.RUnlock()
+
+	if p.destPriceRegistryReader == nil {
+		p.lggr.Info("Skipping token metadata refresh due to destPriceRegistry not ready")
+		return nil
+	}
+
+	tokens := make([]cciptypes.Address, 0, len(p.tokenMetadata))
+	for token := range p.tokenMetadata {
+		tokens = append(tokens, token)
+	}
+	slices.Sort(tokens)
+
+	decimals, err := p.getDestTokensDecimals(ctx, tokens)
+	if err != nil {
+		return fmt.Errorf("get token decimals: %w", err)
+	}
+
+	metadata := make([]cciporm.TokenMetadata, 0, len(tokens))
+	for i, token := range tokens {
+		metadata = append(metadata, cciporm.TokenMetadata{
+			TokenAddr:   string(token),
+			Symbol:      p.tokenMetadata[token].Symbol,
+			Decimals:    decimals[i],
+			CoinGeckoID: p.tokenMetadata[token].CoinGeckoID,
+		})
+	}
+	if _, err = p.orm.UpsertTokenMetadata(ctx, p.destChainSelector, metadata); err != nil {
+		return fmt.Errorf("upsert token metadata: %w", err)
+	}
+
+	dbMetadata, err := p.GetTokenMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	symbols := make(map[cciptypes.Address]string, len(dbMetadata))
+	for token, m := range dbMetadata {
+		symbols[token] = m.Symbol
+	}
+
+	p.tokenSymbolsMu.Lock()
+	defer p.tokenSymbolsMu.Unlock()
+	p.tokenSymbols = symbols
+	return nil
+}
+
+func (p *priceService) GetTokenMetadata(ctx context.Context) (map[cciptypes.Address]TokenMetadata, error) {
+	dbMetadata, err := p.orm.GetTokenMetadata(ctx, p.destChainSelector)
+	if err != nil {
+		return nil, fmt.Errorf("get token metadata from DB: %w", err)
+	}
+	metadata := make(map[cciptypes.Address]TokenMetadata, len(dbMetadata))
+	for _, m := range dbMetadata {
+		metadata[cciptypes.Address(m.TokenAddr)] = TokenMetadata{
+			Symbol:      m.Symbol,
+			Decimals:    m.Decimals,
+			CoinGeckoID: m.CoinGeckoID,
+		}
+	}
+	return metadata, nil
+}
+
+// tokenSymbolsOf returns the known symbols of the given tokens, for logging.
+func (p *priceService) tokenSymbolsOf(tokens []cciptypes.Address) map[cciptypes.Address]string {
+	p.tokenSymbolsMu.RLock()
+	defer p.tokenSymbolsMu.RUnlock()
+
+	symbols := make(map[cciptypes.Address]string)
+	for _, token := range tokens {
+		if symbol, ok := p.tokenSymbols[token]; ok {
+			symbols[token] = symbol
+		}
+	}
+	return symbols
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ccipmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
+)
+
+func TestPriceService_refreshTokenMetadata(t *testing.T) {
+	ctx := tests.Context(t)
+	destChainSelector := uint64(12345)
+	link := cciptypes.Address("0x0001")
+	usdc := cciptypes.Address("0x0002")
+	otherLaneToken := cciptypes.Address("0x0003")
+
+	newPriceService := func(t *testing.T, mockOrm *ccipmocks.ORM, metadata map[cciptypes.Address]TokenMetadata) *priceService {
+		return NewPriceService(
+			logger.TestLogger(t),
+			mockOrm,
+			1,
+			destChainSelector,
+			67890,
+			"",
+			nil,
+			nil,
+			PriceServiceOptions{TokenMetadata: metadata},
+		).(*priceService)
+	}
+
+	t.Run("no metadata configured", func(t *testing.T) {
+		priceService := newPriceService(t, ccipmocks.NewORM(t), nil)
+		require.NoError(t, priceService.refreshTokenMetadata(ctx))
+	})
+
+	t.Run("dest price registry not ready", func(t *testing.T) {
+		priceService := newPriceService(t, ccipmocks.NewORM(t), map[cciptypes.Address]TokenMetadata{link: {Symbol: "LINK"}})
+		require.NoError(t, priceService.refreshTokenMetadata(ctx))
+	})
+
+	t.Run("metadata is written with decimals and symbols are reloaded", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("UpsertTokenMetadata", ctx, destChainSelector, []cciporm.TokenMetadata{
+			{TokenAddr: string(link), Symbol: "LINK", Decimals: 18, CoinGeckoID: "chainlink"},
+			{TokenAddr: string(usdc), Symbol: "USDC", Decimals: 6},
+		}).Return(int64(2), nil).Once()
+		mockOrm.On("GetTokenMetadata", ctx, destChainSelector).Return([]cciporm.TokenMetadata{
+			{TokenAddr: string(link), Symbol: "LINK", Decimals: 18, CoinGeckoID: "chainlink"},
+			{TokenAddr: string(usdc), Symbol: "USDC", Decimals: 6},
+			{TokenAddr: string(otherLaneToken), Symbol: "WETH", Decimals: 18},
+		}, nil).Once()
+
+		priceService := newPriceService(t, mockOrm, map[cciptypes.Address]TokenMetadata{
+			usdc: {Symbol: "USDC"},
+			link: {Symbol: "LINK", CoinGeckoID: "chainlink"},
+		})
+		destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+		destPriceReg.On("GetTokensDecimals", mock.Anything, []cciptypes.Address{link, usdc}).Return([]uint8{18, 6}, nil).Once()
+		priceService.destPriceRegistryReader = destPriceReg
+
+		require.NoError(t, priceService.refreshTokenMetadata(ctx))
+		assert.Equal(t, map[cciptypes.Address]string{
+			usdc:           "USDC",
+			otherLaneToken: "WETH",
+		}, priceService.tokenSymbolsOf([]cciptypes.Address{usdc, otherLaneToken, "0x0004"}))
+	})
+
+	t.Run("ORM error", func(t *testing.T) {
+		mockOrm := ccipmocks.NewORM(t)
+		mockOrm.On("UpsertTokenMetadata", ctx, destChainSelector, mock.Anything).Return(int64(0), errors.New("db error")).Once()
+
+		priceService := newPriceService(t, mockOrm, map[cciptypes.Address]TokenMetadata{link: {Symbol: "LINK"}})
+		destPriceReg := ccipdatamocks.NewPriceRegistryReader(t)
+		destPriceReg.On("GetTokensDecimals", mock.Anything, []cciptypes.Address{link}).Return([]uint8{18}, nil).Once()
+		priceService.destPriceRegistryReader = destPriceReg
+
+		require.ErrorContains(t, priceService.refreshTokenMetadata(ctx), "db error")
+		assert.Empty(t, priceService.tokenSymbolsOf([]cciptypes.Address{link}))
+	})
+}
@@ -0,0 +1,88 @@
+package logpollerutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+// DeferredRegistration registers the log poller filters of a reader in the background, so that the creation of the
+// reader is not blocked on a slow RPC. The registration is retried until it succeeds or the DeferredRegistration is
+// closed. The reader can be used before its filters are registered, but it won't return the logs of the filters until
+// then, Ready signals when it does.
+type DeferredRegistration struct {
+	lggr          logger.Logger
+	register      func(ctx context.Context) error
+	retryInterval time.Duration
+
+	ready     chan struct{}
+	stopChan  services.StopChan
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// RegisterDeferred starts registering the filters with the register func, it is retried every retryInterval on failure.
+func RegisterDeferred(lggr logger.Logger, register func(ctx context.Context) error, retryInterval time.Duration) *DeferredRegistration {
+	r := &DeferredRegistration{
+		lggr:          lggr,
+		register:      register,
+		retryInterval: retryInterval,
+		ready:         make(chan struct{}),
+		stopChan:      make(services.StopChan),
+	}
+	r.wg.Add(1)
+	go r.run()
+	return r
+}
+
+func (r *DeferredRegistration) run() {
+	defer r.wg.Done()
+	ctx, cancel := r.stopChan.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(r.retryInterval)
+	defer ticker.Stop()
+	for {
+		err := r.register(ctx)
+		if err == nil {
+			close(r.ready)
+			return
+		}
+		r.lggr.Warnw("Failed to register filters, retrying", "retryInterval", r.retryInterval, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Ready returns a channel closed once the filters are registered. It is never closed when the registration is closed
+// before it succeeds.
+func (r *DeferredRegistration) Ready() <-chan struct{} {
+	return r.ready
+}
+
+// IsReady returns whether the filters are registered.
+func (r *DeferredRegistration) IsReady() bool {
+	select {
+	case <-r.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops retrying the registration and waits for an ongoing attempt to return. It must be called before the reader
+// is closed, so that the filters are not registered after the reader unregistered them.
+func (r *DeferredRegistration) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.stopChan)
+	})
+	r.wg.Wait()
+	return nil
+}
@@ -0,0 +1,50 @@
+package logpollerutil
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+func TestDeferredRegistration(t *testing.T) {
+	t.Run("registration is retried until it succeeds", func(t *testing.T) {
+		var attempts atomic.Int32
+		r := RegisterDeferred(logger.Test(t), func(context.Context) error {
+			if attempts.Add(1) < 3 {
+				return errors.New("rpc timeout")
+			}
+			return nil
+		}, time.Millisecond)
+
+		select {
+		case <-r.Ready():
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatal("filters were not registered")
+		}
+		assert.True(t, r.IsReady())
+		assert.Equal(t, int32(3), attempts.Load())
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("close stops retrying", func(t *testing.T) {
+		var attempts atomic.Int32
+		r := RegisterDeferred(logger.Test(t), func(context.Context) error {
+			attempts.Add(1)
+			return errors.New("rpc timeout")
+		}, time.Hour)
+
+		require.Eventually(t, func() bool { return attempts.Load() == 1 }, tests.WaitTimeout(t), time.Millisecond)
+		require.NoError(t, r.Close())
+		require.NoError(t, r.Close())
+		assert.False(t, r.IsReady())
+		assert.Equal(t, int32(1), attempts.Load())
+	})
+}
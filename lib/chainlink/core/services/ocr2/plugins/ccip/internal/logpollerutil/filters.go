@@ -69,3 +69,14 @@ func filterContainsZeroAddress(addrs []common.Address) bool {
 	}
 	return false
 }
+
+// DedupFilters returns the filters without the filters whose name was already seen, keeping their order.
+func DedupFilters(filters []logpoller.Filter) []logpoller.Filter {
+	deduped := make([]logpoller.Filter, 0, len(filters))
+	for _, f := range filters {
+		if !containsFilter(deduped, f) {
+			deduped = append(deduped, f)
+		}
+	}
+	return deduped
+}
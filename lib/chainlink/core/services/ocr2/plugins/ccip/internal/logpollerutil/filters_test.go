@@ -154,3 +154,11 @@ func Test_containsFilter(t *testing.T) {
 		})
 	}
 }
+
+func Test_DedupFilters(t *testing.T) {
+	filters := []logpoller.Filter{{Name: "a"}, {Name: "b", Retention: time.Minute}, {Name: "a"}, {Name: "b", Retention: time.Second}, {Name: "c"}}
+	assert.Equal(t,
+		[]logpoller.Filter{{Name: "a"}, {Name: "b", Retention: time.Minute}, {Name: "c"}},
+		DedupFilters(filters))
+	assert.Empty(t, DedupFilters(nil))
+}
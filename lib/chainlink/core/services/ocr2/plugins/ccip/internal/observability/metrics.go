@@ -1,11 +1,16 @@
 package observability
 
 import (
+	"context"
 	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -35,6 +40,12 @@ var (
 		Name: "ccip_reader_dataset_size",
 		Help: "Size of the dataset returned from the Reader instance",
 	}, labels)
+	tracedLabels          = []string{"reader", "version", "contract", "function", "success"}
+	tracedReaderHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ccip_reader_call_duration",
+		Help:    "Duration of the chain reads of the Reader instances created by the ccipdata factory",
+		Buckets: latencyBuckets,
+	}, tracedLabels)
 )
 
 type metricDetails struct {
@@ -73,3 +84,41 @@ func withObservedInteractionAndResults[T any](metric metricDetails, function str
 	}
 	return results, err
 }
+
+var tracer = otel.Tracer("github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata")
+
+// tracedDetails are the labels of the Reader instances created by the ccipdata factory, their contract address
+// identifies the lane of the onRamp, offRamp and commitStore readers.
+type tracedDetails struct {
+	readerName string
+	version    string
+	contract   string
+}
+
+// withTracedInteraction wraps the reader call in a span and records its duration, unlike withObservedInteraction it is
+// not bound to a plugin, so that the reads of any reader created by the factory are observed.
+func withTracedInteraction[T any](ctx context.Context, details tracedDetails, function string, f func(ctx context.Context) (T, error)) (T, error) {
+	ctx, span := tracer.Start(ctx, details.readerName+"."+function, trace.WithAttributes(
+		attribute.String("reader", details.readerName),
+		attribute.String("version", details.version),
+		attribute.String("contract", details.contract),
+	))
+	defer span.End()
+
+	contractExecutionStarted := time.Now()
+	value, err := f(ctx)
+	tracedReaderHistogram.
+		WithLabelValues(
+			details.readerName,
+			details.version,
+			details.contract,
+			function,
+			strconv.FormatBool(err == nil),
+		).
+		Observe(float64(time.Since(contractExecutionStarted)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return value, err
+}
@@ -7,10 +7,14 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
 	ccipdatamocks "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata/mocks"
 )
 
@@ -57,9 +61,34 @@ func TestMetricsSendFromContractDirectly(t *testing.T) {
 		_, _ = observedOfframp.GetTokens(ctx)
 	}
 
-	assert.Equal(t, expectedCounter, counterFromHistogramByLabels(t, observedOfframp.metric.interactionDuration, "420", "plugin", "OffRampReader", "GetTokens", "false"))
-	assert.Equal(t, 0, counterFromHistogramByLabels(t, observedOfframp.metric.interactionDuration, "420", "plugin", "OffRampReader", "GetPoolByDestToken", "false"))
-	assert.Equal(t, 0, counterFromHistogramByLabels(t, observedOfframp.metric.interactionDuration, "420", "plugin", "OffRampReader", "GetPoolByDestToken", "true"))
+	assert.Equal(t, expectedCounter, counterFromHistogramByLabels(t, readerHistogram, "420", "plugin", "OffRampReader", "GetTokens", "false"))
+	assert.Equal(t, 0, counterFromHistogramByLabels(t, readerHistogram, "420", "plugin", "OffRampReader", "GetPoolByDestToken", "false"))
+	assert.Equal(t, 0, counterFromHistogramByLabels(t, readerHistogram, "420", "plugin", "OffRampReader", "GetPoolByDestToken", "true"))
+}
+
+func TestTracedReaderMetrics(t *testing.T) {
+	ctx := testutils.Context(t)
+	contract := cciptypes.Address(utils.RandomAddress().String())
+
+	mockedOffRamp := ccipdatamocks.NewOffRampReader(t)
+	mockedOffRamp.On("GetTokens", mock.Anything).Return(cciptypes.OffRampTokens{}, errors.New("execution error")).Twice()
+	mockedOffRamp.On("GetRouter", mock.Anything).Return(cciptypes.Address(utils.RandomAddress().String()), nil).Once()
+
+	tracedOffRamp := NewTracedOffRampReader(mockedOffRamp, "1.5.0", contract)
+	for i := 0; i < 2; i++ {
+		_, err := tracedOffRamp.GetTokens(ctx)
+		require.Error(t, err)
+	}
+	_, err := tracedOffRamp.GetRouter(ctx)
+	require.NoError(t, err)
+
+	// The mocked reader doesn't read the token pool history, so neither does the traced one
+	_, ok := tracedOffRamp.(ccipdata.OffRampTokenPoolHistoryReader)
+	assert.False(t, ok)
+
+	assert.Equal(t, 2, counterFromHistogramByLabels(t, tracedReaderHistogram, "OffRampReader", "1.5.0", string(contract), "GetTokens", "false"))
+	assert.Equal(t, 1, counterFromHistogramByLabels(t, tracedReaderHistogram, "OffRampReader", "1.5.0", string(contract), "GetRouter", "true"))
+	assert.Equal(t, 0, counterFromHistogramByLabels(t, tracedReaderHistogram, "OffRampReader", "1.5.0", string(contract), "GetTokens", "true"))
 }
 
 func counterFromHistogramByLabels(t *testing.T, histogramVec *prometheus.HistogramVec, labels ...string) int {
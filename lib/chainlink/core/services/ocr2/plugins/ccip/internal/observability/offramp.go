@@ -2,6 +2,7 @@ package observability
 
 import (
 	"context"
+	"errors"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
@@ -13,8 +14,10 @@ type ObservedOffRampReader struct {
 	metric metricDetails
 }
 
-func NewObservedOffRampReader(origin ccipdata.OffRampReader, chainID int64, pluginName string) *ObservedOffRampReader {
-	return &ObservedOffRampReader{
+// NewObservedOffRampReader returns an ObservedOffRampReader, which is a ccipdata.OffRampTokenPoolHistoryReader if origin
+// is.
+func NewObservedOffRampReader(origin ccipdata.OffRampReader, chainID int64, pluginName string) ccipdata.OffRampReader {
+	observed := &ObservedOffRampReader{
 		OffRampReader: origin,
 		metric: metricDetails{
 			interactionDuration: readerHistogram,
@@ -24,6 +27,16 @@ func NewObservedOffRampReader(origin ccipdata.OffRampReader, chainID int64, plug
 			chainId:             chainID,
 		},
 	}
+	if historyReader, ok := origin.(ccipdata.OffRampTokenPoolHistoryReader); ok {
+		return &observedOffRampTokenPoolHistoryReader{ObservedOffRampReader: observed, historyReader: historyReader}
+	}
+	return observed
+}
+
+// observedOffRampTokenPoolHistoryReader is the ObservedOffRampReader of an offRamp reader reading the token pool history.
+type observedOffRampTokenPoolHistoryReader struct {
+	*ObservedOffRampReader
+	historyReader ccipdata.OffRampTokenPoolHistoryReader
 }
 
 func (o *ObservedOffRampReader) GetExecutionStateChangesBetweenSeqNums(ctx context.Context, seqNumMin, seqNumMax uint64, confs int) ([]cciptypes.ExecutionStateChangedWithTxMeta, error) {
@@ -32,6 +45,12 @@ func (o *ObservedOffRampReader) GetExecutionStateChangesBetweenSeqNums(ctx conte
 	})
 }
 
+func (o *observedOffRampTokenPoolHistoryReader) GetTokenPoolChangesBetweenBlocks(ctx context.Context, fromBlock, toBlock uint64) ([]ccipdata.Event[ccipdata.TokenPoolChange], error) {
+	return withObservedInteractionAndResults(o.metric, "GetTokenPoolChangesBetweenBlocks", func() ([]ccipdata.Event[ccipdata.TokenPoolChange], error) {
+		return o.historyReader.GetTokenPoolChangesBetweenBlocks(ctx, fromBlock, toBlock)
+	})
+}
+
 func (o *ObservedOffRampReader) CurrentRateLimiterState(ctx context.Context) (cciptypes.TokenBucketRateLimit, error) {
 	return withObservedInteraction(o.metric, "CurrentRateLimiterState", func() (cciptypes.TokenBucketRateLimit, error) {
 		return o.OffRampReader.CurrentRateLimiterState(ctx)
@@ -67,3 +86,13 @@ func (o *ObservedOffRampReader) GetSendersNonce(ctx context.Context, senders []c
 		return o.OffRampReader.ListSenderNonces(ctx, senders)
 	})
 }
+
+func (o *ObservedOffRampReader) GetTokenBucketState(ctx context.Context, tokenPools []cciptypes.Address) (ccipdata.TokenBucketState, error) {
+	return withObservedInteraction(o.metric, "GetTokenBucketState", func() (ccipdata.TokenBucketState, error) {
+		bucketReader, ok := o.OffRampReader.(ccipdata.OffRampTokenBucketReader)
+		if !ok {
+			return ccipdata.TokenBucketState{}, errors.New("offRamp reader does not support token bucket state")
+		}
+		return bucketReader.GetTokenBucketState(ctx, tokenPools)
+	})
+}
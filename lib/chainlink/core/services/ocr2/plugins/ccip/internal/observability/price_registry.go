@@ -2,6 +2,7 @@ package observability
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
@@ -62,3 +63,14 @@ func (o *ObservedPriceRegistryReader) GetTokensDecimals(ctx context.Context, tok
 		return o.PriceRegistryReader.GetTokensDecimals(ctx, tokenAddresses)
 	})
 }
+
+func (o *ObservedPriceRegistryReader) SimulatePriceUpdates(ctx context.Context, priceUpdater cciptypes.Address, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice) error {
+	_, err := withObservedInteraction(o.metric, "SimulatePriceUpdates", func() (struct{}, error) {
+		simulator, ok := o.PriceRegistryReader.(ccipdata.PriceRegistryUpdateSimulator)
+		if !ok {
+			return struct{}{}, errors.New("price registry reader does not support price update simulation")
+		}
+		return struct{}{}, simulator.SimulatePriceUpdates(ctx, priceUpdater, gasPrices, tokenPrices)
+	})
+	return err
+}
@@ -0,0 +1,82 @@
+package observability
+
+import (
+	"context"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// TracedCommitStoreReader records a span and the duration of the chain reads of the CommitStoreReader instances created
+// by the ccipdata factory.
+type TracedCommitStoreReader struct {
+	ccipdata.CommitStoreReader
+	details tracedDetails
+}
+
+func NewTracedCommitStoreReader(origin ccipdata.CommitStoreReader, version string, contract cciptypes.Address) *TracedCommitStoreReader {
+	return &TracedCommitStoreReader{
+		CommitStoreReader: origin,
+		details: tracedDetails{
+			readerName: "CommitStoreReader",
+			version:    version,
+			contract:   string(contract),
+		},
+	}
+}
+
+func (o *TracedCommitStoreReader) GetAcceptedCommitReportsGteTimestamp(ctx context.Context, ts time.Time, confirmations int) ([]cciptypes.CommitStoreReportWithTxMeta, error) {
+	return withTracedInteraction(ctx, o.details, "GetAcceptedCommitReportsGteTimestamp", func(ctx context.Context) ([]cciptypes.CommitStoreReportWithTxMeta, error) {
+		return o.CommitStoreReader.GetAcceptedCommitReportsGteTimestamp(ctx, ts, confirmations)
+	})
+}
+
+func (o *TracedCommitStoreReader) GetCommitReportMatchingSeqNum(ctx context.Context, seqNum uint64, confirmations int) ([]cciptypes.CommitStoreReportWithTxMeta, error) {
+	return withTracedInteraction(ctx, o.details, "GetCommitReportMatchingSeqNum", func(ctx context.Context) ([]cciptypes.CommitStoreReportWithTxMeta, error) {
+		return o.CommitStoreReader.GetCommitReportMatchingSeqNum(ctx, seqNum, confirmations)
+	})
+}
+
+func (o *TracedCommitStoreReader) GetCommitStoreStaticConfig(ctx context.Context) (cciptypes.CommitStoreStaticConfig, error) {
+	return withTracedInteraction(ctx, o.details, "GetCommitStoreStaticConfig", func(ctx context.Context) (cciptypes.CommitStoreStaticConfig, error) {
+		return o.CommitStoreReader.GetCommitStoreStaticConfig(ctx)
+	})
+}
+
+func (o *TracedCommitStoreReader) GetExpectedNextSequenceNumber(ctx context.Context) (uint64, error) {
+	return withTracedInteraction(ctx, o.details, "GetExpectedNextSequenceNumber", func(ctx context.Context) (uint64, error) {
+		return o.CommitStoreReader.GetExpectedNextSequenceNumber(ctx)
+	})
+}
+
+func (o *TracedCommitStoreReader) GetLatestPriceEpochAndRound(ctx context.Context) (uint64, error) {
+	return withTracedInteraction(ctx, o.details, "GetLatestPriceEpochAndRound", func(ctx context.Context) (uint64, error) {
+		return o.CommitStoreReader.GetLatestPriceEpochAndRound(ctx)
+	})
+}
+
+func (o *TracedCommitStoreReader) IsBlessed(ctx context.Context, root [32]byte) (bool, error) {
+	return withTracedInteraction(ctx, o.details, "IsBlessed", func(ctx context.Context) (bool, error) {
+		return o.CommitStoreReader.IsBlessed(ctx, root)
+	})
+}
+
+func (o *TracedCommitStoreReader) IsDestChainHealthy(ctx context.Context) (bool, error) {
+	return withTracedInteraction(ctx, o.details, "IsDestChainHealthy", func(ctx context.Context) (bool, error) {
+		return o.CommitStoreReader.IsDestChainHealthy(ctx)
+	})
+}
+
+func (o *TracedCommitStoreReader) IsDown(ctx context.Context) (bool, error) {
+	return withTracedInteraction(ctx, o.details, "IsDown", func(ctx context.Context) (bool, error) {
+		return o.CommitStoreReader.IsDown(ctx)
+	})
+}
+
+func (o *TracedCommitStoreReader) VerifyExecutionReport(ctx context.Context, report cciptypes.ExecReport) (bool, error) {
+	return withTracedInteraction(ctx, o.details, "VerifyExecutionReport", func(ctx context.Context) (bool, error) {
+		return o.CommitStoreReader.VerifyExecutionReport(ctx, report)
+	})
+}
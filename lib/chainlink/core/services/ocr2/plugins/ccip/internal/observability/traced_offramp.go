@@ -0,0 +1,103 @@
+package observability
+
+import (
+	"context"
+	"errors"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// TracedOffRampReader records a span and the duration of the chain reads of the OffRampReader instances created
+// by the ccipdata factory.
+type TracedOffRampReader struct {
+	ccipdata.OffRampReader
+	details tracedDetails
+}
+
+// NewTracedOffRampReader returns a TracedOffRampReader, which is a ccipdata.OffRampTokenPoolHistoryReader if origin is.
+func NewTracedOffRampReader(origin ccipdata.OffRampReader, version string, contract cciptypes.Address) ccipdata.OffRampReader {
+	traced := &TracedOffRampReader{
+		OffRampReader: origin,
+		details: tracedDetails{
+			readerName: "OffRampReader",
+			version:    version,
+			contract:   string(contract),
+		},
+	}
+	if historyReader, ok := origin.(ccipdata.OffRampTokenPoolHistoryReader); ok {
+		return &tracedOffRampTokenPoolHistoryReader{TracedOffRampReader: traced, historyReader: historyReader}
+	}
+	return traced
+}
+
+// tracedOffRampTokenPoolHistoryReader is the TracedOffRampReader of an offRamp reader reading the token pool history.
+type tracedOffRampTokenPoolHistoryReader struct {
+	*TracedOffRampReader
+	historyReader ccipdata.OffRampTokenPoolHistoryReader
+}
+
+func (o *TracedOffRampReader) CurrentRateLimiterState(ctx context.Context) (cciptypes.TokenBucketRateLimit, error) {
+	return withTracedInteraction(ctx, o.details, "CurrentRateLimiterState", func(ctx context.Context) (cciptypes.TokenBucketRateLimit, error) {
+		return o.OffRampReader.CurrentRateLimiterState(ctx)
+	})
+}
+
+func (o *TracedOffRampReader) GetExecutionState(ctx context.Context, sequenceNumber uint64) (uint8, error) {
+	return withTracedInteraction(ctx, o.details, "GetExecutionState", func(ctx context.Context) (uint8, error) {
+		return o.OffRampReader.GetExecutionState(ctx, sequenceNumber)
+	})
+}
+
+func (o *TracedOffRampReader) GetExecutionStateChangesBetweenSeqNums(ctx context.Context, seqNumMin, seqNumMax uint64, confirmations int) ([]cciptypes.ExecutionStateChangedWithTxMeta, error) {
+	return withTracedInteraction(ctx, o.details, "GetExecutionStateChangesBetweenSeqNums", func(ctx context.Context) ([]cciptypes.ExecutionStateChangedWithTxMeta, error) {
+		return o.OffRampReader.GetExecutionStateChangesBetweenSeqNums(ctx, seqNumMin, seqNumMax, confirmations)
+	})
+}
+
+func (o *TracedOffRampReader) GetRouter(ctx context.Context) (cciptypes.Address, error) {
+	return withTracedInteraction(ctx, o.details, "GetRouter", func(ctx context.Context) (cciptypes.Address, error) {
+		return o.OffRampReader.GetRouter(ctx)
+	})
+}
+
+func (o *TracedOffRampReader) GetSourceToDestTokensMapping(ctx context.Context) (map[cciptypes.Address]cciptypes.Address, error) {
+	return withTracedInteraction(ctx, o.details, "GetSourceToDestTokensMapping", func(ctx context.Context) (map[cciptypes.Address]cciptypes.Address, error) {
+		return o.OffRampReader.GetSourceToDestTokensMapping(ctx)
+	})
+}
+
+func (o *TracedOffRampReader) GetStaticConfig(ctx context.Context) (cciptypes.OffRampStaticConfig, error) {
+	return withTracedInteraction(ctx, o.details, "GetStaticConfig", func(ctx context.Context) (cciptypes.OffRampStaticConfig, error) {
+		return o.OffRampReader.GetStaticConfig(ctx)
+	})
+}
+
+func (o *TracedOffRampReader) GetTokens(ctx context.Context) (cciptypes.OffRampTokens, error) {
+	return withTracedInteraction(ctx, o.details, "GetTokens", func(ctx context.Context) (cciptypes.OffRampTokens, error) {
+		return o.OffRampReader.GetTokens(ctx)
+	})
+}
+
+func (o *TracedOffRampReader) ListSenderNonces(ctx context.Context, senders []cciptypes.Address) (map[cciptypes.Address]uint64, error) {
+	return withTracedInteraction(ctx, o.details, "ListSenderNonces", func(ctx context.Context) (map[cciptypes.Address]uint64, error) {
+		return o.OffRampReader.ListSenderNonces(ctx, senders)
+	})
+}
+
+func (o *tracedOffRampTokenPoolHistoryReader) GetTokenPoolChangesBetweenBlocks(ctx context.Context, fromBlock, toBlock uint64) ([]ccipdata.Event[ccipdata.TokenPoolChange], error) {
+	return withTracedInteraction(ctx, o.details, "GetTokenPoolChangesBetweenBlocks", func(ctx context.Context) ([]ccipdata.Event[ccipdata.TokenPoolChange], error) {
+		return o.historyReader.GetTokenPoolChangesBetweenBlocks(ctx, fromBlock, toBlock)
+	})
+}
+
+func (o *TracedOffRampReader) GetTokenBucketState(ctx context.Context, tokenPools []cciptypes.Address) (ccipdata.TokenBucketState, error) {
+	return withTracedInteraction(ctx, o.details, "GetTokenBucketState", func(ctx context.Context) (ccipdata.TokenBucketState, error) {
+		bucketReader, ok := o.OffRampReader.(ccipdata.OffRampTokenBucketReader)
+		if !ok {
+			return ccipdata.TokenBucketState{}, errors.New("offRamp reader does not support token bucket state")
+		}
+		return bucketReader.GetTokenBucketState(ctx, tokenPools)
+	})
+}
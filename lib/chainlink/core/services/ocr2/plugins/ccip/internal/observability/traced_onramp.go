@@ -0,0 +1,63 @@
+package observability
+
+import (
+	"context"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// TracedOnRampReader records a span and the duration of the chain reads of the OnRampReader instances created
+// by the ccipdata factory.
+type TracedOnRampReader struct {
+	ccipdata.OnRampReader
+	details tracedDetails
+}
+
+func NewTracedOnRampReader(origin ccipdata.OnRampReader, version string, contract cciptypes.Address) *TracedOnRampReader {
+	return &TracedOnRampReader{
+		OnRampReader: origin,
+		details: tracedDetails{
+			readerName: "OnRampReader",
+			version:    version,
+			contract:   string(contract),
+		},
+	}
+}
+
+func (o *TracedOnRampReader) GetDynamicConfig(ctx context.Context) (cciptypes.OnRampDynamicConfig, error) {
+	return withTracedInteraction(ctx, o.details, "GetDynamicConfig", func(ctx context.Context) (cciptypes.OnRampDynamicConfig, error) {
+		return o.OnRampReader.GetDynamicConfig(ctx)
+	})
+}
+
+func (o *TracedOnRampReader) GetSendRequestsBetweenSeqNums(ctx context.Context, seqNumMin, seqNumMax uint64, finalized bool) ([]cciptypes.EVM2EVMMessageWithTxMeta, error) {
+	return withTracedInteraction(ctx, o.details, "GetSendRequestsBetweenSeqNums", func(ctx context.Context) ([]cciptypes.EVM2EVMMessageWithTxMeta, error) {
+		return o.OnRampReader.GetSendRequestsBetweenSeqNums(ctx, seqNumMin, seqNumMax, finalized)
+	})
+}
+
+func (o *TracedOnRampReader) IsSourceChainHealthy(ctx context.Context) (bool, error) {
+	return withTracedInteraction(ctx, o.details, "IsSourceChainHealthy", func(ctx context.Context) (bool, error) {
+		return o.OnRampReader.IsSourceChainHealthy(ctx)
+	})
+}
+
+func (o *TracedOnRampReader) IsSourceCursed(ctx context.Context) (bool, error) {
+	return withTracedInteraction(ctx, o.details, "IsSourceCursed", func(ctx context.Context) (bool, error) {
+		return o.OnRampReader.IsSourceCursed(ctx)
+	})
+}
+
+func (o *TracedOnRampReader) RouterAddress(ctx context.Context) (cciptypes.Address, error) {
+	return withTracedInteraction(ctx, o.details, "RouterAddress", func(ctx context.Context) (cciptypes.Address, error) {
+		return o.OnRampReader.RouterAddress(ctx)
+	})
+}
+
+func (o *TracedOnRampReader) SourcePriceRegistryAddress(ctx context.Context) (cciptypes.Address, error) {
+	return withTracedInteraction(ctx, o.details, "SourcePriceRegistryAddress", func(ctx context.Context) (cciptypes.Address, error) {
+		return o.OnRampReader.SourcePriceRegistryAddress(ctx)
+	})
+}
@@ -0,0 +1,76 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdata"
+)
+
+// TracedPriceRegistryReader records a span and the duration of the chain reads of the PriceRegistryReader instances created
+// by the ccipdata factory.
+type TracedPriceRegistryReader struct {
+	ccipdata.PriceRegistryReader
+	details tracedDetails
+}
+
+func NewTracedPriceRegistryReader(origin ccipdata.PriceRegistryReader, version string, contract cciptypes.Address) *TracedPriceRegistryReader {
+	return &TracedPriceRegistryReader{
+		PriceRegistryReader: origin,
+		details: tracedDetails{
+			readerName: "PriceRegistryReader",
+			version:    version,
+			contract:   string(contract),
+		},
+	}
+}
+
+func (o *TracedPriceRegistryReader) GetAllGasPriceUpdatesCreatedAfter(ctx context.Context, ts time.Time, confirmations int) ([]cciptypes.GasPriceUpdateWithTxMeta, error) {
+	return withTracedInteraction(ctx, o.details, "GetAllGasPriceUpdatesCreatedAfter", func(ctx context.Context) ([]cciptypes.GasPriceUpdateWithTxMeta, error) {
+		return o.PriceRegistryReader.GetAllGasPriceUpdatesCreatedAfter(ctx, ts, confirmations)
+	})
+}
+
+func (o *TracedPriceRegistryReader) GetFeeTokens(ctx context.Context) ([]cciptypes.Address, error) {
+	return withTracedInteraction(ctx, o.details, "GetFeeTokens", func(ctx context.Context) ([]cciptypes.Address, error) {
+		return o.PriceRegistryReader.GetFeeTokens(ctx)
+	})
+}
+
+func (o *TracedPriceRegistryReader) GetGasPriceUpdatesCreatedAfter(ctx context.Context, chainSelector uint64, ts time.Time, confirmations int) ([]cciptypes.GasPriceUpdateWithTxMeta, error) {
+	return withTracedInteraction(ctx, o.details, "GetGasPriceUpdatesCreatedAfter", func(ctx context.Context) ([]cciptypes.GasPriceUpdateWithTxMeta, error) {
+		return o.PriceRegistryReader.GetGasPriceUpdatesCreatedAfter(ctx, chainSelector, ts, confirmations)
+	})
+}
+
+func (o *TracedPriceRegistryReader) GetTokenPriceUpdatesCreatedAfter(ctx context.Context, ts time.Time, confirmations int) ([]cciptypes.TokenPriceUpdateWithTxMeta, error) {
+	return withTracedInteraction(ctx, o.details, "GetTokenPriceUpdatesCreatedAfter", func(ctx context.Context) ([]cciptypes.TokenPriceUpdateWithTxMeta, error) {
+		return o.PriceRegistryReader.GetTokenPriceUpdatesCreatedAfter(ctx, ts, confirmations)
+	})
+}
+
+func (o *TracedPriceRegistryReader) GetTokenPrices(ctx context.Context, wantedTokens []cciptypes.Address) ([]cciptypes.TokenPriceUpdate, error) {
+	return withTracedInteraction(ctx, o.details, "GetTokenPrices", func(ctx context.Context) ([]cciptypes.TokenPriceUpdate, error) {
+		return o.PriceRegistryReader.GetTokenPrices(ctx, wantedTokens)
+	})
+}
+
+func (o *TracedPriceRegistryReader) GetTokensDecimals(ctx context.Context, tokenAddresses []cciptypes.Address) ([]uint8, error) {
+	return withTracedInteraction(ctx, o.details, "GetTokensDecimals", func(ctx context.Context) ([]uint8, error) {
+		return o.PriceRegistryReader.GetTokensDecimals(ctx, tokenAddresses)
+	})
+}
+
+func (o *TracedPriceRegistryReader) SimulatePriceUpdates(ctx context.Context, priceUpdater cciptypes.Address, gasPrices []cciptypes.GasPrice, tokenPrices []cciptypes.TokenPrice) error {
+	_, err := withTracedInteraction(ctx, o.details, "SimulatePriceUpdates", func(ctx context.Context) (struct{}, error) {
+		simulator, ok := o.PriceRegistryReader.(ccipdata.PriceRegistryUpdateSimulator)
+		if !ok {
+			return struct{}{}, errors.New("price registry reader does not support price update simulation")
+		}
+		return struct{}{}, simulator.SimulatePriceUpdates(ctx, priceUpdater, gasPrices, tokenPrices)
+	})
+	return err
+}
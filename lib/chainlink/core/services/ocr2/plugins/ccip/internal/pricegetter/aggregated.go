@@ -0,0 +1,207 @@
+package pricegetter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+var _ ConfidencePriceGetter = &AggregatedPriceGetter{}
+
+// AggregatedPriceGetter queries multiple redundant price getters concurrently and aggregates the prices returned
+// for every token according to the configured mode. It improves robustness against a single bad price feed.
+// Failing price getters are skipped, an error is returned only if all of them fail.
+type AggregatedPriceGetter struct {
+	lggr    logger.Logger
+	mode    config.PriceAggregationMode
+	getters []AllTokensPriceGetter
+}
+
+func NewAggregatedPriceGetter(
+	lggr logger.Logger,
+	mode config.PriceAggregationMode,
+	getters ...AllTokensPriceGetter,
+) (*AggregatedPriceGetter, error) {
+	if len(getters) == 0 {
+		return nil, errors.New("at least one price getter is required")
+	}
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &AggregatedPriceGetter{
+		lggr:    lggr,
+		mode:    mode,
+		getters: getters,
+	}, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the aggregated prices of all tokens defined in the job specs of the underlying price getters.
+func (a *AggregatedPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	prices, err := a.GetJobSpecTokenPricesWithConfidence(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return withoutConfidence(prices), nil
+}
+
+// GetTokenPricesUSD returns the aggregated prices of the provided tokens in USD.
+func (a *AggregatedPriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	prices, err := a.GetTokenPricesWithConfidence(ctx, tokens)
+	if err != nil {
+		return nil, err
+	}
+	return withoutConfidence(prices), nil
+}
+
+// GetJobSpecTokenPricesWithConfidence returns the aggregated job spec prices along with the confidence in them, see
+// priceConfidence.
+func (a *AggregatedPriceGetter) GetJobSpecTokenPricesWithConfidence(ctx context.Context) (map[ccipcommon.TokenID]PriceWithConfidence, error) {
+	return a.aggregate(func(getter AllTokensPriceGetter) (map[ccipcommon.TokenID]*big.Int, error) {
+		return getter.GetJobSpecTokenPricesUSD(ctx)
+	})
+}
+
+// GetTokenPricesWithConfidence returns the aggregated prices of the provided tokens along with the confidence in them,
+// see priceConfidence.
+func (a *AggregatedPriceGetter) GetTokenPricesWithConfidence(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]PriceWithConfidence, error) {
+	return a.aggregate(func(getter AllTokensPriceGetter) (map[ccipcommon.TokenID]*big.Int, error) {
+		return getter.GetTokenPricesUSD(ctx, tokens)
+	})
+}
+
+func (a *AggregatedPriceGetter) aggregate(
+	getPrices func(getter AllTokensPriceGetter) (map[ccipcommon.TokenID]*big.Int, error),
+) (map[ccipcommon.TokenID]PriceWithConfidence, error) {
+	results := make([]map[ccipcommon.TokenID]*big.Int, len(a.getters))
+	errs := make([]error, len(a.getters))
+
+	var wg sync.WaitGroup
+	for i, getter := range a.getters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = getPrices(getter)
+		}()
+	}
+	wg.Wait()
+
+	pricesPerToken := make(map[ccipcommon.TokenID][]*big.Int)
+	numFailed := 0
+	for i := range a.getters {
+		if errs[i] != nil {
+			numFailed++
+			// Open circuits were already reported when they opened, don't repeat it on every aggregation
+			if errors.Is(errs[i], ErrCircuitOpen) {
+				a.lggr.Debugw("Price getter circuit is open, skipping it during aggregation", "index", i)
+				continue
+			}
+			a.lggr.Warnw("Price getter failed, skipping it during aggregation", "index", i, "err", errs[i])
+			continue
+		}
+		for token, price := range results[i] {
+			if price == nil {
+				continue
+			}
+			pricesPerToken[token] = append(pricesPerToken[token], price)
+		}
+	}
+	if numFailed == len(a.getters) {
+		return nil, fmt.Errorf("all %d price getters failed: %w", numFailed, errors.Join(errs...))
+	}
+
+	prices := make(map[ccipcommon.TokenID]PriceWithConfidence, len(pricesPerToken))
+	for token, tokenPrices := range pricesPerToken {
+		price := aggregatePrices(a.mode, tokenPrices)
+		prices[token] = PriceWithConfidence{
+			Price:           price,
+			PriceConfidence: priceConfidence(price, tokenPrices, len(a.getters)),
+		}
+	}
+
+	a.lggr.Debugw("Aggregated token prices",
+		"mode", a.mode,
+		"numGetters", len(a.getters),
+		"numFailed", numFailed,
+		"prices", prices,
+	)
+	return prices, nil
+}
+
+// Close closes all the underlying price getters.
+func (a *AggregatedPriceGetter) Close() error {
+	errs := make([]error, 0, len(a.getters))
+	for _, getter := range a.getters {
+		errs = append(errs, getter.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// aggregatePrices combines the given non-empty list of prices according to the mode.
+func aggregatePrices(mode config.PriceAggregationMode, prices []*big.Int) *big.Int {
+	sorted := make([]*big.Int, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+
+	switch mode {
+	case config.PriceAggregationMean:
+		return mean(sorted)
+	case config.PriceAggregationTrimmedMean:
+		// Drop the lowest and the highest price, as long as there is at least one price left
+		if len(sorted) >= 3 {
+			return mean(sorted[1 : len(sorted)-1])
+		}
+		return mean(sorted)
+	default:
+		mid := len(sorted) / 2
+		if len(sorted)%2 == 1 {
+			return new(big.Int).Set(sorted[mid])
+		}
+		return mean(sorted[mid-1 : mid+1])
+	}
+}
+
+func mean(prices []*big.Int) *big.Int {
+	sum := big.NewInt(0)
+	for _, price := range prices {
+		sum.Add(sum, price)
+	}
+	return sum.Div(sum, big.NewInt(int64(len(prices))))
+}
+
+// priceConfidence scores an aggregated price by the share of the price getters which returned a price for the token,
+// lowered by the spread of the returned prices relative to the aggregated price. A price all price getters agree on
+// scores 1, the score is 0 once the prices spread by the aggregated price or more.
+func priceConfidence(price *big.Int, prices []*big.Int, numGetters int) PriceConfidence {
+	score := float64(len(prices)) / float64(numGetters)
+	if price.Sign() > 0 {
+		lowest, highest := prices[0], prices[0]
+		for _, p := range prices[1:] {
+			if p.Cmp(lowest) < 0 {
+				lowest = p
+			}
+			if p.Cmp(highest) > 0 {
+				highest = p
+			}
+		}
+		spread, _ := new(big.Rat).SetFrac(new(big.Int).Sub(highest, lowest), price).Float64()
+		score *= max(0, 1-spread)
+	}
+	return PriceConfidence{SourceCount: len(prices), Score: score}
+}
+
+func withoutConfidence(prices map[ccipcommon.TokenID]PriceWithConfidence) map[ccipcommon.TokenID]*big.Int {
+	result := make(map[ccipcommon.TokenID]*big.Int, len(prices))
+	for token, price := range prices {
+		result[token] = price.Price
+	}
+	return result
+}
@@ -0,0 +1,128 @@
+package pricegetter
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+func TestAggregatedPriceGetter_aggregatePrices(t *testing.T) {
+	prices := []*big.Int{big.NewInt(100), big.NewInt(1), big.NewInt(10), big.NewInt(13)}
+
+	testCases := []struct {
+		name     string
+		mode     config.PriceAggregationMode
+		prices   []*big.Int
+		expPrice *big.Int
+	}{
+		{name: "median of odd number of prices", mode: config.PriceAggregationMedian, prices: prices[:3], expPrice: big.NewInt(10)},
+		{name: "median of even number of prices", mode: config.PriceAggregationMedian, prices: prices, expPrice: big.NewInt(11)},
+		{name: "mean", mode: config.PriceAggregationMean, prices: prices, expPrice: big.NewInt(31)},
+		{name: "trimmed mean", mode: config.PriceAggregationTrimmedMean, prices: prices, expPrice: big.NewInt(11)},
+		{name: "trimmed mean of two prices", mode: config.PriceAggregationTrimmedMean, prices: prices[:2], expPrice: big.NewInt(50)},
+		{name: "single price", mode: config.PriceAggregationMedian, prices: prices[:1], expPrice: big.NewInt(100)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expPrice, aggregatePrices(tc.mode, tc.prices))
+		})
+	}
+}
+
+func TestAggregatedPriceGetter_GetTokenPricesUSD(t *testing.T) {
+	ctx := tests.Context(t)
+	token1 := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x1"), ChainSelector: 1}
+	token2 := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x2"), ChainSelector: 1}
+	tokens := []ccipcommon.TokenID{token1, token2}
+
+	t.Run("prices are aggregated and failing getters are skipped", func(t *testing.T) {
+		getter1 := NewMockAllTokensPriceGetter(t)
+		getter1.EXPECT().GetTokenPricesUSD(ctx, tokens).
+			Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10), token2: big.NewInt(20)}, nil)
+		getter2 := NewMockAllTokensPriceGetter(t)
+		getter2.EXPECT().GetTokenPricesUSD(ctx, tokens).
+			Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(12)}, nil)
+		getter3 := NewMockAllTokensPriceGetter(t)
+		getter3.EXPECT().GetTokenPricesUSD(ctx, tokens).
+			Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(1000), token2: big.NewInt(22)}, nil)
+		getter4 := NewMockAllTokensPriceGetter(t)
+		getter4.EXPECT().GetTokenPricesUSD(ctx, tokens).Return(nil, errors.New("rpc error"))
+
+		priceGetter, err := NewAggregatedPriceGetter(logger.Test(t), config.PriceAggregationMedian, getter1, getter2, getter3, getter4)
+		require.NoError(t, err)
+
+		prices, err := priceGetter.GetTokenPricesUSD(ctx, tokens)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{
+			token1: big.NewInt(12),
+			token2: big.NewInt(21),
+		}, prices)
+	})
+
+	t.Run("error when all getters fail", func(t *testing.T) {
+		getter1 := NewMockAllTokensPriceGetter(t)
+		getter1.EXPECT().GetTokenPricesUSD(ctx, tokens).Return(nil, errors.New("rpc error"))
+		getter2 := NewMockAllTokensPriceGetter(t)
+		getter2.EXPECT().GetTokenPricesUSD(ctx, tokens).Return(nil, errors.New("feed error"))
+
+		priceGetter, err := NewAggregatedPriceGetter(logger.Test(t), config.PriceAggregationMean, getter1, getter2)
+		require.NoError(t, err)
+
+		_, err = priceGetter.GetTokenPricesUSD(ctx, tokens)
+		require.ErrorContains(t, err, "all 2 price getters failed")
+	})
+}
+
+func TestAggregatedPriceGetter_GetJobSpecTokenPricesWithConfidence(t *testing.T) {
+	ctx := tests.Context(t)
+	token1 := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x1"), ChainSelector: 1}
+	token2 := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x2"), ChainSelector: 1}
+
+	getter1 := NewMockAllTokensPriceGetter(t)
+	getter1.EXPECT().GetJobSpecTokenPricesUSD(ctx).
+		Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(100), token2: big.NewInt(90)}, nil)
+	getter2 := NewMockAllTokensPriceGetter(t)
+	getter2.EXPECT().GetJobSpecTokenPricesUSD(ctx).
+		Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(100), token2: big.NewInt(110)}, nil)
+	getter3 := NewMockAllTokensPriceGetter(t)
+	getter3.EXPECT().GetJobSpecTokenPricesUSD(ctx).
+		Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(100)}, nil)
+	getter4 := NewMockAllTokensPriceGetter(t)
+	getter4.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(nil, errors.New("rpc error"))
+
+	priceGetter, err := NewAggregatedPriceGetter(logger.Test(t), config.PriceAggregationMedian, getter1, getter2, getter3, getter4)
+	require.NoError(t, err)
+
+	prices, err := priceGetter.GetJobSpecTokenPricesWithConfidence(ctx)
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+
+	// All prices agree, but one of the four getters failed
+	assert.Equal(t, big.NewInt(100), prices[token1].Price)
+	assert.Equal(t, 3, prices[token1].SourceCount)
+	assert.InDelta(t, 0.75, prices[token1].Score, 1e-9)
+
+	// Half of the getters returned prices spreading by 20% of the median
+	assert.Equal(t, big.NewInt(100), prices[token2].Price)
+	assert.Equal(t, 2, prices[token2].SourceCount)
+	assert.InDelta(t, 0.4, prices[token2].Score, 1e-9)
+}
+
+func TestNewAggregatedPriceGetter(t *testing.T) {
+	_, err := NewAggregatedPriceGetter(logger.Test(t), config.PriceAggregationMedian)
+	require.Error(t, err)
+
+	_, err = NewAggregatedPriceGetter(logger.Test(t), "max", NewMockAllTokensPriceGetter(t))
+	require.Error(t, err)
+}
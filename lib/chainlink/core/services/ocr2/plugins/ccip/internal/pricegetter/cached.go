@@ -0,0 +1,234 @@
+package pricegetter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+var priceCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "ccip_price_getter_cache_lookups",
+	Help: "Number of token prices looked up in the shared price getter cache, by result (hit or miss)",
+}, []string{"result"})
+
+type cachedPrice struct {
+	price     *big.Int
+	fetchedAt time.Time
+}
+
+type cachedJobSpecPrices struct {
+	prices    map[ccipcommon.TokenID]*big.Int
+	fetchedAt time.Time
+}
+
+// PriceCache holds the prices fetched by the cached price getters sharing it, per price source key. Concurrent cache
+// misses of the same price source are merged into a single upstream call.
+type PriceCache struct {
+	ttl   time.Duration
+	now   func() time.Time
+	group singleflight.Group
+
+	mu            sync.Mutex
+	jobSpecPrices map[string]cachedJobSpecPrices
+	tokenPrices   map[string]map[ccipcommon.TokenID]cachedPrice
+}
+
+func NewPriceCache(ttl time.Duration) (*PriceCache, error) {
+	if ttl <= 0 {
+		return nil, errors.New("price cache ttl must be positive")
+	}
+	return &PriceCache{
+		ttl:           ttl,
+		now:           time.Now,
+		jobSpecPrices: make(map[string]cachedJobSpecPrices),
+		tokenPrices:   make(map[string]map[ccipcommon.TokenID]cachedPrice),
+	}, nil
+}
+
+func (c *PriceCache) getJobSpecPrices(key string) (map[ccipcommon.TokenID]*big.Int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.jobSpecPrices[key]
+	if !ok || c.now().Sub(cached.fetchedAt) >= c.ttl {
+		return nil, false
+	}
+	return copyPrices(cached.prices), true
+}
+
+func (c *PriceCache) setJobSpecPrices(key string, prices map[ccipcommon.TokenID]*big.Int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.jobSpecPrices[key] = cachedJobSpecPrices{prices: copyPrices(prices), fetchedAt: c.now()}
+	c.setTokenPricesLocked(key, prices)
+}
+
+// getTokenPrices returns the fresh cached prices of the tokens, and the tokens without a fresh price.
+func (c *PriceCache) getTokenPrices(key string, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, []ccipcommon.TokenID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prices := make(map[ccipcommon.TokenID]*big.Int, len(tokens))
+	var missing []ccipcommon.TokenID
+	for _, token := range tokens {
+		cached, ok := c.tokenPrices[key][token]
+		if !ok || c.now().Sub(cached.fetchedAt) >= c.ttl {
+			missing = append(missing, token)
+			continue
+		}
+		prices[token] = new(big.Int).Set(cached.price)
+	}
+	return prices, missing
+}
+
+func (c *PriceCache) setTokenPrices(key string, prices map[ccipcommon.TokenID]*big.Int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.setTokenPricesLocked(key, prices)
+}
+
+func (c *PriceCache) setTokenPricesLocked(key string, prices map[ccipcommon.TokenID]*big.Int) {
+	if _, ok := c.tokenPrices[key]; !ok {
+		c.tokenPrices[key] = make(map[ccipcommon.TokenID]cachedPrice)
+	}
+	now := c.now()
+	for token, price := range prices {
+		if price == nil {
+			continue
+		}
+		c.tokenPrices[key][token] = cachedPrice{price: new(big.Int).Set(price), fetchedAt: now}
+	}
+}
+
+var _ AllTokensPriceGetter = &CachedPriceGetter{}
+
+// CachedPriceGetter serves the prices of the underlying price getter from a PriceCache shared by the lanes of the node.
+// Lanes whose price getters are configured the same share the key, so that they make one upstream call per token
+// within the ttl of the cache instead of one per lane.
+type CachedPriceGetter struct {
+	lggr   logger.Logger
+	cache  *PriceCache
+	key    string
+	getter AllTokensPriceGetter
+}
+
+func NewCachedPriceGetter(lggr logger.Logger, cache *PriceCache, key string, getter AllTokensPriceGetter) (*CachedPriceGetter, error) {
+	if cache == nil {
+		return nil, errors.New("price cache is nil")
+	}
+	if key == "" {
+		return nil, errors.New("price cache key is empty")
+	}
+	return &CachedPriceGetter{
+		lggr:   lggr,
+		cache:  cache,
+		key:    key,
+		getter: getter,
+	}, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the cached job spec prices, fetching them when they are older than the ttl.
+func (c *CachedPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	if prices, ok := c.cache.getJobSpecPrices(c.key); ok {
+		priceCacheLookups.WithLabelValues("hit").Add(float64(len(prices)))
+		return prices, nil
+	}
+
+	prices, err := c.do(c.key+"/jobSpec", func() (map[ccipcommon.TokenID]*big.Int, error) {
+		prices, err := c.getter.GetJobSpecTokenPricesUSD(ctx)
+		if err == nil {
+			c.cache.setJobSpecPrices(c.key, prices)
+		}
+		return prices, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	priceCacheLookups.WithLabelValues("miss").Add(float64(len(prices)))
+	return prices, nil
+}
+
+// GetTokenPricesUSD returns the cached prices of the tokens, only the tokens without a fresh price are fetched.
+func (c *CachedPriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	prices, missing := c.cache.getTokenPrices(c.key, tokens)
+	priceCacheLookups.WithLabelValues("hit").Add(float64(len(prices)))
+	if len(missing) == 0 {
+		return prices, nil
+	}
+	priceCacheLookups.WithLabelValues("miss").Add(float64(len(missing)))
+
+	fetched, err := c.do(c.key+"/tokens/"+tokensKey(missing), func() (map[ccipcommon.TokenID]*big.Int, error) {
+		fetched, err := c.getter.GetTokenPricesUSD(ctx, missing)
+		if err == nil {
+			c.cache.setTokenPrices(c.key, fetched)
+		}
+		return fetched, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for token, price := range fetched {
+		prices[token] = price
+	}
+	return prices, nil
+}
+
+// Close closes the underlying price getter.
+func (c *CachedPriceGetter) Close() error {
+	return c.getter.Close()
+}
+
+// do runs getPrices once for all concurrent callers with the same key, every caller gets its own copy of the prices.
+// The call runs with the context of the first caller.
+func (c *CachedPriceGetter) do(key string, getPrices func() (map[ccipcommon.TokenID]*big.Int, error)) (map[ccipcommon.TokenID]*big.Int, error) {
+	res, err, shared := c.cache.group.Do(key, func() (interface{}, error) {
+		return getPrices()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		c.lggr.Debugw("Shared upstream price getter call", "key", key)
+	}
+	prices, ok := res.(map[ccipcommon.TokenID]*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected price getter result %T", res)
+	}
+	return copyPrices(prices), nil
+}
+
+func tokensKey(tokens []ccipcommon.TokenID) string {
+	keys := make([]string, len(tokens))
+	for i, token := range tokens {
+		keys[i] = fmt.Sprintf("%d:%s", token.ChainSelector, strings.ToLower(string(token.TokenAddress)))
+	}
+	slices.Sort(keys)
+	return strings.Join(keys, ",")
+}
+
+func copyPrices(prices map[ccipcommon.TokenID]*big.Int) map[ccipcommon.TokenID]*big.Int {
+	copied := make(map[ccipcommon.TokenID]*big.Int, len(prices))
+	for token, price := range prices {
+		if price == nil {
+			copied[token] = nil
+			continue
+		}
+		copied[token] = new(big.Int).Set(price)
+	}
+	return copied
+}
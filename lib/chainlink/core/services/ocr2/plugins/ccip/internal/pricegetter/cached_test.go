@@ -0,0 +1,152 @@
+package pricegetter
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+func TestCachedPriceGetter(t *testing.T) {
+	ctx := tests.Context(t)
+	token1 := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x1"), ChainSelector: 1}
+	token2 := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x2"), ChainSelector: 1}
+	ttl := 10 * time.Second
+
+	newCache := func(t *testing.T, now *time.Time) *PriceCache {
+		cache, err := NewPriceCache(ttl)
+		require.NoError(t, err)
+		cache.now = func() time.Time { return *now }
+		return cache
+	}
+	newCachedPriceGetter := func(t *testing.T, cache *PriceCache, key string, getter AllTokensPriceGetter) *CachedPriceGetter {
+		cachedPriceGetter, err := NewCachedPriceGetter(logger.Test(t), cache, key, getter)
+		require.NoError(t, err)
+		return cachedPriceGetter
+	}
+
+	t.Run("job spec prices are shared by price getters with the same key until the ttl passed", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(t, &now)
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, nil).Once()
+		lane1 := newCachedPriceGetter(t, cache, "key", getter)
+		lane2 := newCachedPriceGetter(t, cache, "key", NewMockAllTokensPriceGetter(t))
+
+		for _, lane := range []*CachedPriceGetter{lane1, lane2} {
+			prices, err := lane.GetJobSpecTokenPricesUSD(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, prices)
+		}
+
+		// The job spec prices fill the token prices cache as well
+		prices, err := lane2.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token1})
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, prices)
+
+		now = now.Add(ttl)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(11)}, nil).Once()
+		prices, err = lane1.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(11)}, prices)
+	})
+
+	t.Run("only tokens without a fresh price are fetched", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(t, &now)
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token1}).
+			Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, nil).Once()
+		getter.EXPECT().GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token2}).
+			Return(map[ccipcommon.TokenID]*big.Int{token2: big.NewInt(20)}, nil).Once()
+		cachedPriceGetter := newCachedPriceGetter(t, cache, "key", getter)
+
+		_, err := cachedPriceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token1})
+		require.NoError(t, err)
+		prices, err := cachedPriceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token1, token2})
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10), token2: big.NewInt(20)}, prices)
+	})
+
+	t.Run("price getters with different keys do not share prices", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(t, &now)
+		for _, key := range []string{"key1", "key2"} {
+			getter := NewMockAllTokensPriceGetter(t)
+			getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, nil).Once()
+			_, err := newCachedPriceGetter(t, cache, key, getter).GetJobSpecTokenPricesUSD(ctx)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(t, &now)
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(nil, errors.New("api down")).Once()
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, nil).Once()
+		cachedPriceGetter := newCachedPriceGetter(t, cache, "key", getter)
+
+		_, err := cachedPriceGetter.GetJobSpecTokenPricesUSD(ctx)
+		require.Error(t, err)
+		prices, err := cachedPriceGetter.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, prices)
+	})
+
+	t.Run("concurrent cache misses share one upstream call", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(t, &now)
+		release := make(chan struct{})
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetTokenPricesUSD(mock.Anything, []ccipcommon.TokenID{token1}).
+			RunAndReturn(func(context.Context, []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+				<-release
+				return map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, nil
+			}).Once()
+		cachedPriceGetter := newCachedPriceGetter(t, cache, "key", getter)
+
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				prices, err := cachedPriceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token1})
+				assert.NoError(t, err)
+				assert.Equal(t, map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, prices)
+			}()
+		}
+		// Let the callers pile up behind the first one, the ones arriving late are served from the cache
+		time.Sleep(100 * time.Millisecond)
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("callers get their own copy of the prices", func(t *testing.T) {
+		now := time.Now()
+		cache := newCache(t, &now)
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, nil).Once()
+		cachedPriceGetter := newCachedPriceGetter(t, cache, "key", getter)
+
+		prices, err := cachedPriceGetter.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		prices[token1].SetInt64(0)
+
+		prices, err = cachedPriceGetter.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{token1: big.NewInt(10)}, prices)
+	})
+}
@@ -0,0 +1,179 @@
+package pricegetter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+// ErrCircuitOpen is returned instead of querying a price getter whose circuit is open.
+var ErrCircuitOpen = errors.New("price getter circuit is open")
+
+var circuitOpenedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "ccip_price_getter_circuit_opened",
+	Help: "Number of times the circuit of a price getter was opened after consecutive failures",
+}, []string{"priceGetter"})
+
+type circuitState string
+
+const (
+	circuitClosed   circuitState = "closed"
+	circuitOpen     circuitState = "open"
+	circuitHalfOpen circuitState = "halfOpen"
+)
+
+var _ AllTokensPriceGetter = &CircuitBreakerPriceGetter{}
+
+// CircuitBreakerPriceGetter stops querying a price getter after failureThreshold consecutive failures, so that a dead
+// external price API fails fast instead of adding its timeout to every observation and flooding the logs.
+// Once openDuration has passed, a single probe request is let through (half-open state), its success closes the circuit
+// while its failure keeps the circuit open for another openDuration.
+type CircuitBreakerPriceGetter struct {
+	lggr             logger.Logger
+	name             string
+	getter           AllTokensPriceGetter
+	failureThreshold int
+	openDuration     time.Duration
+	now              func() time.Time
+
+	mu                  sync.Mutex
+	state               circuitState
+	consecutiveFailures int
+	openedAt            time.Time
+}
+
+func NewCircuitBreakerPriceGetter(
+	lggr logger.Logger,
+	name string,
+	getter AllTokensPriceGetter,
+	failureThreshold int,
+	openDuration time.Duration,
+) (*CircuitBreakerPriceGetter, error) {
+	if failureThreshold <= 0 {
+		return nil, errors.New("failure threshold must be positive")
+	}
+	if openDuration <= 0 {
+		return nil, errors.New("open duration must be positive")
+	}
+
+	return &CircuitBreakerPriceGetter{
+		lggr:             logger.With(lggr, "priceGetter", name),
+		name:             name,
+		getter:           getter,
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		now:              time.Now,
+		state:            circuitClosed,
+	}, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the prices of the underlying price getter, unless its circuit is open.
+func (c *CircuitBreakerPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	return c.call(ctx, func() (map[ccipcommon.TokenID]*big.Int, error) {
+		return c.getter.GetJobSpecTokenPricesUSD(ctx)
+	})
+}
+
+// GetTokenPricesUSD returns the prices of the underlying price getter, unless its circuit is open.
+func (c *CircuitBreakerPriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	return c.call(ctx, func() (map[ccipcommon.TokenID]*big.Int, error) {
+		return c.getter.GetTokenPricesUSD(ctx, tokens)
+	})
+}
+
+// Close closes the underlying price getter.
+func (c *CircuitBreakerPriceGetter) Close() error {
+	return c.getter.Close()
+}
+
+func (c *CircuitBreakerPriceGetter) call(
+	ctx context.Context,
+	getPrices func() (map[ccipcommon.TokenID]*big.Int, error),
+) (map[ccipcommon.TokenID]*big.Int, error) {
+	if !c.allow() {
+		return nil, fmt.Errorf("%s: %w", c.name, ErrCircuitOpen)
+	}
+
+	prices, err := getPrices()
+	// Cancellations come from the caller, they don't tell anything about the health of the price getter
+	if ctx.Err() != nil {
+		c.cancelProbe()
+		return prices, err
+	}
+	c.record(err)
+	return prices, err
+}
+
+// cancelProbe reopens a half-open circuit whose probe was cancelled, so that another probe is let through once
+// openDuration passed again, instead of the circuit staying half-open without a probe in flight.
+func (c *CircuitBreakerPriceGetter) cancelProbe() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != circuitHalfOpen {
+		return
+	}
+	c.state = circuitOpen
+	c.openedAt = c.now()
+	c.lggr.Infow("Price getter probe was cancelled, keeping its circuit open", "openDuration", c.openDuration)
+}
+
+// allow reports whether the price getter can be queried, moving an open circuit to half-open once openDuration passed.
+// Only a single probe is let through in the half-open state.
+func (c *CircuitBreakerPriceGetter) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case circuitOpen:
+		if c.now().Sub(c.openedAt) < c.openDuration {
+			return false
+		}
+		c.state = circuitHalfOpen
+		c.lggr.Infow("Price getter circuit is half-open, probing the price getter")
+		return true
+	case circuitHalfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+func (c *CircuitBreakerPriceGetter) record(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil {
+		if c.state != circuitClosed {
+			c.lggr.Infow("Price getter recovered, closing its circuit")
+		}
+		c.state = circuitClosed
+		c.consecutiveFailures = 0
+		return
+	}
+
+	c.consecutiveFailures++
+	switch {
+	case c.state == circuitHalfOpen:
+		c.state = circuitOpen
+		c.openedAt = c.now()
+		c.lggr.Warnw("Price getter probe failed, keeping its circuit open",
+			"openDuration", c.openDuration, "consecutiveFailures", c.consecutiveFailures, "err", err)
+	case c.state == circuitClosed && c.consecutiveFailures >= c.failureThreshold:
+		c.state = circuitOpen
+		c.openedAt = c.now()
+		circuitOpenedCounter.WithLabelValues(c.name).Inc()
+		c.lggr.Errorw("Price getter failed repeatedly, opening its circuit",
+			"openDuration", c.openDuration, "consecutiveFailures", c.consecutiveFailures, "err", err)
+	}
+}
@@ -0,0 +1,145 @@
+package pricegetter
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+func TestCircuitBreakerPriceGetter(t *testing.T) {
+	ctx := tests.Context(t)
+	token := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x1"), ChainSelector: 1}
+	prices := map[ccipcommon.TokenID]*big.Int{token: big.NewInt(10)}
+	openDuration := time.Minute
+
+	newCircuitBreaker := func(t *testing.T, getter AllTokensPriceGetter, now *time.Time) *CircuitBreakerPriceGetter {
+		circuitBreaker, err := NewCircuitBreakerPriceGetter(logger.Test(t), "test", getter, 2, openDuration)
+		require.NoError(t, err)
+		circuitBreaker.now = func() time.Time { return *now }
+		return circuitBreaker
+	}
+
+	t.Run("opens after consecutive failures and closes after a successful probe", func(t *testing.T) {
+		now := time.Now()
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(nil, errors.New("api down")).Twice()
+		circuitBreaker := newCircuitBreaker(t, getter, &now)
+
+		for range 2 {
+			_, err := circuitBreaker.GetJobSpecTokenPricesUSD(ctx)
+			require.ErrorContains(t, err, "api down")
+		}
+
+		// The underlying price getter is not called while the circuit is open
+		_, err := circuitBreaker.GetJobSpecTokenPricesUSD(ctx)
+		require.ErrorIs(t, err, ErrCircuitOpen)
+
+		now = now.Add(openDuration)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(prices, nil).Twice()
+		for range 2 {
+			res, err := circuitBreaker.GetJobSpecTokenPricesUSD(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, prices, res)
+		}
+		assert.Equal(t, circuitClosed, circuitBreaker.state)
+	})
+
+	t.Run("failed probe keeps the circuit open", func(t *testing.T) {
+		now := time.Now()
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token}).Return(nil, errors.New("api down")).Times(3)
+		circuitBreaker := newCircuitBreaker(t, getter, &now)
+
+		for range 2 {
+			_, err := circuitBreaker.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token})
+			require.ErrorContains(t, err, "api down")
+		}
+
+		now = now.Add(openDuration)
+		_, err := circuitBreaker.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token})
+		require.ErrorContains(t, err, "api down")
+		assert.Equal(t, circuitOpen, circuitBreaker.state)
+
+		now = now.Add(openDuration / 2)
+		_, err = circuitBreaker.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token})
+		require.ErrorIs(t, err, ErrCircuitOpen)
+	})
+
+	t.Run("success resets the consecutive failures", func(t *testing.T) {
+		now := time.Now()
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(nil, errors.New("api down")).Once()
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(prices, nil).Once()
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(nil, errors.New("api down")).Once()
+		circuitBreaker := newCircuitBreaker(t, getter, &now)
+
+		for range 3 {
+			_, _ = circuitBreaker.GetJobSpecTokenPricesUSD(ctx)
+		}
+		assert.Equal(t, circuitClosed, circuitBreaker.state)
+	})
+
+	t.Run("cancelled calls are not counted as failures", func(t *testing.T) {
+		now := time.Now()
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(cancelledCtx).Return(nil, context.Canceled).Times(3)
+		circuitBreaker := newCircuitBreaker(t, getter, &now)
+
+		for range 3 {
+			_, err := circuitBreaker.GetJobSpecTokenPricesUSD(cancelledCtx)
+			require.ErrorIs(t, err, context.Canceled)
+		}
+		assert.Equal(t, circuitClosed, circuitBreaker.state)
+	})
+
+	t.Run("cancelled probe reopens the circuit", func(t *testing.T) {
+		now := time.Now()
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(nil, errors.New("api down")).Twice()
+		getter.EXPECT().GetJobSpecTokenPricesUSD(cancelledCtx).Return(nil, context.Canceled).Once()
+		circuitBreaker := newCircuitBreaker(t, getter, &now)
+
+		for range 2 {
+			_, err := circuitBreaker.GetJobSpecTokenPricesUSD(ctx)
+			require.ErrorContains(t, err, "api down")
+		}
+
+		now = now.Add(openDuration)
+		_, err := circuitBreaker.GetJobSpecTokenPricesUSD(cancelledCtx)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, circuitOpen, circuitBreaker.state)
+
+		now = now.Add(openDuration / 2)
+		_, err = circuitBreaker.GetJobSpecTokenPricesUSD(ctx)
+		require.ErrorIs(t, err, ErrCircuitOpen)
+
+		// Another probe is let through once openDuration passed since the cancellation
+		now = now.Add(openDuration / 2)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(prices, nil).Once()
+		_, err = circuitBreaker.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, circuitClosed, circuitBreaker.state)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewCircuitBreakerPriceGetter(logger.Test(t), "test", nil, 0, openDuration)
+		require.Error(t, err)
+		_, err = NewCircuitBreakerPriceGetter(logger.Test(t), "test", nil, 1, 0)
+		require.Error(t, err)
+	})
+}
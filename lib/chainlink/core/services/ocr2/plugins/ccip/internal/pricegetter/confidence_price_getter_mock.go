@@ -0,0 +1,318 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package pricegetter
+
+import (
+	context "context"
+	big "math/big"
+
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockConfidencePriceGetter is an autogenerated mock type for the ConfidencePriceGetter type
+type MockConfidencePriceGetter struct {
+	mock.Mock
+}
+
+type MockConfidencePriceGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConfidencePriceGetter) EXPECT() *MockConfidencePriceGetter_Expecter {
+	return &MockConfidencePriceGetter_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *MockConfidencePriceGetter) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConfidencePriceGetter_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockConfidencePriceGetter_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockConfidencePriceGetter_Expecter) Close() *MockConfidencePriceGetter_Close_Call {
+	return &MockConfidencePriceGetter_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockConfidencePriceGetter_Close_Call) Run(run func()) *MockConfidencePriceGetter_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_Close_Call) Return(_a0 error) *MockConfidencePriceGetter_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_Close_Call) RunAndReturn(run func() error) *MockConfidencePriceGetter_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobSpecTokenPricesUSD provides a mock function with given fields: ctx
+func (_m *MockConfidencePriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobSpecTokenPricesUSD")
+	}
+
+	var r0 map[ccipcommon.TokenID]*big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[ccipcommon.TokenID]*big.Int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[ccipcommon.TokenID]*big.Int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipcommon.TokenID]*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobSpecTokenPricesUSD'
+type MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call struct {
+	*mock.Call
+}
+
+// GetJobSpecTokenPricesUSD is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockConfidencePriceGetter_Expecter) GetJobSpecTokenPricesUSD(ctx interface{}) *MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call {
+	return &MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call{Call: _e.mock.On("GetJobSpecTokenPricesUSD", ctx)}
+}
+
+func (_c *MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call) Run(run func(ctx context.Context)) *MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call) Return(_a0 map[ccipcommon.TokenID]*big.Int, _a1 error) *MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call) RunAndReturn(run func(context.Context) (map[ccipcommon.TokenID]*big.Int, error)) *MockConfidencePriceGetter_GetJobSpecTokenPricesUSD_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobSpecTokenPricesWithConfidence provides a mock function with given fields: ctx
+func (_m *MockConfidencePriceGetter) GetJobSpecTokenPricesWithConfidence(ctx context.Context) (map[ccipcommon.TokenID]PriceWithConfidence, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobSpecTokenPricesWithConfidence")
+	}
+
+	var r0 map[ccipcommon.TokenID]PriceWithConfidence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[ccipcommon.TokenID]PriceWithConfidence, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[ccipcommon.TokenID]PriceWithConfidence); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipcommon.TokenID]PriceWithConfidence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobSpecTokenPricesWithConfidence'
+type MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call struct {
+	*mock.Call
+}
+
+// GetJobSpecTokenPricesWithConfidence is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockConfidencePriceGetter_Expecter) GetJobSpecTokenPricesWithConfidence(ctx interface{}) *MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call {
+	return &MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call{Call: _e.mock.On("GetJobSpecTokenPricesWithConfidence", ctx)}
+}
+
+func (_c *MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call) Run(run func(ctx context.Context)) *MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call) Return(_a0 map[ccipcommon.TokenID]PriceWithConfidence, _a1 error) *MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call) RunAndReturn(run func(context.Context) (map[ccipcommon.TokenID]PriceWithConfidence, error)) *MockConfidencePriceGetter_GetJobSpecTokenPricesWithConfidence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokenPricesUSD provides a mock function with given fields: ctx, tokens
+func (_m *MockConfidencePriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	ret := _m.Called(ctx, tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenPricesUSD")
+	}
+
+	var r0 map[ccipcommon.TokenID]*big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error)); ok {
+		return rf(ctx, tokens)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []ccipcommon.TokenID) map[ccipcommon.TokenID]*big.Int); ok {
+		r0 = rf(ctx, tokens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipcommon.TokenID]*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []ccipcommon.TokenID) error); ok {
+		r1 = rf(ctx, tokens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfidencePriceGetter_GetTokenPricesUSD_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenPricesUSD'
+type MockConfidencePriceGetter_GetTokenPricesUSD_Call struct {
+	*mock.Call
+}
+
+// GetTokenPricesUSD is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokens []ccipcommon.TokenID
+func (_e *MockConfidencePriceGetter_Expecter) GetTokenPricesUSD(ctx interface{}, tokens interface{}) *MockConfidencePriceGetter_GetTokenPricesUSD_Call {
+	return &MockConfidencePriceGetter_GetTokenPricesUSD_Call{Call: _e.mock.On("GetTokenPricesUSD", ctx, tokens)}
+}
+
+func (_c *MockConfidencePriceGetter_GetTokenPricesUSD_Call) Run(run func(ctx context.Context, tokens []ccipcommon.TokenID)) *MockConfidencePriceGetter_GetTokenPricesUSD_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ccipcommon.TokenID))
+	})
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_GetTokenPricesUSD_Call) Return(_a0 map[ccipcommon.TokenID]*big.Int, _a1 error) *MockConfidencePriceGetter_GetTokenPricesUSD_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_GetTokenPricesUSD_Call) RunAndReturn(run func(context.Context, []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error)) *MockConfidencePriceGetter_GetTokenPricesUSD_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokenPricesWithConfidence provides a mock function with given fields: ctx, tokens
+func (_m *MockConfidencePriceGetter) GetTokenPricesWithConfidence(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]PriceWithConfidence, error) {
+	ret := _m.Called(ctx, tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenPricesWithConfidence")
+	}
+
+	var r0 map[ccipcommon.TokenID]PriceWithConfidence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ccipcommon.TokenID) (map[ccipcommon.TokenID]PriceWithConfidence, error)); ok {
+		return rf(ctx, tokens)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []ccipcommon.TokenID) map[ccipcommon.TokenID]PriceWithConfidence); ok {
+		r0 = rf(ctx, tokens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipcommon.TokenID]PriceWithConfidence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []ccipcommon.TokenID) error); ok {
+		r1 = rf(ctx, tokens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenPricesWithConfidence'
+type MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call struct {
+	*mock.Call
+}
+
+// GetTokenPricesWithConfidence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokens []ccipcommon.TokenID
+func (_e *MockConfidencePriceGetter_Expecter) GetTokenPricesWithConfidence(ctx interface{}, tokens interface{}) *MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call {
+	return &MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call{Call: _e.mock.On("GetTokenPricesWithConfidence", ctx, tokens)}
+}
+
+func (_c *MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call) Run(run func(ctx context.Context, tokens []ccipcommon.TokenID)) *MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ccipcommon.TokenID))
+	})
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call) Return(_a0 map[ccipcommon.TokenID]PriceWithConfidence, _a1 error) *MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call) RunAndReturn(run func(context.Context, []ccipcommon.TokenID) (map[ccipcommon.TokenID]PriceWithConfidence, error)) *MockConfidencePriceGetter_GetTokenPricesWithConfidence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConfidencePriceGetter creates a new instance of MockConfidencePriceGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConfidencePriceGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConfidencePriceGetter {
+	mock := &MockConfidencePriceGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,140 @@
+package pricegetter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+var _ AllTokensPriceGetter = &CrossRatePriceGetter{}
+
+// CrossRatePriceGetter derives the USD prices of the tokens whose price source is denominated in another token, e.g.
+// a TOKEN/ETH feed, by multiplying them with the USD price of the quote token. The quote tokens are priced by the
+// underlying price getter as well, following the conversion path until a token without cross rate is reached.
+type CrossRatePriceGetter struct {
+	lggr   logger.Logger
+	quotes map[ccipcommon.TokenID]ccipcommon.TokenID
+	getter AllTokensPriceGetter
+}
+
+func NewCrossRatePriceGetter(lggr logger.Logger, crossRates config.CrossRatesConfig, getter AllTokensPriceGetter) (*CrossRatePriceGetter, error) {
+	if err := crossRates.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cross rates: %w", err)
+	}
+	if len(crossRates) == 0 {
+		return nil, errors.New("no cross rates")
+	}
+
+	quotes := make(map[ccipcommon.TokenID]ccipcommon.TokenID, len(crossRates))
+	for _, crossRate := range crossRates {
+		token := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(crossRate.TokenAddress), ChainSelector: crossRate.ChainSelector}
+		quotes[token] = ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(crossRate.QuoteTokenAddress), ChainSelector: crossRate.QuoteChainSelector}
+	}
+
+	return &CrossRatePriceGetter{
+		lggr:   lggr,
+		quotes: quotes,
+		getter: getter,
+	}, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the job spec prices of the underlying price getter with the cross rates applied,
+// the quote tokens missing from the job spec are fetched separately.
+func (c *CrossRatePriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	rawPrices, err := c.getter.GetJobSpecTokenPricesUSD(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]ccipcommon.TokenID, 0, len(rawPrices))
+	for token := range rawPrices {
+		tokens = append(tokens, token)
+	}
+
+	var missingQuotes []ccipcommon.TokenID
+	for _, quote := range c.pathTokens(tokens) {
+		if _, ok := rawPrices[quote]; !ok {
+			missingQuotes = append(missingQuotes, quote)
+		}
+	}
+	if len(missingQuotes) > 0 {
+		quotePrices, err := c.getter.GetTokenPricesUSD(ctx, missingQuotes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the prices of the quote tokens %v: %w", missingQuotes, err)
+		}
+		for quote, price := range quotePrices {
+			rawPrices[quote] = price
+		}
+	}
+	return c.derivePrices(tokens, rawPrices), nil
+}
+
+// GetTokenPricesUSD returns the prices of the tokens with the cross rates applied, the prices of the tokens are
+// fetched along with the prices of all quote tokens of their conversion paths.
+func (c *CrossRatePriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	rawPrices, err := c.getter.GetTokenPricesUSD(ctx, c.pathTokens(tokens))
+	if err != nil {
+		return nil, err
+	}
+	return c.derivePrices(tokens, rawPrices), nil
+}
+
+// Close closes the underlying price getter.
+func (c *CrossRatePriceGetter) Close() error {
+	return c.getter.Close()
+}
+
+// pathTokens returns the tokens along with the quote tokens of their conversion paths, without duplicates.
+func (c *CrossRatePriceGetter) pathTokens(tokens []ccipcommon.TokenID) []ccipcommon.TokenID {
+	pathTokens := slices.Clone(tokens)
+	for _, token := range tokens {
+		for quote, ok := c.quotes[token]; ok; quote, ok = c.quotes[quote] {
+			if !slices.Contains(pathTokens, quote) {
+				pathTokens = append(pathTokens, quote)
+			}
+		}
+	}
+	return pathTokens
+}
+
+// derivePrices returns the USD prices of the tokens from the raw prices of the underlying price getter. The price of
+// a token is nil if the price of any token of its conversion path is missing, so that it is reported as not priced.
+func (c *CrossRatePriceGetter) derivePrices(tokens []ccipcommon.TokenID, rawPrices map[ccipcommon.TokenID]*big.Int) map[ccipcommon.TokenID]*big.Int {
+	prices := make(map[ccipcommon.TokenID]*big.Int, len(tokens))
+	for _, token := range tokens {
+		if _, ok := rawPrices[token]; !ok {
+			continue
+		}
+		price, err := c.usdPrice(token, rawPrices)
+		if err != nil {
+			c.lggr.Warnw("Failed to derive token price from cross rate", "token", token, "err", err)
+		}
+		prices[token] = price
+	}
+	return prices
+}
+
+func (c *CrossRatePriceGetter) usdPrice(token ccipcommon.TokenID, rawPrices map[ccipcommon.TokenID]*big.Int) (*big.Int, error) {
+	rawPrice := rawPrices[token]
+	if rawPrice == nil {
+		return nil, fmt.Errorf("no price for token %v", token)
+	}
+	quote, ok := c.quotes[token]
+	if !ok {
+		return rawPrice, nil
+	}
+	quotePrice, err := c.usdPrice(quote, rawPrices)
+	if err != nil {
+		return nil, fmt.Errorf("quote token %v: %w", quote, err)
+	}
+	// Both prices are the price of one whole token scaled by 1e18
+	price := new(big.Int).Mul(rawPrice, quotePrice)
+	return price.Div(price, big.NewInt(1e18)), nil
+}
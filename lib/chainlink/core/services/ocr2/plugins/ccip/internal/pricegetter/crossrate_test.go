@@ -0,0 +1,96 @@
+package pricegetter
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+func TestCrossRatePriceGetter(t *testing.T) {
+	ctx := tests.Context(t)
+	longTail := common.HexToAddress("0x1")
+	steth := common.HexToAddress("0x2")
+	weth := common.HexToAddress("0x3")
+	longTailID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(longTail), ChainSelector: 1}
+	stethID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(steth), ChainSelector: 1}
+	wethID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(weth), ChainSelector: 1}
+	linkID := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x4"), ChainSelector: 1}
+
+	// The long tail token is quoted in stETH, which is quoted in WETH
+	crossRates := config.CrossRatesConfig{
+		{TokenAddress: longTail, ChainSelector: 1, QuoteTokenAddress: steth, QuoteChainSelector: 1},
+		{TokenAddress: steth, ChainSelector: 1, QuoteTokenAddress: weth, QuoteChainSelector: 1},
+	}
+	newCrossRatePriceGetter := func(t *testing.T, getter AllTokensPriceGetter) *CrossRatePriceGetter {
+		crossRatePriceGetter, err := NewCrossRatePriceGetter(logger.Test(t), crossRates, getter)
+		require.NoError(t, err)
+		return crossRatePriceGetter
+	}
+	e18 := func(v int64) *big.Int { return new(big.Int).Mul(big.NewInt(v), big.NewInt(1e18)) }
+
+	t.Run("job spec prices are converted along the conversion paths", func(t *testing.T) {
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(map[ccipcommon.TokenID]*big.Int{
+			longTailID: big.NewInt(5e15), // 0.005 stETH
+			stethID:    big.NewInt(2e18), // 2 WETH, to keep the numbers simple
+			linkID:     e18(15),
+		}, nil).Once()
+		getter.EXPECT().GetTokenPricesUSD(ctx, []ccipcommon.TokenID{wethID}).
+			Return(map[ccipcommon.TokenID]*big.Int{wethID: e18(3000)}, nil).Once()
+
+		prices, err := newCrossRatePriceGetter(t, getter).GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{
+			longTailID: e18(30),
+			stethID:    e18(6000),
+			linkID:     e18(15),
+		}, prices)
+	})
+
+	t.Run("quote token prices are fetched along with the tokens", func(t *testing.T) {
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetTokenPricesUSD(ctx, []ccipcommon.TokenID{longTailID, linkID, stethID, wethID}).
+			Return(map[ccipcommon.TokenID]*big.Int{
+				longTailID: big.NewInt(5e15),
+				linkID:     e18(15),
+				stethID:    big.NewInt(2e18),
+				wethID:     e18(3000),
+			}, nil).Once()
+
+		prices, err := newCrossRatePriceGetter(t, getter).GetTokenPricesUSD(ctx, []ccipcommon.TokenID{longTailID, linkID})
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{
+			longTailID: e18(30),
+			linkID:     e18(15),
+		}, prices)
+	})
+
+	t.Run("missing quote price leaves the token without price", func(t *testing.T) {
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetTokenPricesUSD(ctx, []ccipcommon.TokenID{stethID, wethID}).
+			Return(map[ccipcommon.TokenID]*big.Int{stethID: big.NewInt(2e18)}, nil).Once()
+
+		prices, err := newCrossRatePriceGetter(t, getter).GetTokenPricesUSD(ctx, []ccipcommon.TokenID{stethID})
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{stethID: nil}, prices)
+	})
+
+	t.Run("price getter errors are returned", func(t *testing.T) {
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(nil, errors.New("feed down")).Once()
+
+		_, err := newCrossRatePriceGetter(t, getter).GetJobSpecTokenPricesUSD(ctx)
+		require.ErrorContains(t, err, "feed down")
+	})
+}
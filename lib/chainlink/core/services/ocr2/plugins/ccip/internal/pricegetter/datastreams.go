@@ -0,0 +1,262 @@
+package pricegetter
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+	reporttypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/v3/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/verifier"
+)
+
+const (
+	dataStreamsLatestReportPath = "/api/v1/reports/latest"
+	dataStreamsAuthorization    = "Authorization"
+	dataStreamsTimestamp        = "X-Authorization-Timestamp"
+	dataStreamsSignature        = "X-Authorization-Signature-SHA256"
+)
+
+var _ HTTPPriceGetter = &DataStreamsPriceGetter{}
+
+// dataStreamsPayloadTypes is the ABI of a full report, i.e. the report along with its signatures as submitted to the verifier contract.
+var dataStreamsPayloadTypes = abi.Arguments{
+	{Name: "reportContext", Type: mustNewABIType("bytes32[3]")},
+	{Name: "report", Type: mustNewABIType("bytes")},
+	{Name: "rawRs", Type: mustNewABIType("bytes32[]")},
+	{Name: "rawSs", Type: mustNewABIType("bytes32[]")},
+	{Name: "rawVs", Type: mustNewABIType("bytes32")},
+}
+
+func mustNewABIType(t string) abi.Type {
+	result, err := abi.NewType(t, "", []abi.ArgumentMarshaling{})
+	if err != nil {
+		panic(fmt.Sprintf("Unexpected error during abi.NewType: %s", err))
+	}
+	return result
+}
+
+type dataStreamsLatestReportResponse struct {
+	Report struct {
+		FeedID     string `json:"feedID"`
+		FullReport string `json:"fullReport"`
+	} `json:"report"`
+}
+
+// DataStreamsPriceGetter gets the token prices from the latest reports of Data Streams feeds. The signatures of every
+// report are verified off-chain against the configured signers, and reports older than the max report age are rejected.
+type DataStreamsPriceGetter struct {
+	lggr        logger.Logger
+	cfg         config.DataStreamsPriceGetterConfig
+	credentials types.MercuryCredentials
+	httpClient  *http.Client
+	verifier    verifier.Verifier
+	feeds       map[ccipcommon.TokenID]config.DataStreamsFeedConfig
+	now         func() time.Time
+}
+
+func NewDataStreamsPriceGetter(
+	lggr logger.Logger,
+	cfg config.DataStreamsPriceGetterConfig,
+	credentials types.MercuryCredentials,
+	httpClient *http.Client,
+) (*DataStreamsPriceGetter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid data streams price getter config: %w", err)
+	}
+	if credentials.URL == "" {
+		return nil, errors.New("data streams credentials have no URL")
+	}
+
+	feeds := make(map[ccipcommon.TokenID]config.DataStreamsFeedConfig, len(cfg.Feeds))
+	for _, feed := range cfg.Feeds {
+		feeds[ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(feed.TokenAddress), ChainSelector: feed.ChainSelector}] = feed
+	}
+
+	return &DataStreamsPriceGetter{
+		lggr:        lggr,
+		cfg:         cfg,
+		credentials: credentials,
+		httpClient:  httpClient,
+		verifier:    verifier.NewVerifier(),
+		feeds:       feeds,
+		now:         time.Now,
+	}, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the prices of all tokens with a feed in the config.
+func (d *DataStreamsPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	tokens := make([]ccipcommon.TokenID, 0, len(d.feeds))
+	for token := range d.feeds {
+		tokens = append(tokens, token)
+	}
+	return d.GetTokenPricesUSD(ctx, tokens)
+}
+
+// GetTokenPricesUSD fetches the latest reports of the feeds of the provided tokens concurrently, it fails if any of
+// the tokens has no feed or no valid report.
+func (d *DataStreamsPriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	for _, token := range tokens {
+		if _, ok := d.feeds[token]; !ok {
+			return nil, fmt.Errorf("no data streams feed for token %v", token)
+		}
+	}
+
+	prices := make([]*big.Int, len(tokens))
+	errs := make([]error, len(tokens))
+
+	var wg sync.WaitGroup
+	for i, token := range tokens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			feed := d.feeds[token]
+			prices[i], errs[i] = d.getFeedPrice(ctx, feed)
+			if errs[i] != nil {
+				errs[i] = fmt.Errorf("data streams feed %s of token %v: %w", feed.FeedID, token, errs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	tokenPrices := make(map[ccipcommon.TokenID]*big.Int, len(tokens))
+	for i, token := range tokens {
+		tokenPrices[token] = prices[i]
+	}
+	d.lggr.Debugw("Data streams token prices", "prices", tokenPrices)
+	return tokenPrices, nil
+}
+
+func (d *DataStreamsPriceGetter) getFeedPrice(ctx context.Context, feed config.DataStreamsFeedConfig) (*big.Int, error) {
+	fullReport, err := d.fetchLatestReport(ctx, feed.FeedID)
+	if err != nil {
+		return nil, err
+	}
+	report, err := d.verifyReport(fullReport)
+	if err != nil {
+		return nil, err
+	}
+
+	if common.Hash(report.FeedId) != feed.FeedID {
+		return nil, fmt.Errorf("report is for feed %s", common.Hash(report.FeedId))
+	}
+	observedAt := time.Unix(int64(report.ObservationsTimestamp), 0)
+	if age := d.now().Sub(observedAt); age > d.cfg.MaxReportAge.Duration() {
+		return nil, fmt.Errorf("report observed at %s is stale, max report age is %s", observedAt, d.cfg.MaxReportAge.Duration())
+	}
+	if report.BenchmarkPrice == nil || report.BenchmarkPrice.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid benchmark price %v", report.BenchmarkPrice)
+	}
+
+	// The benchmark price is the USD price of one whole token with 18 decimals, the same as the price returned by the
+	// other getters. The price service scales it by the token decimals.
+	return report.BenchmarkPrice, nil
+}
+
+// verifyReport unpacks the full report and verifies that it is signed by F+1 of the configured signers.
+func (d *DataStreamsPriceGetter) verifyReport(fullReport []byte) (*reporttypes.Report, error) {
+	values := make(map[string]interface{})
+	if err := dataStreamsPayloadTypes.UnpackIntoMap(values, fullReport); err != nil {
+		return nil, fmt.Errorf("failed to unpack full report: %w", err)
+	}
+	reportContext, ok1 := values["reportContext"].([3][32]byte)
+	report, ok2 := values["report"].([]byte)
+	rawRs, ok3 := values["rawRs"].([][32]byte)
+	rawSs, ok4 := values["rawSs"].([][32]byte)
+	rawVs, ok5 := values["rawVs"].([32]byte)
+	if !ok1 || !ok2 || !ok3 || !ok4 || !ok5 {
+		return nil, errors.New("unexpected types in full report")
+	}
+
+	_, err := d.verifier.Verify(verifier.SignedReport{
+		RawRs:         rawRs,
+		RawSs:         rawSs,
+		RawVs:         rawVs,
+		ReportContext: reportContext,
+		Report:        report,
+	}, d.cfg.F, d.cfg.Signers)
+	if err != nil {
+		return nil, err
+	}
+	return reporttypes.Decode(report)
+}
+
+func (d *DataStreamsPriceGetter) fetchLatestReport(ctx context.Context, feedID common.Hash) ([]byte, error) {
+	path := dataStreamsLatestReportPath + "?" + url.Values{"feedID": {feedID.Hex()}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.credentials.URL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	ts := d.now().UTC().UnixMilli()
+	req.Header.Set(dataStreamsAuthorization, d.credentials.Username)
+	req.Header.Set(dataStreamsTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(dataStreamsSignature, dataStreamsHMAC(http.MethodGet, path, d.credentials.Username, d.credentials.Password, ts))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	var latestReport dataStreamsLatestReportResponse
+	if err = json.Unmarshal(body, &latestReport); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return hexutil.Decode(latestReport.Report.FullReport)
+}
+
+// dataStreamsHMAC signs a request to the Data Streams API with the credentials of the node.
+func dataStreamsHMAC(method, path, clientID, secret string, ts int64) string {
+	bodyHash := sha256.Sum256(nil)
+	signedMessage := hmac.New(sha256.New, []byte(secret))
+	signedMessage.Write([]byte(fmt.Sprintf("%s %s %s %s %d", method, path, hex.EncodeToString(bodyHash[:]), clientID, ts)))
+	return hex.EncodeToString(signedMessage.Sum(nil))
+}
+
+// RequestsPerHost returns one request to the Data Streams API per token.
+func (d *DataStreamsPriceGetter) RequestsPerHost(tokens []ccipcommon.TokenID) map[string]int {
+	u, err := url.Parse(d.credentials.URL)
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+	if tokens == nil {
+		return map[string]int{u.Hostname(): len(d.feeds)}
+	}
+	return map[string]int{u.Hostname(): len(tokens)}
+}
+
+func (d *DataStreamsPriceGetter) Close() error {
+	return nil
+}
@@ -0,0 +1,152 @@
+package pricegetter
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+	reporttypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/v3/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/verifier"
+)
+
+func TestDataStreamsPriceGetter(t *testing.T) {
+	signer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherSigner, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	linkFeed := common.HexToHash("0x0003c317fec7fad514c67aacc6366bf2f007ce37100e3cddcacd0ccaa1f3746d")
+	usdcFeed := common.HexToHash("0x0003dc85e8b01946bf9dfd8b0db860129181eb6105a8c8981d9f28e00b6f60d9")
+	link := common.HexToAddress("0x779877A7B0D9E8603169DdbD7836e478b4624789")
+	usdc := common.HexToAddress("0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238")
+	linkID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(link), ChainSelector: 1}
+	usdcID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(usdc), ChainSelector: 2}
+	// 15 USD with 18 decimals doesn't fit into an int64
+	linkPrice := new(big.Int).Mul(big.NewInt(15), big.NewInt(1e18))
+
+	cfg := config.DataStreamsPriceGetterConfig{
+		CredentialName: "streams",
+		Signers:        []common.Address{crypto.PubkeyToAddress(signer.PublicKey)},
+		F:              0,
+		MaxReportAge:   *commonconfig.MustNewDuration(time.Minute),
+		Feeds: []config.DataStreamsFeedConfig{
+			{TokenAddress: link, ChainSelector: 1, FeedID: linkFeed},
+			{TokenAddress: usdc, ChainSelector: 2, FeedID: usdcFeed},
+		},
+	}
+
+	newPriceGetter := func(t *testing.T, reports map[common.Hash][]byte) *DataStreamsPriceGetter {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, dataStreamsLatestReportPath, r.URL.Path)
+			assert.Equal(t, "user", r.Header.Get(dataStreamsAuthorization))
+			assert.NotEmpty(t, r.Header.Get(dataStreamsSignature))
+			fullReport, ok := reports[common.HexToHash(r.URL.Query().Get("feedID"))]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, err := fmt.Fprintf(w, `{"report": {"feedID": %q, "fullReport": %q}}`, r.URL.Query().Get("feedID"), hexutil.Encode(fullReport))
+			assert.NoError(t, err)
+		}))
+		t.Cleanup(srv.Close)
+
+		priceGetter, err := NewDataStreamsPriceGetter(logger.Test(t), cfg,
+			types.MercuryCredentials{URL: srv.URL, Username: "user", Password: "secret"}, srv.Client())
+		require.NoError(t, err)
+		priceGetter.now = func() time.Time { return now }
+		return priceGetter
+	}
+
+	t.Run("verified prices", func(t *testing.T) {
+		priceGetter := newPriceGetter(t, map[common.Hash][]byte{
+			linkFeed: newSignedDataStreamsReport(t, signer, linkFeed, now.Add(-10*time.Second), linkPrice),
+			usdcFeed: newSignedDataStreamsReport(t, signer, usdcFeed, now.Add(-10*time.Second), big.NewInt(1e18)),
+		})
+
+		prices, err := priceGetter.GetJobSpecTokenPricesUSD(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{
+			linkID: linkPrice,
+			usdcID: big.NewInt(1e18),
+		}, prices)
+	})
+
+	t.Run("stale report", func(t *testing.T) {
+		priceGetter := newPriceGetter(t, map[common.Hash][]byte{
+			linkFeed: newSignedDataStreamsReport(t, signer, linkFeed, now.Add(-2*time.Minute), linkPrice),
+		})
+
+		_, err := priceGetter.GetTokenPricesUSD(tests.Context(t), []ccipcommon.TokenID{linkID})
+		require.ErrorContains(t, err, "stale")
+	})
+
+	t.Run("report of unauthorized signer", func(t *testing.T) {
+		priceGetter := newPriceGetter(t, map[common.Hash][]byte{
+			linkFeed: newSignedDataStreamsReport(t, otherSigner, linkFeed, now, linkPrice),
+		})
+
+		_, err := priceGetter.GetTokenPricesUSD(tests.Context(t), []ccipcommon.TokenID{linkID})
+		require.ErrorIs(t, err, verifier.ErrVerifySomeSignerUnauthorized)
+	})
+
+	t.Run("report of another feed", func(t *testing.T) {
+		priceGetter := newPriceGetter(t, map[common.Hash][]byte{
+			linkFeed: newSignedDataStreamsReport(t, signer, usdcFeed, now, big.NewInt(1e18)),
+		})
+
+		_, err := priceGetter.GetTokenPricesUSD(tests.Context(t), []ccipcommon.TokenID{linkID})
+		require.Error(t, err)
+	})
+
+	t.Run("missing report", func(t *testing.T) {
+		priceGetter := newPriceGetter(t, map[common.Hash][]byte{})
+
+		_, err := priceGetter.GetTokenPricesUSD(tests.Context(t), []ccipcommon.TokenID{linkID})
+		require.Error(t, err)
+	})
+
+	t.Run("token without feed", func(t *testing.T) {
+		priceGetter := newPriceGetter(t, map[common.Hash][]byte{})
+
+		_, err := priceGetter.GetTokenPricesUSD(tests.Context(t), []ccipcommon.TokenID{{TokenAddress: linkID.TokenAddress, ChainSelector: 3}})
+		require.Error(t, err)
+	})
+}
+
+func newSignedDataStreamsReport(t *testing.T, signer *ecdsa.PrivateKey, feedID common.Hash, observedAt time.Time, price *big.Int) []byte {
+	ts := uint32(observedAt.Unix())
+	report, err := reporttypes.GetSchema().Pack(feedID, ts, ts, big.NewInt(0), big.NewInt(0), ts+3600, price, price, price)
+	require.NoError(t, err)
+
+	reportContext := [3][32]byte{{1}, {2}, {3}}
+	sig, err := crypto.Sign(verifier.ReportToSigData(reportContext, report), signer)
+	require.NoError(t, err)
+
+	var rs, ss, vs [32]byte
+	copy(rs[:], sig[:32])
+	copy(ss[:], sig[32:64])
+	vs[0] = sig[64]
+
+	fullReport, err := dataStreamsPayloadTypes.Pack(reportContext, report, [][32]byte{rs}, [][32]byte{ss}, vs)
+	require.NoError(t, err)
+	return fullReport
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math/big"
 	"strings"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"go.uber.org/multierr"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
 
@@ -53,10 +56,15 @@ func NewDynamicPriceGetterClient(batchCaller rpclib.EvmBatchCaller) DynamicPrice
 	}
 }
 
+var _ BatchedPriceGetter = &DynamicPriceGetter{}
+
 type DynamicPriceGetter struct {
 	cfg             config.DynamicPriceGetterConfig
 	contractReaders map[uint64]types.ContractReader
 	aggregatorAbi   abi.ABI
+	// batchCfg splits the aggregator batch calls of a chain into chunks, all aggregators of a chain are read by a
+	// single batch call and the chains are queried sequentially when unset.
+	batchCfg *config.PriceGetterBatchConfig
 }
 
 func NewDynamicPriceGetterConfig(configJson string) (config.DynamicPriceGetterConfig, error) {
@@ -82,7 +90,7 @@ func NewDynamicPriceGetter(cfg config.DynamicPriceGetterConfig, contractReaders
 	if err != nil {
 		return nil, fmt.Errorf("parsing offchainaggregator abi: %w", err)
 	}
-	priceGetter := DynamicPriceGetter{cfg, contractReaders, aggregatorAbi}
+	priceGetter := DynamicPriceGetter{cfg: cfg, contractReaders: contractReaders, aggregatorAbi: aggregatorAbi}
 	return &priceGetter, nil
 }
 
@@ -102,6 +110,16 @@ func (d *DynamicPriceGetter) MoveDeprecatedFields(
 	return nil
 }
 
+// SetBatchConfig implements the BatchedPriceGetter interface, the aggregators of a chain are read by batch calls of
+// at most cfg.ChunkSize aggregators, and at most cfg.Parallelism batch calls run concurrently across all chains.
+func (d *DynamicPriceGetter) SetBatchConfig(cfg config.PriceGetterBatchConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("validating price getter batch config: %w", err)
+	}
+	d.batchCfg = &cfg
+	return nil
+}
+
 // FilterConfiguredTokens implements the PriceGetter interface.
 // It filters a list of token addresses for only those that have a price resolution rule configured on the PriceGetterConfig
 func (d *DynamicPriceGetter) FilterConfiguredTokens(_ context.Context, tokens []cciptypes.Address) (configured []cciptypes.Address, unconfigured []cciptypes.Address, err error) {
@@ -155,12 +173,34 @@ func (d *DynamicPriceGetter) performBatchCalls(
 	batchCallsPerChain map[uint64]*batchCallsForChain,
 	prices map[ccipcommon.TokenID]*big.Int,
 ) error {
+	if d.batchCfg == nil {
+		for chainID, batchCalls := range batchCallsPerChain {
+			if err := d.performBatchCall(ctx, chainID, batchCalls, prices); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var mu sync.Mutex
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(int(d.batchCfg.Parallelism))
 	for chainID, batchCalls := range batchCallsPerChain {
-		if err := d.performBatchCall(ctx, chainID, batchCalls, prices); err != nil {
-			return err
+		for _, chunk := range batchCalls.chunks(int(d.batchCfg.ChunkSize)) {
+			eg.Go(func() error {
+				chunkPrices := make(map[ccipcommon.TokenID]*big.Int, len(chunk.tokenOrder))
+				if err := d.performBatchCall(ctx, chainID, chunk, chunkPrices); err != nil {
+					return fmt.Errorf("batch call of aggregators %d to %d on chain %d: %w",
+						chunk.offset, chunk.offset+len(chunk.tokenOrder)-1, chainID, err)
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				maps.Copy(prices, chunkPrices)
+				return nil
+			})
 		}
 	}
-	return nil
+	return eg.Wait()
 }
 
 // performBatchCall performs a batch call on a given chain to retrieve token prices.
@@ -182,7 +222,7 @@ func (d *DynamicPriceGetter) performBatchCall(
 	for i, call := range batchCalls.decimalCalls {
 		bindings = append(bindings, types.BoundContract{
 			Address: string(ccipcalc.EvmAddrToGeneric(call.ContractAddress())),
-			Name:    fmt.Sprintf("%v_%v", OffchainAggregator, i),
+			Name:    fmt.Sprintf("%v_%v", OffchainAggregator, batchCalls.offset+i),
 		})
 	}
 
@@ -197,7 +237,7 @@ func (d *DynamicPriceGetter) performBatchCall(
 	for i, call := range batchCalls.decimalCalls {
 		boundContract := types.BoundContract{
 			Address: call.ContractAddress().Hex(),
-			Name:    fmt.Sprintf("%v_%v", OffchainAggregator, i),
+			Name:    fmt.Sprintf("%v_%v", OffchainAggregator, batchCalls.offset+i),
 		}
 		batchGetLatestValuesRequest[boundContract] = append(batchGetLatestValuesRequest[boundContract], types.BatchRead{
 			ReadName:  call.MethodName(),
@@ -208,7 +248,7 @@ func (d *DynamicPriceGetter) performBatchCall(
 	for i, call := range batchCalls.latestRoundDataCalls {
 		boundContract := types.BoundContract{
 			Address: call.ContractAddress().Hex(),
-			Name:    fmt.Sprintf("%v_%v", OffchainAggregator, i),
+			Name:    fmt.Sprintf("%v_%v", OffchainAggregator, batchCalls.offset+i),
 		}
 		batchGetLatestValuesRequest[boundContract] = append(batchGetLatestValuesRequest[boundContract], types.BatchRead{
 			ReadName:  call.MethodName(),
@@ -231,7 +271,7 @@ func (d *DynamicPriceGetter) performBatchCall(
 	for j := range nbCalls {
 		boundContract := types.BoundContract{
 			Address: batchCalls.decimalCalls[j].ContractAddress().Hex(),
-			Name:    fmt.Sprintf("%v_%v", OffchainAggregator, j),
+			Name:    fmt.Sprintf("%v_%v", OffchainAggregator, batchCalls.offset+j),
 		}
 		offchainAggregatorRespSlice := result[boundContract]
 
@@ -341,6 +381,27 @@ type batchCallsForChain struct {
 	decimalCalls         []rpclib.EvmCall
 	latestRoundDataCalls []rpclib.EvmCall
 	tokenOrder           []ccipcommon.TokenID // required to maintain the order of the batched rpc calls for mapping the results.
+	// offset is the index of the first call of a chunk among the calls of the chain, it keeps the contract names
+	// bound by concurrent chunks unique.
+	offset int
+}
+
+// chunks splits the batch calls into batch calls of at most chunkSize tokens.
+func (b *batchCallsForChain) chunks(chunkSize int) []*batchCallsForChain {
+	if chunkSize <= 0 || len(b.tokenOrder) <= chunkSize {
+		return []*batchCallsForChain{b}
+	}
+	chunks := make([]*batchCallsForChain, 0, (len(b.tokenOrder)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(b.tokenOrder); start += chunkSize {
+		end := min(start+chunkSize, len(b.tokenOrder))
+		chunks = append(chunks, &batchCallsForChain{
+			decimalCalls:         b.decimalCalls[start:end],
+			latestRoundDataCalls: b.latestRoundDataCalls[start:end],
+			tokenOrder:           b.tokenOrder[start:end],
+			offset:               b.offset + start,
+		})
+	}
+	return chunks
 }
 
 func (d *DynamicPriceGetter) Close() error {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -173,6 +174,57 @@ func TestDynamicPriceGetterWithEmptyInput(t *testing.T) {
 	}
 }
 
+func TestDynamicPriceGetterBatchConfig(t *testing.T) {
+	destChain := chainselectors.TEST_1338
+	cfg := config.DynamicPriceGetterConfig{
+		AggregatorPrices: map[common.Address]config.AggregatorPriceConfig{
+			TK1: {ChainID: 101, AggregatorContractAddress: utils.RandomAddress()},
+			TK2: {ChainID: 101, AggregatorContractAddress: utils.RandomAddress()},
+			TK3: {ChainID: 101, AggregatorContractAddress: utils.RandomAddress()},
+		},
+		StaticPrices: map[common.Address]config.StaticPriceConfig{},
+	}
+	tokens := []common.Address{TK1, TK2, TK3}
+	rounds := make([]aggregator_v3_interface.LatestRoundData, len(tokens))
+	tokenIDs := make([]ccipcommon.TokenID, len(tokens))
+	for i, token := range tokens {
+		rounds[i] = aggregator_v3_interface.LatestRoundData{Answer: big.NewInt(int64(i+1) * 1e8)}
+		tokenIDs[i] = ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(token), ChainSelector: destChain.Selector}
+	}
+
+	t.Run("aggregators are read in chunks", func(t *testing.T) {
+		contractReader := mockCR([]uint8{8, 8, 8}, cfg, tokens, rounds)
+		pg, err := NewDynamicPriceGetter(cfg, map[uint64]types.ContractReader{101: contractReader})
+		require.NoError(t, err)
+		require.NoError(t, pg.MoveDeprecatedFields(chainselectors.TEST_1000.Selector, destChain.Selector, common.Address{}))
+		require.NoError(t, pg.SetBatchConfig(config.PriceGetterBatchConfig{ChunkSize: 2, Parallelism: 2}))
+
+		prices, err := pg.GetTokenPricesUSD(testutils.Context(t), tokenIDs)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), contractReader.batchCalls.Load())
+		require.Len(t, prices, len(tokens))
+		for i, tokenID := range tokenIDs {
+			assert.Equal(t, 0, multExp(big.NewInt(int64(i+1)), 18).Cmp(prices[tokenID]))
+		}
+	})
+
+	t.Run("chunk errors fail the price query", func(t *testing.T) {
+		pg, err := NewDynamicPriceGetter(cfg, map[uint64]types.ContractReader{101: mockErrCR()})
+		require.NoError(t, err)
+		require.NoError(t, pg.MoveDeprecatedFields(chainselectors.TEST_1000.Selector, destChain.Selector, common.Address{}))
+		require.NoError(t, pg.SetBatchConfig(config.PriceGetterBatchConfig{ChunkSize: 1, Parallelism: 3}))
+
+		_, err = pg.GetTokenPricesUSD(testutils.Context(t), tokenIDs)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid batch config", func(t *testing.T) {
+		pg, err := NewDynamicPriceGetter(cfg, map[uint64]types.ContractReader{})
+		require.NoError(t, err)
+		require.Error(t, pg.SetBatchConfig(config.PriceGetterBatchConfig{ChunkSize: 0, Parallelism: 1}))
+	})
+}
+
 func testParamAggregatorOnly(t *testing.T) testParameters {
 	cfg := config.DynamicPriceGetterConfig{
 		AggregatorPrices: map[common.Address]config.AggregatorPriceConfig{
@@ -819,8 +871,9 @@ func multExp(x *big.Int, e int64) *big.Int {
 
 type mockContractReader struct {
 	types.UnimplementedContractReader
-	result types.BatchGetLatestValuesResult
-	err    error
+	result     types.BatchGetLatestValuesResult
+	err        error
+	batchCalls atomic.Int32
 }
 
 func (m *mockContractReader) Bind(context.Context, []types.BoundContract) error {
@@ -828,6 +881,7 @@ func (m *mockContractReader) Bind(context.Context, []types.BoundContract) error
 }
 
 func (m *mockContractReader) BatchGetLatestValues(context.Context, types.BatchGetLatestValuesRequest) (types.BatchGetLatestValuesResult, error) {
+	m.batchCalls.Add(1)
 	if m.err != nil {
 		return nil, m.err
 	}
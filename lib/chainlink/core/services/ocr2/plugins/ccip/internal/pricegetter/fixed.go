@@ -0,0 +1,124 @@
+package pricegetter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+var _ AllTokensPriceGetter = &FixedPriceGetter{}
+
+// FixedPriceGetter returns operator configured fixed prices until they expire, e.g. in system tests or as a
+// break-glass override while the price source of a token is down. The other tokens, and the tokens whose fixed price
+// expired, are priced by the underlying price getter. Without an underlying price getter only the tokens with an
+// active fixed price can be priced.
+type FixedPriceGetter struct {
+	lggr   logger.Logger
+	prices map[ccipcommon.TokenID]config.FixedPriceConfig
+	getter AllTokensPriceGetter
+	now    func() time.Time
+}
+
+func NewFixedPriceGetter(lggr logger.Logger, prices config.FixedPricesConfig, getter AllTokensPriceGetter) (*FixedPriceGetter, error) {
+	if err := prices.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid fixed prices: %w", err)
+	}
+	if len(prices) == 0 {
+		return nil, errors.New("no fixed prices")
+	}
+
+	fixedPrices := make(map[ccipcommon.TokenID]config.FixedPriceConfig, len(prices))
+	for _, price := range prices {
+		fixedPrices[ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(price.TokenAddress), ChainSelector: price.ChainSelector}] = price
+	}
+
+	return &FixedPriceGetter{
+		lggr:   lggr,
+		prices: fixedPrices,
+		getter: getter,
+		now:    time.Now,
+	}, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the job spec prices of the underlying price getter with the active fixed prices
+// applied. When the underlying price getter fails, only the active fixed prices are returned, so that the tokens with
+// an override keep being priced while the price source is down.
+func (f *FixedPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	fixedPrices := f.activePrices()
+	if f.getter == nil {
+		return fixedPrices, nil
+	}
+
+	prices, err := f.getter.GetJobSpecTokenPricesUSD(ctx)
+	if err != nil {
+		if len(fixedPrices) == 0 {
+			return nil, err
+		}
+		f.lggr.Warnw("Price getter failed, returning the fixed prices only", "err", err, "fixedPrices", fixedPrices)
+		return fixedPrices, nil
+	}
+	for token, price := range fixedPrices {
+		prices[token] = price
+	}
+	return prices, nil
+}
+
+// GetTokenPricesUSD returns the active fixed prices of the tokens, the prices of the other tokens are fetched from
+// the underlying price getter.
+func (f *FixedPriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	fixedPrices := f.activePrices()
+	prices := make(map[ccipcommon.TokenID]*big.Int, len(tokens))
+	var missing []ccipcommon.TokenID
+	for _, token := range tokens {
+		if price, ok := fixedPrices[token]; ok {
+			prices[token] = price
+			continue
+		}
+		missing = append(missing, token)
+	}
+	if len(missing) == 0 {
+		return prices, nil
+	}
+	if f.getter == nil {
+		return nil, fmt.Errorf("no active fixed price for tokens %v", missing)
+	}
+
+	fetched, err := f.getter.GetTokenPricesUSD(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for token, price := range fetched {
+		prices[token] = price
+	}
+	return prices, nil
+}
+
+// Close closes the underlying price getter.
+func (f *FixedPriceGetter) Close() error {
+	if f.getter == nil {
+		return nil
+	}
+	return f.getter.Close()
+}
+
+// activePrices returns copies of the fixed prices which did not expire yet.
+func (f *FixedPriceGetter) activePrices() map[ccipcommon.TokenID]*big.Int {
+	now := f.now()
+	prices := make(map[ccipcommon.TokenID]*big.Int, len(f.prices))
+	for token, fixedPrice := range f.prices {
+		if !now.Before(fixedPrice.ExpiresAt) {
+			f.lggr.Warnw("Fixed price expired, ignoring it", "token", token, "expiredAt", fixedPrice.ExpiresAt)
+			continue
+		}
+		prices[token] = new(big.Int).Set(fixedPrice.Price)
+	}
+	return prices
+}
@@ -0,0 +1,101 @@
+package pricegetter
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+func TestFixedPriceGetter(t *testing.T) {
+	ctx := tests.Context(t)
+	now := time.Unix(1_700_000_000, 0)
+	link := common.HexToAddress("0x1")
+	usdc := common.HexToAddress("0x2")
+	linkID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(link), ChainSelector: 1}
+	usdcID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(usdc), ChainSelector: 1}
+	wethID := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x3"), ChainSelector: 1}
+
+	fixedPrices := config.FixedPricesConfig{
+		{TokenAddress: link, ChainSelector: 1, Price: big.NewInt(15), ExpiresAt: now.Add(time.Hour)},
+		{TokenAddress: usdc, ChainSelector: 1, Price: big.NewInt(1), ExpiresAt: now.Add(-time.Second)},
+	}
+	newFixedPriceGetter := func(t *testing.T, getter AllTokensPriceGetter) *FixedPriceGetter {
+		fixedPriceGetter, err := NewFixedPriceGetter(logger.Test(t), fixedPrices, getter)
+		require.NoError(t, err)
+		fixedPriceGetter.now = func() time.Time { return now }
+		return fixedPriceGetter
+	}
+
+	t.Run("fixed prices override the prices of the price getter until they expire", func(t *testing.T) {
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(map[ccipcommon.TokenID]*big.Int{
+			linkID: big.NewInt(10),
+			usdcID: big.NewInt(2),
+			wethID: big.NewInt(3000),
+		}, nil).Once()
+
+		prices, err := newFixedPriceGetter(t, getter).GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{
+			linkID: big.NewInt(15),
+			usdcID: big.NewInt(2),
+			wethID: big.NewInt(3000),
+		}, prices)
+	})
+
+	t.Run("fixed prices are returned when the price getter fails", func(t *testing.T) {
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(nil, errors.New("feed down")).Once()
+
+		prices, err := newFixedPriceGetter(t, getter).GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{linkID: big.NewInt(15)}, prices)
+	})
+
+	t.Run("only the tokens without an active fixed price are fetched", func(t *testing.T) {
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetTokenPricesUSD(ctx, []ccipcommon.TokenID{usdcID, wethID}).Return(map[ccipcommon.TokenID]*big.Int{
+			usdcID: big.NewInt(2),
+			wethID: big.NewInt(3000),
+		}, nil).Once()
+
+		prices, err := newFixedPriceGetter(t, getter).GetTokenPricesUSD(ctx, []ccipcommon.TokenID{linkID, usdcID, wethID})
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{
+			linkID: big.NewInt(15),
+			usdcID: big.NewInt(2),
+			wethID: big.NewInt(3000),
+		}, prices)
+	})
+
+	t.Run("without price getter", func(t *testing.T) {
+		fixedPriceGetter := newFixedPriceGetter(t, nil)
+
+		prices, err := fixedPriceGetter.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{linkID: big.NewInt(15)}, prices)
+
+		_, err = fixedPriceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{linkID, usdcID})
+		require.ErrorContains(t, err, "no active fixed price")
+		require.NoError(t, fixedPriceGetter.Close())
+	})
+
+	t.Run("invalid fixed prices", func(t *testing.T) {
+		_, err := NewFixedPriceGetter(logger.Test(t), config.FixedPricesConfig{{TokenAddress: link, ChainSelector: 1, Price: big.NewInt(1)}}, nil)
+		require.Error(t, err)
+		_, err = NewFixedPriceGetter(logger.Test(t), nil, nil)
+		require.Error(t, err)
+	})
+}
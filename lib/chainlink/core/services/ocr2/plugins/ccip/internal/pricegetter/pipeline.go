@@ -21,6 +21,7 @@ import (
 )
 
 var _ PriceGetter = &PipelineGetter{}
+var _ HTTPPriceGetter = &PipelineGetter{}
 
 // PipelineGetter is not supposed to be used but it seems that some JobSpecs are still using it.
 // Should be removed after all JobSpecs migrate to the dynamic price getter. It uses a legacy pipeline component of
@@ -35,6 +36,7 @@ type PipelineGetter struct {
 	sourceNativeTokenAddr cciptypes.Address
 	sourceChainSelector   uint64
 	destChainSelector     uint64
+	requestsPerHost       map[string]int
 }
 
 func NewPipelineGetter(
@@ -48,7 +50,7 @@ func NewPipelineGetter(
 	sourceChainSelector uint64,
 	destChainSelector uint64,
 ) (*PipelineGetter, error) {
-	_, err := pipeline.Parse(source)
+	requestsPerHost, err := pipelineRequestsPerHost(source)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +65,7 @@ func NewPipelineGetter(
 		sourceNativeTokenAddr: sourceNativeTokenAddr,
 		sourceChainSelector:   sourceChainSelector,
 		destChainSelector:     destChainSelector,
+		requestsPerHost:       requestsPerHost,
 	}, nil
 }
 
@@ -189,6 +192,13 @@ func (d *PipelineGetter) getPricesFromRunner(ctx context.Context) (map[string]in
 	return prices, nil
 }
 
+// RequestsPerHost returns the http tasks of the pipeline per host, the whole pipeline runs whatever the tokens.
+func (d *PipelineGetter) RequestsPerHost([]ccipcommon.TokenID) map[string]int {
+	requestsPerHost := make(map[string]int, len(d.requestsPerHost))
+	addRequestsPerHost(requestsPerHost, d.requestsPerHost)
+	return requestsPerHost
+}
+
 func (d *PipelineGetter) Close() error {
 	return d.runner.Close()
 }
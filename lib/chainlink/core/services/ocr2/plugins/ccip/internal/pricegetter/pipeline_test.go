@@ -106,6 +106,17 @@ func TestParsingDifferentFormats(t *testing.T) {
 
 func newTestPipelineGetter(t *testing.T, source string) *pricegetter.PipelineGetter {
 	lggr, _ := logger.NewLogger()
+	runner := newTestPipelineRunner(t, lggr)
+	sourceNative := ccipcalc.EvmAddrToGeneric(common.HexToAddress("0x"))
+	sourceChain := chainsel.TEST_1000
+	destChain := chainsel.TEST_1338
+	ds, err := pricegetter.NewPipelineGetter(source, runner, 1, uuid.New(), "test",
+		lggr, sourceNative, sourceChain.Selector, destChain.Selector)
+	require.NoError(t, err)
+	return ds
+}
+
+func newTestPipelineRunner(t *testing.T, lggr logger.Logger) pipeline.Runner {
 	cfg := pipelinemocks.NewConfig(t)
 	cfg.On("MaxRunDuration").Return(time.Second)
 	cfg.On("DefaultHTTPTimeout").Return(*config2.MustNewDuration(time.Second))
@@ -113,13 +124,6 @@ func newTestPipelineGetter(t *testing.T, source string) *pricegetter.PipelineGet
 	cfg.On("VerboseLogging").Return(true)
 	db := pgtest.NewSqlxDB(t)
 	bridgeORM := bridges.NewORM(db)
-	runner := pipeline.NewRunner(pipeline.NewORM(db, lggr, config.NewTestGeneralConfig(t).JobPipeline().MaxSuccessfulRuns()),
+	return pipeline.NewRunner(pipeline.NewORM(db, lggr, config.NewTestGeneralConfig(t).JobPipeline().MaxSuccessfulRuns()),
 		bridgeORM, cfg, nil, nil, nil, nil, lggr, &http.Client{}, &http.Client{})
-	sourceNative := ccipcalc.EvmAddrToGeneric(common.HexToAddress("0x"))
-	sourceChain := chainsel.TEST_1000
-	destChain := chainsel.TEST_1338
-	ds, err := pricegetter.NewPipelineGetter(source, runner, 1, uuid.New(), "test",
-		lggr, sourceNative, sourceChain.Selector, destChain.Selector)
-	require.NoError(t, err)
-	return ds
 }
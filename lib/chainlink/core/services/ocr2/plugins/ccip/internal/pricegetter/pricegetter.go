@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
 )
 
@@ -21,3 +22,60 @@ type AllTokensPriceGetter interface {
 	// GetTokenPricesUSD returns the prices of the provided tokens in USD.
 	GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error)
 }
+
+// BatchedPriceGetter is implemented by price getters which split the price queries of many tokens into chunks,
+// querying the chunks in parallel.
+type BatchedPriceGetter interface {
+	AllTokensPriceGetter
+
+	// SetBatchConfig sets the max number of tokens per chunk and the max number of chunks queried in parallel.
+	SetBatchConfig(cfg config.PriceGetterBatchConfig) error
+}
+
+// SignedPrice is a USD price along with the signed payload it was taken from, e.g. a data streams report.
+type SignedPrice struct {
+	Price     *big.Int
+	Payload   []byte
+	Signature []byte
+}
+
+// SignedPriceGetter is implemented by price getters whose prices carry a signature, so that the prices can be verified
+// before they are trusted.
+type SignedPriceGetter interface {
+	AllTokensPriceGetter
+
+	// GetSignedJobSpecTokenPricesUSD returns all token prices defined in the jobspec along with their signed payloads.
+	GetSignedJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]SignedPrice, error)
+	// GetSignedTokenPricesUSD returns the prices of the provided tokens in USD along with their signed payloads.
+	GetSignedTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]SignedPrice, error)
+}
+
+// PriceConfidence describes how much a token price can be trusted.
+type PriceConfidence struct {
+	// SourceCount is the number of price sources the price was derived from.
+	SourceCount int
+	// Score is the confidence in the price, from 0 (none) to 1 (full).
+	Score float64
+}
+
+// PriceWithConfidence is a USD price along with the confidence in it.
+type PriceWithConfidence struct {
+	Price *big.Int
+	PriceConfidence
+}
+
+// ConfidencePriceGetter is implemented by price getters which know how much their prices can be trusted, e.g. because
+// they derive them from multiple price sources.
+type ConfidencePriceGetter interface {
+	AllTokensPriceGetter
+
+	// GetJobSpecTokenPricesWithConfidence returns all token prices defined in the jobspec along with their confidence.
+	GetJobSpecTokenPricesWithConfidence(ctx context.Context) (map[ccipcommon.TokenID]PriceWithConfidence, error)
+	// GetTokenPricesWithConfidence returns the prices of the provided tokens in USD along with their confidence.
+	GetTokenPricesWithConfidence(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]PriceWithConfidence, error)
+}
+
+// PriceVerifier verifies the signature of a signed price, and that the signed payload is the price of the token.
+type PriceVerifier interface {
+	VerifyPrice(token ccipcommon.TokenID, price SignedPrice) error
+}
@@ -0,0 +1,184 @@
+package pricegetter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+var rateLimitedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "ccip_price_getter_rate_limited_requests",
+	Help: "Number of price getter requests which had to wait for the request budget of the host",
+}, []string{"host"})
+
+// HTTPPriceGetter is implemented by the price getters querying external HTTP APIs.
+type HTTPPriceGetter interface {
+	AllTokensPriceGetter
+
+	// RequestsPerHost returns the number of requests sent to each host to get the prices of the tokens,
+	// or of all the job spec tokens when tokens is nil.
+	RequestsPerHost(tokens []ccipcommon.TokenID) map[string]int
+}
+
+// HostRateLimits holds the request budget of every host, it is shared by the rate limited price getters of all lanes
+// so that the budget of a host applies to the node as a whole.
+type HostRateLimits struct {
+	lggr logger.Logger
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func NewHostRateLimits(lggr logger.Logger) *HostRateLimits {
+	return &HostRateLimits{
+		lggr:     lggr,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// SetBudget sets the budget of the host to requestsPerSecond with the given burst. When lanes configure different
+// budgets for the same host, the most restrictive one applies.
+func (h *HostRateLimits) SetBudget(host string, requestsPerSecond float64, burst int) error {
+	if host == "" {
+		return errors.New("host is empty")
+	}
+	if requestsPerSecond <= 0 || burst <= 0 {
+		return fmt.Errorf("invalid budget of host %s: requests per second and burst must be positive", host)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	limiter, ok := h.limiters[host]
+	if !ok {
+		h.limiters[host] = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+		return nil
+	}
+	if rate.Limit(requestsPerSecond) < limiter.Limit() {
+		h.lggr.Warnw("Lowering the request budget of host configured by another lane", "host", host,
+			"requestsPerSecond", requestsPerSecond, "previousRequestsPerSecond", float64(limiter.Limit()))
+		limiter.SetLimit(rate.Limit(requestsPerSecond))
+	}
+	if burst < limiter.Burst() {
+		limiter.SetBurst(burst)
+	}
+	return nil
+}
+
+func (h *HostRateLimits) limiter(host string) (*rate.Limiter, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	limiter, ok := h.limiters[host]
+	return limiter, ok
+}
+
+// wait blocks until the hosts have the budget for the requests, the hosts without budget are not limited.
+func (h *HostRateLimits) wait(ctx context.Context, requestsPerHost map[string]int) error {
+	hosts := make([]string, 0, len(requestsPerHost))
+	for host := range requestsPerHost {
+		hosts = append(hosts, host)
+	}
+	// Wait for the hosts in a stable order so that concurrent callers queue up the same way
+	sort.Strings(hosts)
+
+	for _, host := range hosts {
+		limiter, ok := h.limiter(host)
+		if !ok {
+			continue
+		}
+		// Requests beyond the burst can't be waited for at once, they are spread over multiple waits
+		for remaining := requestsPerHost[host]; remaining > 0; {
+			n := min(remaining, limiter.Burst())
+			if limiter.Tokens() < float64(n) {
+				rateLimitedRequests.WithLabelValues(host).Add(float64(n))
+			}
+			if err := limiter.WaitN(ctx, n); err != nil {
+				return fmt.Errorf("waiting for the request budget of host %s: %w", host, err)
+			}
+			remaining -= n
+		}
+	}
+	return nil
+}
+
+var _ AllTokensPriceGetter = &RateLimitedPriceGetter{}
+
+// RateLimitedPriceGetter waits for the request budgets of the hosts queried by the underlying price getter before
+// every price query, so that many lanes querying the same external price API don't exceed its rate limits.
+type RateLimitedPriceGetter struct {
+	rateLimits *HostRateLimits
+	getter     HTTPPriceGetter
+}
+
+func NewRateLimitedPriceGetter(rateLimits *HostRateLimits, getter HTTPPriceGetter) (*RateLimitedPriceGetter, error) {
+	if rateLimits == nil {
+		return nil, errors.New("host rate limits are nil")
+	}
+	return &RateLimitedPriceGetter{
+		rateLimits: rateLimits,
+		getter:     getter,
+	}, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the prices of the underlying price getter once the hosts have the budget for them.
+func (r *RateLimitedPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	if err := r.rateLimits.wait(ctx, r.getter.RequestsPerHost(nil)); err != nil {
+		return nil, err
+	}
+	return r.getter.GetJobSpecTokenPricesUSD(ctx)
+}
+
+// GetTokenPricesUSD returns the prices of the underlying price getter once the hosts have the budget for them.
+func (r *RateLimitedPriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	if err := r.rateLimits.wait(ctx, r.getter.RequestsPerHost(tokens)); err != nil {
+		return nil, err
+	}
+	return r.getter.GetTokenPricesUSD(ctx, tokens)
+}
+
+// Close closes the underlying price getter.
+func (r *RateLimitedPriceGetter) Close() error {
+	return r.getter.Close()
+}
+
+// pipelineRequestsPerHost counts the http tasks of the pipeline per host. Bridge tasks are not counted, the bridges
+// are operated by the node operator, and neither are the URLs resolved from pipeline variables.
+func pipelineRequestsPerHost(source string) (map[string]int, error) {
+	p, err := pipeline.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	requestsPerHost := make(map[string]int)
+	for _, task := range p.Tasks {
+		httpTask, ok := task.(*pipeline.HTTPTask)
+		if !ok {
+			continue
+		}
+		u, err := url.Parse(httpTask.URL)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		requestsPerHost[u.Hostname()]++
+	}
+	return requestsPerHost, nil
+}
+
+func addRequestsPerHost(total, requestsPerHost map[string]int) {
+	for host, requests := range requestsPerHost {
+		total[host] += requests
+	}
+}
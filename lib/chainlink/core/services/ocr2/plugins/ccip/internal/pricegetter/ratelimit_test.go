@@ -0,0 +1,142 @@
+package pricegetter
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+type fakeHTTPPriceGetter struct {
+	*MockAllTokensPriceGetter
+	requestsPerHost map[string]int
+}
+
+func (f fakeHTTPPriceGetter) RequestsPerHost([]ccipcommon.TokenID) map[string]int {
+	return f.requestsPerHost
+}
+
+func TestHostRateLimits_SetBudget(t *testing.T) {
+	rateLimits := NewHostRateLimits(logger.Test(t))
+	require.Error(t, rateLimits.SetBudget("", 1, 1))
+	require.Error(t, rateLimits.SetBudget("api.example.com", 0, 1))
+	require.Error(t, rateLimits.SetBudget("api.example.com", 1, 0))
+
+	require.NoError(t, rateLimits.SetBudget("api.example.com", 10, 5))
+	require.NoError(t, rateLimits.SetBudget("api.example.com", 2, 10))
+	require.NoError(t, rateLimits.SetBudget("api.example.com", 20, 3))
+
+	limiter, ok := rateLimits.limiter("api.example.com")
+	require.True(t, ok)
+	assert.InDelta(t, 2, float64(limiter.Limit()), 0)
+	assert.Equal(t, 3, limiter.Burst())
+}
+
+func TestRateLimitedPriceGetter(t *testing.T) {
+	token := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x1"), ChainSelector: 1}
+	prices := map[ccipcommon.TokenID]*big.Int{token: big.NewInt(10)}
+
+	t.Run("requests within the budget are not delayed", func(t *testing.T) {
+		ctx := tests.Context(t)
+		rateLimits := NewHostRateLimits(logger.Test(t))
+		require.NoError(t, rateLimits.SetBudget("api.example.com", 0.001, 2))
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(prices, nil).Once()
+		getter.EXPECT().GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token}).Return(prices, nil).Once()
+
+		rateLimited, err := NewRateLimitedPriceGetter(rateLimits,
+			fakeHTTPPriceGetter{MockAllTokensPriceGetter: getter, requestsPerHost: map[string]int{"api.example.com": 1}})
+		require.NoError(t, err)
+
+		got, err := rateLimited.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, prices, got)
+		got, err = rateLimited.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{token})
+		require.NoError(t, err)
+		assert.Equal(t, prices, got)
+	})
+
+	t.Run("requests over the budget wait for it", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(tests.Context(t), 50*time.Millisecond)
+		defer cancel()
+		rateLimits := NewHostRateLimits(logger.Test(t))
+		require.NoError(t, rateLimits.SetBudget("api.example.com", 0.001, 1))
+
+		// The budget of the host is shared, the second lane has to wait for the request of the first one
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(prices, nil).Once()
+		lane1, err := NewRateLimitedPriceGetter(rateLimits,
+			fakeHTTPPriceGetter{MockAllTokensPriceGetter: getter, requestsPerHost: map[string]int{"api.example.com": 1}})
+		require.NoError(t, err)
+		lane2, err := NewRateLimitedPriceGetter(rateLimits,
+			fakeHTTPPriceGetter{MockAllTokensPriceGetter: NewMockAllTokensPriceGetter(t), requestsPerHost: map[string]int{"api.example.com": 1}})
+		require.NoError(t, err)
+
+		_, err = lane1.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		_, err = lane2.GetJobSpecTokenPricesUSD(ctx)
+		require.ErrorContains(t, err, "api.example.com")
+	})
+
+	t.Run("requests beyond the burst are spread over time", func(t *testing.T) {
+		ctx := tests.Context(t)
+		rateLimits := NewHostRateLimits(logger.Test(t))
+		require.NoError(t, rateLimits.SetBudget("api.example.com", 100, 2))
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(prices, nil).Once()
+
+		rateLimited, err := NewRateLimitedPriceGetter(rateLimits,
+			fakeHTTPPriceGetter{MockAllTokensPriceGetter: getter, requestsPerHost: map[string]int{"api.example.com": 5}})
+		require.NoError(t, err)
+
+		start := time.Now()
+		_, err = rateLimited.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	})
+
+	t.Run("hosts without budget are not limited", func(t *testing.T) {
+		ctx := tests.Context(t)
+		rateLimits := NewHostRateLimits(logger.Test(t))
+		require.NoError(t, rateLimits.SetBudget("api.example.com", 0.001, 1))
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(prices, nil).Times(3)
+
+		rateLimited, err := NewRateLimitedPriceGetter(rateLimits,
+			fakeHTTPPriceGetter{MockAllTokensPriceGetter: getter, requestsPerHost: map[string]int{"other.example.com": 10}})
+		require.NoError(t, err)
+
+		for range 3 {
+			_, err = rateLimited.GetJobSpecTokenPricesUSD(ctx)
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestPipelineRequestsPerHost(t *testing.T) {
+	requestsPerHost, err := pipelineRequestsPerHost(`
+		link_1 [type=http method=GET url="https://api.example.com/link"];
+		link_2 [type=http method=GET url="https://api.example.com:8080/link?x=1"];
+		link_3 [type=http method=GET url="https://other.example.com/link"];
+		link_bridge [type=bridge name="prices"];
+		link_parse [type=jsonparse path="data,price"];
+		link_1 -> link_parse;
+		link_2 -> link_parse;
+		link_3 -> link_parse;
+		link_bridge -> link_parse;
+	`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"api.example.com": 2, "other.example.com": 1}, requestsPerHost)
+
+	_, err = pipelineRequestsPerHost(`invalid pipeline`)
+	require.Error(t, err)
+}
@@ -0,0 +1,78 @@
+package pricegetter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+// pricePayloadHeaderLength is the length of the chain selector, timestamp and price preceding the token address.
+const pricePayloadHeaderLength = 8 + 8 + 32
+
+var _ PriceVerifier = &ECDSAPriceVerifier{}
+
+// ECDSAPriceVerifier verifies prices signed by one of the allowed secp256k1 signers. The signed payloads must be
+// encoded by EncodePricePayload and not be older than maxPayloadAge, so that stale signed prices cannot be replayed.
+type ECDSAPriceVerifier struct {
+	signers       map[common.Address]struct{}
+	maxPayloadAge time.Duration
+}
+
+func NewECDSAPriceVerifier(signers []common.Address, maxPayloadAge time.Duration) (*ECDSAPriceVerifier, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("at least one price signer is required")
+	}
+	if maxPayloadAge <= 0 {
+		return nil, errors.New("max payload age must be positive")
+	}
+	allowedSigners := make(map[common.Address]struct{}, len(signers))
+	for _, signer := range signers {
+		allowedSigners[signer] = struct{}{}
+	}
+	return &ECDSAPriceVerifier{signers: allowedSigners, maxPayloadAge: maxPayloadAge}, nil
+}
+
+// EncodePricePayload encodes the price of the token observed at the given time into the payload signed by the price
+// signers: the chain selector, the unix timestamp, the 32 bytes price, all big-endian, followed by the token address.
+func EncodePricePayload(token ccipcommon.TokenID, price *big.Int, observedAt time.Time) []byte {
+	payload := make([]byte, pricePayloadHeaderLength, pricePayloadHeaderLength+len(token.TokenAddress))
+	binary.BigEndian.PutUint64(payload[0:8], token.ChainSelector)
+	binary.BigEndian.PutUint64(payload[8:16], uint64(observedAt.Unix()))
+	price.FillBytes(payload[16:48])
+	return append(payload, token.TokenAddress...)
+}
+
+func (v *ECDSAPriceVerifier) VerifyPrice(token ccipcommon.TokenID, price SignedPrice) error {
+	if price.Price == nil || price.Price.Sign() < 0 || price.Price.BitLen() > 256 {
+		return fmt.Errorf("invalid price %v", price.Price)
+	}
+	if len(price.Payload) < pricePayloadHeaderLength {
+		return fmt.Errorf("signed payload too short: %d bytes", len(price.Payload))
+	}
+
+	observedAt := time.Unix(int64(binary.BigEndian.Uint64(price.Payload[8:16])), 0)
+	if !bytes.Equal(EncodePricePayload(token, price.Price, observedAt), price.Payload) {
+		return errors.New("signed payload does not match the token price")
+	}
+	if age := time.Since(observedAt); age > v.maxPayloadAge {
+		return fmt.Errorf("signed payload is %s old, older than %s", age.Round(time.Second), v.maxPayloadAge)
+	}
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(price.Payload), price.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	signer := crypto.PubkeyToAddress(*pubKey)
+	if _, ok := v.signers[signer]; !ok {
+		return fmt.Errorf("price signed by unknown signer %s", signer.Hex())
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package pricegetter
+
+import (
+	context "context"
+	big "math/big"
+
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSignedPriceGetter is an autogenerated mock type for the SignedPriceGetter type
+type MockSignedPriceGetter struct {
+	mock.Mock
+}
+
+type MockSignedPriceGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSignedPriceGetter) EXPECT() *MockSignedPriceGetter_Expecter {
+	return &MockSignedPriceGetter_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *MockSignedPriceGetter) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSignedPriceGetter_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockSignedPriceGetter_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockSignedPriceGetter_Expecter) Close() *MockSignedPriceGetter_Close_Call {
+	return &MockSignedPriceGetter_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockSignedPriceGetter_Close_Call) Run(run func()) *MockSignedPriceGetter_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_Close_Call) Return(_a0 error) *MockSignedPriceGetter_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_Close_Call) RunAndReturn(run func() error) *MockSignedPriceGetter_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobSpecTokenPricesUSD provides a mock function with given fields: ctx
+func (_m *MockSignedPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobSpecTokenPricesUSD")
+	}
+
+	var r0 map[ccipcommon.TokenID]*big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[ccipcommon.TokenID]*big.Int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[ccipcommon.TokenID]*big.Int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipcommon.TokenID]*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobSpecTokenPricesUSD'
+type MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call struct {
+	*mock.Call
+}
+
+// GetJobSpecTokenPricesUSD is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSignedPriceGetter_Expecter) GetJobSpecTokenPricesUSD(ctx interface{}) *MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call {
+	return &MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call{Call: _e.mock.On("GetJobSpecTokenPricesUSD", ctx)}
+}
+
+func (_c *MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call) Run(run func(ctx context.Context)) *MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call) Return(_a0 map[ccipcommon.TokenID]*big.Int, _a1 error) *MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call) RunAndReturn(run func(context.Context) (map[ccipcommon.TokenID]*big.Int, error)) *MockSignedPriceGetter_GetJobSpecTokenPricesUSD_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSignedJobSpecTokenPricesUSD provides a mock function with given fields: ctx
+func (_m *MockSignedPriceGetter) GetSignedJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]SignedPrice, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSignedJobSpecTokenPricesUSD")
+	}
+
+	var r0 map[ccipcommon.TokenID]SignedPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[ccipcommon.TokenID]SignedPrice, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[ccipcommon.TokenID]SignedPrice); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipcommon.TokenID]SignedPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSignedJobSpecTokenPricesUSD'
+type MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call struct {
+	*mock.Call
+}
+
+// GetSignedJobSpecTokenPricesUSD is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSignedPriceGetter_Expecter) GetSignedJobSpecTokenPricesUSD(ctx interface{}) *MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call {
+	return &MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call{Call: _e.mock.On("GetSignedJobSpecTokenPricesUSD", ctx)}
+}
+
+func (_c *MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call) Run(run func(ctx context.Context)) *MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call) Return(_a0 map[ccipcommon.TokenID]SignedPrice, _a1 error) *MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call) RunAndReturn(run func(context.Context) (map[ccipcommon.TokenID]SignedPrice, error)) *MockSignedPriceGetter_GetSignedJobSpecTokenPricesUSD_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSignedTokenPricesUSD provides a mock function with given fields: ctx, tokens
+func (_m *MockSignedPriceGetter) GetSignedTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]SignedPrice, error) {
+	ret := _m.Called(ctx, tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSignedTokenPricesUSD")
+	}
+
+	var r0 map[ccipcommon.TokenID]SignedPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ccipcommon.TokenID) (map[ccipcommon.TokenID]SignedPrice, error)); ok {
+		return rf(ctx, tokens)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []ccipcommon.TokenID) map[ccipcommon.TokenID]SignedPrice); ok {
+		r0 = rf(ctx, tokens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipcommon.TokenID]SignedPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []ccipcommon.TokenID) error); ok {
+		r1 = rf(ctx, tokens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSignedPriceGetter_GetSignedTokenPricesUSD_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSignedTokenPricesUSD'
+type MockSignedPriceGetter_GetSignedTokenPricesUSD_Call struct {
+	*mock.Call
+}
+
+// GetSignedTokenPricesUSD is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokens []ccipcommon.TokenID
+func (_e *MockSignedPriceGetter_Expecter) GetSignedTokenPricesUSD(ctx interface{}, tokens interface{}) *MockSignedPriceGetter_GetSignedTokenPricesUSD_Call {
+	return &MockSignedPriceGetter_GetSignedTokenPricesUSD_Call{Call: _e.mock.On("GetSignedTokenPricesUSD", ctx, tokens)}
+}
+
+func (_c *MockSignedPriceGetter_GetSignedTokenPricesUSD_Call) Run(run func(ctx context.Context, tokens []ccipcommon.TokenID)) *MockSignedPriceGetter_GetSignedTokenPricesUSD_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ccipcommon.TokenID))
+	})
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_GetSignedTokenPricesUSD_Call) Return(_a0 map[ccipcommon.TokenID]SignedPrice, _a1 error) *MockSignedPriceGetter_GetSignedTokenPricesUSD_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_GetSignedTokenPricesUSD_Call) RunAndReturn(run func(context.Context, []ccipcommon.TokenID) (map[ccipcommon.TokenID]SignedPrice, error)) *MockSignedPriceGetter_GetSignedTokenPricesUSD_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokenPricesUSD provides a mock function with given fields: ctx, tokens
+func (_m *MockSignedPriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	ret := _m.Called(ctx, tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenPricesUSD")
+	}
+
+	var r0 map[ccipcommon.TokenID]*big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error)); ok {
+		return rf(ctx, tokens)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []ccipcommon.TokenID) map[ccipcommon.TokenID]*big.Int); ok {
+		r0 = rf(ctx, tokens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipcommon.TokenID]*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []ccipcommon.TokenID) error); ok {
+		r1 = rf(ctx, tokens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSignedPriceGetter_GetTokenPricesUSD_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenPricesUSD'
+type MockSignedPriceGetter_GetTokenPricesUSD_Call struct {
+	*mock.Call
+}
+
+// GetTokenPricesUSD is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokens []ccipcommon.TokenID
+func (_e *MockSignedPriceGetter_Expecter) GetTokenPricesUSD(ctx interface{}, tokens interface{}) *MockSignedPriceGetter_GetTokenPricesUSD_Call {
+	return &MockSignedPriceGetter_GetTokenPricesUSD_Call{Call: _e.mock.On("GetTokenPricesUSD", ctx, tokens)}
+}
+
+func (_c *MockSignedPriceGetter_GetTokenPricesUSD_Call) Run(run func(ctx context.Context, tokens []ccipcommon.TokenID)) *MockSignedPriceGetter_GetTokenPricesUSD_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ccipcommon.TokenID))
+	})
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_GetTokenPricesUSD_Call) Return(_a0 map[ccipcommon.TokenID]*big.Int, _a1 error) *MockSignedPriceGetter_GetTokenPricesUSD_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSignedPriceGetter_GetTokenPricesUSD_Call) RunAndReturn(run func(context.Context, []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error)) *MockSignedPriceGetter_GetTokenPricesUSD_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSignedPriceGetter creates a new instance of MockSignedPriceGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSignedPriceGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSignedPriceGetter {
+	mock := &MockSignedPriceGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,78 @@
+package pricegetter
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+func signPrice(t *testing.T, key *ecdsa.PrivateKey, token ccipcommon.TokenID, price *big.Int, observedAt time.Time) SignedPrice {
+	payload := EncodePricePayload(token, price, observedAt)
+	signature, err := crypto.Sign(crypto.Keccak256(payload), key)
+	require.NoError(t, err)
+	return SignedPrice{Price: price, Payload: payload, Signature: signature}
+}
+
+func TestECDSAPriceVerifier(t *testing.T) {
+	signerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	unknownKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	token := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x1"), ChainSelector: 1}
+	otherToken := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x2"), ChainSelector: 1}
+	price := big.NewInt(5e18)
+
+	verifier, err := NewECDSAPriceVerifier([]common.Address{crypto.PubkeyToAddress(signerKey.PublicKey)}, time.Minute)
+	require.NoError(t, err)
+
+	t.Run("valid signature", func(t *testing.T) {
+		require.NoError(t, verifier.VerifyPrice(token, signPrice(t, signerKey, token, price, time.Now())))
+	})
+
+	t.Run("unknown signer", func(t *testing.T) {
+		err := verifier.VerifyPrice(token, signPrice(t, unknownKey, token, price, time.Now()))
+		assert.ErrorContains(t, err, "unknown signer")
+	})
+
+	t.Run("price does not match the payload", func(t *testing.T) {
+		signedPrice := signPrice(t, signerKey, token, price, time.Now())
+		signedPrice.Price = big.NewInt(6e18)
+		assert.ErrorContains(t, verifier.VerifyPrice(token, signedPrice), "does not match")
+	})
+
+	t.Run("payload of another token", func(t *testing.T) {
+		err := verifier.VerifyPrice(token, signPrice(t, signerKey, otherToken, price, time.Now()))
+		assert.ErrorContains(t, err, "does not match")
+	})
+
+	t.Run("stale payload", func(t *testing.T) {
+		err := verifier.VerifyPrice(token, signPrice(t, signerKey, token, price, time.Now().Add(-time.Hour)))
+		assert.ErrorContains(t, err, "old")
+	})
+
+	t.Run("unsigned payload", func(t *testing.T) {
+		err := verifier.VerifyPrice(token, SignedPrice{Price: price, Payload: EncodePricePayload(token, price, time.Now())})
+		assert.ErrorContains(t, err, "invalid signature")
+	})
+
+	t.Run("missing payload", func(t *testing.T) {
+		assert.ErrorContains(t, verifier.VerifyPrice(token, SignedPrice{Price: price}), "too short")
+	})
+}
+
+func TestNewECDSAPriceVerifier(t *testing.T) {
+	_, err := NewECDSAPriceVerifier(nil, time.Minute)
+	require.Error(t, err)
+	_, err = NewECDSAPriceVerifier([]common.Address{{1}}, 0)
+	require.Error(t, err)
+}
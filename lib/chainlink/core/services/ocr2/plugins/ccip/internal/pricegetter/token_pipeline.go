@@ -0,0 +1,159 @@
+package pricegetter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/parseutil"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+var _ HTTPPriceGetter = &TokenPipelineGetter{}
+
+// TokenPipelineGetter runs a pipeline per token, as defined in the job spec, and takes its single final result as the
+// USD price of the token. Unlike the PipelineGetter, the pipeline of a token can compose multiple sources, e.g. a median
+// task over several bridge tasks, without knowing about the other tokens.
+type TokenPipelineGetter struct {
+	pipelines       map[ccipcommon.TokenID]string
+	requestsPerHost map[ccipcommon.TokenID]map[string]int
+	runner          pipeline.Runner
+	jobID           int32
+	name            string
+	lggr            logger.Logger
+}
+
+func NewTokenPipelineGetter(
+	cfg config.TokenPricePipelinesConfig,
+	runner pipeline.Runner,
+	jobID int32,
+	name string,
+	lggr logger.Logger,
+) (*TokenPipelineGetter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid token price pipelines config: %w", err)
+	}
+
+	pipelines := make(map[ccipcommon.TokenID]string, len(cfg))
+	requestsPerHost := make(map[ccipcommon.TokenID]map[string]int, len(cfg))
+	for _, tokenCfg := range cfg {
+		tokenRequestsPerHost, err := pipelineRequestsPerHost(tokenCfg.Pipeline)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price pipeline of token %v: %w", tokenCfg.TokenAddress, err)
+		}
+		tokenID := ccipcommon.TokenID{
+			TokenAddress:  ccipcalc.EvmAddrToGeneric(tokenCfg.TokenAddress),
+			ChainSelector: tokenCfg.ChainSelector,
+		}
+		pipelines[tokenID] = tokenCfg.Pipeline
+		requestsPerHost[tokenID] = tokenRequestsPerHost
+	}
+
+	return &TokenPipelineGetter{
+		pipelines:       pipelines,
+		requestsPerHost: requestsPerHost,
+		runner:          runner,
+		jobID:           jobID,
+		name:            name,
+		lggr:            lggr,
+	}, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the prices of all tokens with a pipeline in the job spec.
+func (d *TokenPipelineGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	tokens := make([]ccipcommon.TokenID, 0, len(d.pipelines))
+	for token := range d.pipelines {
+		tokens = append(tokens, token)
+	}
+	return d.GetTokenPricesUSD(ctx, tokens)
+}
+
+// GetTokenPricesUSD runs the pipelines of the provided tokens concurrently, it fails if any of them has no pipeline
+// or its pipeline fails.
+func (d *TokenPipelineGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	for _, token := range tokens {
+		if _, ok := d.pipelines[token]; !ok {
+			return nil, fmt.Errorf("no price pipeline for token %v", token)
+		}
+	}
+
+	prices := make([]*big.Int, len(tokens))
+	errs := make([]error, len(tokens))
+
+	var wg sync.WaitGroup
+	for i, token := range tokens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			prices[i], errs[i] = d.runTokenPipeline(ctx, d.pipelines[token])
+			if errs[i] != nil {
+				errs[i] = fmt.Errorf("price pipeline of token %v: %w", token, errs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	tokenPrices := make(map[ccipcommon.TokenID]*big.Int, len(tokens))
+	for i, token := range tokens {
+		tokenPrices[token] = prices[i]
+	}
+	d.lggr.Debugw("Token price pipelines completed", "prices", tokenPrices)
+	return tokenPrices, nil
+}
+
+func (d *TokenPipelineGetter) runTokenPipeline(ctx context.Context, source string) (*big.Int, error) {
+	_, trrs, err := d.runner.ExecuteRun(ctx, pipeline.Spec{
+		ID:           d.jobID,
+		DotDagSource: source,
+		CreatedAt:    time.Now(),
+		JobID:        d.jobID,
+		JobName:      d.name,
+		JobType:      "",
+	}, pipeline.NewVarsFrom(map[string]interface{}{}))
+	if err != nil {
+		return nil, err
+	}
+	finalResult := trrs.FinalResult()
+	if finalResult.HasErrors() {
+		return nil, fmt.Errorf("error getting price %v", finalResult.AllErrors)
+	}
+	if len(finalResult.Values) != 1 {
+		return nil, fmt.Errorf("invalid number of price results, expected 1 got %v", len(finalResult.Values))
+	}
+	price, err := parseutil.ParseBigIntFromAny(finalResult.Values[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse price %v: %w", finalResult.Values[0], err)
+	}
+	return price, nil
+}
+
+// RequestsPerHost returns the http tasks of the pipelines of the tokens per host.
+func (d *TokenPipelineGetter) RequestsPerHost(tokens []ccipcommon.TokenID) map[string]int {
+	requestsPerHost := make(map[string]int)
+	if tokens == nil {
+		for _, tokenRequestsPerHost := range d.requestsPerHost {
+			addRequestsPerHost(requestsPerHost, tokenRequestsPerHost)
+		}
+		return requestsPerHost
+	}
+	for _, token := range tokens {
+		addRequestsPerHost(requestsPerHost, d.requestsPerHost[token])
+	}
+	return requestsPerHost
+}
+
+func (d *TokenPipelineGetter) Close() error {
+	return d.runner.Close()
+}
@@ -0,0 +1,114 @@
+package pricegetter_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/pricegetter"
+)
+
+func TestTokenPipelineGetter(t *testing.T) {
+	newPriceSource := func(t *testing.T, price string) string {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, err := fmt.Fprintf(w, `{"price": %s}`, price)
+			require.NoError(t, err)
+		}))
+		t.Cleanup(srv.Close)
+		return srv.URL
+	}
+	medianPipeline := func(urls ...string) string {
+		source := ""
+		values := ""
+		for i, url := range urls {
+			source += fmt.Sprintf("src%d [type=http method=GET url=%q];\n", i, url)
+			source += fmt.Sprintf("src%d_parse [type=jsonparse path=\"price\"];\n", i)
+			source += fmt.Sprintf("src%d -> src%d_parse -> median;\n", i, i)
+			if i > 0 {
+				values += ", "
+			}
+			values += fmt.Sprintf("$(src%d_parse)", i)
+		}
+		return source + fmt.Sprintf("median [type=median values=<[ %s ]>];\n", values)
+	}
+
+	tokenA := common.HexToAddress("0x94025780a1aB58868D9B2dBBB775f44b32e8E6e5")
+	tokenB := common.HexToAddress("0x3c69cbE5e8B7e2B4C5E4b6ae5B11b07c2d4eCe1f")
+	tokenAID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(tokenA), ChainSelector: 1}
+	tokenBID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(tokenB), ChainSelector: 2}
+
+	newGetter := func(t *testing.T, cfg config.TokenPricePipelinesConfig) *pricegetter.TokenPipelineGetter {
+		lggr := logger.TestLogger(t)
+		getter, err := pricegetter.NewTokenPipelineGetter(cfg, newTestPipelineRunner(t, lggr), 1, "test", lggr)
+		require.NoError(t, err)
+		return getter
+	}
+
+	t.Run("medianized prices", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		getter := newGetter(t, config.TokenPricePipelinesConfig{
+			{
+				TokenAddress:  tokenA,
+				ChainSelector: 1,
+				Pipeline: medianPipeline(
+					newPriceSource(t, "1000000000000000000"),
+					newPriceSource(t, "1100000000000000000"),
+					newPriceSource(t, "5000000000000000000"),
+				),
+			},
+			{
+				TokenAddress:  tokenB,
+				ChainSelector: 2,
+				Pipeline:      medianPipeline(newPriceSource(t, "\"2000000000000000000\"")),
+			},
+		})
+
+		prices, err := getter.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{
+			tokenAID: big.NewInt(1.1e18),
+			tokenBID: big.NewInt(2e18),
+		}, prices)
+
+		prices, err = getter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{tokenBID})
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{tokenBID: big.NewInt(2e18)}, prices)
+	})
+
+	t.Run("token without pipeline", func(t *testing.T) {
+		// The runner is not needed, as no pipeline is run.
+		getter, err := pricegetter.NewTokenPipelineGetter(config.TokenPricePipelinesConfig{
+			{TokenAddress: tokenA, ChainSelector: 1, Pipeline: medianPipeline(newPriceSource(t, "1"))},
+		}, nil, 1, "test", logger.TestLogger(t))
+		require.NoError(t, err)
+		_, err = getter.GetTokenPricesUSD(testutils.Context(t), []ccipcommon.TokenID{tokenAID, tokenBID})
+		require.Error(t, err)
+	})
+
+	t.Run("failing pipeline", func(t *testing.T) {
+		getter := newGetter(t, config.TokenPricePipelinesConfig{
+			{TokenAddress: tokenA, ChainSelector: 1, Pipeline: medianPipeline(newPriceSource(t, "1"))},
+			{TokenAddress: tokenB, ChainSelector: 2, Pipeline: medianPipeline(newPriceSource(t, "null"))},
+		})
+		_, err := getter.GetJobSpecTokenPricesUSD(testutils.Context(t))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid pipeline", func(t *testing.T) {
+		_, err := pricegetter.NewTokenPipelineGetter(config.TokenPricePipelinesConfig{
+			{TokenAddress: tokenA, ChainSelector: 1, Pipeline: "median [type=median"},
+		}, nil, 1, "test", logger.TestLogger(t))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,283 @@
+package pricegetter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+const UniswapV3Pool = "UniswapV3Pool"
+const ObserveMethodName = "observe"
+
+// UniswapV3PoolABI is the subset of the Uniswap v3 pool ABI read by the TWAP price getter.
+const UniswapV3PoolABI = `[{"inputs":[{"internalType":"uint32[]","name":"secondsAgos","type":"uint32[]"}],"name":"observe","outputs":[{"internalType":"int56[]","name":"tickCumulatives","type":"int56[]"},{"internalType":"uint160[]","name":"secondsPerLiquidityCumulativeX128s","type":"uint160[]"}],"stateMutability":"view","type":"function"}]`
+
+// tickBase is the price ratio between two adjacent ticks of a Uniswap v3 pool.
+var tickBase, _, _ = big.ParseFloat("1.0001", 10, 256, big.ToNearestEven)
+
+var _ AllTokensPriceGetter = &UniswapV3TWAPPriceGetter{}
+
+// uniswapV3Observations is the result of the observe method of a Uniswap v3 pool.
+type uniswapV3Observations struct {
+	TickCumulatives                    []*big.Int
+	SecondsPerLiquidityCumulativeX128s []*big.Int
+}
+
+// UniswapV3TWAPPriceGetter prices the tokens lacking price feeds with the time weighted average price of their
+// Uniswap v3 pool over the configured window, denominated in the quote token of the pool. The other tokens are priced
+// by the underlying price getter, without an underlying price getter only the tokens with a pool can be priced.
+type UniswapV3TWAPPriceGetter struct {
+	lggr            logger.Logger
+	cfg             config.UniswapV3TWAPPriceGetterConfig
+	pools           map[ccipcommon.TokenID]config.UniswapV3PoolConfig
+	contractReaders map[uint64]types.ContractReader
+	getter          AllTokensPriceGetter
+}
+
+func NewUniswapV3TWAPPriceGetter(
+	lggr logger.Logger,
+	cfg config.UniswapV3TWAPPriceGetterConfig,
+	contractReaders map[uint64]types.ContractReader,
+	getter AllTokensPriceGetter,
+) (*UniswapV3TWAPPriceGetter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid uniswap v3 twap price getter config: %w", err)
+	}
+
+	pools := make(map[ccipcommon.TokenID]config.UniswapV3PoolConfig, len(cfg.Pools))
+	for _, pool := range cfg.Pools {
+		if _, ok := contractReaders[pool.ChainID]; !ok {
+			return nil, fmt.Errorf("no contract reader for chain %d of the pool of token %v", pool.ChainID, pool.TokenAddress)
+		}
+		pools[ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(pool.TokenAddress), ChainSelector: pool.ChainSelector}] = pool
+	}
+
+	return &UniswapV3TWAPPriceGetter{
+		lggr:            lggr,
+		cfg:             cfg,
+		pools:           pools,
+		contractReaders: contractReaders,
+		getter:          getter,
+	}, nil
+}
+
+// GetJobSpecTokenPricesUSD returns the TWAP prices of the tokens with a pool along with the job spec prices of the
+// underlying price getter.
+func (u *UniswapV3TWAPPriceGetter) GetJobSpecTokenPricesUSD(ctx context.Context) (map[ccipcommon.TokenID]*big.Int, error) {
+	tokens := make([]ccipcommon.TokenID, 0, len(u.pools))
+	for token := range u.pools {
+		tokens = append(tokens, token)
+	}
+	prices, err := u.getTWAPPrices(ctx, tokens)
+	if err != nil {
+		return nil, err
+	}
+	if u.getter == nil {
+		return prices, nil
+	}
+
+	jobSpecPrices, err := u.getter.GetJobSpecTokenPricesUSD(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for token, price := range jobSpecPrices {
+		if _, ok := prices[token]; !ok {
+			prices[token] = price
+		}
+	}
+	return prices, nil
+}
+
+// GetTokenPricesUSD returns the TWAP prices of the tokens with a pool, the prices of the other tokens are fetched
+// from the underlying price getter.
+func (u *UniswapV3TWAPPriceGetter) GetTokenPricesUSD(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	var pooled, others []ccipcommon.TokenID
+	for _, token := range tokens {
+		if _, ok := u.pools[token]; ok {
+			pooled = append(pooled, token)
+			continue
+		}
+		others = append(others, token)
+	}
+	if len(others) > 0 && u.getter == nil {
+		return nil, fmt.Errorf("no uniswap v3 pool for tokens %v", others)
+	}
+
+	prices, err := u.getTWAPPrices(ctx, pooled)
+	if err != nil {
+		return nil, err
+	}
+	if len(others) == 0 {
+		return prices, nil
+	}
+	otherPrices, err := u.getter.GetTokenPricesUSD(ctx, others)
+	if err != nil {
+		return nil, err
+	}
+	for token, price := range otherPrices {
+		prices[token] = price
+	}
+	return prices, nil
+}
+
+// Close closes the underlying price getter.
+func (u *UniswapV3TWAPPriceGetter) Close() error {
+	if u.getter == nil {
+		return nil
+	}
+	return u.getter.Close()
+}
+
+// getTWAPPrices reads the observations of the pools of the tokens with one batch call per chain.
+func (u *UniswapV3TWAPPriceGetter) getTWAPPrices(ctx context.Context, tokens []ccipcommon.TokenID) (map[ccipcommon.TokenID]*big.Int, error) {
+	tokensPerChain := make(map[uint64][]ccipcommon.TokenID)
+	for _, token := range tokens {
+		chainID := u.pools[token].ChainID
+		tokensPerChain[chainID] = append(tokensPerChain[chainID], token)
+	}
+
+	prices := make(map[ccipcommon.TokenID]*big.Int, len(tokens))
+	for chainID, chainTokens := range tokensPerChain {
+		observations, err := u.observe(ctx, chainID, chainTokens)
+		if err != nil {
+			return nil, fmt.Errorf("failed to observe the uniswap v3 pools on chain %d: %w", chainID, err)
+		}
+		for i, token := range chainTokens {
+			price, err := u.twapPrice(u.pools[token], observations[i])
+			if err != nil {
+				return nil, fmt.Errorf("twap of token %v: %w", token, err)
+			}
+			prices[token] = price
+		}
+	}
+	return prices, nil
+}
+
+// observe reads the tick cumulatives of the pools of the tokens at the start and the end of the window.
+func (u *UniswapV3TWAPPriceGetter) observe(ctx context.Context, chainID uint64, tokens []ccipcommon.TokenID) ([]uniswapV3Observations, error) {
+	contractReader := u.contractReaders[chainID]
+	secondsAgos := []uint32{uint32(u.cfg.Window.Duration().Seconds()), 0}
+
+	boundContracts := make([]types.BoundContract, len(tokens))
+	request := make(types.BatchGetLatestValuesRequest, len(tokens))
+	for i, token := range tokens {
+		boundContracts[i] = types.BoundContract{
+			Address: string(ccipcalc.EvmAddrToGeneric(u.pools[token].PoolAddress)),
+			Name:    fmt.Sprintf("%v_%v", UniswapV3Pool, i),
+		}
+		request[boundContracts[i]] = append(request[boundContracts[i]], types.BatchRead{
+			ReadName:  ObserveMethodName,
+			Params:    map[string]any{"secondsAgos": secondsAgos},
+			ReturnVal: &uniswapV3Observations{},
+		})
+	}
+	if err := contractReader.Bind(ctx, boundContracts); err != nil {
+		return nil, fmt.Errorf("binding contracts failed: %w", err)
+	}
+
+	result, err := contractReader.BatchGetLatestValues(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("BatchGetLatestValues failed %w", err)
+	}
+
+	observations := make([]uniswapV3Observations, len(tokens))
+	for i, boundContract := range boundContracts {
+		reads := result[boundContract]
+		if len(reads) != 1 {
+			return nil, fmt.Errorf("expected one result for pool %s, got %d", boundContract.Address, len(reads))
+		}
+		val, readErr := reads[0].GetResult()
+		if readErr != nil {
+			return nil, fmt.Errorf("error with contract reader readName %v of pool %s: %w", reads[0].ReadName, boundContract.Address, readErr)
+		}
+		observation, ok := val.(*uniswapV3Observations)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T for method call %v on pool %s", val, ObserveMethodName, boundContract.Address)
+		}
+		observations[i] = *observation
+	}
+	return observations, nil
+}
+
+// twapPrice returns the price of one whole token denominated in the quote token and scaled by 1e18, clamped to the
+// price bounds of the pool.
+func (u *UniswapV3TWAPPriceGetter) twapPrice(pool config.UniswapV3PoolConfig, observations uniswapV3Observations) (*big.Int, error) {
+	if len(observations.TickCumulatives) != 2 {
+		return nil, fmt.Errorf("expected 2 tick cumulatives, got %d", len(observations.TickCumulatives))
+	}
+	tick := averageTick(observations.TickCumulatives[0], observations.TickCumulatives[1], int64(u.cfg.Window.Duration().Seconds()))
+
+	// The tick is the price of token0 in token1, token0 being the token with the lower address
+	ratio := tickRatio(tick)
+	if bytes.Compare(pool.TokenAddress.Bytes(), pool.QuoteTokenAddress.Bytes()) > 0 {
+		ratio.Quo(new(big.Float).SetPrec(ratio.Prec()).SetInt64(1), ratio)
+	}
+
+	// The ratio is in the smallest units of the tokens, scale it to whole tokens
+	ratio.Mul(ratio, new(big.Float).SetInt(pow10(int64(pool.TokenDecimals)+18)))
+	ratio.Quo(ratio, new(big.Float).SetInt(pow10(int64(pool.QuoteTokenDecimals))))
+	price, _ := ratio.Int(nil)
+	if price.Sign() <= 0 {
+		return nil, errors.New("twap price rounds down to zero")
+	}
+
+	switch {
+	case pool.MinPrice != nil && price.Cmp(pool.MinPrice) < 0:
+		u.lggr.Warnw("TWAP price below min price, clamping it", "token", pool.TokenAddress, "pool", pool.PoolAddress,
+			"twapPrice", price, "minPrice", pool.MinPrice)
+		return new(big.Int).Set(pool.MinPrice), nil
+	case pool.MaxPrice != nil && price.Cmp(pool.MaxPrice) > 0:
+		u.lggr.Warnw("TWAP price above max price, clamping it", "token", pool.TokenAddress, "pool", pool.PoolAddress,
+			"twapPrice", price, "maxPrice", pool.MaxPrice)
+		return new(big.Int).Set(pool.MaxPrice), nil
+	}
+	return price, nil
+}
+
+// averageTick returns the arithmetic mean tick over the window, rounded towards negative infinity like the Uniswap
+// v3 oracle library.
+func averageTick(startTickCumulative, endTickCumulative *big.Int, windowSeconds int64) int64 {
+	delta := new(big.Int).Sub(endTickCumulative, startTickCumulative)
+	window := big.NewInt(windowSeconds)
+	tick, rem := new(big.Int).QuoRem(delta, window, new(big.Int))
+	if delta.Sign() < 0 && rem.Sign() != 0 {
+		tick.Sub(tick, big.NewInt(1))
+	}
+	return tick.Int64()
+}
+
+// tickRatio returns 1.0001^tick.
+func tickRatio(tick int64) *big.Float {
+	ratio := new(big.Float).SetPrec(tickBase.Prec()).SetInt64(1)
+	base := new(big.Float).Copy(tickBase)
+	for exp := abs(tick); exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			ratio.Mul(ratio, base)
+		}
+		base.Mul(base, base)
+	}
+	if tick < 0 {
+		ratio.Quo(new(big.Float).SetPrec(ratio.Prec()).SetInt64(1), ratio)
+	}
+	return ratio
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func pow10(exp int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(exp), nil)
+}
@@ -0,0 +1,145 @@
+package pricegetter
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcommon"
+)
+
+func TestUniswapV3TWAPPriceGetter(t *testing.T) {
+	ctx := tests.Context(t)
+	window := time.Minute
+	lowToken := common.HexToAddress("0x1")
+	highToken := common.HexToAddress("0x2")
+	usdc := common.HexToAddress("0x3")
+	lowTokenID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(lowToken), ChainSelector: 1}
+	highTokenID := ccipcommon.TokenID{TokenAddress: ccipcalc.EvmAddrToGeneric(highToken), ChainSelector: 1}
+	linkID := ccipcommon.TokenID{TokenAddress: ccipcalc.HexToAddress("0x4"), ChainSelector: 1}
+
+	// Both tokens share a pool, the low token is token0 and the high token is token1 of the pool
+	pool := common.HexToAddress("0x10")
+	cfg := config.UniswapV3TWAPPriceGetterConfig{
+		Window: *commonconfig.MustNewDuration(window),
+		Pools: []config.UniswapV3PoolConfig{
+			{TokenAddress: lowToken, ChainSelector: 1, TokenDecimals: 18, ChainID: 100, PoolAddress: pool, QuoteTokenAddress: highToken, QuoteTokenDecimals: 18},
+			{TokenAddress: highToken, ChainSelector: 1, TokenDecimals: 18, ChainID: 100, PoolAddress: pool, QuoteTokenAddress: lowToken, QuoteTokenDecimals: 18},
+		},
+	}
+
+	// newContractReader returns the same tick cumulatives for all pools of the chain, the tick being 23027 (~10x)
+	newContractReader := func(tick int64, numPools int) types.ContractReader {
+		result := make(types.BatchGetLatestValuesResult)
+		for i := range numPools {
+			read := types.BatchReadResult{ReadName: ObserveMethodName}
+			read.SetResult(&uniswapV3Observations{
+				TickCumulatives: []*big.Int{big.NewInt(1000), big.NewInt(1000 + tick*int64(window.Seconds()))},
+			}, nil)
+			boundContract := types.BoundContract{Address: string(ccipcalc.EvmAddrToGeneric(pool)), Name: fmt.Sprintf("%v_%v", UniswapV3Pool, i)}
+			result[boundContract] = append(result[boundContract], read)
+		}
+		return &mockContractReader{result: result}
+	}
+	assertPrice := func(t *testing.T, expected float64, price *big.Int) {
+		actual, _ := new(big.Float).SetInt(price).Float64()
+		assert.InEpsilon(t, expected, actual, 1e-4)
+	}
+
+	t.Run("twap is the price of token0 in token1", func(t *testing.T) {
+		priceGetter, err := NewUniswapV3TWAPPriceGetter(logger.Test(t), cfg, map[uint64]types.ContractReader{100: newContractReader(23027, 1)}, nil)
+		require.NoError(t, err)
+
+		prices, err := priceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{lowTokenID})
+		require.NoError(t, err)
+		assertPrice(t, 10e18, prices[lowTokenID])
+
+		prices, err = priceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{highTokenID})
+		require.NoError(t, err)
+		assertPrice(t, 0.1e18, prices[highTokenID])
+	})
+
+	t.Run("decimals are accounted for", func(t *testing.T) {
+		usdcCfg := config.UniswapV3TWAPPriceGetterConfig{
+			Window: cfg.Window,
+			Pools: []config.UniswapV3PoolConfig{
+				{TokenAddress: lowToken, ChainSelector: 1, TokenDecimals: 18, ChainID: 100, PoolAddress: pool, QuoteTokenAddress: usdc, QuoteTokenDecimals: 6},
+			},
+		}
+		priceGetter, err := NewUniswapV3TWAPPriceGetter(logger.Test(t), usdcCfg, map[uint64]types.ContractReader{100: newContractReader(0, 1)}, nil)
+		require.NoError(t, err)
+
+		prices, err := priceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{lowTokenID})
+		require.NoError(t, err)
+		// A tick of 0 is 1 USDC unit per token unit, i.e. 1e12 USDC per whole token
+		assert.Equal(t, new(big.Int).Mul(pow10(12), pow10(18)), prices[lowTokenID])
+	})
+
+	t.Run("twap is clamped to the price bounds", func(t *testing.T) {
+		clampedCfg := cfg
+		clampedCfg.Pools = []config.UniswapV3PoolConfig{cfg.Pools[0]}
+		clampedCfg.Pools[0].MaxPrice = big.NewInt(2e18)
+		priceGetter, err := NewUniswapV3TWAPPriceGetter(logger.Test(t), clampedCfg, map[uint64]types.ContractReader{100: newContractReader(23027, 1)}, nil)
+		require.NoError(t, err)
+
+		prices, err := priceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{lowTokenID})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2e18), prices[lowTokenID])
+	})
+
+	t.Run("other tokens are priced by the price getter", func(t *testing.T) {
+		getter := NewMockAllTokensPriceGetter(t)
+		getter.EXPECT().GetJobSpecTokenPricesUSD(ctx).Return(map[ccipcommon.TokenID]*big.Int{linkID: big.NewInt(15)}, nil).Once()
+		getter.EXPECT().GetTokenPricesUSD(ctx, []ccipcommon.TokenID{linkID}).Return(map[ccipcommon.TokenID]*big.Int{linkID: big.NewInt(15)}, nil).Once()
+		priceGetter, err := NewUniswapV3TWAPPriceGetter(logger.Test(t), cfg, map[uint64]types.ContractReader{100: newContractReader(0, 2)}, getter)
+		require.NoError(t, err)
+
+		prices, err := priceGetter.GetJobSpecTokenPricesUSD(ctx)
+		require.NoError(t, err)
+		assert.Len(t, prices, 3)
+		assert.Equal(t, big.NewInt(15), prices[linkID])
+
+		prices, err = priceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{linkID, lowTokenID})
+		require.NoError(t, err)
+		assert.Equal(t, map[ccipcommon.TokenID]*big.Int{linkID: big.NewInt(15), lowTokenID: big.NewInt(1e18)}, prices)
+	})
+
+	t.Run("tokens without pool and price getter", func(t *testing.T) {
+		priceGetter, err := NewUniswapV3TWAPPriceGetter(logger.Test(t), cfg, map[uint64]types.ContractReader{100: newContractReader(0, 1)}, nil)
+		require.NoError(t, err)
+
+		_, err = priceGetter.GetTokenPricesUSD(ctx, []ccipcommon.TokenID{linkID})
+		require.ErrorContains(t, err, "no uniswap v3 pool")
+	})
+
+	t.Run("contract reader errors are returned", func(t *testing.T) {
+		priceGetter, err := NewUniswapV3TWAPPriceGetter(logger.Test(t), cfg, map[uint64]types.ContractReader{100: mockErrCR()}, nil)
+		require.NoError(t, err)
+
+		_, err = priceGetter.GetJobSpecTokenPricesUSD(ctx)
+		require.Error(t, err)
+	})
+
+	t.Run("missing contract reader", func(t *testing.T) {
+		_, err := NewUniswapV3TWAPPriceGetter(logger.Test(t), cfg, map[uint64]types.ContractReader{}, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestAverageTick(t *testing.T) {
+	assert.Equal(t, int64(3), averageTick(big.NewInt(0), big.NewInt(7), 2))
+	assert.Equal(t, int64(-4), averageTick(big.NewInt(0), big.NewInt(-7), 2))
+	assert.Equal(t, int64(-3), averageTick(big.NewInt(10), big.NewInt(4), 2))
+}
@@ -0,0 +1,10 @@
+package ccip
+
+import (
+	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
+)
+
+type (
+	PriceLeader = db.PriceLeader
+	PriceWriter = db.PriceWriter
+)
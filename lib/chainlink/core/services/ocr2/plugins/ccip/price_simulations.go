@@ -0,0 +1,7 @@
+package ccip
+
+import (
+	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
+)
+
+type PriceUpdateSimulation = db.PriceUpdateSimulation
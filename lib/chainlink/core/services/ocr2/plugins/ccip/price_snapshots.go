@@ -0,0 +1,16 @@
+package ccip
+
+import (
+	db "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipdb"
+)
+
+type (
+	PriceSnapshot = db.PriceSnapshot
+	// PriceServiceRegistry tracks the price services of the commit plugins running on this node, it returns the gas and
+	// token prices, the price leaders and the price update simulations of every dest chain they feed.
+	PriceServiceRegistry = db.PriceServiceRegistry
+)
+
+func NewPriceServiceRegistry() *PriceServiceRegistry {
+	return db.NewPriceServiceRegistry()
+}
@@ -166,6 +166,26 @@ func (g DAGasPriceEstimator) EstimateMsgCostUSD(ctx context.Context, p *big.Int,
 	return execCostUSD, nil
 }
 
+// DecodeGasPriceComponents splits a gas price encoded by the DAGasPriceEstimator into its execution and data availability
+// components. Gas prices without a data availability component decode to a zero DA gas price.
+func DecodeGasPriceComponents(p *big.Int) (execGasPrice, daGasPrice *big.Int, err error) {
+	daGasPrice, execGasPrice, err = DAGasPriceEstimator{priceEncodingLength: daGasPriceEncodingLength}.parseEncodedGasPrice(p)
+	return execGasPrice, daGasPrice, err
+}
+
+// EncodeGasPriceComponents packs execution and data availability gas prices the way the DAGasPriceEstimator does,
+// it is the inverse of DecodeGasPriceComponents.
+func EncodeGasPriceComponents(execGasPrice, daGasPrice *big.Int) (*big.Int, error) {
+	if execGasPrice.BitLen() > daGasPriceEncodingLength {
+		return nil, fmt.Errorf("exec gas price exceeded max range %+v", execGasPrice)
+	}
+	if daGasPrice.BitLen() > daGasPriceEncodingLength {
+		return nil, fmt.Errorf("data availability gas price exceeded max range %+v", daGasPrice)
+	}
+	encoded := new(big.Int).Lsh(daGasPrice, daGasPriceEncodingLength)
+	return encoded.Add(encoded, execGasPrice), nil
+}
+
 func (g DAGasPriceEstimator) parseEncodedGasPrice(p *big.Int) (*big.Int, *big.Int, error) {
 	if p.BitLen() > int(g.priceEncodingLength*2) {
 		return nil, nil, fmt.Errorf("encoded gas price exceeded max range %+v", p)
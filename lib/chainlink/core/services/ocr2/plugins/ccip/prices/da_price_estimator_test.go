@@ -519,3 +519,34 @@ func TestDAPriceEstimator_EstimateMsgCostUSD(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeGasPriceComponents(t *testing.T) {
+	execGasPrice, daGasPrice, err := DecodeGasPriceComponents(encodeGasPrice(big.NewInt(2e9), big.NewInt(1e9)))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1e9), execGasPrice)
+	assert.Equal(t, big.NewInt(2e9), daGasPrice)
+
+	execGasPrice, daGasPrice, err = DecodeGasPriceComponents(big.NewInt(1e9))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1e9), execGasPrice)
+	assert.Equal(t, big.NewInt(0), daGasPrice)
+
+	_, _, err = DecodeGasPriceComponents(new(big.Int).Lsh(big.NewInt(1), daGasPriceEncodingLength*2))
+	require.Error(t, err)
+}
+
+func TestEncodeGasPriceComponents(t *testing.T) {
+	encoded, err := EncodeGasPriceComponents(big.NewInt(1e9), big.NewInt(2e9))
+	require.NoError(t, err)
+	assert.Equal(t, encodeGasPrice(big.NewInt(2e9), big.NewInt(1e9)), encoded)
+
+	encoded, err = EncodeGasPriceComponents(big.NewInt(1e9), big.NewInt(0))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1e9), encoded)
+
+	_, err = EncodeGasPriceComponents(new(big.Int).Lsh(big.NewInt(1), daGasPriceEncodingLength), big.NewInt(0))
+	require.Error(t, err)
+
+	_, err = EncodeGasPriceComponents(big.NewInt(1e9), new(big.Int).Lsh(big.NewInt(1), daGasPriceEncodingLength))
+	require.Error(t, err)
+}
@@ -0,0 +1,139 @@
+package prices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+)
+
+// baseFeeMultiplierBase is the precision of the base fee multiplier, it is applied in multiples of 0.0001.
+const baseFeeMultiplierBase = int64(10000)
+
+// FeeHistoryReader reads the EIP-1559 fee history of a chain, it is implemented by the EVM client.
+type FeeHistoryReader interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// EIP1559Fees are the base fee and the priority fee making up an EIP-1559 execution gas price.
+type EIP1559Fees struct {
+	BaseFee     *big.Int
+	PriorityFee *big.Int
+}
+
+// EIP1559GasPriceEstimatorCommit is implemented by the commit gas price estimators which know the EIP-1559 fees making
+// up the execution gas price.
+type EIP1559GasPriceEstimatorCommit interface {
+	GasPriceEstimatorCommit
+	// GetGasPriceWithFees returns the gas price along with the fees making up its execution component.
+	GetGasPriceWithFees(ctx context.Context) (*big.Int, EIP1559Fees, error)
+}
+
+var _ EIP1559GasPriceEstimatorCommit = &EIP1559GasPriceEstimator{}
+
+// EIP1559GasPriceEstimator derives the execution gas price from the fee history of the chain, as the base fee of the
+// next block times the base fee multiplier plus the median of the recent priority fees at the configured percentile.
+// The data availability component of the gas price, the USD conversion and the deviation checks are left to the
+// underlying estimator, its gas price interceptors only apply to the data availability component.
+type EIP1559GasPriceEstimator struct {
+	GasPriceEstimatorCommit
+	feeHistory            FeeHistoryReader
+	maxGasPrice           *big.Int
+	baseFeeMultiplierBps  int64
+	priorityFeePercentile float64
+	blockCount            uint64
+}
+
+func NewEIP1559GasPriceEstimator(
+	estimator GasPriceEstimatorCommit,
+	feeHistory FeeHistoryReader,
+	maxGasPrice *big.Int,
+	cfg config.EIP1559GasPriceEstimatorConfig,
+) (*EIP1559GasPriceEstimator, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid eip1559 gas price estimator config: %w", err)
+	}
+	if estimator == nil {
+		return nil, errors.New("underlying gas price estimator is nil")
+	}
+	if feeHistory == nil {
+		return nil, errors.New("fee history reader is nil")
+	}
+	return &EIP1559GasPriceEstimator{
+		GasPriceEstimatorCommit: estimator,
+		feeHistory:              feeHistory,
+		maxGasPrice:             maxGasPrice,
+		baseFeeMultiplierBps:    int64(math.Round(cfg.BaseFeeMultiplier * float64(baseFeeMultiplierBase))),
+		priorityFeePercentile:   cfg.PriorityFeePercentile,
+		blockCount:              cfg.BlockCount(),
+	}, nil
+}
+
+// GetGasPrice returns the gas price of the underlying estimator with the execution component derived from the fee history.
+func (g *EIP1559GasPriceEstimator) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	gasPrice, _, err := g.GetGasPriceWithFees(ctx)
+	return gasPrice, err
+}
+
+// GetGasPriceWithFees returns the gas price along with the base fee, already multiplied, and the priority fee making up
+// its execution component. The fees are capped to the max gas price, the priority fee is lowered first.
+func (g *EIP1559GasPriceEstimator) GetGasPriceWithFees(ctx context.Context) (*big.Int, EIP1559Fees, error) {
+	fees, err := g.getFees(ctx)
+	if err != nil {
+		return nil, EIP1559Fees{}, err
+	}
+	execGasPrice := new(big.Int).Add(fees.BaseFee, fees.PriorityFee)
+
+	gasPrice, err := g.GasPriceEstimatorCommit.GetGasPrice(ctx)
+	if err != nil {
+		return nil, EIP1559Fees{}, err
+	}
+	_, daGasPrice, err := DecodeGasPriceComponents(gasPrice)
+	if err != nil {
+		return nil, EIP1559Fees{}, err
+	}
+	gasPrice, err = EncodeGasPriceComponents(execGasPrice, daGasPrice)
+	if err != nil {
+		return nil, EIP1559Fees{}, err
+	}
+	return gasPrice, fees, nil
+}
+
+func (g *EIP1559GasPriceEstimator) getFees(ctx context.Context) (EIP1559Fees, error) {
+	feeHistory, err := g.feeHistory.FeeHistory(ctx, g.blockCount, nil, []float64{g.priorityFeePercentile})
+	if err != nil {
+		return EIP1559Fees{}, fmt.Errorf("failed to get fee history: %w", err)
+	}
+	// The fee history includes the base fee of the block after the newest one as its last base fee
+	if feeHistory == nil || len(feeHistory.BaseFee) == 0 || feeHistory.BaseFee[len(feeHistory.BaseFee)-1] == nil {
+		return EIP1559Fees{}, errors.New("fee history has no base fee, the chain may not support EIP-1559")
+	}
+	baseFee := new(big.Int).Mul(feeHistory.BaseFee[len(feeHistory.BaseFee)-1], big.NewInt(g.baseFeeMultiplierBps))
+	baseFee.Div(baseFee, big.NewInt(baseFeeMultiplierBase))
+
+	priorityFees := make([]*big.Int, 0, len(feeHistory.Reward))
+	for _, rewards := range feeHistory.Reward {
+		if len(rewards) == 0 || rewards[0] == nil {
+			continue
+		}
+		priorityFees = append(priorityFees, rewards[0])
+	}
+	priorityFee := big.NewInt(0)
+	if len(priorityFees) > 0 {
+		priorityFee = new(big.Int).Set(ccipcalc.BigIntSortedMiddle(priorityFees))
+	}
+
+	if g.maxGasPrice != nil && new(big.Int).Add(baseFee, priorityFee).Cmp(g.maxGasPrice) > 0 {
+		if baseFee.Cmp(g.maxGasPrice) >= 0 {
+			baseFee = new(big.Int).Set(g.maxGasPrice)
+		}
+		priorityFee = new(big.Int).Sub(g.maxGasPrice, baseFee)
+	}
+	return EIP1559Fees{BaseFee: baseFee, PriorityFee: priorityFee}, nil
+}
@@ -0,0 +1,122 @@
+package prices
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+)
+
+func TestEIP1559GasPriceEstimator_GetGasPriceWithFees(t *testing.T) {
+	cfg := config.EIP1559GasPriceEstimatorConfig{BaseFeeMultiplier: 1.5, PriorityFeePercentile: 60}
+	feeHistory := &ethereum.FeeHistory{
+		BaseFee: []*big.Int{big.NewInt(100), big.NewInt(110), big.NewInt(120)},
+		Reward:  [][]*big.Int{{big.NewInt(5)}, {big.NewInt(1)}, {big.NewInt(3)}},
+	}
+	encodedGasPrice := func(t *testing.T, execGasPrice, daGasPrice int64) *big.Int {
+		gasPrice, err := EncodeGasPriceComponents(big.NewInt(execGasPrice), big.NewInt(daGasPrice))
+		require.NoError(t, err)
+		return gasPrice
+	}
+
+	testCases := []struct {
+		name        string
+		maxGasPrice *big.Int
+		expGasPrice int64
+		expFees     EIP1559Fees
+	}{
+		{
+			name:        "base fee of the next block times the multiplier plus the median priority fee",
+			expGasPrice: 183,
+			expFees:     EIP1559Fees{BaseFee: big.NewInt(180), PriorityFee: big.NewInt(3)},
+		},
+		{
+			name:        "priority fee is lowered to the max gas price first",
+			maxGasPrice: big.NewInt(181),
+			expGasPrice: 181,
+			expFees:     EIP1559Fees{BaseFee: big.NewInt(180), PriorityFee: big.NewInt(1)},
+		},
+		{
+			name:        "base fee is capped to the max gas price",
+			maxGasPrice: big.NewInt(150),
+			expGasPrice: 150,
+			expFees:     EIP1559Fees{BaseFee: big.NewInt(150), PriorityFee: big.NewInt(0)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tests.Context(t)
+			reader := NewMockFeeHistoryReader(t)
+			reader.EXPECT().FeeHistory(ctx, uint64(config.DefaultEIP1559FeeHistoryBlocks), (*big.Int)(nil), []float64{60}).Return(feeHistory, nil)
+			underlying := NewMockGasPriceEstimatorCommit(t)
+			underlying.EXPECT().GetGasPrice(ctx).Return(encodedGasPrice(t, 999, 7), nil)
+
+			estimator, err := NewEIP1559GasPriceEstimator(underlying, reader, tc.maxGasPrice, cfg)
+			require.NoError(t, err)
+
+			gasPrice, fees, err := estimator.GetGasPriceWithFees(ctx)
+			require.NoError(t, err)
+			// The data availability component of the underlying estimator is kept
+			assert.Equal(t, encodedGasPrice(t, tc.expGasPrice, 7), gasPrice)
+			assert.Equal(t, tc.expFees, fees)
+		})
+	}
+
+	t.Run("blocks without priority fees are skipped", func(t *testing.T) {
+		ctx := tests.Context(t)
+		reader := NewMockFeeHistoryReader(t)
+		reader.EXPECT().FeeHistory(ctx, uint64(3), (*big.Int)(nil), []float64{60}).Return(&ethereum.FeeHistory{
+			BaseFee: []*big.Int{big.NewInt(100), big.NewInt(100)},
+			Reward:  [][]*big.Int{{}, nil, {big.NewInt(2)}},
+		}, nil)
+		underlying := NewMockGasPriceEstimatorCommit(t)
+		underlying.EXPECT().GetGasPrice(ctx).Return(big.NewInt(50), nil)
+
+		estimator, err := NewEIP1559GasPriceEstimator(underlying, reader, nil,
+			config.EIP1559GasPriceEstimatorConfig{BaseFeeMultiplier: 1, PriorityFeePercentile: 60, FeeHistoryBlocks: 3})
+		require.NoError(t, err)
+
+		gasPrice, err := estimator.GetGasPrice(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(102), gasPrice)
+	})
+
+	t.Run("chain without base fee", func(t *testing.T) {
+		ctx := tests.Context(t)
+		reader := NewMockFeeHistoryReader(t)
+		reader.EXPECT().FeeHistory(ctx, mock.Anything, mock.Anything, mock.Anything).Return(&ethereum.FeeHistory{}, nil)
+
+		estimator, err := NewEIP1559GasPriceEstimator(NewMockGasPriceEstimatorCommit(t), reader, nil, cfg)
+		require.NoError(t, err)
+
+		_, _, err = estimator.GetGasPriceWithFees(ctx)
+		require.ErrorContains(t, err, "no base fee")
+	})
+
+	t.Run("fee history error", func(t *testing.T) {
+		ctx := tests.Context(t)
+		reader := NewMockFeeHistoryReader(t)
+		reader.EXPECT().FeeHistory(ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("rpc down"))
+
+		estimator, err := NewEIP1559GasPriceEstimator(NewMockGasPriceEstimatorCommit(t), reader, nil, cfg)
+		require.NoError(t, err)
+
+		_, err = estimator.GetGasPrice(ctx)
+		require.ErrorContains(t, err, "rpc down")
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewEIP1559GasPriceEstimator(NewMockGasPriceEstimatorCommit(t), NewMockFeeHistoryReader(t), nil,
+			config.EIP1559GasPriceEstimatorConfig{PriorityFeePercentile: 60})
+		require.Error(t, err)
+	})
+}
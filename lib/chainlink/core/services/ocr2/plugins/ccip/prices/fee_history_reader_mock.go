@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package prices
+
+import (
+	context "context"
+	big "math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFeeHistoryReader is an autogenerated mock type for the FeeHistoryReader type
+type MockFeeHistoryReader struct {
+	mock.Mock
+}
+
+type MockFeeHistoryReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFeeHistoryReader) EXPECT() *MockFeeHistoryReader_Expecter {
+	return &MockFeeHistoryReader_Expecter{mock: &_m.Mock}
+}
+
+// FeeHistory provides a mock function with given fields: ctx, blockCount, lastBlock, rewardPercentiles
+func (_m *MockFeeHistoryReader) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	ret := _m.Called(ctx, blockCount, lastBlock, rewardPercentiles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeHistory")
+	}
+
+	var r0 *ethereum.FeeHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *big.Int, []float64) (*ethereum.FeeHistory, error)); ok {
+		return rf(ctx, blockCount, lastBlock, rewardPercentiles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *big.Int, []float64) *ethereum.FeeHistory); ok {
+		r0 = rf(ctx, blockCount, lastBlock, rewardPercentiles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ethereum.FeeHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *big.Int, []float64) error); ok {
+		r1 = rf(ctx, blockCount, lastBlock, rewardPercentiles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFeeHistoryReader_FeeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeeHistory'
+type MockFeeHistoryReader_FeeHistory_Call struct {
+	*mock.Call
+}
+
+// FeeHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockCount uint64
+//   - lastBlock *big.Int
+//   - rewardPercentiles []float64
+func (_e *MockFeeHistoryReader_Expecter) FeeHistory(ctx interface{}, blockCount interface{}, lastBlock interface{}, rewardPercentiles interface{}) *MockFeeHistoryReader_FeeHistory_Call {
+	return &MockFeeHistoryReader_FeeHistory_Call{Call: _e.mock.On("FeeHistory", ctx, blockCount, lastBlock, rewardPercentiles)}
+}
+
+func (_c *MockFeeHistoryReader_FeeHistory_Call) Run(run func(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64)) *MockFeeHistoryReader_FeeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(*big.Int), args[3].([]float64))
+	})
+	return _c
+}
+
+func (_c *MockFeeHistoryReader_FeeHistory_Call) Return(_a0 *ethereum.FeeHistory, _a1 error) *MockFeeHistoryReader_FeeHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFeeHistoryReader_FeeHistory_Call) RunAndReturn(run func(context.Context, uint64, *big.Int, []float64) (*ethereum.FeeHistory, error)) *MockFeeHistoryReader_FeeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFeeHistoryReader creates a new instance of MockFeeHistoryReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFeeHistoryReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFeeHistoryReader {
+	mock := &MockFeeHistoryReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
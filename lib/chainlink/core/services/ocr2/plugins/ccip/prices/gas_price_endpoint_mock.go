@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package prices
+
+import (
+	context "context"
+	big "math/big"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGasPriceEndpoint is an autogenerated mock type for the GasPriceEndpoint type
+type MockGasPriceEndpoint struct {
+	mock.Mock
+}
+
+type MockGasPriceEndpoint_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGasPriceEndpoint) EXPECT() *MockGasPriceEndpoint_Expecter {
+	return &MockGasPriceEndpoint_Expecter{mock: &_m.Mock}
+}
+
+// GetGasPrice provides a mock function with given fields: ctx
+func (_m *MockGasPriceEndpoint) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGasPrice")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*big.Int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *big.Int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGasPriceEndpoint_GetGasPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGasPrice'
+type MockGasPriceEndpoint_GetGasPrice_Call struct {
+	*mock.Call
+}
+
+// GetGasPrice is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGasPriceEndpoint_Expecter) GetGasPrice(ctx interface{}) *MockGasPriceEndpoint_GetGasPrice_Call {
+	return &MockGasPriceEndpoint_GetGasPrice_Call{Call: _e.mock.On("GetGasPrice", ctx)}
+}
+
+func (_c *MockGasPriceEndpoint_GetGasPrice_Call) Run(run func(ctx context.Context)) *MockGasPriceEndpoint_GetGasPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGasPriceEndpoint_GetGasPrice_Call) Return(_a0 *big.Int, _a1 error) *MockGasPriceEndpoint_GetGasPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGasPriceEndpoint_GetGasPrice_Call) RunAndReturn(run func(context.Context) (*big.Int, error)) *MockGasPriceEndpoint_GetGasPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGasPriceEndpoint creates a new instance of MockGasPriceEndpoint. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGasPriceEndpoint(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGasPriceEndpoint {
+	mock := &MockGasPriceEndpoint{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,205 @@
+package prices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/internal/ccipcalc"
+)
+
+const (
+	// PrimaryGasPriceEndpoint labels the gas price of the underlying estimator in the endpoint metrics.
+	PrimaryGasPriceEndpoint = "primary"
+	// defaultMaxEndpointDeviationBps discards the execution gas prices deviating by more than 20% from the median.
+	defaultMaxEndpointDeviationBps = 2_000
+)
+
+var (
+	gasPriceEndpointRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_gas_price_endpoint_requests",
+		Help: "Number of gas price requests to the endpoints of the multi-endpoint gas price estimator",
+	}, []string{"chainSelector", "endpoint", "success"})
+	gasPriceEndpointOutliers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ccip_gas_price_endpoint_outliers",
+		Help: "Number of gas prices of an endpoint discarded as outliers by the multi-endpoint gas price estimator",
+	}, []string{"chainSelector", "endpoint"})
+	gasPriceEndpointHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ccip_gas_price_endpoint_healthy",
+		Help: "Whether the last gas price of an endpoint was fetched and not discarded as an outlier, 1 when healthy",
+	}, []string{"chainSelector", "endpoint"})
+)
+
+// GasPriceEndpoint reports the execution gas price of a chain, e.g. as seen by a single RPC.
+type GasPriceEndpoint interface {
+	GetGasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// GasPriceSuggester is implemented by the go-ethereum clients.
+type GasPriceSuggester interface {
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// NamedGasPriceEndpoint is a GasPriceEndpoint with the name it is reported under in the endpoint metrics.
+type NamedGasPriceEndpoint struct {
+	Name     string
+	Endpoint GasPriceEndpoint
+}
+
+type rpcGasPriceEndpoint struct {
+	client GasPriceSuggester
+}
+
+// NewRPCGasPriceEndpoint returns an endpoint reporting the eth_gasPrice of the RPC the client is connected to.
+func NewRPCGasPriceEndpoint(client GasPriceSuggester) GasPriceEndpoint {
+	return &rpcGasPriceEndpoint{client: client}
+}
+
+func (e *rpcGasPriceEndpoint) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	return e.client.SuggestGasPrice(ctx)
+}
+
+var _ GasPriceEstimatorCommit = &MultiEndpointGasPriceEstimator{}
+
+// MultiEndpointGasPriceEstimator queries the execution gas price from several endpoints along with the underlying
+// estimator, discards the gas prices deviating too much from their median and takes the median of the rest, so that
+// a single flaky RPC can't skew the gas price. Failing endpoints are skipped as long as enough endpoints respond.
+// The underlying estimator must respond, as it provides the data availability component of the gas price, the USD
+// conversion and the deviation checks.
+type MultiEndpointGasPriceEstimator struct {
+	GasPriceEstimatorCommit
+	endpoints       []NamedGasPriceEndpoint
+	maxDeviationBps uint32
+	minResponses    int
+	chainSelector   string
+}
+
+// NewMultiEndpointGasPriceEstimator returns an estimator querying the endpoints along with the underlying estimator.
+// The deviation from the median defaults to 20% when zero, minResponses counts the underlying estimator and defaults
+// to a majority of all endpoints when zero.
+func NewMultiEndpointGasPriceEstimator(
+	estimator GasPriceEstimatorCommit,
+	chainSelector uint64,
+	endpoints []NamedGasPriceEndpoint,
+	maxDeviationBps uint32,
+	minResponses int,
+) *MultiEndpointGasPriceEstimator {
+	if maxDeviationBps == 0 {
+		maxDeviationBps = defaultMaxEndpointDeviationBps
+	}
+	if minResponses <= 0 {
+		minResponses = (len(endpoints)+1)/2 + 1
+	}
+	return &MultiEndpointGasPriceEstimator{
+		GasPriceEstimatorCommit: estimator,
+		endpoints:               endpoints,
+		maxDeviationBps:         maxDeviationBps,
+		minResponses:            minResponses,
+		chainSelector:           strconv.FormatUint(chainSelector, 10),
+	}
+}
+
+// endpointGasPrice is the execution gas price reported by an endpoint.
+type endpointGasPrice struct {
+	name     string
+	gasPrice *big.Int
+	err      error
+}
+
+// GetGasPrice returns the gas price of the underlying estimator with the execution component replaced by the median
+// of the endpoint gas prices which are not outliers.
+func (g *MultiEndpointGasPriceEstimator) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	responses := make([]endpointGasPrice, len(g.endpoints)+1)
+	var daGasPrice *big.Int
+	var wg sync.WaitGroup
+	wg.Add(len(responses))
+	go func() {
+		defer wg.Done()
+		responses[0].name = PrimaryGasPriceEndpoint
+		gasPrice, err := g.GasPriceEstimatorCommit.GetGasPrice(ctx)
+		if err != nil {
+			responses[0].err = err
+			return
+		}
+		responses[0].gasPrice, daGasPrice, responses[0].err = DecodeGasPriceComponents(gasPrice)
+	}()
+	for i, endpoint := range g.endpoints {
+		go func(i int, endpoint NamedGasPriceEndpoint) {
+			defer wg.Done()
+			responses[i+1].name = endpoint.Name
+			responses[i+1].gasPrice, responses[i+1].err = endpoint.Endpoint.GetGasPrice(ctx)
+			if responses[i+1].err == nil && responses[i+1].gasPrice == nil {
+				responses[i+1].err = errors.New("missing gas price")
+			}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	if responses[0].err != nil {
+		g.recordResponses(responses, nil)
+		return nil, fmt.Errorf("failed to get gas price of the underlying estimator: %w", responses[0].err)
+	}
+
+	gasPrices := make([]*big.Int, 0, len(responses))
+	var errs []error
+	for _, response := range responses {
+		if response.err != nil {
+			errs = append(errs, fmt.Errorf("endpoint %s: %w", response.name, response.err))
+			continue
+		}
+		gasPrices = append(gasPrices, response.gasPrice)
+	}
+	if len(gasPrices) < g.minResponses {
+		g.recordResponses(responses, nil)
+		return nil, fmt.Errorf("only %d of %d gas price endpoints responded, at least %d required: %w",
+			len(gasPrices), len(responses), g.minResponses, errors.Join(errs...))
+	}
+
+	median := ccipcalc.BigIntSortedMiddle(gasPrices)
+	outliers := make(map[string]bool)
+	inliers := make([]*big.Int, 0, len(gasPrices))
+	for _, response := range responses {
+		if response.err != nil {
+			continue
+		}
+		if deviatesMoreThanBps(median, response.gasPrice, g.maxDeviationBps) {
+			outliers[response.name] = true
+			continue
+		}
+		inliers = append(inliers, response.gasPrice)
+	}
+	g.recordResponses(responses, outliers)
+
+	return EncodeGasPriceComponents(ccipcalc.BigIntSortedMiddle(inliers), daGasPrice)
+}
+
+// recordResponses updates the endpoint metrics, outliers is nil when no gas price was derived from the responses.
+func (g *MultiEndpointGasPriceEstimator) recordResponses(responses []endpointGasPrice, outliers map[string]bool) {
+	for _, response := range responses {
+		gasPriceEndpointRequests.
+			WithLabelValues(g.chainSelector, response.name, strconv.FormatBool(response.err == nil)).
+			Inc()
+		if outliers[response.name] {
+			gasPriceEndpointOutliers.WithLabelValues(g.chainSelector, response.name).Inc()
+		}
+		healthy := 0.0
+		if response.err == nil && !outliers[response.name] {
+			healthy = 1
+		}
+		gasPriceEndpointHealthy.WithLabelValues(g.chainSelector, response.name).Set(healthy)
+	}
+}
+
+// deviatesMoreThanBps reports whether price deviates by more than bps basis points from base.
+func deviatesMoreThanBps(base, price *big.Int, bps uint32) bool {
+	diff := new(big.Int).Sub(price, base)
+	diff.Abs(diff).Mul(diff, big.NewInt(10_000))
+	threshold := new(big.Int).Mul(new(big.Int).Abs(base), big.NewInt(int64(bps)))
+	return diff.Cmp(threshold) > 0
+}
@@ -0,0 +1,92 @@
+package prices
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+func TestMultiEndpointGasPriceEstimator_GetGasPrice(t *testing.T) {
+	encodedGasPrice := func(t *testing.T, execGasPrice, daGasPrice int64) *big.Int {
+		gasPrice, err := EncodeGasPriceComponents(big.NewInt(execGasPrice), big.NewInt(daGasPrice))
+		require.NoError(t, err)
+		return gasPrice
+	}
+	newEndpoint := func(t *testing.T, name string, gasPrice *big.Int, err error) NamedGasPriceEndpoint {
+		endpoint := NewMockGasPriceEndpoint(t)
+		endpoint.EXPECT().GetGasPrice(mock.Anything).Return(gasPrice, err)
+		return NamedGasPriceEndpoint{Name: name, Endpoint: endpoint}
+	}
+
+	t.Run("outliers are discarded", func(t *testing.T) {
+		ctx := tests.Context(t)
+		underlying := NewMockGasPriceEstimatorCommit(t)
+		underlying.EXPECT().GetGasPrice(mock.Anything).Return(encodedGasPrice(t, 100, 7), nil)
+
+		estimator := NewMultiEndpointGasPriceEstimator(underlying, 1001, []NamedGasPriceEndpoint{
+			newEndpoint(t, "a", big.NewInt(102), nil),
+			newEndpoint(t, "b", big.NewInt(98), nil),
+			newEndpoint(t, "c", big.NewInt(1000), nil),
+		}, 0, 0)
+
+		gasPrice, err := estimator.GetGasPrice(ctx)
+		require.NoError(t, err)
+		// The data availability component of the underlying estimator is kept
+		assert.Equal(t, encodedGasPrice(t, 100, 7), gasPrice)
+		assert.Equal(t, float64(1), testutil.ToFloat64(gasPriceEndpointOutliers.WithLabelValues("1001", "c")))
+		assert.Equal(t, float64(0), testutil.ToFloat64(gasPriceEndpointHealthy.WithLabelValues("1001", "c")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(gasPriceEndpointHealthy.WithLabelValues("1001", PrimaryGasPriceEndpoint)))
+	})
+
+	t.Run("failing endpoints are skipped", func(t *testing.T) {
+		ctx := tests.Context(t)
+		underlying := NewMockGasPriceEstimatorCommit(t)
+		underlying.EXPECT().GetGasPrice(mock.Anything).Return(big.NewInt(100), nil)
+
+		estimator := NewMultiEndpointGasPriceEstimator(underlying, 1002, []NamedGasPriceEndpoint{
+			newEndpoint(t, "a", nil, errors.New("rpc down")),
+			newEndpoint(t, "b", big.NewInt(105), nil),
+		}, 1_000, 0)
+
+		gasPrice, err := estimator.GetGasPrice(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(105), gasPrice)
+		assert.Equal(t, float64(1), testutil.ToFloat64(gasPriceEndpointRequests.WithLabelValues("1002", "a", "false")))
+		assert.Equal(t, float64(0), testutil.ToFloat64(gasPriceEndpointHealthy.WithLabelValues("1002", "a")))
+	})
+
+	t.Run("not enough endpoints respond", func(t *testing.T) {
+		ctx := tests.Context(t)
+		underlying := NewMockGasPriceEstimatorCommit(t)
+		underlying.EXPECT().GetGasPrice(mock.Anything).Return(big.NewInt(100), nil)
+
+		estimator := NewMultiEndpointGasPriceEstimator(underlying, 1003, []NamedGasPriceEndpoint{
+			newEndpoint(t, "a", nil, errors.New("rpc down")),
+			newEndpoint(t, "b", nil, nil),
+		}, 0, 0)
+
+		_, err := estimator.GetGasPrice(ctx)
+		require.ErrorContains(t, err, "only 1 of 3 gas price endpoints responded")
+	})
+
+	t.Run("underlying estimator fails", func(t *testing.T) {
+		ctx := tests.Context(t)
+		underlying := NewMockGasPriceEstimatorCommit(t)
+		underlying.EXPECT().GetGasPrice(mock.Anything).Return(nil, errors.New("estimator down"))
+
+		estimator := NewMultiEndpointGasPriceEstimator(underlying, 1004, []NamedGasPriceEndpoint{
+			newEndpoint(t, "a", big.NewInt(100), nil),
+			newEndpoint(t, "b", big.NewInt(100), nil),
+		}, 0, 1)
+
+		_, err := estimator.GetGasPrice(ctx)
+		require.ErrorContains(t, err, "estimator down")
+	})
+}
@@ -0,0 +1,165 @@
+package prices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+)
+
+// SimulatedGasPriceSchedule is a scripted sequence of gas price steps, so that system tests can exercise the fee update
+// logic under controlled gas scenarios. A step holds a constant gas price, ramps linearly towards its To gas prices, or
+// spikes when it is short. The schedule holds the end gas prices of its last step once it ends, unless it repeats.
+// It is parsed from TOML, e.g.
+//
+//	Repeat = true
+//
+//	[[Steps]]
+//	Duration = '10m'
+//	ExecGasPrice = '10 gwei'
+//
+//	[[Steps]]
+//	Duration = '10m'
+//	ExecGasPrice = '10 gwei'
+//	ToExecGasPrice = '50 gwei'
+//
+//	[[Steps]]
+//	Duration = '30s'
+//	ExecGasPrice = '500 gwei'
+//	DAGasPrice = '20 gwei'
+type SimulatedGasPriceSchedule struct {
+	Steps  []SimulatedGasPriceStep
+	Repeat bool
+}
+
+// SimulatedGasPriceStep is a step of the SimulatedGasPriceSchedule, the data availability gas price is zero when unset.
+type SimulatedGasPriceStep struct {
+	Duration       commonconfig.Duration
+	ExecGasPrice   *assets.Wei
+	ToExecGasPrice *assets.Wei
+	DAGasPrice     *assets.Wei
+	ToDAGasPrice   *assets.Wei
+}
+
+// ParseSimulatedGasPriceSchedule parses and validates a TOML gas price schedule.
+func ParseSimulatedGasPriceSchedule(schedule string) (SimulatedGasPriceSchedule, error) {
+	var s SimulatedGasPriceSchedule
+	if err := toml.Unmarshal([]byte(schedule), &s); err != nil {
+		return SimulatedGasPriceSchedule{}, fmt.Errorf("failed to parse simulated gas price schedule: %w", err)
+	}
+	if err := s.Validate(); err != nil {
+		return SimulatedGasPriceSchedule{}, err
+	}
+	return s, nil
+}
+
+// Validate checks the schedule has steps, each with a positive duration and an execution gas price.
+func (s SimulatedGasPriceSchedule) Validate() error {
+	if len(s.Steps) == 0 {
+		return errors.New("simulated gas price schedule has no steps")
+	}
+	for i, step := range s.Steps {
+		if step.Duration.Duration() <= 0 {
+			return fmt.Errorf("duration of step %d must be positive", i)
+		}
+		if step.ExecGasPrice == nil {
+			return fmt.Errorf("exec gas price of step %d must be set", i)
+		}
+		if step.ToDAGasPrice != nil && step.DAGasPrice == nil {
+			return fmt.Errorf("da gas price of step %d must be set to ramp it", i)
+		}
+	}
+	return nil
+}
+
+// gasPricesAt returns the execution and data availability gas prices of the schedule, elapsed since its start.
+func (s SimulatedGasPriceSchedule) gasPricesAt(elapsed time.Duration) (*big.Int, *big.Int) {
+	var total time.Duration
+	for _, step := range s.Steps {
+		total += step.Duration.Duration()
+	}
+	if elapsed >= total {
+		if !s.Repeat {
+			last := s.Steps[len(s.Steps)-1]
+			return last.gasPricesAt(last.Duration.Duration())
+		}
+		elapsed %= total
+	}
+	for _, step := range s.Steps {
+		if elapsed < step.Duration.Duration() {
+			return step.gasPricesAt(elapsed)
+		}
+		elapsed -= step.Duration.Duration()
+	}
+	// Unreachable as elapsed is below the total duration
+	return s.Steps[0].gasPricesAt(0)
+}
+
+func (s SimulatedGasPriceStep) gasPricesAt(offset time.Duration) (*big.Int, *big.Int) {
+	return interpolate(s.ExecGasPrice, s.ToExecGasPrice, offset, s.Duration.Duration()),
+		interpolate(s.DAGasPrice, s.ToDAGasPrice, offset, s.Duration.Duration())
+}
+
+// interpolate returns the price linearly ramped from from towards to, to the given offset of the duration.
+func interpolate(from, to *assets.Wei, offset, duration time.Duration) *big.Int {
+	if from == nil {
+		return big.NewInt(0)
+	}
+	if to == nil {
+		return from.ToInt()
+	}
+	price := new(big.Int).Sub(to.ToInt(), from.ToInt())
+	price.Mul(price, big.NewInt(int64(offset)))
+	price.Quo(price, big.NewInt(int64(duration)))
+	return price.Add(price, from.ToInt())
+}
+
+var _ GasPriceEstimatorCommit = &SimulatedGasPriceEstimator{}
+
+// SimulatedGasPriceEstimator returns the gas prices of a SimulatedGasPriceSchedule instead of estimating them, it must
+// only be used by tests. The USD conversion and the deviation checks are left to the underlying estimator, which must
+// decode data availability gas prices when the schedule sets them.
+type SimulatedGasPriceEstimator struct {
+	GasPriceEstimatorCommit
+	schedule SimulatedGasPriceSchedule
+	start    time.Time
+	now      func() time.Time
+}
+
+// NewSimulatedGasPriceEstimator returns an estimator following the schedule from the given start, estimators sharing
+// the start follow the same gas prices.
+func NewSimulatedGasPriceEstimator(
+	estimator GasPriceEstimatorCommit,
+	schedule SimulatedGasPriceSchedule,
+	start time.Time,
+) (*SimulatedGasPriceEstimator, error) {
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+	if estimator == nil {
+		return nil, errors.New("underlying gas price estimator is nil")
+	}
+	return &SimulatedGasPriceEstimator{
+		GasPriceEstimatorCommit: estimator,
+		schedule:                schedule,
+		start:                   start,
+		now:                     time.Now,
+	}, nil
+}
+
+// GetGasPrice returns the encoded gas price of the schedule at the current time.
+func (g *SimulatedGasPriceEstimator) GetGasPrice(context.Context) (*big.Int, error) {
+	elapsed := g.now().Sub(g.start)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	execGasPrice, daGasPrice := g.schedule.gasPricesAt(elapsed)
+	return EncodeGasPriceComponents(execGasPrice, daGasPrice)
+}
@@ -0,0 +1,86 @@
+package prices
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+func TestSimulatedGasPriceEstimator_GetGasPrice(t *testing.T) {
+	schedule, err := ParseSimulatedGasPriceSchedule(`
+[[Steps]]
+Duration = '10m'
+ExecGasPrice = '10 gwei'
+
+[[Steps]]
+Duration = '10m'
+ExecGasPrice = '10 gwei'
+ToExecGasPrice = '50 gwei'
+DAGasPrice = '1 gwei'
+ToDAGasPrice = '3 gwei'
+
+[[Steps]]
+Duration = '30s'
+ExecGasPrice = '500 gwei'
+`)
+	require.NoError(t, err)
+	encodedGasPrice := func(t *testing.T, execGasPrice, daGasPrice int64) *big.Int {
+		gasPrice, err := EncodeGasPriceComponents(big.NewInt(execGasPrice), big.NewInt(daGasPrice))
+		require.NoError(t, err)
+		return gasPrice
+	}
+
+	testCases := []struct {
+		name        string
+		elapsed     time.Duration
+		repeat      bool
+		expGasPrice *big.Int
+	}{
+		{name: "constant", elapsed: 5 * time.Minute, expGasPrice: big.NewInt(10e9)},
+		{name: "ramp start", elapsed: 10 * time.Minute, expGasPrice: encodedGasPrice(t, 10e9, 1e9)},
+		{name: "ramp middle", elapsed: 15 * time.Minute, expGasPrice: encodedGasPrice(t, 30e9, 2e9)},
+		{name: "spike", elapsed: 20 * time.Minute, expGasPrice: big.NewInt(500e9)},
+		{name: "holds the last step after the end", elapsed: time.Hour, expGasPrice: big.NewInt(500e9)},
+		{name: "restarts after the end when repeating", elapsed: 20*time.Minute + 30*time.Second + 15*time.Minute, repeat: true, expGasPrice: encodedGasPrice(t, 30e9, 2e9)},
+		{name: "before the start", elapsed: -time.Minute, expGasPrice: big.NewInt(10e9)},
+	}
+
+	start := time.Now()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := schedule
+			schedule.Repeat = tc.repeat
+			estimator, err := NewSimulatedGasPriceEstimator(NewMockGasPriceEstimatorCommit(t), schedule, start)
+			require.NoError(t, err)
+			estimator.now = func() time.Time { return start.Add(tc.elapsed) }
+
+			gasPrice, err := estimator.GetGasPrice(tests.Context(t))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expGasPrice, gasPrice)
+		})
+	}
+}
+
+func TestParseSimulatedGasPriceSchedule(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule string
+	}{
+		{name: "invalid toml", schedule: `[[Steps]`},
+		{name: "no steps", schedule: `Repeat = true`},
+		{name: "step without duration", schedule: "[[Steps]]\nExecGasPrice = '1 gwei'"},
+		{name: "step without exec gas price", schedule: "[[Steps]]\nDuration = '1m'"},
+		{name: "da ramp without da gas price", schedule: "[[Steps]]\nDuration = '1m'\nExecGasPrice = '1 gwei'\nToDAGasPrice = '1 gwei'"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseSimulatedGasPriceSchedule(tc.schedule)
+			require.Error(t, err)
+		})
+	}
+}
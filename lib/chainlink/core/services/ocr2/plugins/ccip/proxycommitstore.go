@@ -52,6 +52,12 @@ type IncompleteDestCommitStoreReader interface {
 	io.Closer
 }
 
+// AcceptedCommitReportsSubscriber is implemented by the dest commitStore readers streaming the accepted commit reports,
+// the readers served by LOOP relayers don't provide it.
+type AcceptedCommitReportsSubscriber interface {
+	SubscribeAcceptedCommitReports(ctx context.Context, minSeqNum uint64, confirmations int, pollInterval time.Duration) (<-chan cciptypes.CommitStoreReportWithTxMeta, error)
+}
+
 func NewProviderProxyCommitStoreReader(srcReader cciptypes.CommitStoreReader, dstReader cciptypes.CommitStoreReader) *ProviderProxyCommitStoreReader {
 	return &ProviderProxyCommitStoreReader{
 		srcCommitStoreReader: srcReader,
@@ -114,6 +120,16 @@ func (p *ProviderProxyCommitStoreReader) OffchainConfig(ctx context.Context) (cc
 	return p.srcCommitStoreReader.OffchainConfig(ctx)
 }
 
+// SubscribeAcceptedCommitReports streams the accepted commit reports of the dest relayer, it fails when the dest
+// relayer does not provide the subscription.
+func (p *ProviderProxyCommitStoreReader) SubscribeAcceptedCommitReports(ctx context.Context, minSeqNum uint64, confirmations int, pollInterval time.Duration) (<-chan cciptypes.CommitStoreReportWithTxMeta, error) {
+	subscriber, ok := p.dstCommitStoreReader.(AcceptedCommitReportsSubscriber)
+	if !ok {
+		return nil, errors.New("accepted commit reports subscription is not supported by the dest relayer")
+	}
+	return subscriber.SubscribeAcceptedCommitReports(ctx, minSeqNum, confirmations, pollInterval)
+}
+
 func (p *ProviderProxyCommitStoreReader) VerifyExecutionReport(ctx context.Context, report cciptypes.ExecReport) (bool, error) {
 	return p.dstCommitStoreReader.VerifyExecutionReport(ctx, report)
 }
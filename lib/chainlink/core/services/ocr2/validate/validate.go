@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 	lloconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/llo/config"
 	mercuryconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/mercury/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
@@ -344,25 +345,60 @@ func validateOCR2CCIPCommitSpec(jsonConfig job.JSONConfig) error {
 		return pkgerrors.Wrap(err, "error while unmarshalling plugin config")
 	}
 
-	// Ensure that either the tokenPricesUSDPipeline or the priceGetterConfig is set, but not both.
+	// Ensure that exactly one of the tokenPricesUSDPipeline, the priceGetterConfig, the tokenPricePipelines or the
+	// dataStreamsPriceGetterConfig is set.
 	emptyPipeline := strings.Trim(cfg.TokenPricesUSDPipeline, "\n\t ") == ""
 	emptyPriceGetter := cfg.PriceGetterConfig == nil
-	if emptyPipeline && emptyPriceGetter {
-		return errors.New("either tokenPricesUSDPipeline or priceGetterConfig must be set")
+	emptyTokenPipelines := len(cfg.TokenPricePipelines) == 0
+	emptyDataStreams := cfg.DataStreamsPriceGetterConfig == nil
+	numPriceSources := 0
+	for _, empty := range []bool{emptyPipeline, emptyPriceGetter, emptyTokenPipelines, emptyDataStreams} {
+		if !empty {
+			numPriceSources++
+		}
+	}
+	if numPriceSources == 0 {
+		return errors.New("either tokenPricesUSDPipeline, priceGetterConfig, tokenPricePipelines or dataStreamsPriceGetterConfig must be set")
 	}
-	if !emptyPipeline && !emptyPriceGetter {
-		return fmt.Errorf("only one of tokenPricesUSDPipeline or priceGetterConfig must be set: %s and %v", cfg.TokenPricesUSDPipeline, cfg.PriceGetterConfig)
+	if numPriceSources > 1 {
+		return fmt.Errorf("only one of tokenPricesUSDPipeline, priceGetterConfig, tokenPricePipelines or dataStreamsPriceGetterConfig must be set: %s, %v, %v and %v",
+			cfg.TokenPricesUSDPipeline, cfg.PriceGetterConfig, cfg.TokenPricePipelines, cfg.DataStreamsPriceGetterConfig)
 	}
 
-	if !emptyPipeline {
+	switch {
+	case !emptyPipeline:
 		_, err = pipeline.Parse(cfg.TokenPricesUSDPipeline)
 		if err != nil {
 			return pkgerrors.Wrap(err, "invalid token prices pipeline")
 		}
-	} else {
-		// Validate prices config (like it was done for the pipeline).
-		if emptyPriceGetter {
-			return pkgerrors.New("priceGetterConfig is empty")
+	case !emptyTokenPipelines:
+		if err = cfg.TokenPricePipelines.Validate(); err != nil {
+			return pkgerrors.Wrap(err, "invalid token price pipelines")
+		}
+		for _, tokenPipeline := range cfg.TokenPricePipelines {
+			if _, err = pipeline.Parse(tokenPipeline.Pipeline); err != nil {
+				return pkgerrors.Wrapf(err, "invalid price pipeline of token %v", tokenPipeline.TokenAddress)
+			}
+		}
+	case !emptyDataStreams:
+		if err = cfg.DataStreamsPriceGetterConfig.Validate(); err != nil {
+			return pkgerrors.Wrap(err, "invalid data streams price getter config")
+		}
+	}
+
+	if cfg.UniswapV3TWAPPriceGetterConfig != nil {
+		if err = cfg.UniswapV3TWAPPriceGetterConfig.Validate(); err != nil {
+			return pkgerrors.Wrap(err, "invalid uniswap v3 twap price getter config")
+		}
+	}
+	for sourceChainSelector, estimatorConfig := range cfg.EIP1559GasPriceEstimators {
+		if err = estimatorConfig.Validate(); err != nil {
+			return pkgerrors.Wrapf(err, "invalid eip1559 gas price estimator config of source chain %d", sourceChainSelector)
+		}
+	}
+	if cfg.SimulatedGasPriceSchedule != "" {
+		if _, err = prices.ParseSimulatedGasPriceSchedule(cfg.SimulatedGasPriceSchedule); err != nil {
+			return pkgerrors.Wrap(err, "invalid simulated gas price schedule")
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 
 var _ cciptypes.CommitStoreReader = (*IncompleteSourceCommitStoreReader)(nil)
 var _ cciptypes.CommitStoreReader = (*IncompleteDestCommitStoreReader)(nil)
+var _ ccip.AcceptedCommitReportsSubscriber = (*IncompleteDestCommitStoreReader)(nil)
 
 // IncompleteSourceCommitStoreReader is an implementation of CommitStoreReader with the only valid methods being
 // GasPriceEstimator, ChangeConfig, and OffchainConfig
@@ -139,12 +140,13 @@ func NewIncompleteDestCommitStoreReader(
 	ctx context.Context,
 	lggr logger.Logger,
 	versionFinder ccip.VersionFinder,
+	logsConfig ccip.LogsConfig,
 	address cciptypes.Address,
 	ec client.Client,
 	lp logpoller.LogPoller,
 	feeEstimatorConfig estimatorconfig.FeeEstimatorConfigProvider,
 ) (*IncompleteDestCommitStoreReader, error) {
-	cs, err := ccip.NewCommitStoreReader(ctx, lggr, versionFinder, address, ec, lp, feeEstimatorConfig)
+	cs, err := ccip.NewCommitStoreReaderWithLogsConfig(ctx, lggr, versionFinder, address, ec, lp, feeEstimatorConfig, logsConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +208,14 @@ func (i *IncompleteDestCommitStoreReader) OffchainConfig(ctx context.Context) (c
 	return cciptypes.CommitOffchainConfig{}, fmt.Errorf("invalid usage of IncompleteDestCommitStoreReader")
 }
 
+func (i *IncompleteDestCommitStoreReader) SubscribeAcceptedCommitReports(ctx context.Context, minSeqNum uint64, confirmations int, pollInterval time.Duration) (<-chan cciptypes.CommitStoreReportWithTxMeta, error) {
+	subscriber, ok := i.cs.(ccip.AcceptedCommitReportsSubscriber)
+	if !ok {
+		return nil, fmt.Errorf("accepted commit reports subscription is not supported by %T", i.cs)
+	}
+	return subscriber.SubscribeAcceptedCommitReports(ctx, minSeqNum, confirmations, pollInterval)
+}
+
 func (i *IncompleteDestCommitStoreReader) VerifyExecutionReport(ctx context.Context, report cciptypes.ExecReport) (bool, error) {
 	return i.cs.VerifyExecutionReport(ctx, report)
 }
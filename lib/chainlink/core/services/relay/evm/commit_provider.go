@@ -8,6 +8,7 @@ import (
 
 	"go.uber.org/multierr"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
@@ -29,6 +30,8 @@ var _ commontypes.CCIPCommitProvider = (*DstCommitProvider)(nil)
 
 type SrcCommitProvider struct {
 	lggr               logger.Logger
+	versionFinder      ccip.VersionFinder
+	logsConfig         ccip.LogsConfig
 	startBlock         uint64
 	client             client.Client
 	lp                 logpoller.LogPoller
@@ -44,6 +47,8 @@ type SrcCommitProvider struct {
 
 func NewSrcCommitProvider(
 	lggr logger.Logger,
+	versionFinder ccip.VersionFinder,
+	logsConfig ccip.LogsConfig,
 	startBlock uint64,
 	client client.Client,
 	lp logpoller.LogPoller,
@@ -53,6 +58,8 @@ func NewSrcCommitProvider(
 ) commontypes.CCIPCommitProvider {
 	return &SrcCommitProvider{
 		lggr:               logger.Named(lggr, "SrcCommitProvider"),
+		versionFinder:      versionFinder,
+		logsConfig:         logsConfig,
 		startBlock:         startBlock,
 		client:             client,
 		lp:                 lp,
@@ -65,6 +72,7 @@ func NewSrcCommitProvider(
 type DstCommitProvider struct {
 	lggr                logger.Logger
 	versionFinder       ccip.VersionFinder
+	logsConfig          ccip.LogsConfig
 	startBlock          uint64
 	client              client.Client
 	lp                  logpoller.LogPoller
@@ -82,6 +90,7 @@ type DstCommitProvider struct {
 func NewDstCommitProvider(
 	lggr logger.Logger,
 	versionFinder ccip.VersionFinder,
+	logsConfig ccip.LogsConfig,
 	startBlock uint64,
 	client client.Client,
 	lp logpoller.LogPoller,
@@ -94,6 +103,7 @@ func NewDstCommitProvider(
 	return &DstCommitProvider{
 		lggr:                logger.Named(lggr, "DstCommitProvider"),
 		versionFinder:       versionFinder,
+		logsConfig:          logsConfig,
 		startBlock:          startBlock,
 		client:              client,
 		lp:                  lp,
@@ -114,15 +124,13 @@ func (p *SrcCommitProvider) Name() string {
 // If NewOnRampReader has not been called, their corresponding
 // Close methods will be expected to error.
 func (p *SrcCommitProvider) Close() error {
-	versionFinder := ccip.NewEvmVersionFinder()
-
 	unregisterFuncs := make([]func() error, 0, 2)
 	unregisterFuncs = append(unregisterFuncs, func() error {
 		// avoid panic in the case NewOnRampReader wasn't called
 		if p.seenOnRampAddress == nil {
 			return nil
 		}
-		return ccip.CloseOnRampReader(context.Background(), p.lggr, versionFinder, *p.seenSourceChainSelector, *p.seenDestChainSelector, *p.seenOnRampAddress, p.lp, p.client)
+		return ccip.CloseOnRampReader(context.Background(), p.lggr, p.versionFinder, *p.seenSourceChainSelector, *p.seenDestChainSelector, *p.seenOnRampAddress, p.lp, p.client)
 	})
 
 	var multiErr error
@@ -174,20 +182,18 @@ func (p *DstCommitProvider) Name() string {
 
 func (p *DstCommitProvider) Close() error {
 	ctx := context.Background()
-	versionFinder := ccip.NewEvmVersionFinder()
-
 	unregisterFuncs := make([]func(ctx context.Context) error, 0, 2)
 	unregisterFuncs = append(unregisterFuncs, func(ctx context.Context) error {
 		if p.seenCommitStoreAddress == nil {
 			return nil
 		}
-		return ccip.CloseCommitStoreReader(ctx, p.lggr, versionFinder, *p.seenCommitStoreAddress, p.client, p.lp, p.feeEstimatorConfig)
+		return ccip.CloseCommitStoreReader(ctx, p.lggr, p.versionFinder, *p.seenCommitStoreAddress, p.client, p.lp, p.feeEstimatorConfig)
 	})
 	unregisterFuncs = append(unregisterFuncs, func(ctx context.Context) error {
 		if p.seenOffRampAddress == nil {
 			return nil
 		}
-		return ccip.CloseOffRampReader(ctx, p.lggr, versionFinder, *p.seenOffRampAddress, p.client, p.lp, nil, big.NewInt(0), p.feeEstimatorConfig)
+		return ccip.CloseOffRampReader(ctx, p.lggr, p.versionFinder, *p.seenOffRampAddress, p.client, p.lp, nil, big.NewInt(0), p.feeEstimatorConfig)
 	})
 
 	var multiErr error
@@ -265,8 +271,7 @@ func (p *SrcCommitProvider) NewCommitStoreReader(ctx context.Context, commitStor
 func (p *DstCommitProvider) NewCommitStoreReader(ctx context.Context, commitStoreAddress cciptypes.Address) (commitStoreReader cciptypes.CommitStoreReader, err error) {
 	p.seenCommitStoreAddress = &commitStoreAddress
 
-	versionFinder := ccip.NewEvmVersionFinder()
-	commitStoreReader, err = NewIncompleteDestCommitStoreReader(ctx, p.lggr, versionFinder, commitStoreAddress, p.client, p.lp, p.feeEstimatorConfig)
+	commitStoreReader, err = NewIncompleteDestCommitStoreReader(ctx, p.lggr, p.versionFinder, p.logsConfig, commitStoreAddress, p.client, p.lp, p.feeEstimatorConfig)
 	return
 }
 
@@ -275,9 +280,7 @@ func (p *SrcCommitProvider) NewOnRampReader(ctx context.Context, onRampAddress c
 	p.seenSourceChainSelector = &sourceChainSelector
 	p.seenDestChainSelector = &destChainSelector
 
-	versionFinder := ccip.NewEvmVersionFinder()
-
-	onRampReader, err = ccip.NewOnRampReader(ctx, p.lggr, versionFinder, sourceChainSelector, destChainSelector, onRampAddress, p.lp, p.client)
+	onRampReader, err = ccip.NewOnRampReaderWithLogsConfig(ctx, p.lggr, p.versionFinder, sourceChainSelector, destChainSelector, onRampAddress, p.lp, p.client, p.logsConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -294,7 +297,7 @@ func (p *SrcCommitProvider) NewOffRampReader(ctx context.Context, offRampAddr cc
 }
 
 func (p *DstCommitProvider) NewOffRampReader(ctx context.Context, offRampAddr cciptypes.Address) (offRampReader cciptypes.OffRampReader, err error) {
-	offRampReader, err = ccip.NewOffRampReader(ctx, p.lggr, p.versionFinder, offRampAddr, p.client, p.lp, p.gasEstimator, &p.maxGasPrice, true, p.feeEstimatorConfig)
+	offRampReader, err = ccip.NewOffRampReaderWithLogsConfig(ctx, p.lggr, p.versionFinder, offRampAddr, p.client, p.lp, p.gasEstimator, &p.maxGasPrice, true, p.feeEstimatorConfig, p.logsConfig)
 	return
 }
 
@@ -325,6 +328,17 @@ func (p *SrcCommitProvider) SourceNativeToken(ctx context.Context, sourceRouterA
 	return ccip.EvmAddrToGeneric(sourceNative), nil
 }
 
+// FeeHistory returns the EIP-1559 fee history of the source chain, so that the commit plugin can derive the execution
+// gas price from it instead of the fee estimator of the node.
+func (p *SrcCommitProvider) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return p.client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+}
+
+// SourceMaxGasPrice returns the max gas price of the source chain, it caps the gas prices derived from the fee history.
+func (p *SrcCommitProvider) SourceMaxGasPrice() *big.Int {
+	return p.maxGasPrice
+}
+
 func (p *DstCommitProvider) SourceNativeToken(ctx context.Context, sourceRouterAddr cciptypes.Address) (cciptypes.Address, error) {
 	return "", fmt.Errorf("invalid: SourceNativeToken called for DstCommitProvider. SourceNativeToken should be called on SrcCommitProvider")
 }
@@ -57,6 +57,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/codec"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/functions"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/interceptors/mantle"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/interceptors/rollups"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
@@ -470,13 +471,15 @@ func chainToUUID(chainID *big.Int) uuid.UUID {
 // subset of implementations of the complete interface as certain contracts in a CCIP lane are only deployed on the src
 // chain or on the dst chain. This results in the two implementations of providers: a src and dst implementation.
 func (r *Relayer) NewCCIPCommitProvider(ctx context.Context, rargs commontypes.RelayArgs, pargs commontypes.PluginArgs) (commontypes.CCIPCommitProvider, error) {
-	versionFinder := ccip.NewEvmVersionFinder()
-
 	var commitPluginConfig ccipconfig.CommitPluginConfig
 	err := json.Unmarshal(pargs.PluginConfig, &commitPluginConfig)
 	if err != nil {
 		return nil, err
 	}
+	versionFinder, err := ccip.NewEvmVersionFinderWithOverrides(commitPluginConfig.TypeAndVersionOverrides)
+	if err != nil {
+		return nil, err
+	}
 	sourceStartBlock := commitPluginConfig.SourceStartBlock
 	destStartBlock := commitPluginConfig.DestStartBlock
 
@@ -495,11 +498,26 @@ func (r *Relayer) NewCCIPCommitProvider(ctx context.Context, rargs commontypes.R
 		}
 	}
 
+	// CCIPCommit prices the L1 data posting cost of rollup source chains with the gas price oracle of their family
+	if commitPluginConfig.IsSourceProvider {
+		if sourceChainSelector, sErr := chainselectors.SelectorFromChainId(r.chain.ID().Uint64()); sErr == nil {
+			rollupInterceptor, iErr := rollups.NewInterceptor(ctx, r.chain.Client(), sourceChainSelector)
+			if iErr != nil {
+				return nil, iErr
+			}
+			if rollupInterceptor != nil {
+				feeEstimatorConfig.AddGasPriceInterceptor(rollupInterceptor)
+			}
+		}
+	}
+
 	// The src chain implementation of this provider does not need a configWatcher or contractTransmitter;
 	// bail early.
 	if commitPluginConfig.IsSourceProvider {
 		return NewSrcCommitProvider(
 			r.lggr,
+			versionFinder,
+			ccip.NewLogsConfig(commitPluginConfig.LogsConfig),
 			sourceStartBlock,
 			r.chain.Client(),
 			r.chain.LogPoller(),
@@ -514,8 +532,7 @@ func (r *Relayer) NewCCIPCommitProvider(ctx context.Context, rargs commontypes.R
 	if err != nil {
 		return nil, err
 	}
-	address := common.HexToAddress(relayOpts.ContractID)
-	typ, ver, err := ccipconfig.TypeAndVersion(address, r.chain.Client())
+	typ, ver, err := versionFinder.TypeAndVersion(cciptypes.Address(common.HexToAddress(relayOpts.ContractID).String()), r.chain.Client())
 	if err != nil {
 		return nil, err
 	}
@@ -534,6 +551,7 @@ func (r *Relayer) NewCCIPCommitProvider(ctx context.Context, rargs commontypes.R
 	return NewDstCommitProvider(
 		r.lggr,
 		versionFinder,
+		ccip.NewLogsConfig(commitPluginConfig.LogsConfig),
 		destStartBlock,
 		r.chain.Client(),
 		r.chain.LogPoller(),
@@ -551,13 +569,15 @@ func (r *Relayer) NewCCIPCommitProvider(ctx context.Context, rargs commontypes.R
 // subset of implementations of the complete interface as certain contracts in a CCIP lane are only deployed on the src
 // chain or on the dst chain. This results in the two implementations of providers: a src and dst implementation.
 func (r *Relayer) NewCCIPExecProvider(ctx context.Context, rargs commontypes.RelayArgs, pargs commontypes.PluginArgs) (commontypes.CCIPExecProvider, error) {
-	versionFinder := ccip.NewEvmVersionFinder()
-
 	var execPluginConfig ccipconfig.ExecPluginConfig
 	err := json.Unmarshal(pargs.PluginConfig, &execPluginConfig)
 	if err != nil {
 		return nil, err
 	}
+	versionFinder, err := ccip.NewEvmVersionFinderWithOverrides(execPluginConfig.TypeAndVersionOverrides)
+	if err != nil {
+		return nil, err
+	}
 
 	feeEstimatorConfig := estimatorconfig.NewFeeEstimatorConfigService()
 
@@ -581,6 +601,7 @@ func (r *Relayer) NewCCIPExecProvider(ctx context.Context, rargs commontypes.Rel
 			ctx,
 			r.lggr,
 			versionFinder,
+			ccip.NewLogsConfig(execPluginConfig.LogsConfig),
 			r.chain.Client(),
 			r.chain.GasEstimator(),
 			r.chain.Config().EVM().GasEstimator().PriceMax().ToInt(),
@@ -598,8 +619,7 @@ func (r *Relayer) NewCCIPExecProvider(ctx context.Context, rargs commontypes.Rel
 	if err != nil {
 		return nil, err
 	}
-	address := common.HexToAddress(relayOpts.ContractID)
-	typ, ver, err := ccipconfig.TypeAndVersion(address, r.chain.Client())
+	typ, ver, err := versionFinder.TypeAndVersion(cciptypes.Address(common.HexToAddress(relayOpts.ContractID).String()), r.chain.Client())
 	if err != nil {
 		return nil, err
 	}
@@ -618,6 +638,7 @@ func (r *Relayer) NewCCIPExecProvider(ctx context.Context, rargs commontypes.Rel
 	return NewDstExecProvider(
 		r.lggr,
 		versionFinder,
+		ccip.NewLogsConfig(execPluginConfig.LogsConfig),
 		r.chain.Client(),
 		r.chain.LogPoller(),
 		execPluginConfig.DestStartBlock,
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"net/url"
-	"time"
 
 	"go.uber.org/multierr"
 
@@ -19,7 +17,6 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_2_0/router"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
-	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/lbtc"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/client"
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
@@ -27,12 +24,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/estimatorconfig"
-	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/tokendata/usdc"
 )
 
 type SrcExecProvider struct {
 	lggr          logger.Logger
 	versionFinder ccip.VersionFinder
+	logsConfig    ccip.LogsConfig
 	client        client.Client
 	lp            logpoller.LogPoller
 	startBlock    uint64
@@ -56,6 +53,7 @@ func NewSrcExecProvider(
 	ctx context.Context,
 	lggr logger.Logger,
 	versionFinder ccip.VersionFinder,
+	logsConfig ccip.LogsConfig,
 	client client.Client,
 	estimator gas.EvmFeeEstimator,
 	maxGasPrice *big.Int,
@@ -78,6 +76,7 @@ func NewSrcExecProvider(
 	return &SrcExecProvider{
 		lggr:               logger.Named(lggr, "SrcExecProvider"),
 		versionFinder:      versionFinder,
+		logsConfig:         logsConfig,
 		client:             client,
 		estimator:          estimator,
 		maxGasPrice:        maxGasPrice,
@@ -105,15 +104,13 @@ func (s *SrcExecProvider) Start(ctx context.Context) error {
 // Close is called when the job that created this provider is closed.
 func (s *SrcExecProvider) Close() error {
 	ctx := context.Background()
-	versionFinder := ccip.NewEvmVersionFinder()
-
 	unregisterFuncs := make([]func(context.Context) error, 0, 2)
 	unregisterFuncs = append(unregisterFuncs, func(ctx context.Context) error {
 		// avoid panic in the case NewOnRampReader wasn't called
 		if s.seenOnRampAddress == nil {
 			return nil
 		}
-		return ccip.CloseOnRampReader(ctx, s.lggr, versionFinder, *s.seenSourceChainSelector, *s.seenDestChainSelector, *s.seenOnRampAddress, s.lp, s.client)
+		return ccip.CloseOnRampReader(ctx, s.lggr, s.versionFinder, *s.seenSourceChainSelector, *s.seenDestChainSelector, *s.seenOnRampAddress, s.lp, s.client)
 	})
 	unregisterFuncs = append(unregisterFuncs, func(ctx context.Context) error {
 		if s.usdcConfig.AttestationAPI == "" {
@@ -180,8 +177,7 @@ func (s *SrcExecProvider) NewOffRampReader(ctx context.Context, addr cciptypes.A
 func (s *SrcExecProvider) NewOnRampReader(ctx context.Context, onRampAddress cciptypes.Address, sourceChainSelector uint64, destChainSelector uint64) (onRampReader cciptypes.OnRampReader, err error) {
 	s.seenOnRampAddress = &onRampAddress
 
-	versionFinder := ccip.NewEvmVersionFinder()
-	onRampReader, err = ccip.NewOnRampReader(ctx, s.lggr, versionFinder, sourceChainSelector, destChainSelector, onRampAddress, s.lp, s.client)
+	onRampReader, err = ccip.NewOnRampReaderWithLogsConfig(ctx, s.lggr, s.versionFinder, sourceChainSelector, destChainSelector, onRampAddress, s.lp, s.client, s.logsConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -200,37 +196,35 @@ func (s *SrcExecProvider) NewTokenDataReader(ctx context.Context, tokenAddress c
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token address: %w", err)
 	}
-	switch tokenAddr {
-	case s.usdcConfig.SourceTokenAddress:
-		attestationURI, err := url.ParseRequestURI(s.usdcConfig.AttestationAPI)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse USDC attestation API: %w", err)
+	for _, cfg := range s.tokenDataReaderConfigs() {
+		if cfg.SourceTokenAddress == tokenAddr {
+			return ccip.NewTokenDataReader(s.lggr, cfg)
 		}
-		return usdc.NewUSDCTokenDataReader(
-			s.lggr,
-			s.usdcReader,
-			attestationURI,
-			//nolint:gosec // integer overflow
-			int(s.usdcConfig.AttestationAPITimeoutSeconds),
-			tokenAddr,
-			time.Duration(s.usdcConfig.AttestationAPIIntervalMilliseconds)*time.Millisecond,
-		), nil
-	case s.lbtcConfig.SourceTokenAddress:
-		attestationURI, err := url.ParseRequestURI(s.lbtcConfig.AttestationAPI)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse USDC attestation API: %w", err)
-		}
-		return lbtc.NewLBTCTokenDataReader(
-			s.lggr,
-			attestationURI,
-			//nolint:gosec // integer overflow
-			int(s.lbtcConfig.AttestationAPITimeoutSeconds),
-			tokenAddr,
-			time.Duration(s.lbtcConfig.AttestationAPIIntervalMilliseconds)*time.Millisecond,
-		), nil
-	default:
-		return nil, fmt.Errorf("unsupported token address: %s", tokenAddress)
 	}
+	return nil, fmt.Errorf("unsupported token address: %s", tokenAddress)
+}
+
+// tokenDataReaderConfigs returns the token data reader configs of the attestation-backed tokens of the job spec.
+func (s *SrcExecProvider) tokenDataReaderConfigs() []ccip.TokenDataReaderConfig {
+	usdcConfig := ccip.TokenDataReaderConfig{
+		Type:                               ccip.USDCTokenDataReader,
+		SourceTokenAddress:                 s.usdcConfig.SourceTokenAddress,
+		AttestationAPI:                     s.usdcConfig.AttestationAPI,
+		AttestationAPITimeoutSeconds:       s.usdcConfig.AttestationAPITimeoutSeconds,
+		AttestationAPIIntervalMilliseconds: s.usdcConfig.AttestationAPIIntervalMilliseconds,
+	}
+	// avoid a typed nil USDCReader in the case the USDC attestation API isn't set
+	if s.usdcReader != nil {
+		usdcConfig.USDCReader = s.usdcReader
+	}
+	lbtcConfig := ccip.TokenDataReaderConfig{
+		Type:                               ccip.LBTCTokenDataReader,
+		SourceTokenAddress:                 s.lbtcConfig.SourceTokenAddress,
+		AttestationAPI:                     s.lbtcConfig.AttestationAPI,
+		AttestationAPITimeoutSeconds:       s.lbtcConfig.AttestationAPITimeoutSeconds,
+		AttestationAPIIntervalMilliseconds: s.lbtcConfig.AttestationAPIIntervalMilliseconds,
+	}
+	return []ccip.TokenDataReaderConfig{usdcConfig, lbtcConfig}
 }
 
 func (s *SrcExecProvider) NewTokenPoolBatchedReader(ctx context.Context, offRampAddr cciptypes.Address, sourceChainSelector uint64) (cciptypes.TokenPoolBatchedReader, error) {
@@ -257,6 +251,7 @@ func (s *SrcExecProvider) SourceNativeToken(ctx context.Context, sourceRouterAdd
 type DstExecProvider struct {
 	lggr                logger.Logger
 	versionFinder       ccip.VersionFinder
+	logsConfig          ccip.LogsConfig
 	client              client.Client
 	lp                  logpoller.LogPoller
 	startBlock          uint64
@@ -275,6 +270,7 @@ type DstExecProvider struct {
 func NewDstExecProvider(
 	lggr logger.Logger,
 	versionFinder ccip.VersionFinder,
+	logsConfig ccip.LogsConfig,
 	client client.Client,
 	lp logpoller.LogPoller,
 	startBlock uint64,
@@ -289,6 +285,7 @@ func NewDstExecProvider(
 	return &DstExecProvider{
 		lggr:                logger.Named(lggr, "DstExecProvider"),
 		versionFinder:       versionFinder,
+		logsConfig:          logsConfig,
 		client:              client,
 		lp:                  lp,
 		startBlock:          startBlock,
@@ -320,17 +317,15 @@ func (d *DstExecProvider) Start(ctx context.Context) error {
 // Close methods will be expected to error.
 func (d *DstExecProvider) Close() error {
 	ctx := context.Background()
-	versionFinder := ccip.NewEvmVersionFinder()
-
 	unregisterFuncs := make([]func(context.Context) error, 0, 2)
 	unregisterFuncs = append(unregisterFuncs, func(ctx context.Context) error {
 		if d.seenCommitStoreAddr == nil {
 			return nil
 		}
-		return ccip.CloseCommitStoreReader(ctx, d.lggr, versionFinder, *d.seenCommitStoreAddr, d.client, d.lp, d.feeEstimatorConfig)
+		return ccip.CloseCommitStoreReader(ctx, d.lggr, d.versionFinder, *d.seenCommitStoreAddr, d.client, d.lp, d.feeEstimatorConfig)
 	})
 	unregisterFuncs = append(unregisterFuncs, func(ctx context.Context) error {
-		return ccip.CloseOffRampReader(ctx, d.lggr, versionFinder, d.offRampAddress, d.client, d.lp, nil, big.NewInt(0), d.feeEstimatorConfig)
+		return ccip.CloseOffRampReader(ctx, d.lggr, d.versionFinder, d.offRampAddress, d.client, d.lp, nil, big.NewInt(0), d.feeEstimatorConfig)
 	})
 
 	var multiErr error
@@ -377,13 +372,12 @@ func (d *DstExecProvider) GetTransactionStatus(ctx context.Context, transactionI
 func (d *DstExecProvider) NewCommitStoreReader(ctx context.Context, addr cciptypes.Address) (commitStoreReader cciptypes.CommitStoreReader, err error) {
 	d.seenCommitStoreAddr = &addr
 
-	versionFinder := ccip.NewEvmVersionFinder()
-	commitStoreReader, err = NewIncompleteDestCommitStoreReader(ctx, d.lggr, versionFinder, addr, d.client, d.lp, d.feeEstimatorConfig)
+	commitStoreReader, err = NewIncompleteDestCommitStoreReader(ctx, d.lggr, d.versionFinder, d.logsConfig, addr, d.client, d.lp, d.feeEstimatorConfig)
 	return
 }
 
 func (d *DstExecProvider) NewOffRampReader(ctx context.Context, offRampAddress cciptypes.Address) (offRampReader cciptypes.OffRampReader, err error) {
-	offRampReader, err = ccip.NewOffRampReader(ctx, d.lggr, d.versionFinder, offRampAddress, d.client, d.lp, d.gasEstimator, &d.maxGasPrice, true, d.feeEstimatorConfig)
+	offRampReader, err = ccip.NewOffRampReaderWithLogsConfig(ctx, d.lggr, d.versionFinder, offRampAddress, d.client, d.lp, d.gasEstimator, &d.maxGasPrice, true, d.feeEstimatorConfig, d.logsConfig)
 	return
 }
 
@@ -0,0 +1,49 @@
+package rollups
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmClient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+)
+
+const (
+	// getPricesInWei returns the per L2 tx, per L1 calldata byte, per storage allocation and per ArbGas prices in wei
+	arbitrumGetPricesInWeiMethod = "getPricesInWei"
+	arbitrumGasInfoAbiString     = `[{"inputs":[],"name":"getPricesInWei","outputs":[{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+	// arbitrumPerL1CalldataByteIdx is the index of the L1 calldata byte price in the getPricesInWei outputs
+	arbitrumPerL1CalldataByteIdx = 1
+)
+
+// arbitrumGasInfoAddress is the address of the ArbGasInfo precompile.
+var arbitrumGasInfoAddress = common.HexToAddress("0x000000000000000000000000000000000000006C")
+
+// ArbitrumInterceptor prices the data availability gas from the L1 calldata byte price of the ArbGasInfo precompile,
+// which already includes the L1 base fee estimate of the sequencer along with its surplus and reward.
+type ArbitrumInterceptor struct {
+	oracle *oracleCaller
+}
+
+func NewArbitrumInterceptor(_ context.Context, client evmClient.Client) (*ArbitrumInterceptor, error) {
+	oracle, err := newOracleCaller(client, arbitrumGasInfoAddress, arbitrumGasInfoAbiString)
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrumInterceptor{oracle: oracle}, nil
+}
+
+// ModifyGasPriceComponents replaces the data availability gas price with the L1 calldata price per unit of calldata gas.
+func (i *ArbitrumInterceptor) ModifyGasPriceComponents(ctx context.Context, execGasPrice, _ *big.Int) (*big.Int, *big.Int, error) {
+	outputs, err := i.oracle.call(ctx, arbitrumGetPricesInWeiMethod)
+	if err != nil {
+		return nil, nil, err
+	}
+	perL1CalldataByte, err := toBigInt(arbitrumGetPricesInWeiMethod, outputs, arbitrumPerL1CalldataByteIdx)
+	if err != nil {
+		return nil, nil, err
+	}
+	daGasPrice := new(big.Int).Div(perL1CalldataByte, big.NewInt(calldataGasPerByte))
+	return execGasPrice, daGasPrice, nil
+}
@@ -0,0 +1,142 @@
+package rollups
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	evmClient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/estimatorconfig"
+)
+
+// Family is a rollup chain family with its own way of pricing the L1 data posting cost.
+type Family string
+
+const (
+	Arbitrum Family = "arbitrum"
+	Optimism Family = "optimism"
+	Scroll   Family = "scroll"
+)
+
+// calldataGasPerByte is the L1 gas charged per calldata byte, the data availability gas price is reported per unit of
+// data availability gas and the onRamp charges destGasPerDataAvailabilityByte units per byte.
+const calldataGasPerByte = 16
+
+var (
+	familiesMu         sync.RWMutex
+	familiesBySelector = map[uint64]Family{
+		chainselectors.ETHEREUM_MAINNET_ARBITRUM_1.Selector:         Arbitrum,
+		chainselectors.ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1.Selector: Arbitrum,
+		chainselectors.ETHEREUM_MAINNET_OPTIMISM_1.Selector:         Optimism,
+		chainselectors.ETHEREUM_TESTNET_SEPOLIA_OPTIMISM_1.Selector: Optimism,
+		chainselectors.ETHEREUM_MAINNET_BASE_1.Selector:             Optimism,
+		chainselectors.ETHEREUM_TESTNET_SEPOLIA_BASE_1.Selector:     Optimism,
+		chainselectors.ETHEREUM_MAINNET_SCROLL_1.Selector:           Scroll,
+		chainselectors.ETHEREUM_TESTNET_SEPOLIA_SCROLL_1.Selector:   Scroll,
+	}
+)
+
+// RegisterFamily registers the rollup family of the chain with the given selector, overriding any previous registration.
+func RegisterFamily(chainSelector uint64, family Family) {
+	familiesMu.Lock()
+	defer familiesMu.Unlock()
+	familiesBySelector[chainSelector] = family
+}
+
+// FamilyOf returns the rollup family registered for the chain with the given selector.
+func FamilyOf(chainSelector uint64) (Family, bool) {
+	familiesMu.RLock()
+	defer familiesMu.RUnlock()
+	family, ok := familiesBySelector[chainSelector]
+	return family, ok
+}
+
+// NewInterceptor returns the data availability gas price interceptor of the rollup family registered for the chain
+// with the given selector, or nil when the chain is not a registered rollup.
+func NewInterceptor(ctx context.Context, client evmClient.Client, chainSelector uint64) (estimatorconfig.GasPriceInterceptor, error) {
+	family, ok := FamilyOf(chainSelector)
+	if !ok {
+		return nil, nil
+	}
+	switch family {
+	case Arbitrum:
+		return NewArbitrumInterceptor(ctx, client)
+	case Optimism:
+		return NewOptimismInterceptor(ctx, client)
+	case Scroll:
+		return NewScrollInterceptor(ctx, client)
+	default:
+		return nil, fmt.Errorf("unsupported rollup family %q for chain selector %d", family, chainSelector)
+	}
+}
+
+// oracleCaller calls the no-argument view methods of an L1 gas price oracle deployed on the rollup.
+type oracleCaller struct {
+	client        evmClient.Client
+	oracleAddress common.Address
+	abi           abi.ABI
+}
+
+func newOracleCaller(client evmClient.Client, oracleAddress common.Address, abiString string) (*oracleCaller, error) {
+	oracleAbi, err := abi.JSON(strings.NewReader(abiString))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse gas price oracle ABI: %w", err)
+	}
+	return &oracleCaller{
+		client:        client,
+		oracleAddress: oracleAddress,
+		abi:           oracleAbi,
+	}, nil
+}
+
+// call invokes the method and returns its unpacked outputs.
+func (c *oracleCaller) call(ctx context.Context, method string) ([]interface{}, error) {
+	callData, err := c.abi.Pack(method)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s() calldata: %w", method, err)
+	}
+	res, err := c.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &c.oracleAddress,
+		Data: callData,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s() call to %s failed: %w", method, c.oracleAddress, err)
+	}
+	outputs, err := c.abi.Unpack(method, res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s() result: %w", method, err)
+	}
+	return outputs, nil
+}
+
+// callBigInt invokes the method and returns its single numeric output.
+func (c *oracleCaller) callBigInt(ctx context.Context, method string) (*big.Int, error) {
+	outputs, err := c.call(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	return toBigInt(method, outputs, 0)
+}
+
+func toBigInt(method string, outputs []interface{}, idx int) (*big.Int, error) {
+	if len(outputs) <= idx {
+		return nil, fmt.Errorf("%s() returned %d outputs, expected at least %d", method, len(outputs), idx+1)
+	}
+	switch v := outputs[idx].(type) {
+	case *big.Int:
+		return v, nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	default:
+		return nil, fmt.Errorf("%s() returned unexpected output type %T", method, outputs[idx])
+	}
+}
@@ -0,0 +1,122 @@
+package rollups
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+)
+
+// expectCall mocks a call of the no-argument method on the oracle, returning the given words.
+func expectCall(ethClient *clienttest.Client, oracleAddress common.Address, method string, words ...int64) {
+	selector := crypto.Keccak256([]byte(method + "()"))[:4]
+	var res []byte
+	for _, word := range words {
+		res = append(res, common.BigToHash(big.NewInt(word)).Bytes()...)
+	}
+	ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return *msg.To == oracleAddress && common.Bytes2Hex(msg.Data) == common.Bytes2Hex(selector)
+	}), mock.IsType(&big.Int{})).Return(res, nil).Once()
+}
+
+func TestNewInterceptor(t *testing.T) {
+	ctx := context.Background()
+	ethClient := clienttest.NewClient(t)
+
+	interceptor, err := NewInterceptor(ctx, ethClient, chainselectors.ETHEREUM_MAINNET_ARBITRUM_1.Selector)
+	require.NoError(t, err)
+	require.IsType(t, &ArbitrumInterceptor{}, interceptor)
+
+	interceptor, err = NewInterceptor(ctx, ethClient, chainselectors.ETHEREUM_MAINNET_BASE_1.Selector)
+	require.NoError(t, err)
+	require.IsType(t, &OptimismInterceptor{}, interceptor)
+
+	interceptor, err = NewInterceptor(ctx, ethClient, chainselectors.ETHEREUM_MAINNET_SCROLL_1.Selector)
+	require.NoError(t, err)
+	require.IsType(t, &ScrollInterceptor{}, interceptor)
+
+	interceptor, err = NewInterceptor(ctx, ethClient, chainselectors.ETHEREUM_MAINNET.Selector)
+	require.NoError(t, err)
+	require.Nil(t, interceptor)
+
+	const customSelector = uint64(1234567)
+	RegisterFamily(customSelector, Scroll)
+	family, ok := FamilyOf(customSelector)
+	require.True(t, ok)
+	require.Equal(t, Scroll, family)
+
+	RegisterFamily(customSelector, Family("unknown"))
+	_, err = NewInterceptor(ctx, ethClient, customSelector)
+	require.Error(t, err)
+}
+
+func TestArbitrumInterceptor(t *testing.T) {
+	ctx := context.Background()
+	ethClient := clienttest.NewClient(t)
+	interceptor, err := NewArbitrumInterceptor(ctx, ethClient)
+	require.NoError(t, err)
+
+	expectCall(ethClient, arbitrumGasInfoAddress, arbitrumGetPricesInWeiMethod, 1, 1600, 3, 4, 5, 6)
+
+	execGasPrice, daGasPrice, err := interceptor.ModifyGasPriceComponents(ctx, big.NewInt(10), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), execGasPrice.Int64())
+	require.Equal(t, int64(100), daGasPrice.Int64())
+}
+
+func TestOptimismInterceptor(t *testing.T) {
+	ctx := context.Background()
+	ethClient := clienttest.NewClient(t)
+	interceptor, err := NewOptimismInterceptor(ctx, ethClient)
+	require.NoError(t, err)
+
+	expectCall(ethClient, optimismGasPriceOracleAddress, optimismL1BaseFeeMethod, 20e9)
+	expectCall(ethClient, optimismGasPriceOracleAddress, optimismBlobBaseFeeMethod, 1e9)
+	expectCall(ethClient, optimismGasPriceOracleAddress, optimismBaseFeeScalarMethod, 1368)
+	expectCall(ethClient, optimismGasPriceOracleAddress, optimismBlobBaseFeeScalarMethod, 810949)
+
+	execGasPrice, daGasPrice, err := interceptor.ModifyGasPriceComponents(ctx, big.NewInt(10), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), execGasPrice.Int64())
+	// (16 * 1368 * 20e9 + 810949 * 1e9) / (16 * 1e6)
+	require.Equal(t, int64(78044312), daGasPrice.Int64())
+}
+
+func TestScrollInterceptor(t *testing.T) {
+	ctx := context.Background()
+	ethClient := clienttest.NewClient(t)
+	interceptor, err := NewScrollInterceptor(ctx, ethClient)
+	require.NoError(t, err)
+
+	expectCall(ethClient, scrollL1GasPriceOracleAddress, scrollL1BlobBaseFeeMethod, 2e9)
+	expectCall(ethClient, scrollL1GasPriceOracleAddress, scrollBlobScalarMethod, 40e9)
+
+	execGasPrice, daGasPrice, err := interceptor.ModifyGasPriceComponents(ctx, big.NewInt(10), big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), execGasPrice.Int64())
+	// 2e9 * 40e9 / (16 * 1e9)
+	require.Equal(t, int64(5e9), daGasPrice.Int64())
+}
+
+func TestInterceptorCallError(t *testing.T) {
+	ctx := context.Background()
+	ethClient := clienttest.NewClient(t)
+	interceptor, err := NewScrollInterceptor(ctx, ethClient)
+	require.NoError(t, err)
+
+	ethClient.On("CallContract", ctx, mock.IsType(ethereum.CallMsg{}), mock.IsType(&big.Int{})).
+		Return(nil, errors.New("rpc down")).Once()
+
+	_, _, err = interceptor.ModifyGasPriceComponents(ctx, big.NewInt(10), big.NewInt(1))
+	require.ErrorContains(t, err, "rpc down")
+}
@@ -0,0 +1,57 @@
+package rollups
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmClient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+)
+
+const (
+	optimismL1BaseFeeMethod           = "l1BaseFee"
+	optimismBlobBaseFeeMethod         = "blobBaseFee"
+	optimismBaseFeeScalarMethod       = "baseFeeScalar"
+	optimismBlobBaseFeeScalarMethod   = "blobBaseFeeScalar"
+	optimismGasPriceOracleAbiString   = `[{"inputs":[],"name":"l1BaseFee","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"blobBaseFee","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"baseFeeScalar","outputs":[{"internalType":"uint32","name":"","type":"uint32"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"blobBaseFeeScalar","outputs":[{"internalType":"uint32","name":"","type":"uint32"}],"stateMutability":"view","type":"function"}]`
+	optimismScalarDecimalsDenominator = 1_000_000
+)
+
+// optimismGasPriceOracleAddress is the address of the GasPriceOracle predeploy of the OP stack chains.
+var optimismGasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+
+// OptimismInterceptor prices the data availability gas with the Ecotone L1 fee formula of the OP stack GasPriceOracle,
+// which charges both the L1 base fee and the blob base fee weighted by their scalars.
+type OptimismInterceptor struct {
+	oracle *oracleCaller
+}
+
+func NewOptimismInterceptor(_ context.Context, client evmClient.Client) (*OptimismInterceptor, error) {
+	oracle, err := newOracleCaller(client, optimismGasPriceOracleAddress, optimismGasPriceOracleAbiString)
+	if err != nil {
+		return nil, err
+	}
+	return &OptimismInterceptor{oracle: oracle}, nil
+}
+
+// ModifyGasPriceComponents replaces the data availability gas price with the Ecotone L1 fee per unit of calldata gas,
+// (16 * baseFeeScalar * l1BaseFee + blobBaseFeeScalar * blobBaseFee) / (16 * 1e6).
+func (i *OptimismInterceptor) ModifyGasPriceComponents(ctx context.Context, execGasPrice, _ *big.Int) (*big.Int, *big.Int, error) {
+	values := make(map[string]*big.Int, 4)
+	for _, method := range []string{optimismL1BaseFeeMethod, optimismBlobBaseFeeMethod, optimismBaseFeeScalarMethod, optimismBlobBaseFeeScalarMethod} {
+		value, err := i.oracle.callBigInt(ctx, method)
+		if err != nil {
+			return nil, nil, err
+		}
+		values[method] = value
+	}
+
+	l1Fee := new(big.Int).Mul(values[optimismL1BaseFeeMethod], values[optimismBaseFeeScalarMethod])
+	l1Fee.Mul(l1Fee, big.NewInt(calldataGasPerByte))
+	blobFee := new(big.Int).Mul(values[optimismBlobBaseFeeMethod], values[optimismBlobBaseFeeScalarMethod])
+
+	daGasPrice := new(big.Int).Add(l1Fee, blobFee)
+	daGasPrice.Div(daGasPrice, big.NewInt(calldataGasPerByte*optimismScalarDecimalsDenominator))
+	return execGasPrice, daGasPrice, nil
+}
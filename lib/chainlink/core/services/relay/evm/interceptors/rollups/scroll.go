@@ -0,0 +1,52 @@
+package rollups
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmClient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+)
+
+const (
+	scrollL1BlobBaseFeeMethod       = "l1BlobBaseFee"
+	scrollBlobScalarMethod          = "blobScalar"
+	scrollL1GasPriceOracleAbiString = `[{"inputs":[],"name":"l1BlobBaseFee","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"blobScalar","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+	scrollScalarPrecision           = 1_000_000_000
+)
+
+// scrollL1GasPriceOracleAddress is the address of the L1GasPriceOracle predeploy of Scroll.
+var scrollL1GasPriceOracleAddress = common.HexToAddress("0x5300000000000000000000000000000000000002")
+
+// ScrollInterceptor prices the data availability gas with the Curie L1 fee formula of the Scroll L1GasPriceOracle.
+// Only the per byte blob cost is priced, the fixed commit cost of a transaction is covered by the data availability
+// overhead gas of the onRamp.
+type ScrollInterceptor struct {
+	oracle *oracleCaller
+}
+
+func NewScrollInterceptor(_ context.Context, client evmClient.Client) (*ScrollInterceptor, error) {
+	oracle, err := newOracleCaller(client, scrollL1GasPriceOracleAddress, scrollL1GasPriceOracleAbiString)
+	if err != nil {
+		return nil, err
+	}
+	return &ScrollInterceptor{oracle: oracle}, nil
+}
+
+// ModifyGasPriceComponents replaces the data availability gas price with the L1 blob fee per unit of calldata gas,
+// blobScalar * l1BlobBaseFee / (16 * 1e9).
+func (i *ScrollInterceptor) ModifyGasPriceComponents(ctx context.Context, execGasPrice, _ *big.Int) (*big.Int, *big.Int, error) {
+	blobBaseFee, err := i.oracle.callBigInt(ctx, scrollL1BlobBaseFeeMethod)
+	if err != nil {
+		return nil, nil, err
+	}
+	blobScalar, err := i.oracle.callBigInt(ctx, scrollBlobScalarMethod)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	daGasPrice := new(big.Int).Mul(blobBaseFee, blobScalar)
+	daGasPrice.Div(daGasPrice, big.NewInt(calldataGasPerByte*scrollScalarPrecision))
+	return execGasPrice, daGasPrice, nil
+}
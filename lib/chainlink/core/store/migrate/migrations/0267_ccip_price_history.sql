@@ -0,0 +1,30 @@
+-- +goose Up
+
+-- Append-only history of the prices written into ccip.observed_gas_prices and ccip.observed_token_prices
+CREATE TABLE ccip.observed_gas_prices_history
+(
+    id                    BIGSERIAL PRIMARY KEY,
+    chain_selector        NUMERIC(20, 0) NOT NULL,
+    source_chain_selector NUMERIC(20, 0) NOT NULL,
+    gas_price             NUMERIC(78, 0) NOT NULL,
+    created_at            TIMESTAMPTZ    NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE ccip.observed_token_prices_history
+(
+    id             BIGSERIAL PRIMARY KEY,
+    chain_selector NUMERIC(20, 0) NOT NULL,
+    token_addr     BYTEA          NOT NULL,
+    token_price    NUMERIC(78, 0) NOT NULL,
+    created_at     TIMESTAMPTZ    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_ccip_gas_prices_history_timestamp ON ccip.observed_gas_prices_history (chain_selector, source_chain_selector, created_at);
+CREATE INDEX idx_ccip_token_prices_history_timestamp ON ccip.observed_token_prices_history (chain_selector, token_addr, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS ccip.idx_ccip_token_prices_history_timestamp;
+DROP INDEX IF EXISTS ccip.idx_ccip_gas_prices_history_timestamp;
+
+DROP TABLE ccip.observed_token_prices_history;
+DROP TABLE ccip.observed_gas_prices_history;
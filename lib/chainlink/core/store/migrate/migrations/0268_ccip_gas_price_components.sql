@@ -0,0 +1,13 @@
+-- +goose Up
+
+-- Execution and data availability components of the encoded gas prices, NULL for rows written before the breakdown
+ALTER TABLE ccip.observed_gas_prices ADD COLUMN exec_gas_price NUMERIC(78, 0);
+ALTER TABLE ccip.observed_gas_prices ADD COLUMN da_gas_price NUMERIC(78, 0);
+ALTER TABLE ccip.observed_gas_prices_history ADD COLUMN exec_gas_price NUMERIC(78, 0);
+ALTER TABLE ccip.observed_gas_prices_history ADD COLUMN da_gas_price NUMERIC(78, 0);
+
+-- +goose Down
+ALTER TABLE ccip.observed_gas_prices_history DROP COLUMN da_gas_price;
+ALTER TABLE ccip.observed_gas_prices_history DROP COLUMN exec_gas_price;
+ALTER TABLE ccip.observed_gas_prices DROP COLUMN da_gas_price;
+ALTER TABLE ccip.observed_gas_prices DROP COLUMN exec_gas_price;
@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Consolidated gas and token prices, meant to replace ccip.observed_gas_prices and ccip.observed_token_prices.
+-- asset is the source chain selector for gas prices and the token address for token prices.
+CREATE TABLE ccip.observed_prices
+(
+    chain_selector NUMERIC(20, 0) NOT NULL,
+    asset_type     TEXT           NOT NULL CHECK (asset_type IN ('gas', 'token')),
+    asset          TEXT           NOT NULL,
+    price          NUMERIC(78, 0) NOT NULL,
+    updated_at     TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chain_selector, asset_type, asset)
+);
+
+-- +goose Down
+DROP TABLE ccip.observed_prices;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Notifies listeners of the dest chain selector whenever its prices are written, so that readers can refresh
+-- their cached prices instead of polling. Identical notifications within a transaction are delivered only once.
+CREATE FUNCTION ccip.notify_price_updates() RETURNS trigger
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    PERFORM pg_notify('ccip_price_updates'::text, NEW.chain_selector::text);
+    RETURN NULL;
+END
+$$;
+
+CREATE TRIGGER notify_gas_price_updates AFTER INSERT OR UPDATE ON ccip.observed_gas_prices FOR EACH ROW EXECUTE PROCEDURE ccip.notify_price_updates();
+CREATE TRIGGER notify_token_price_updates AFTER INSERT OR UPDATE ON ccip.observed_token_prices FOR EACH ROW EXECUTE PROCEDURE ccip.notify_price_updates();
+CREATE TRIGGER notify_price_updates AFTER INSERT OR UPDATE ON ccip.observed_prices FOR EACH ROW EXECUTE PROCEDURE ccip.notify_price_updates();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS notify_price_updates ON ccip.observed_prices;
+DROP TRIGGER IF EXISTS notify_token_price_updates ON ccip.observed_token_prices;
+DROP TRIGGER IF EXISTS notify_gas_price_updates ON ccip.observed_gas_prices;
+DROP FUNCTION IF EXISTS ccip.notify_price_updates();
+-- +goose StatementEnd
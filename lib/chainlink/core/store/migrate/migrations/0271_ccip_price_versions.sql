@@ -0,0 +1,12 @@
+-- +goose Up
+
+-- Every gas and token price write takes a new version from the shared sequence, so that readers can tell whether
+-- prices were written while they were reading them.
+CREATE SEQUENCE ccip.observed_price_version_seq;
+ALTER TABLE ccip.observed_gas_prices ADD COLUMN version BIGINT NOT NULL DEFAULT nextval('ccip.observed_price_version_seq');
+ALTER TABLE ccip.observed_token_prices ADD COLUMN version BIGINT NOT NULL DEFAULT nextval('ccip.observed_price_version_seq');
+
+-- +goose Down
+ALTER TABLE ccip.observed_token_prices DROP COLUMN version;
+ALTER TABLE ccip.observed_gas_prices DROP COLUMN version;
+DROP SEQUENCE ccip.observed_price_version_seq;
@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Human-readable metadata of the tokens priced for a dest chain, shared by all lanes of the dest chain.
+CREATE TABLE ccip.token_metadata
+(
+    chain_selector NUMERIC(20, 0) NOT NULL,
+    token_addr     BYTEA          NOT NULL,
+    symbol         TEXT           NOT NULL,
+    decimals       SMALLINT       NOT NULL,
+    coingecko_id   TEXT           NOT NULL DEFAULT '',
+    updated_at     TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chain_selector, token_addr)
+);
+
+-- +goose Down
+DROP TABLE ccip.token_metadata;
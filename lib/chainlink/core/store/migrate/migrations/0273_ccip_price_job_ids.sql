@@ -0,0 +1,20 @@
+-- +goose Up
+
+-- Every gas and token price row records the job which last wrote it, so that disagreeing lanes of a dest chain can be
+-- told apart and the prices of deleted jobs can be cleaned up. Rows written before this migration have no job.
+ALTER TABLE ccip.observed_gas_prices ADD COLUMN job_id INTEGER;
+ALTER TABLE ccip.observed_token_prices ADD COLUMN job_id INTEGER;
+ALTER TABLE ccip.observed_gas_prices_history ADD COLUMN job_id INTEGER;
+ALTER TABLE ccip.observed_token_prices_history ADD COLUMN job_id INTEGER;
+
+CREATE INDEX idx_ccip_gas_prices_job_id ON ccip.observed_gas_prices (job_id);
+CREATE INDEX idx_ccip_token_prices_job_id ON ccip.observed_token_prices (job_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS ccip.idx_ccip_token_prices_job_id;
+DROP INDEX IF EXISTS ccip.idx_ccip_gas_prices_job_id;
+
+ALTER TABLE ccip.observed_token_prices_history DROP COLUMN job_id;
+ALTER TABLE ccip.observed_gas_prices_history DROP COLUMN job_id;
+ALTER TABLE ccip.observed_token_prices DROP COLUMN job_id;
+ALTER TABLE ccip.observed_gas_prices DROP COLUMN job_id;
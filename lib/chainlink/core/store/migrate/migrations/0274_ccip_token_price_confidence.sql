@@ -0,0 +1,13 @@
+-- +goose Up
+
+-- Confidence in the token prices as reported by the price getter, NULL for prices without a known confidence
+ALTER TABLE ccip.observed_token_prices ADD COLUMN confidence DOUBLE PRECISION;
+ALTER TABLE ccip.observed_token_prices ADD COLUMN source_count INTEGER;
+ALTER TABLE ccip.observed_token_prices_history ADD COLUMN confidence DOUBLE PRECISION;
+ALTER TABLE ccip.observed_token_prices_history ADD COLUMN source_count INTEGER;
+
+-- +goose Down
+ALTER TABLE ccip.observed_token_prices_history DROP COLUMN source_count;
+ALTER TABLE ccip.observed_token_prices_history DROP COLUMN confidence;
+ALTER TABLE ccip.observed_token_prices DROP COLUMN source_count;
+ALTER TABLE ccip.observed_token_prices DROP COLUMN confidence;
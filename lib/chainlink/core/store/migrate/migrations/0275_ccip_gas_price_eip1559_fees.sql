@@ -0,0 +1,13 @@
+-- +goose Up
+
+-- EIP-1559 base fee and priority fee making up the execution gas price, NULL when the gas price estimator doesn't know them
+ALTER TABLE ccip.observed_gas_prices ADD COLUMN base_fee NUMERIC(78, 0);
+ALTER TABLE ccip.observed_gas_prices ADD COLUMN priority_fee NUMERIC(78, 0);
+ALTER TABLE ccip.observed_gas_prices_history ADD COLUMN base_fee NUMERIC(78, 0);
+ALTER TABLE ccip.observed_gas_prices_history ADD COLUMN priority_fee NUMERIC(78, 0);
+
+-- +goose Down
+ALTER TABLE ccip.observed_gas_prices_history DROP COLUMN priority_fee;
+ALTER TABLE ccip.observed_gas_prices_history DROP COLUMN base_fee;
+ALTER TABLE ccip.observed_gas_prices DROP COLUMN priority_fee;
+ALTER TABLE ccip.observed_gas_prices DROP COLUMN base_fee;
@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- Native gas price the USD gas price was converted from, NULL when not known
+ALTER TABLE ccip.observed_gas_prices ADD COLUMN gas_price_wei NUMERIC(78, 0);
+ALTER TABLE ccip.observed_gas_prices_history ADD COLUMN gas_price_wei NUMERIC(78, 0);
+
+-- +goose Down
+ALTER TABLE ccip.observed_gas_prices_history DROP COLUMN gas_price_wei;
+ALTER TABLE ccip.observed_gas_prices DROP COLUMN gas_price_wei;
@@ -0,0 +1,53 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+// CCIPPricesController dumps the CCIP gas and token prices of this node.
+type CCIPPricesController struct {
+	App chainlink.Application
+}
+
+// Show returns the price snapshots of all dest chains fed by the CCIP commit plugins running on this node, so that
+// the price views of the nodes of a DON can be compared independently of OCR.
+// Example:
+// "<application>/debug/ccip/prices"
+func (cpc *CCIPPricesController) Show(c *gin.Context) {
+	snapshots, err := cpc.App.GetCCIPPriceServices().GetPriceSnapshots(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, snapshots)
+}
+
+// Simulate eth_calls the price updates the CCIP commit plugins running on this node would submit with the current DB
+// prices, reporting the revert reason of every lane whose price update would revert.
+// Example:
+// "<application>/debug/ccip/prices/simulation"
+func (cpc *CCIPPricesController) Simulate(c *gin.Context) {
+	simulations, err := cpc.App.GetCCIPPriceServices().SimulatePriceUpdates(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, simulations)
+}
+
+// Leaders returns which lanes and jobs wrote the current prices of every dest chain fed by the CCIP commit plugins
+// running on this node, the job which wrote the most recently updated price being the price leader of the dest chain.
+// Example:
+// "<application>/debug/ccip/prices/leaders"
+func (cpc *CCIPPricesController) Leaders(c *gin.Context) {
+	leaders, err := cpc.App.GetCCIPPriceServices().GetPriceLeaders(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, leaders)
+}
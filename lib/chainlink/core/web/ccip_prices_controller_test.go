@@ -0,0 +1,58 @@
+package web_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestCCIPPricesController_Show(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	// No commit plugins are running, there are no dest chains to report
+	resp, cleanup := client.Get("/debug/ccip/prices")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	assert.Equal(t, "[]", strings.TrimSpace(string(cltest.ParseResponseBody(t, resp))))
+}
+
+func TestCCIPPricesController_Simulate(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	// No commit plugins are running, there are no price updates to simulate
+	resp, cleanup := client.Get("/debug/ccip/prices/simulation")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	assert.Equal(t, "[]", strings.TrimSpace(string(cltest.ParseResponseBody(t, resp))))
+}
+
+func TestCCIPPricesController_Leaders(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	// No commit plugins are running, there are no dest chains to report
+	resp, cleanup := client.Get("/debug/ccip/prices/leaders")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	assert.Equal(t, "[]", strings.TrimSpace(string(cltest.ParseResponseBody(t, resp))))
+}
@@ -176,6 +176,11 @@ func secureMiddleware(tlsRedirect bool, tlsHost string, devWebServer bool) gin.H
 func debugRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	group := r.Group("/debug", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
 	group.GET("/vars", expvar.Handler())
+
+	cpc := CCIPPricesController{app}
+	group.GET("/ccip/prices", cpc.Show)
+	group.GET("/ccip/prices/simulation", cpc.Simulate)
+	group.GET("/ccip/prices/leaders", cpc.Leaders)
 }
 
 func metricRoutes(r *gin.RouterGroup, includeHeap bool) {
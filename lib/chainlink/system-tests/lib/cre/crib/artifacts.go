@@ -0,0 +1,147 @@
+package crib
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+)
+
+const (
+	donDeploymentEnvFile    = "deploy-don-env.json"
+	donDeploymentOutputFile = "deploy-don-output.log"
+
+	redacted = "<redacted>"
+)
+
+var (
+	secretsOverrideFile = regexp.MustCompile(`^secrets-override-(bt-)?\d+\.toml$`)
+	sensitiveKey        = regexp.MustCompile(`(?i)(secret|password|passwd|token|private|credential|api_?key)`)
+)
+
+// recordDonDeployment writes the env vars and the output of the deploy-don command to the configs dir of the DON
+func recordDonDeployment(donConfigsDir string, envVars map[string]string, output string) error {
+	envVarsJSON, err := json.MarshalIndent(envVars, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal env vars")
+	}
+	if err := os.WriteFile(filepath.Join(donConfigsDir, donDeploymentEnvFile), envVarsJSON, 0600); err != nil {
+		return errors.Wrap(err, "failed to write env vars")
+	}
+	if err := os.WriteFile(filepath.Join(donConfigsDir, donDeploymentOutputFile), []byte(output), 0600); err != nil {
+		return errors.Wrap(err, "failed to write devspace output")
+	}
+
+	return nil
+}
+
+// BundleArtifacts copies the CRIB configs dir, i.e. the config and secrets overrides, the env vars and outputs of
+// the devspace commands and the URLs of the deployed services, to a timestamped directory in artifactsDir, so that
+// a deployment can be reproduced locally. Secrets are redacted. It returns the path of the bundle.
+func BundleArtifacts(cribConfigsDir, artifactsDir string) (string, error) {
+	bundleDir := filepath.Join(artifactsDir, "crib-"+time.Now().UTC().Format("20060102T150405Z"))
+	if err := os.MkdirAll(bundleDir, os.ModePerm); err != nil {
+		return "", errors.Wrapf(err, "failed to create artifacts dir %s", bundleDir)
+	}
+
+	walkErr := filepath.WalkDir(cribConfigsDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		relPath, relErr := filepath.Rel(cribConfigsDir, path)
+		if relErr != nil {
+			return relErr
+		}
+
+		content, readErr := os.ReadFile(path)
+		if readErr != nil {
+			return readErr
+		}
+
+		destination := filepath.Join(bundleDir, relPath)
+		if mkdirErr := os.MkdirAll(filepath.Dir(destination), os.ModePerm); mkdirErr != nil {
+			return mkdirErr
+		}
+
+		return os.WriteFile(destination, redactArtifact(entry.Name(), content), 0600)
+	})
+	if walkErr != nil {
+		return "", errors.Wrapf(walkErr, "failed to bundle %s", cribConfigsDir)
+	}
+
+	return bundleDir, nil
+}
+
+// redactArtifact replaces all values of secrets overrides and the sensitive values of JSON files (e.g. API
+// credentials) with a placeholder, keeping their keys, so that the structure of the bundled files stays intact
+func redactArtifact(fileName string, content []byte) []byte {
+	switch {
+	case secretsOverrideFile.MatchString(fileName):
+		return redactTOML(content)
+	case strings.HasSuffix(fileName, ".json"):
+		return redactJSON(content)
+	default:
+		return content
+	}
+}
+
+func redactTOML(content []byte) []byte {
+	var data map[string]any
+	if err := toml.Unmarshal(content, &data); err != nil {
+		return []byte(fmt.Sprintf("# %s, failed to parse: %s\n", redacted, err))
+	}
+
+	redactedTOML, err := toml.Marshal(redactValues(data, true))
+	if err != nil {
+		return []byte(fmt.Sprintf("# %s, failed to marshal: %s\n", redacted, err))
+	}
+
+	return redactedTOML
+}
+
+func redactJSON(content []byte) []byte {
+	var data any
+	if err := json.Unmarshal(content, &data); err != nil {
+		// not JSON after all, nothing known to redact
+		return content
+	}
+
+	redactedJSON, err := json.MarshalIndent(redactValues(data, false), "", "  ")
+	if err != nil {
+		return []byte(redacted)
+	}
+
+	return redactedJSON
+}
+
+// redactValues redacts all leaf values, or only the ones with a sensitive key, if all is false
+func redactValues(value any, all bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			v[key] = redactValues(nested, all || sensitiveKey.MatchString(key))
+		}
+		return v
+	case []any:
+		for i, nested := range v {
+			v[i] = redactValues(nested, all)
+		}
+		return v
+	default:
+		if all {
+			return redacted
+		}
+		return v
+	}
+}
@@ -0,0 +1,253 @@
+package crib
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+const capabilitiesArchiveDestination = "/tmp/capabilities.tar"
+
+var sha256SumLine = regexp.MustCompile(`^([0-9a-f]{64})\s+\*?(\S+)$`)
+
+// copyCapabilities copies capability binaries to all pods of the node set, whose names match podNamePattern
+func copyCapabilities(input *types.DeployCribDonsInput, nodeSetName, podNamePattern, destinationDir string, capabilities []string) error {
+	if input.CapabilitiesCopyMode == types.CribCapabilitiesCopyArchive {
+		return copyCapabilitiesArchive(input, nodeSetName, podNamePattern, destinationDir, capabilities)
+	}
+
+	for _, capability := range capabilities {
+		absSource, pathErr := filepath.Abs(capability)
+		if pathErr != nil {
+			return errors.Wrapf(pathErr, "failed to get absolute path to capability %s", capability)
+		}
+
+		destination := filepath.Join(destinationDir, filepath.Base(capability))
+		// copying overwrites the destination, so it's safe to run it again
+		_, copyErr := runWithRetry(input.NixShell, input.RetryPolicy, retryableCommand{
+			command: fmt.Sprintf("devspace run copy-to-pods --no-warn --var POD_NAME_PATTERN=%s --var SOURCE=%s --var DESTINATION=%s", podNamePattern, absSource, destination),
+		})
+		if copyErr != nil {
+			return errors.Wrap(copyErr, "failed to copy capability to pods")
+		}
+	}
+
+	return nil
+}
+
+// copyCapabilitiesArchive bundles all capability binaries into a single tar archive, which is copied once to every pod
+// and extracted there. Binaries, whose checksum matches the one already present in the pod, are not extracted, and pods
+// that already have all of them are skipped altogether (e.g. when redeploying a DON).
+func copyCapabilitiesArchive(input *types.DeployCribDonsInput, nodeSetName, podNamePattern, destinationDir string, capabilities []string) error {
+	if len(capabilities) == 0 {
+		return nil
+	}
+
+	checksums := make(map[string]string, len(capabilities))
+	for _, capability := range capabilities {
+		name := filepath.Base(capability)
+		if _, ok := checksums[name]; ok {
+			return fmt.Errorf("capabilities of nodeset %s contain more than one binary named %s", nodeSetName, name)
+		}
+		checksum, err := fileSHA256(capability)
+		if err != nil {
+			return errors.Wrapf(err, "failed to calculate checksum of capability %s", capability)
+		}
+		checksums[name] = checksum
+	}
+
+	pods, err := listNodeSetPods(input.NixShell, nodeSetName)
+	if err != nil {
+		return err
+	}
+
+	outdated := make(map[string][]string, len(pods))
+	for _, pod := range pods {
+		podChecksums, checksumErr := podFileChecksums(input, pod, destinationDir, capabilities)
+		if checksumErr != nil {
+			return checksumErr
+		}
+		for name, checksum := range checksums {
+			if podChecksums[name] != checksum {
+				outdated[pod] = append(outdated[pod], name)
+			}
+		}
+	}
+
+	if len(outdated) == 0 {
+		fmt.Printf("all %d pods of nodeset %s already have up-to-date capabilities, skipping copying them\n", len(pods), nodeSetName)
+		return nil
+	}
+
+	archive, err := writeCapabilitiesArchive(capabilities)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create capabilities archive for nodeset %s", nodeSetName)
+	}
+	defer os.Remove(archive)
+
+	// copying overwrites the destination, so it's safe to run it again
+	_, copyErr := runWithRetry(input.NixShell, input.RetryPolicy, retryableCommand{
+		command: fmt.Sprintf("devspace run copy-to-pods --no-warn --var POD_NAME_PATTERN=%s --var SOURCE=%s --var DESTINATION=%s", podNamePattern, archive, capabilitiesArchiveDestination),
+	})
+	if copyErr != nil {
+		return errors.Wrap(copyErr, "failed to copy capabilities archive to pods")
+	}
+
+	for _, pod := range pods {
+		names := outdated[pod]
+		if len(names) == 0 {
+			continue
+		}
+		slices.Sort(names)
+
+		extract := fmt.Sprintf("mkdir -p %s && tar -xf %s -C %s %s && rm -f %s", shellQuote(destinationDir), capabilitiesArchiveDestination, shellQuote(destinationDir), strings.Join(names, " "), capabilitiesArchiveDestination)
+		_, extractErr := runWithRetry(input.NixShell, input.RetryPolicy, retryableCommand{
+			command: fmt.Sprintf(`kubectl exec -n "$DEVSPACE_NAMESPACE" %s -- sh -c %s`, pod, shellQuote(extract)),
+		})
+		if extractErr != nil {
+			return errors.Wrapf(extractErr, "failed to extract capabilities archive in pod %s", pod)
+		}
+	}
+
+	return nil
+}
+
+// listNodeSetPods returns the names of the pods of the node set, i.e. <nodeset name>-<node index>
+func listNodeSetPods(nixShell *nix.Shell, nodeSetName string) ([]string, error) {
+	pods, err := listPods(nixShell, nodeSetPodName(nodeSetName))
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("no pods found for nodeset %s", nodeSetName)
+	}
+
+	return pods, nil
+}
+
+func nodeSetPodName(nodeSetName string) *regexp.Regexp {
+	return regexp.MustCompile(`^` + regexp.QuoteMeta(nodeSetName) + `-\d+$`)
+}
+
+// listPods returns the names of the pods of the namespace matching the pattern
+func listPods(nixShell *nix.Shell, podName *regexp.Regexp) ([]string, error) {
+	output, err := nixShell.RunCommand(`kubectl get pods -n "$DEVSPACE_NAMESPACE" -o jsonpath='{range .items[*]}{.metadata.name}{"\n"}{end}'`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pods")
+	}
+
+	pods := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimSpace(line); podName.MatchString(line) {
+			pods = append(pods, line)
+		}
+	}
+
+	return pods, nil
+}
+
+// podFileChecksums returns the checksums of the capabilities present in the destination dir of the pod, keyed by
+// binary name. Missing binaries are not included.
+func podFileChecksums(input *types.DeployCribDonsInput, pod, destinationDir string, capabilities []string) (map[string]string, error) {
+	names := make([]string, 0, len(capabilities))
+	for _, capability := range capabilities {
+		names = append(names, filepath.Base(capability))
+	}
+
+	// sha256sum fails if some of the files are missing, but still prints the checksums of the others
+	checksum := fmt.Sprintf("cd %s 2>/dev/null && sha256sum %s 2>/dev/null; true", shellQuote(destinationDir), strings.Join(names, " "))
+	output, err := input.NixShell.RunCommand(fmt.Sprintf(`kubectl exec -n "$DEVSPACE_NAMESPACE" %s -- sh -c %s`, pod, shellQuote(checksum)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read checksums of capabilities in pod %s", pod)
+	}
+
+	checksums := map[string]string{}
+	for _, line := range strings.Split(output, "\n") {
+		if match := sha256SumLine.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			checksums[match[2]] = match[1]
+		}
+	}
+
+	return checksums, nil
+}
+
+// writeCapabilitiesArchive writes the binaries to a temporary tar archive, flat and executable, and returns its
+// absolute path
+func writeCapabilitiesArchive(capabilities []string) (string, error) {
+	archiveFile, err := os.CreateTemp("", "capabilities-*.tar")
+	if err != nil {
+		return "", err
+	}
+	defer archiveFile.Close()
+
+	tw := tar.NewWriter(archiveFile)
+	for _, capability := range capabilities {
+		if err := addFileToArchive(tw, capability); err != nil {
+			_ = os.Remove(archiveFile.Name())
+			return "", errors.Wrapf(err, "failed to add %s to archive", capability)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		_ = os.Remove(archiveFile.Name())
+		return "", err
+	}
+
+	return filepath.Abs(archiveFile.Name())
+}
+
+func addFileToArchive(tw *tar.Writer, fileName string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	header := &tar.Header{
+		Name:    filepath.Base(fileName),
+		Mode:    0o755,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tw, file)
+	return err
+}
+
+func fileSHA256(fileName string) (string, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,238 @@
+package crib
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+const (
+	chaosMeshAPIVersion = "chaos-mesh.org/v1alpha1"
+	networkChaosKind    = "NetworkChaos"
+	// statefulSetPodNameLabel is set by Kubernetes on every pod of a StatefulSet, which node sets are deployed as
+	statefulSetPodNameLabel = "statefulset.kubernetes.io/pod-name"
+)
+
+// ChaosSelector selects the pods targeted by a chaos experiment, pods have to match both the labels and the names
+type ChaosSelector struct {
+	Labels   map[string]string
+	PodNames []string
+}
+
+// NodesSelector selects the nodes of the node set with the given indexes, use Chaos.NodeSetSelector to select all of
+// them
+func NodesSelector(nodeSetName string, indexes ...int) ChaosSelector {
+	selector := ChaosSelector{}
+	for _, index := range indexes {
+		selector.PodNames = append(selector.PodNames, fmt.Sprintf("%s-%d", nodeSetName, index))
+	}
+
+	return selector
+}
+
+func (s ChaosSelector) validate() error {
+	if len(s.Labels) == 0 && len(s.PodNames) == 0 {
+		return errors.New("selector selects no pods, it needs labels or pod names")
+	}
+
+	return nil
+}
+
+// spec returns the selector in the format of chaos-mesh, selected pods are always in the namespace of the experiment
+func (s ChaosSelector) spec() map[string]any {
+	spec := map[string]any{}
+	if len(s.Labels) > 0 {
+		spec["labelSelectors"] = s.Labels
+	}
+	if len(s.PodNames) > 0 {
+		spec["expressionSelectors"] = []map[string]any{
+			{"key": statefulSetPodNameLabel, "operator": "In", "values": s.PodNames},
+		}
+	}
+
+	return spec
+}
+
+// NetworkThrottle degrades the network of the selected pods, zero values leave that aspect of the network as is
+type NetworkThrottle struct {
+	Latency time.Duration
+	Jitter  time.Duration
+	// Rate limits the bandwidth, e.g. "1mbps"
+	Rate string
+}
+
+// ChaosExperiment is a running chaos experiment, it ends after its duration or when it's stopped
+type ChaosExperiment struct {
+	nixShell *nix.Shell
+	names    []string
+}
+
+// Stop ends the experiment before its duration elapsed, it's a no-op for experiments which already ended
+func (e *ChaosExperiment) Stop() error {
+	if len(e.names) == 0 {
+		return nil
+	}
+
+	_, err := e.nixShell.RunCommand(fmt.Sprintf(`kubectl delete %s -n "$DEVSPACE_NAMESPACE" %s --ignore-not-found`, strings.ToLower(networkChaosKind), strings.Join(e.names, " ")))
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete chaos experiments %s", strings.Join(e.names, ", "))
+	}
+	e.names = nil
+
+	return nil
+}
+
+// Chaos injects faults into the pods deployed to the CRIB namespace, so that tests can exercise the fault tolerance
+// of DONs. Pods are killed with kubectl, network faults require chaos-mesh to be installed in the cluster.
+type Chaos struct {
+	nixShell *nix.Shell
+}
+
+func NewChaos(nixShell *nix.Shell) *Chaos {
+	return &Chaos{nixShell: nixShell}
+}
+
+// NodeSetSelector selects all nodes of the node set deployed to the namespace
+func (c *Chaos) NodeSetSelector(nodeSetName string) (ChaosSelector, error) {
+	pods, err := listNodeSetPods(c.nixShell, nodeSetName)
+	if err != nil {
+		return ChaosSelector{}, err
+	}
+
+	return ChaosSelector{PodNames: pods}, nil
+}
+
+// KillNode force deletes the pod of the node with the given index of the node set, without waiting for the
+// StatefulSet to recreate it
+func (c *Chaos) KillNode(nodeSetName string, index int) error {
+	if nodeSetName == "" {
+		return errors.New("node set name is empty")
+	}
+	if index < 0 {
+		return errors.New("node index must not be negative")
+	}
+
+	pod := fmt.Sprintf("%s-%d", nodeSetName, index)
+	_, err := c.nixShell.RunCommand(fmt.Sprintf(`kubectl delete pod -n "$DEVSPACE_NAMESPACE" %s --grace-period=0 --force --wait=false`, pod))
+	if err != nil {
+		return errors.Wrapf(err, "failed to kill pod %s", pod)
+	}
+
+	return nil
+}
+
+// PartitionNodes cuts the network between the pods selected by a and by b in both directions for the duration, zero
+// duration keeps them partitioned until the experiment is stopped
+func (c *Chaos) PartitionNodes(a, b ChaosSelector, duration time.Duration) (*ChaosExperiment, error) {
+	if err := a.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid selector a")
+	}
+	if err := b.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid selector b")
+	}
+
+	experiment := &ChaosExperiment{nixShell: c.nixShell}
+	name, err := c.applyNetworkChaos("partition", a, duration, map[string]any{
+		"direction": "both",
+		"target": map[string]any{
+			"mode":     "all",
+			"selector": b.spec(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	experiment.names = append(experiment.names, name)
+
+	return experiment, nil
+}
+
+// ThrottleNetwork adds latency to and/or limits the bandwidth of the pods selected for the duration, zero duration
+// keeps the network throttled until the experiment is stopped
+func (c *Chaos) ThrottleNetwork(selector ChaosSelector, throttle NetworkThrottle, duration time.Duration) (*ChaosExperiment, error) {
+	if valErr := selector.validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "invalid selector")
+	}
+	if throttle.Latency == 0 && throttle.Rate == "" {
+		return nil, errors.New("throttle has neither latency nor rate")
+	}
+	if throttle.Latency < 0 || throttle.Jitter < 0 {
+		return nil, errors.New("latency and jitter must not be negative")
+	}
+
+	experiment := &ChaosExperiment{nixShell: c.nixShell}
+
+	// chaos-mesh applies a single action per experiment, so latency and bandwidth are separate experiments
+	if throttle.Latency > 0 {
+		name, delayErr := c.applyNetworkChaos("delay", selector, duration, map[string]any{
+			"delay": map[string]any{
+				"latency": throttle.Latency.String(),
+				"jitter":  throttle.Jitter.String(),
+			},
+		})
+		if delayErr != nil {
+			return nil, delayErr
+		}
+		experiment.names = append(experiment.names, name)
+	}
+
+	if throttle.Rate != "" {
+		name, bandwidthErr := c.applyNetworkChaos("bandwidth", selector, duration, map[string]any{
+			"bandwidth": map[string]any{
+				"rate":   throttle.Rate,
+				"limit":  20971520,
+				"buffer": 10000,
+			},
+		})
+		if bandwidthErr != nil {
+			// don't leave half of the throttling behind
+			_ = experiment.Stop()
+			return nil, bandwidthErr
+		}
+		experiment.names = append(experiment.names, name)
+	}
+
+	return experiment, nil
+}
+
+// applyNetworkChaos creates a NetworkChaos experiment with the action and returns its name
+func (c *Chaos) applyNetworkChaos(action string, selector ChaosSelector, duration time.Duration, actionSpec map[string]any) (string, error) {
+	if duration < 0 {
+		return "", errors.New("duration must not be negative")
+	}
+
+	name := fmt.Sprintf("cre-%s-%d", action, time.Now().UnixNano())
+	spec := map[string]any{
+		"action":   action,
+		"mode":     "all",
+		"selector": selector.spec(),
+	}
+	for key, value := range actionSpec {
+		spec[key] = value
+	}
+	if duration > 0 {
+		spec["duration"] = duration.String()
+	}
+
+	manifest, err := json.Marshal(map[string]any{
+		"apiVersion": chaosMeshAPIVersion,
+		"kind":       networkChaosKind,
+		"metadata":   map[string]any{"name": name},
+		"spec":       spec,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal %s experiment", action)
+	}
+
+	_, err = c.nixShell.RunCommand(fmt.Sprintf(`echo %s | kubectl apply -n "$DEVSPACE_NAMESPACE" -f -`, shellQuote(string(manifest))))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create %s experiment, is chaos-mesh installed in the cluster?", action)
+	}
+
+	return name, nil
+}
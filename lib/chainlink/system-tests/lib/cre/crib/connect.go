@@ -0,0 +1,217 @@
+package crib
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+const (
+	defaultPortForwardReadyTimeout = 30 * time.Second
+	portForwardPollInterval        = 200 * time.Millisecond
+)
+
+// Connection holds port-forwards from localhost to services deployed in CRIB, so that tests can reach them from
+// outside the cluster network. The port-forwards run in the background of the nix shell until Close is called.
+type Connection struct {
+	// NodeURLs are the local URLs of the node APIs keyed by node set name, in the order of the nodes of the node set
+	NodeURLs map[string][]string
+	// JdGRPCURL is empty, if no JD output was given
+	JdGRPCURL string
+	// BlockchainHTTPURLs and BlockchainWSURLs are keyed by chain ID
+	BlockchainHTTPURLs map[string]string
+	BlockchainWSURLs   map[string]string
+
+	nixShell     *nix.Shell
+	readyTimeout time.Duration
+	// local addresses of the established port-forwards keyed by service and port, so that each is forwarded once
+	forwards map[string]string
+	pids     []string
+}
+
+// Connect establishes port-forwards to the internal URLs of the deployed node APIs, JD gRPC and chain RPCs and returns
+// their local URLs. Close the connection to stop the port-forwards.
+func Connect(input *types.ConnectCribInput) (conn *Connection, err error) {
+	if input == nil {
+		return nil, errors.New("ConnectCribInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	conn = &Connection{
+		NodeURLs:           map[string][]string{},
+		BlockchainHTTPURLs: map[string]string{},
+		BlockchainWSURLs:   map[string]string{},
+		nixShell:           input.NixShell,
+		readyTimeout:       input.ReadyTimeout,
+		forwards:           map[string]string{},
+	}
+	if conn.readyTimeout == 0 {
+		conn.readyTimeout = defaultPortForwardReadyTimeout
+	}
+	defer func() {
+		if err != nil {
+			_ = conn.Close()
+		}
+	}()
+
+	for _, nodeSet := range input.NodeSets {
+		if nodeSet.Out == nil {
+			return nil, fmt.Errorf("nodeset %s has no output, it has to be deployed first", nodeSet.Name)
+		}
+		for _, node := range nodeSet.Out.CLNodes {
+			localURL, forwardErr := conn.forward(node.Node.InternalURL)
+			if forwardErr != nil {
+				return nil, errors.Wrapf(forwardErr, "failed to forward API of node of nodeset %s", nodeSet.Name)
+			}
+			conn.NodeURLs[nodeSet.Name] = append(conn.NodeURLs[nodeSet.Name], localURL)
+		}
+	}
+
+	if input.JdOutput != nil {
+		conn.JdGRPCURL, err = conn.forward(input.JdOutput.InternalGRPCUrl)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to forward JD gRPC")
+		}
+	}
+
+	for _, blockchainOut := range input.BlockchainOutputs {
+		if len(blockchainOut.Nodes) == 0 {
+			return nil, fmt.Errorf("blockchain %s has no nodes", blockchainOut.ChainID)
+		}
+		conn.BlockchainHTTPURLs[blockchainOut.ChainID], err = conn.forward(blockchainOut.Nodes[0].InternalHTTPUrl)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to forward RPC of chain %s", blockchainOut.ChainID)
+		}
+		if blockchainOut.Nodes[0].InternalWSUrl != "" {
+			conn.BlockchainWSURLs[blockchainOut.ChainID], err = conn.forward(blockchainOut.Nodes[0].InternalWSUrl)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to forward websocket RPC of chain %s", blockchainOut.ChainID)
+			}
+		}
+	}
+
+	return conn, nil
+}
+
+// Close stops all port-forwards of the connection
+func (c *Connection) Close() error {
+	if len(c.pids) == 0 {
+		return nil
+	}
+
+	_, err := c.nixShell.RunCommand("kill " + strings.Join(c.pids, " ") + " 2>/dev/null || true")
+	c.pids = nil
+	c.forwards = map[string]string{}
+
+	return err
+}
+
+// forward port-forwards the service of the internal URL to a free local port and returns the URL with the service
+// replaced by the local address. Internal URLs are either URLs (http://node-1:6688) or addresses (jd:42242).
+func (c *Connection) forward(internalURL string) (string, error) {
+	service, port, rebuild, err := parseInternalURL(internalURL)
+	if err != nil {
+		return "", err
+	}
+
+	key := net.JoinHostPort(service, port)
+	if localAddress, ok := c.forwards[key]; ok {
+		return rebuild(localAddress), nil
+	}
+
+	localPort, err := freeLocalPort()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to find a free local port")
+	}
+
+	// the port-forward keeps running in the background, its output would be mixed with the output of other commands
+	output, err := c.nixShell.RunCommand(fmt.Sprintf(`kubectl port-forward -n "$DEVSPACE_NAMESPACE" svc/%s %d:%s --address 127.0.0.1 >/dev/null 2>&1 & echo $!`, service, localPort, port))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to start port-forward to %s", key)
+	}
+	pid := strings.TrimSpace(output)
+	if _, convErr := strconv.Atoi(pid); convErr != nil {
+		return "", fmt.Errorf("failed to read PID of port-forward to %s from: %s", key, output)
+	}
+	c.pids = append(c.pids, pid)
+
+	localAddress := net.JoinHostPort("127.0.0.1", strconv.Itoa(localPort))
+	if waitErr := waitForLocalPort(localAddress, c.readyTimeout); waitErr != nil {
+		return "", errors.Wrapf(waitErr, "port-forward to %s is not ready", key)
+	}
+	c.forwards[key] = localAddress
+
+	return rebuild(localAddress), nil
+}
+
+// parseInternalURL returns the service and the port of the internal URL and a function replacing them with a local
+// address. Fully qualified service names (node-1.namespace.svc.cluster.local) are shortened to the service name.
+func parseInternalURL(internalURL string) (service, port string, rebuild func(localAddress string) string, err error) {
+	if internalURL == "" {
+		return "", "", nil, errors.New("internal URL is empty")
+	}
+
+	if !strings.Contains(internalURL, "://") {
+		host, hostPort, splitErr := net.SplitHostPort(internalURL)
+		if splitErr != nil {
+			return "", "", nil, errors.Wrapf(splitErr, "failed to parse internal address %s", internalURL)
+		}
+		return strings.Split(host, ".")[0], hostPort, func(localAddress string) string { return localAddress }, nil
+	}
+
+	u, parseErr := url.Parse(internalURL)
+	if parseErr != nil {
+		return "", "", nil, errors.Wrapf(parseErr, "failed to parse internal URL %s", internalURL)
+	}
+
+	port = u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "https", "wss":
+			port = "443"
+		default:
+			port = "80"
+		}
+	}
+
+	return strings.Split(u.Hostname(), ".")[0], port, func(localAddress string) string {
+		local := *u
+		local.Host = localAddress
+		return local.String()
+	}, nil
+}
+
+func freeLocalPort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+func waitForLocalPort(address string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		conn, err := net.DialTimeout("tcp", address, portForwardPollInterval)
+		if err == nil {
+			return conn.Close()
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s not accepting connections after %s: %w", address, timeout, err)
+		}
+		time.Sleep(portForwardPollInterval)
+	}
+}
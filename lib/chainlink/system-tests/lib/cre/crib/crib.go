@@ -2,6 +2,7 @@ package crib
 
 import (
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -11,9 +12,13 @@ import (
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
 
 	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/blockchain"
 	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/jd"
+	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/postgres"
 	crecaps "github.com/smartcontractkit/chainlink/system-tests/lib/cre/capabilities"
 	libnode "github.com/smartcontractkit/chainlink/system-tests/lib/cre/don/node"
 	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
@@ -31,20 +36,30 @@ func StartNixShell(input *types.StartNixShellInput) (*nix.Shell, error) {
 		return nil, errors.Wrap(valErr, "input validation failed")
 	}
 
+	provider := input.Provider
+	if provider == nil {
+		var providerErr error
+		provider, providerErr = NewProvider(input.InfraInput.CRIB)
+		if providerErr != nil {
+			return nil, errors.Wrap(providerErr, "failed to create CRIB provider")
+		}
+	}
+
+	if preflightErr := provider.PreflightChecks(); preflightErr != nil {
+		return nil, errors.Wrapf(preflightErr, "preflight checks of CRIB provider %s failed", input.InfraInput.CRIB.Provider)
+	}
+
 	globalEnvVars := map[string]string{
 		"PROVIDER":           input.InfraInput.CRIB.Provider,
 		"DEVSPACE_NAMESPACE": input.InfraInput.CRIB.Namespace,
 	}
 
-	for key, value := range input.ExtraEnvVars {
+	for key, value := range provider.EnvVars() {
 		globalEnvVars[key] = value
 	}
 
-	if strings.EqualFold(input.InfraInput.CRIB.Provider, libtypes.AWS) {
-		globalEnvVars["CHAINLINK_TEAM"] = input.InfraInput.CRIB.TeamInput.Team
-		globalEnvVars["CHAINLINK_PRODUCT"] = input.InfraInput.CRIB.TeamInput.Product
-		globalEnvVars["CHAINLINK_COST_CENTER"] = input.InfraInput.CRIB.TeamInput.CostCenter
-		globalEnvVars["CHAINLINK_COMPONENT"] = input.InfraInput.CRIB.TeamInput.Component
+	for key, value := range input.ExtraEnvVars {
+		globalEnvVars[key] = value
 	}
 
 	cribConfigDirAbs, absErr := filepath.Abs(filepath.Join(".", input.CribConfigsDir))
@@ -55,23 +70,79 @@ func StartNixShell(input *types.StartNixShellInput) (*nix.Shell, error) {
 	globalEnvVars["CONFIG_OVERRIDES_DIR"] = cribConfigDirAbs
 
 	// this will run `nix develop`, which will login to all ECRs and set up the environment
-	// by running `crib init`
-	nixShell, err := nix.NewNixShell(input.InfraInput.CRIB.FolderLocation, globalEnvVars)
+	// by running `crib init`, unless a warm shell with the same environment is reused
+	var nixShell *nix.Shell
+	var err error
+	if input.ReuseSession {
+		var reused bool
+		nixShell, reused, err = nix.AcquireNixShell(input.InfraInput.CRIB.FolderLocation, globalEnvVars)
+		if reused {
+			fmt.Println("Reusing warm Nix shell with the same environment")
+		}
+	} else {
+		nixShell, err = nix.NewNixShell(input.InfraInput.CRIB.FolderLocation, globalEnvVars)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create Nix shell")
 	}
+	nixShell.SetCommandDefaults(input.CommandTimeout, input.CommandOutput)
+
+	if input.Preflight {
+		report, preflightErr := Preflight(&types.PreflightCribInput{
+			NixShell:        nixShell,
+			Namespace:       input.InfraInput.CRIB.Namespace,
+			MinToolVersions: input.MinToolVersions,
+			CheckECRLogin:   strings.EqualFold(input.InfraInput.CRIB.Provider, libtypes.AWS),
+		})
+		if preflightErr != nil {
+			return nil, errors.Wrap(preflightErr, "failed to run preflight checks")
+		}
+		fmt.Printf("CRIB preflight checks:\n%s", report)
+		if reportErr := report.Err(); reportErr != nil {
+			return nil, reportErr
+		}
+	}
 
-	if input.PurgeNamespace {
-		// we run `devspace purge` to clean up the environment, in case our namespace is already used
-		_, err = nixShell.RunCommand("devspace purge --no-warn")
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to run devspace purge")
+	if input.NamespaceLifecycle != nil {
+		if nsErr := setUpNamespace(nixShell, input.InfraInput.CRIB.Namespace, input.NamespaceLifecycle); nsErr != nil {
+			return nil, errors.Wrapf(nsErr, "failed to set up namespace %s", input.InfraInput.CRIB.Namespace)
 		}
 	}
 
 	return nixShell, nil
 }
 
+// cribChainPipeline is the devspace pipeline deploying a chain type and the family of the deployed chain
+type cribChainPipeline struct {
+	devspacePipeline string
+	family           string
+}
+
+func (p cribChainPipeline) command() string {
+	return fmt.Sprintf("devspace run %s --no-warn", p.devspacePipeline)
+}
+
+var cribChainPipelines = map[types.CribChainType]cribChainPipeline{
+	types.CribChainGeth:  {devspacePipeline: "deploy-custom-geth-chain", family: chainselectors.FamilyEVM},
+	types.CribChainAnvil: {devspacePipeline: "deploy-anvil-chain", family: chainselectors.FamilyEVM},
+	types.CribChainBesu:  {devspacePipeline: "deploy-besu-chain", family: chainselectors.FamilyEVM},
+	types.CribChainReth:  {devspacePipeline: "deploy-reth-chain", family: chainselectors.FamilyEVM},
+	types.CribChainAptos: {devspacePipeline: "deploy-aptos-chain", family: chainselectors.FamilyAptos},
+	types.CribChainTron:  {devspacePipeline: "deploy-tron-chain", family: chainselectors.FamilyTron},
+}
+
+// chainPipeline returns the pipeline of the chain type, which defaults to the type of the blockchain input and then to geth.
+// Chain types are validated by the inputs.
+func chainPipeline(chainType types.CribChainType, blockchainInput *blockchain.Input) cribChainPipeline {
+	if chainType == "" {
+		chainType = blockchainInput.Type
+	}
+	if pipeline, ok := cribChainPipelines[chainType]; ok {
+		return pipeline
+	}
+	return cribChainPipelines[types.CribChainGeth]
+}
+
 func DeployBlockchain(input *types.DeployCribBlockchainInput) (*blockchain.Output, error) {
 	if input == nil {
 		return nil, errors.New("DeployCribBlockchainInput is nil")
@@ -81,16 +152,21 @@ func DeployBlockchain(input *types.DeployCribBlockchainInput) (*blockchain.Outpu
 		return nil, errors.Wrap(valErr, "input validation failed")
 	}
 
-	gethChainEnvVars := map[string]string{
+	pipeline := chainPipeline(input.ChainType, input.BlockchainInput)
+
+	chainEnvVars := map[string]string{
 		"CHAIN_ID": input.BlockchainInput.ChainID,
 	}
-	_, err := input.NixShell.RunCommandWithEnvVars("devspace run deploy-custom-geth-chain --no-warn", gethChainEnvVars)
+	_, err := runWithRetry(input.NixShell, input.RetryPolicy, retryableCommand{
+		command:        pipeline.command(),
+		envVars:        chainEnvVars,
+		alreadyApplied: fileWrittenSince(infra.BlockchainURLFile(filepath.Join(".", input.CribConfigsDir), input.BlockchainInput.ChainID)),
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to run devspace run deploy-custom-geth-chain --no-warn")
+		return nil, errors.Wrapf(err, "failed to run %s", pipeline.command())
 	}
 
-	// TODO chain family should be dynamic, but currently we don't have in the input (it's set in the output depending on blockchain type)
-	blockchainOut, err := infra.ReadBlockchainURL(filepath.Join(".", input.CribConfigsDir), "evm", input.BlockchainInput.ChainID)
+	blockchainOut, err := infra.ReadBlockchainURL(filepath.Join(".", input.CribConfigsDir), pipeline.family, input.BlockchainInput.ChainID, input.ReadyTimeout)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to read blockchain URLs")
 	}
@@ -98,199 +174,592 @@ func DeployBlockchain(input *types.DeployCribBlockchainInput) (*blockchain.Outpu
 	return blockchainOut, nil
 }
 
-func DeployDons(input *types.DeployCribDonsInput) ([]*types.CapabilitiesAwareNodeSet, error) {
+// DeploySolanaChain deploys a solana-test-validator localnet, minting SOL to the public key of the input and deploying
+// its programs from the contracts dir on startup.
+func DeploySolanaChain(input *types.DeployCribSolanaChainInput) (*blockchain.Output, error) {
 	if input == nil {
-		return nil, errors.New("DeployCribDonsInput is nil")
+		return nil, errors.New("DeployCribSolanaChainInput is nil")
 	}
 
 	if valErr := input.Validate(); valErr != nil {
 		return nil, errors.Wrap(valErr, "input validation failed")
 	}
 
-	for j, donMetadata := range input.Topology.DonsMetadata {
-		deployDonEnvVars := map[string]string{}
-		cribConfigsDirAbs := filepath.Join(".", input.CribConfigsDir, donMetadata.Name)
-		err := os.MkdirAll(cribConfigsDirAbs, os.ModePerm)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to create crib configs directory '%s' for %s", cribConfigsDirAbs, donMetadata.Name)
+	solanaChainEnvVars := map[string]string{
+		"CHAIN_ID": input.BlockchainInput.ChainID,
+	}
+	if input.BlockchainInput.PublicKey != "" {
+		solanaChainEnvVars["SOLANA_PUBLIC_KEY"] = input.BlockchainInput.PublicKey
+	}
+	if len(input.BlockchainInput.SolanaPrograms) > 0 {
+		contractsDirAbs, absErr := filepath.Abs(input.BlockchainInput.ContractsDir)
+		if absErr != nil {
+			return nil, errors.Wrapf(absErr, "failed to get absolute path to contracts dir %s", input.BlockchainInput.ContractsDir)
 		}
 
-		// validate that all nodes in the same node set use the same Docker image
-		dockerImage, dockerImagesErr := nodesetDockerImage(input.NodeSetInputs[j])
-		if dockerImagesErr != nil {
-			return nil, errors.Wrap(dockerImagesErr, "failed to validate node set Docker images")
+		// programs are passed as a sorted list of name:programID pairs, each of them with a matching <name>.so file in the contracts dir
+		programs := make([]string, 0, len(input.BlockchainInput.SolanaPrograms))
+		for name, programID := range input.BlockchainInput.SolanaPrograms {
+			programs = append(programs, fmt.Sprintf("%s:%s", name, programID))
 		}
+		slices.Sort(programs)
 
-		imageName, imageErr := dockerImageName(dockerImage)
-		if imageErr != nil {
-			return nil, errors.Wrap(imageErr, "failed to get image name")
-		}
+		solanaChainEnvVars["SOLANA_CONTRACTS_DIR"] = contractsDirAbs
+		solanaChainEnvVars["SOLANA_PROGRAMS"] = strings.Join(programs, ",")
+	}
 
-		imageTag, imageErr := dockerImageTag(dockerImage)
-		if imageErr != nil {
-			return nil, errors.Wrap(imageErr, "failed to get image tag")
-		}
+	_, err := input.NixShell.RunCommandWithEnvVars("devspace run deploy-solana-chain --no-warn", solanaChainEnvVars)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to run devspace run deploy-solana-chain --no-warn")
+	}
 
-		deployDonEnvVars["DEVSPACE_IMAGE"] = imageName
-		deployDonEnvVars["DEVSPACE_IMAGE_TAG"] = imageTag
+	blockchainOut, err := infra.ReadBlockchainURL(filepath.Join(".", input.CribConfigsDir), chainselectors.FamilySolana, input.BlockchainInput.ChainID, input.ReadyTimeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read blockchain URLs")
+	}
 
-		bootstrapNodes, err := libnode.FindManyWithLabel(donMetadata.NodesMetadata, &types.Label{Key: libnode.NodeTypeKey, Value: types.BootstrapNode}, libnode.EqualLabels)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to find bootstrap nodes")
-		}
+	return blockchainOut, nil
+}
 
-		var cleanToml = func(tomlStr string) ([]byte, error) {
-			// unmarshall and marshall to conver it into proper multi-line string
-			// that will be correctly serliazed to YAML
-			var data interface{}
-			tomlErr := toml.Unmarshal([]byte(tomlStr), &data)
-			if tomlErr != nil {
-				return nil, errors.Wrapf(tomlErr, "failed to unmarshal toml: %s", tomlStr)
-			}
-			newTOMLBytes, marshallErr := toml.Marshal(data)
-			if marshallErr != nil {
-				return nil, errors.Wrap(marshallErr, "failed to marshal toml")
-			}
+// DeployBlockchains deploys all blockchains concurrently, each of them in a separate devspace run with its own retries,
+// and returns their outputs keyed by chain selector. Since a nix shell runs one command at a time, every chain but the
+// first one is deployed in a sibling of the nix shell, which is closed once the chains are deployed.
+func DeployBlockchains(input *types.DeployCribBlockchainsInput) (map[uint64]*blockchain.Output, error) {
+	if input == nil {
+		return nil, errors.New("DeployCribBlockchainsInput is nil")
+	}
 
-			return newTOMLBytes, nil
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	// selectors are resolved before any chain is deployed, so that an unknown chain doesn't leave the others behind
+	chainSelectors := make([]uint64, len(input.BlockchainInputs))
+	for i, blockchainInput := range input.BlockchainInputs {
+		family := chainPipeline(input.ChainTypes[blockchainInput.ChainID], blockchainInput).family
+		chainDetails, selectorErr := chainselectors.GetChainDetailsByChainIDAndFamily(blockchainInput.ChainID, family)
+		if selectorErr != nil {
+			return nil, errors.Wrapf(selectorErr, "failed to get chain selector for chain id %s of family %s", blockchainInput.ChainID, family)
 		}
+		chainSelectors[i] = chainDetails.ChainSelector
+	}
 
-		var writeOverrides = func(nodeMetadata *types.NodeMetadata, i int, nodeType types.NodeType) error {
-			nodeIndexStr, findErr := libnode.FindLabelValue(nodeMetadata, libnode.IndexKey)
-			if findErr != nil {
-				return errors.Wrapf(findErr, "failed to find node index for %s node %d in nodeset %s", nodeType, i, donMetadata.Name)
+	nixShells := make([]*nix.Shell, len(input.BlockchainInputs))
+	nixShells[0] = input.NixShell
+	defer func() {
+		for _, nixShell := range nixShells[1:] {
+			if nixShell != nil {
+				_ = nixShell.Close()
 			}
+		}
+	}()
+	for i := 1; i < len(nixShells); i++ {
+		var shellErr error
+		nixShells[i], shellErr = input.NixShell.NewSiblingShell()
+		if shellErr != nil {
+			return nil, errors.Wrapf(shellErr, "failed to start Nix shell for chain %s", input.BlockchainInputs[i].ChainID)
+		}
+	}
 
-			nodeIndex, convErr := strconv.Atoi(nodeIndexStr)
-			if convErr != nil {
-				return errors.Wrapf(convErr, "failed to convert node index '%s' to int for %s node %d in nodeset %s", nodeIndexStr, nodeType, i, donMetadata.Name)
+	blockchainOuts := make([]*blockchain.Output, len(input.BlockchainInputs))
+	eg := &errgroup.Group{}
+	for i, blockchainInput := range input.BlockchainInputs {
+		eg.Go(func() error {
+			blockchainOut, deployErr := DeployBlockchain(&types.DeployCribBlockchainInput{
+				BlockchainInput: blockchainInput,
+				ChainType:       input.ChainTypes[blockchainInput.ChainID],
+				NixShell:        nixShells[i],
+				CribConfigsDir:  input.CribConfigsDir,
+				RetryPolicy:     input.RetryPolicy,
+				ReadyTimeout:    input.ReadyTimeout,
+			})
+			if deployErr != nil {
+				return errors.Wrapf(deployErr, "failed to deploy chain %s", blockchainInput.ChainID)
 			}
+			blockchainOuts[i] = blockchainOut
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	blockchainOutsBySelector := make(map[uint64]*blockchain.Output, len(input.BlockchainInputs))
+	for i, blockchainInput := range input.BlockchainInputs {
+		blockchainInput.Out = blockchainOuts[i]
+		blockchainOutsBySelector[chainSelectors[i]] = blockchainOuts[i]
+	}
+
+	return blockchainOutsBySelector, nil
+}
 
-			cleanToml, tomlErr := cleanToml(input.NodeSetInputs[j].NodeSpecs[nodeIndex].Node.TestConfigOverrides)
-			if tomlErr != nil {
-				return errors.Wrap(tomlErr, "failed to clean TOML")
+func DeployDons(input *types.DeployCribDonsInput) (nodeSets []*types.CapabilitiesAwareNodeSet, err error) {
+	if input == nil {
+		return nil, errors.New("DeployCribDonsInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	if topologyErr := ValidateTopology(input); topologyErr != nil {
+		return nil, errors.Wrap(topologyErr, "topology validation failed")
+	}
+
+	// DONs are tracked before they are deployed, because a failed deployment might have created some of the resources
+	deployedDons := []string{}
+	defer func() {
+		if err == nil || !input.RollbackOnFailure || len(deployedDons) == 0 {
+			return
+		}
+		teardownErr := TeardownDons(&types.TeardownCribDonsInput{
+			DonNames:       deployedDons,
+			NixShell:       input.NixShell,
+			CribConfigsDir: input.CribConfigsDir,
+		})
+		if teardownErr != nil {
+			err = errors.Wrapf(err, "failed to roll back deployed DONs %s: %s", strings.Join(deployedDons, ", "), teardownErr)
+		}
+	}()
+
+	// deferred after the rollback, so that it runs before the rolled back DONs' configs are removed
+	if input.ArtifactsDir != "" {
+		defer func() {
+			bundleDir, bundleErr := BundleArtifacts(input.CribConfigsDir, input.ArtifactsDir)
+			if bundleErr != nil {
+				// the bundle only helps debugging, so failing to write it doesn't fail the deployment
+				fmt.Printf("failed to bundle CRIB deployment artifacts: %s\n", bundleErr)
+				return
 			}
+			fmt.Printf("CRIB deployment artifacts bundled in %s\n", bundleDir)
+		}()
+	}
+
+	for j, donMetadata := range input.Topology.DonsMetadata {
+		deployedDons = append(deployedDons, donMetadata.Name)
+		if deployErr := deployDon(input, j, donMetadata); deployErr != nil {
+			return nil, deployErr
+		}
+	}
 
-			configFileMask := "config-override-bt-%d.toml"
-			secretsFileMask := "secrets-override-bt-%d.toml"
+	return input.NodeSetInputs, nil
+}
 
-			if nodeType != types.BootstrapNode {
-				configFileMask = "config-override-%d.toml"
-				secretsFileMask = "secrets-override-%d.toml"
+// deployDon deploys the DON of the node set at index j of the input and copies its capabilities to the pods,
+// the URLs of the deployed nodes are set in the output of the node set.
+func deployDon(input *types.DeployCribDonsInput, j int, donMetadata *types.DonMetadata) error {
+	deployDonEnvVars := map[string]string{}
+	cribConfigsDirAbs := filepath.Join(".", input.CribConfigsDir, donMetadata.Name)
+	err := os.MkdirAll(cribConfigsDirAbs, os.ModePerm)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create crib configs directory '%s' for %s", cribConfigsDirAbs, donMetadata.Name)
+	}
+
+	// nodes using a different image than the default one of the node set get per-node image overrides
+	dockerImage, dockerImagesErr := nodesetDockerImage(input.NodeSetInputs[j])
+	if dockerImagesErr != nil {
+		return errors.Wrap(dockerImagesErr, "failed to validate node set Docker images")
+	}
+
+	imageName, imageErr := dockerImageName(dockerImage)
+	if imageErr != nil {
+		return errors.Wrap(imageErr, "failed to get image name")
+	}
+
+	imageTag, imageErr := dockerImageTag(dockerImage)
+	if imageErr != nil {
+		return errors.Wrap(imageErr, "failed to get image tag")
+	}
+
+	deployDonEnvVars["DEVSPACE_IMAGE"] = imageName
+	deployDonEnvVars["DEVSPACE_IMAGE_TAG"] = imageTag
+
+	bootstrapNodes, err := libnode.FindManyWithLabel(donMetadata.NodesMetadata, &types.Label{Key: libnode.NodeTypeKey, Value: types.BootstrapNode}, libnode.EqualLabels)
+	if err != nil {
+		return errors.Wrap(err, "failed to find bootstrap nodes")
+	}
+
+	configOverrides, err := renderConfigOverrides(input, j, donMetadata)
+	if err != nil {
+		return errors.Wrapf(err, "failed to render config overrides of %s", donMetadata.Name)
+	}
+
+	var cleanToml = func(tomlStr string) ([]byte, error) {
+		// unmarshall and marshall to conver it into proper multi-line string
+		// that will be correctly serliazed to YAML
+		var data interface{}
+		tomlErr := toml.Unmarshal([]byte(tomlStr), &data)
+		if tomlErr != nil {
+			return nil, errors.Wrapf(tomlErr, "failed to unmarshal toml: %s", tomlStr)
+		}
+		newTOMLBytes, marshallErr := toml.Marshal(data)
+		if marshallErr != nil {
+			return nil, errors.Wrap(marshallErr, "failed to marshal toml")
+		}
+
+		return newTOMLBytes, nil
+	}
+
+	var writeOverrides = func(nodeMetadata *types.NodeMetadata, i int, nodeType types.NodeType) error {
+		nodeIndexStr, findErr := libnode.FindLabelValue(nodeMetadata, libnode.IndexKey)
+		if findErr != nil {
+			return errors.Wrapf(findErr, "failed to find node index for %s node %d in nodeset %s", nodeType, i, donMetadata.Name)
+		}
+
+		nodeIndex, convErr := strconv.Atoi(nodeIndexStr)
+		if convErr != nil {
+			return errors.Wrapf(convErr, "failed to convert node index '%s' to int for %s node %d in nodeset %s", nodeIndexStr, nodeType, i, donMetadata.Name)
+		}
+
+		cleanToml, tomlErr := cleanToml(configOverrides[nodeIndex])
+		if tomlErr != nil {
+			return errors.Wrap(tomlErr, "failed to clean TOML")
+		}
+
+		configFileMask := "config-override-bt-%d.toml"
+		secretsFileMask := "secrets-override-bt-%d.toml"
+
+		if nodeType != types.BootstrapNode {
+			configFileMask = "config-override-%d.toml"
+			secretsFileMask = "secrets-override-%d.toml"
+		}
+
+		writeErr := os.WriteFile(filepath.Join(cribConfigsDirAbs, fmt.Sprintf(configFileMask, i)), cleanToml, 0600)
+		if writeErr != nil {
+			return errors.Wrapf(writeErr, "failed to write config override for bootstrap node %d to file", i)
+		}
+
+		secretsFile := filepath.Join(cribConfigsDirAbs, fmt.Sprintf(secretsFileMask, i))
+		if input.SecretsDelivery == types.CribSecretsKubernetesSecret {
+			secretName := secretsOverrideSecretName(donMetadata.Name, strings.TrimSuffix(fmt.Sprintf(secretsFileMask, i), ".toml"))
+			secretErr := applySecretsOverrideSecret(input.NixShell, donMetadata.Name, secretName, input.NodeSetInputs[j].NodeSpecs[nodeIndex].Node.TestSecretsOverrides)
+			if secretErr != nil {
+				return errors.Wrapf(secretErr, "failed to create secrets override secret for %s node %d in nodeset %s", nodeType, i, donMetadata.Name)
 			}
 
-			writeErr := os.WriteFile(filepath.Join(cribConfigsDirAbs, fmt.Sprintf(configFileMask, i)), cleanToml, 0600)
-			if writeErr != nil {
-				return errors.Wrapf(writeErr, "failed to write config override for bootstrap node %d to file", i)
+			secretEnvVarMask := "SECRETS_OVERRIDE_SECRET_BT_%d"
+			if nodeType != types.BootstrapNode {
+				secretEnvVarMask = "SECRETS_OVERRIDE_SECRET_%d"
 			}
+			deployDonEnvVars[fmt.Sprintf(secretEnvVarMask, i)] = secretName
 
-			writeErr = os.WriteFile(filepath.Join(cribConfigsDirAbs, fmt.Sprintf(secretsFileMask, i)), []byte(input.NodeSetInputs[j].NodeSpecs[nodeIndex].Node.TestSecretsOverrides), 0600)
+			// remove secrets written by earlier deployments using files
+			if removeErr := os.Remove(secretsFile); removeErr != nil && !os.IsNotExist(removeErr) {
+				return errors.Wrapf(removeErr, "failed to remove secrets override file %s", secretsFile)
+			}
+		} else {
+			writeErr = os.WriteFile(secretsFile, []byte(input.NodeSetInputs[j].NodeSpecs[nodeIndex].Node.TestSecretsOverrides), 0600)
 			if writeErr != nil {
 				return errors.Wrapf(writeErr, "failed to write secrets override for bootstrap node %d to file", i)
 			}
+		}
 
+		nodeImage := input.NodeSetInputs[j].NodeSpecs[nodeIndex].Node.Image
+		if nodeImage == dockerImage {
 			return nil
 		}
 
-		for i, btNode := range bootstrapNodes {
-			writeErr := writeOverrides(btNode, i, types.BootstrapNode)
-			if writeErr != nil {
-				return nil, writeErr
-			}
+		nodeImageName, nodeImageErr := dockerImageName(nodeImage)
+		if nodeImageErr != nil {
+			return errors.Wrapf(nodeImageErr, "failed to get image name for %s node %d in nodeset %s", nodeType, i, donMetadata.Name)
 		}
 
-		workerNodes, err := libnode.FindManyWithLabel(donMetadata.NodesMetadata, &types.Label{Key: libnode.NodeTypeKey, Value: types.WorkerNode}, libnode.EqualLabels)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to find worker nodes")
+		nodeImageTag, nodeImageErr := dockerImageTag(nodeImage)
+		if nodeImageErr != nil {
+			return errors.Wrapf(nodeImageErr, "failed to get image tag for %s node %d in nodeset %s", nodeType, i, donMetadata.Name)
 		}
 
-		for i, workerNode := range workerNodes {
-			writeErr := writeOverrides(workerNode, i, types.WorkerNode)
-			if writeErr != nil {
-				return nil, writeErr
-			}
+		imageEnvVarMask := "DEVSPACE_IMAGE_BT_%d"
+		imageTagEnvVarMask := "DEVSPACE_IMAGE_TAG_BT_%d"
+
+		if nodeType != types.BootstrapNode {
+			imageEnvVarMask = "DEVSPACE_IMAGE_%d"
+			imageTagEnvVarMask = "DEVSPACE_IMAGE_TAG_%d"
 		}
 
-		deployDonEnvVars["DON_BOOT_NODE_COUNT"] = strconv.Itoa(len(bootstrapNodes))
-		deployDonEnvVars["DON_NODE_COUNT"] = strconv.Itoa(len(workerNodes))
-		// IMPORTANT: CRIB will deploy gateway only if don_type == "gateway", in other cases the DON_TYPE value has no other impact than being uses in release/service/etc names
-		deployDonEnvVars["DON_TYPE"] = donMetadata.Name
+		deployDonEnvVars[fmt.Sprintf(imageEnvVarMask, i)] = nodeImageName
+		deployDonEnvVars[fmt.Sprintf(imageTagEnvVarMask, i)] = nodeImageTag
 
-		_, deployErr := input.NixShell.RunCommandWithEnvVars("devspace run deploy-don --no-warn", deployDonEnvVars)
-		if deployErr != nil {
-			return nil, errors.Wrap(deployErr, "failed to run devspace run deploy-don")
+		return nil
+	}
+
+	for i, btNode := range bootstrapNodes {
+		writeErr := writeOverrides(btNode, i, types.BootstrapNode)
+		if writeErr != nil {
+			return writeErr
 		}
+	}
+
+	workerNodes, err := libnode.FindManyWithLabel(donMetadata.NodesMetadata, &types.Label{Key: libnode.NodeTypeKey, Value: types.WorkerNode}, libnode.EqualLabels)
+	if err != nil {
+		return errors.Wrap(err, "failed to find worker nodes")
+	}
 
-		// validate capabilities-related configuration and copy capabilities to pods
-		podNamePattern := input.NodeSetInputs[j].Name + `-\\d+`
-		_, regErr := regexp.Compile(podNamePattern)
-		if regErr != nil {
-			return nil, errors.Wrapf(regErr, "failed to compile regex for pod name pattern %s", podNamePattern)
+	for i, workerNode := range workerNodes {
+		writeErr := writeOverrides(workerNode, i, types.WorkerNode)
+		if writeErr != nil {
+			return writeErr
 		}
-		capabilitiesFound := map[string]int{}
-		capabilitiesDirs := []string{}
-		capabilitiesDirsFound := map[string]int{}
+	}
 
-		// make sure all worker nodes in DON have the same set of capabilities
-		// in the future we might want to allow different capabilities for different nodes
-		// but for now we require all worker nodes in the same DON to have the same capabilities
-		for _, nodeSpec := range input.NodeSetInputs[j].NodeSpecs {
-			for _, capabilityBinaryPath := range nodeSpec.Node.CapabilitiesBinaryPaths {
-				capabilitiesFound[capabilityBinaryPath]++
-			}
+	if input.SecretsDelivery == types.CribSecretsKubernetesSecret {
+		// CRIB mounts the SECRETS_OVERRIDE_SECRET_* Secrets instead of reading secrets overrides from files
+		deployDonEnvVars["SECRETS_OVERRIDES_FROM_SECRETS"] = "true"
+	}
+	if resources := input.NodeSetResources[input.NodeSetInputs[j].Name]; resources != nil {
+		for key, value := range resources.EnvVars() {
+			deployDonEnvVars[key] = value
+		}
+	}
 
-			if nodeSpec.Node.CapabilityContainerDir != "" {
-				capabilitiesDirs = append(capabilitiesDirs, nodeSpec.Node.CapabilityContainerDir)
-				capabilitiesDirsFound[nodeSpec.Node.CapabilityContainerDir]++
+	if input.ScrapeMetrics {
+		deployDonEnvVars["PROMETHEUS_SCRAPE"] = "true"
+	}
+	deployDonEnvVars["DON_BOOT_NODE_COUNT"] = strconv.Itoa(len(bootstrapNodes))
+	deployDonEnvVars["DON_NODE_COUNT"] = strconv.Itoa(len(workerNodes))
+	// DON_TYPE has no other impact than being used in release/service/etc names, gateway is deployed only if GATEWAY_ENABLED is set
+	deployDonEnvVars["DON_TYPE"] = donMetadata.Name
+
+	if slices.Contains(input.NodeSetInputs[j].DONTypes, types.GatewayDON) {
+		deployDonEnvVars["GATEWAY_ENABLED"] = "true"
+		deployDonEnvVars["GATEWAY_CONNECTOR_PORT"] = strconv.Itoa(input.NodeSetInputs[j].GatewayConnectorPort())
+		if gatewayConfig := input.NodeSetInputs[j].GatewayConfig; gatewayConfig != nil {
+			if gatewayConfig.IngressHost != "" {
+				deployDonEnvVars["GATEWAY_INGRESS_HOST"] = gatewayConfig.IngressHost
+			}
+			if gatewayConfig.TLSSecretName != "" {
+				deployDonEnvVars["GATEWAY_TLS_SECRET_NAME"] = gatewayConfig.TLSSecretName
 			}
 		}
+	}
+
+	desiredStateHash, hashErr := donDesiredStateHash(input.NodeSetInputs[j], configOverrides, deployDonEnvVars)
+	if hashErr != nil {
+		return errors.Wrapf(hashErr, "failed to hash desired state of %s", donMetadata.Name)
+	}
+	if input.SkipUnchanged && donUnchanged(input.NixShell, cribConfigsDirAbs, input.NodeSetInputs[j], desiredStateHash) {
+		fmt.Printf("DON %s hasn't changed since it was last deployed, skipping its deployment\n", donMetadata.Name)
+		return readNodeSetOutput(input, j, donMetadata)
+	}
+	if removeErr := removeDonDeploymentState(cribConfigsDirAbs); removeErr != nil {
+		return errors.Wrapf(removeErr, "failed to remove deployment state of %s", donMetadata.Name)
+	}
+
+	deployOutput, deployErr := runWithRetry(input.NixShell, input.RetryPolicy, retryableCommand{
+		command:        "devspace run deploy-don --no-warn",
+		envVars:        deployDonEnvVars,
+		alreadyApplied: fileWrittenSince(infra.NodeSetURLFile(filepath.Join(".", input.CribConfigsDir), donMetadata.Name)),
+	})
+	// kept next to the overrides, so that they end up in the artifacts bundle, even if the deployment failed
+	if recordErr := recordDonDeployment(cribConfigsDirAbs, deployDonEnvVars, deployOutput); recordErr != nil {
+		return errors.Wrapf(recordErr, "failed to record deployment of %s", donMetadata.Name)
+	}
+	if deployErr != nil {
+		return deploymentError(input.NixShell, "devspace run deploy-don", deployErr, nodeSetPodName(input.NodeSetInputs[j].Name))
+	}
+
+	// validate capabilities-related configuration and copy capabilities to pods
+	podNamePattern := input.NodeSetInputs[j].Name + `-\\d+`
+	_, regErr := regexp.Compile(podNamePattern)
+	if regErr != nil {
+		return errors.Wrapf(regErr, "failed to compile regex for pod name pattern %s", podNamePattern)
+	}
+	capabilitiesFound := map[string]int{}
+	capabilitiesDirs := []string{}
+	capabilitiesDirsFound := map[string]int{}
+
+	// make sure all worker nodes in DON have the same set of capabilities
+	// in the future we might want to allow different capabilities for different nodes
+	// but for now we require all worker nodes in the same DON to have the same capabilities
+	for _, nodeSpec := range input.NodeSetInputs[j].NodeSpecs {
+		for _, capabilityBinaryPath := range nodeSpec.Node.CapabilitiesBinaryPaths {
+			capabilitiesFound[capabilityBinaryPath]++
+		}
+
+		if nodeSpec.Node.CapabilityContainerDir != "" {
+			capabilitiesDirs = append(capabilitiesDirs, nodeSpec.Node.CapabilityContainerDir)
+			capabilitiesDirsFound[nodeSpec.Node.CapabilityContainerDir]++
+		}
+	}
+
+	for capability, count := range capabilitiesFound {
+		// we only care about worker nodes, because bootstrap nodes cannot execute any workflows, so they don't need capabilities
+		if count != len(workerNodes) {
+			return fmt.Errorf("capability %s wasn't defined for all worker nodes in nodeset %s. All worker nodes in the same nodeset must have the same capabilities", capability, input.NodeSetInputs[j].Name)
+		}
+	}
+
+	destinationDir, err := crecaps.DefaultContainerDirectory(libtypes.CRIB)
+	if err != nil {
+		return errors.Wrap(err, "failed to get default directory for capabilities in CRIB")
+	}
 
-		for capability, count := range capabilitiesFound {
-			// we only care about worker nodes, because bootstrap nodes cannot execute any workflows, so they don't need capabilities
+	// all of them need to use the same capabilities directory inside the container
+	if len(capabilitiesDirs) > 1 {
+		for capabilityDir, count := range capabilitiesDirsFound {
 			if count != len(workerNodes) {
-				return nil, fmt.Errorf("capability %s wasn't defined for all worker nodes in nodeset %s. All worker nodes in the same nodeset must have the same capabilities", capability, input.NodeSetInputs[j].Name)
+				return fmt.Errorf("the same capability container dir %s wasn't defined for all worker nodes in nodeset %s. All worker nodes in the same nodeset must have the same capability container dir", capabilityDir, input.NodeSetInputs[j].Name)
 			}
 		}
+		destinationDir = capabilitiesDirs[0]
+	}
 
-		destinationDir, err := crecaps.DefaultContainerDirectory(libtypes.CRIB)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get default directory for capabilities in CRIB")
+	capabilities := make([]string, 0, len(capabilitiesFound))
+	for capability := range capabilitiesFound {
+		capabilities = append(capabilities, capability)
+	}
+	slices.Sort(capabilities)
+
+	if copyErr := copyCapabilities(input, input.NodeSetInputs[j].Name, podNamePattern, destinationDir, capabilities); copyErr != nil {
+		return copyErr
+	}
+
+	if outputErr := readNodeSetOutput(input, j, donMetadata); outputErr != nil {
+		return outputErr
+	}
+
+	if stateErr := writeDonDeploymentState(cribConfigsDirAbs, desiredStateHash); stateErr != nil {
+		return errors.Wrapf(stateErr, "failed to write deployment state of %s", donMetadata.Name)
+	}
+
+	return nil
+}
+
+// readNodeSetOutput sets the output of the node set at index j to the URLs of the deployed nodes, once they are ready
+func readNodeSetOutput(input *types.DeployCribDonsInput, j int, donMetadata *types.DonMetadata) error {
+	nsOutput, err := infra.ReadNodeSetURL(filepath.Join(".", input.CribConfigsDir), donMetadata)
+	if err != nil {
+		return errors.Wrap(err, "failed to read node set URLs from file")
+	}
+
+	if input.ReadyTimeout > 0 {
+		if readyErr := waitForNodeSetReady(donMetadata.Name, nsOutput, input.ReadyTimeout); readyErr != nil {
+			return errors.Wrap(readyErr, "failed to wait for nodes to be ready")
 		}
+	}
 
-		// all of them need to use the same capabilities directory inside the container
-		if len(capabilitiesDirs) > 1 {
-			for capabilityDir, count := range capabilitiesDirsFound {
-				if count != len(workerNodes) {
-					return nil, fmt.Errorf("the same capability container dir %s wasn't defined for all worker nodes in nodeset %s. All worker nodes in the same nodeset must have the same capability container dir", capabilityDir, input.NodeSetInputs[j].Name)
-				}
-			}
-			destinationDir = capabilitiesDirs[0]
+	input.NodeSetInputs[j].Out = nsOutput
+
+	return nil
+}
+
+// UpgradeDons redeploys the DONs with upgrades using new images and/or config overrides, without purging the namespace,
+// so that the chain state and the other DONs are kept. Capabilities are copied again to the pods of the upgraded DONs,
+// as restarted pods don't keep them.
+func UpgradeDons(input *types.UpgradeCribDonsInput) ([]*types.CapabilitiesAwareNodeSet, error) {
+	if input == nil {
+		return nil, errors.New("UpgradeCribDonsInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	for donName := range input.Upgrades {
+		if !slices.ContainsFunc(input.Topology.DonsMetadata, func(donMetadata *types.DonMetadata) bool { return donMetadata.Name == donName }) {
+			return nil, fmt.Errorf("DON %s is not in the topology", donName)
 		}
+	}
 
-		for capability := range capabilitiesFound {
-			absSource, pathErr := filepath.Abs(capability)
-			if err != nil {
-				return nil, errors.Wrapf(pathErr, "failed to get absolute path to capability %s", capability)
-			}
+	deployInput := &types.DeployCribDonsInput{
+		Topology:         input.Topology,
+		NodeSetInputs:    input.NodeSetInputs,
+		NixShell:         input.NixShell,
+		CribConfigsDir:   input.CribConfigsDir,
+		ReadyTimeout:     input.ReadyTimeout,
+		RetryPolicy:      input.RetryPolicy,
+		SecretsDelivery:  input.SecretsDelivery,
+		ScrapeMetrics:    input.ScrapeMetrics,
+		NodeSetResources: input.NodeSetResources,
+		BlockchainOutput: input.BlockchainOutput,
+	}
 
-			destination := filepath.Join(destinationDir, filepath.Base(capability))
-			_, copyErr := input.NixShell.RunCommand(fmt.Sprintf("devspace run copy-to-pods --no-warn --var POD_NAME_PATTERN=%s --var SOURCE=%s --var DESTINATION=%s", podNamePattern, absSource, destination))
-			if copyErr != nil {
-				return nil, errors.Wrap(copyErr, "failed to copy capability to pods")
-			}
+	for j, donMetadata := range input.Topology.DonsMetadata {
+		upgrade, ok := input.Upgrades[donMetadata.Name]
+		if !ok || upgrade.IsEmpty() {
+			continue
 		}
 
-		nsOutput, err := infra.ReadNodeSetURL(filepath.Join(".", input.CribConfigsDir), donMetadata)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to read node set URLs from file")
+		if upgradeErr := applyDonUpgrade(input.NodeSetInputs[j], upgrade); upgradeErr != nil {
+			return nil, errors.Wrapf(upgradeErr, "failed to apply upgrade to DON %s", donMetadata.Name)
 		}
 
-		input.NodeSetInputs[j].Out = nsOutput
+		if deployErr := deployDon(deployInput, j, donMetadata); deployErr != nil {
+			return nil, errors.Wrapf(deployErr, "failed to upgrade DON %s", donMetadata.Name)
+		}
 	}
 
 	return input.NodeSetInputs, nil
 }
 
+func applyDonUpgrade(nodeSet *types.CapabilitiesAwareNodeSet, upgrade types.CribDonUpgrade) error {
+	nodeIndexes := upgrade.NodeIndexes
+	if len(nodeIndexes) == 0 {
+		for i := range nodeSet.NodeSpecs {
+			nodeIndexes = append(nodeIndexes, i)
+		}
+	}
+
+	for _, nodeIndex := range nodeIndexes {
+		if nodeIndex < 0 || nodeIndex >= len(nodeSet.NodeSpecs) {
+			return fmt.Errorf("node index %d is out of range, nodeset %s has %d nodes", nodeIndex, nodeSet.Name, len(nodeSet.NodeSpecs))
+		}
+
+		node := nodeSet.NodeSpecs[nodeIndex].Node
+		if upgrade.Image != "" {
+			if _, tagErr := dockerImageTag(upgrade.Image); tagErr != nil {
+				return tagErr
+			}
+			node.Image = upgrade.Image
+		}
+		if upgrade.ConfigOverrides != "" {
+			node.TestConfigOverrides = upgrade.ConfigOverrides
+		}
+		if upgrade.SecretsOverrides != "" {
+			node.TestSecretsOverrides = upgrade.SecretsOverrides
+		}
+	}
+
+	return nil
+}
+
+// TeardownDons purges the devspace releases of the given DONs and removes their config overrides, leaving the rest
+// of the namespace (blockchains, JD, other DONs) as is.
+func TeardownDons(input *types.TeardownCribDonsInput) error {
+	if input == nil {
+		return errors.New("TeardownCribDonsInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return errors.Wrap(valErr, "input validation failed")
+	}
+
+	// purge in reverse deployment order
+	for i := len(input.DonNames) - 1; i >= 0; i-- {
+		donName := input.DonNames[i]
+		purgeDonEnvVars := map[string]string{
+			// has to match the DON_TYPE the DON was deployed with
+			"DON_TYPE": donName,
+		}
+		_, purgeErr := input.NixShell.RunCommandWithEnvVars("devspace run purge-don --no-warn", purgeDonEnvVars)
+		if purgeErr != nil {
+			return errors.Wrapf(purgeErr, "failed to run devspace run purge-don for %s", donName)
+		}
+
+		// DONs deployed with secrets in files have none, so it's a no-op for them
+		if secretsErr := deleteSecretsOverrideSecrets(input.NixShell, donName); secretsErr != nil {
+			return secretsErr
+		}
+
+		cribConfigsDir := filepath.Join(".", input.CribConfigsDir, donName)
+		if removeErr := os.RemoveAll(cribConfigsDir); removeErr != nil {
+			return errors.Wrapf(removeErr, "failed to remove crib configs directory '%s' for %s", cribConfigsDir, donName)
+		}
+	}
+
+	return nil
+}
+
 func DeployJd(input *types.DeployCribJdInput) (*jd.Output, error) {
 	if input == nil {
 		return nil, errors.New("DeployCribJdInput is nil")
@@ -308,21 +777,85 @@ func DeployJd(input *types.DeployCribJdInput) (*jd.Output, error) {
 	jdEnvVars := map[string]string{
 		"JOB_DISTRIBUTOR_IMAGE_TAG": imgTagIndex,
 	}
-	_, err = input.NixShell.RunCommandWithEnvVars("devspace run deploy-jd --no-warn", jdEnvVars)
+	if input.Database != nil {
+		databaseEnvVars, databaseErr := jdDatabaseEnvVars(input.JDInput, input.Database)
+		if databaseErr != nil {
+			return nil, errors.Wrap(databaseErr, "failed to configure JD database")
+		}
+		maps.Copy(jdEnvVars, databaseEnvVars)
+	}
+
+	_, err = runWithRetry(input.NixShell, input.RetryPolicy, retryableCommand{
+		command:        "devspace run deploy-jd --no-warn",
+		envVars:        jdEnvVars,
+		secretEnvVars:  []string{jdDatabaseURLEnvVar},
+		alreadyApplied: fileWrittenSince(infra.JdURLFile(filepath.Join(".", input.CribConfigsDir))),
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to run devspace run deploy-jd")
+		return nil, deploymentError(input.NixShell, "devspace run deploy-jd", err, jdPodName)
 	}
 
-	jdOut, err := infra.ReadJdURL(filepath.Join(".", input.CribConfigsDir))
+	jdOut, err := infra.ReadJdURL(filepath.Join(".", input.CribConfigsDir), input.ReadyTimeout)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to read JD URL from file")
 	}
 
+	if input.Database != nil {
+		// jd.Output has no place for the database, its connection details are the output of the database input,
+		// same as with JD deployed in Docker
+		databaseOut := &postgres.Output{JDUrl: input.Database.ExternalURL, JDInternalURL: input.Database.ExternalURL}
+		if input.Database.Deploy {
+			databaseOut, err = infra.ReadJdDatabaseURL(filepath.Join(".", input.CribConfigsDir))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read JD database URL from file")
+			}
+		}
+		if input.JDInput.DBInput == nil {
+			input.JDInput.DBInput = &postgres.Input{}
+		}
+		input.JDInput.DBInput.Out = databaseOut
+	}
+
 	return jdOut, nil
 }
 
+// jdDatabaseURLEnvVar is a secret env var, it's never logged
+const jdDatabaseURLEnvVar = "JD_DB_URL"
+
+// jdDatabaseEnvVars returns the env vars making devspace deploy a Postgres for JD, or point JD at an external one
+func jdDatabaseEnvVars(jdInput *jd.Input, database *types.CribJdDatabase) (map[string]string, error) {
+	envVars := map[string]string{
+		"JD_DB_DEPLOY":  strconv.FormatBool(database.Deploy),
+		"JD_DB_MIGRATE": strconv.FormatBool(!database.SkipMigrations),
+	}
+
+	if database.ExternalURL != "" {
+		// the URI contains the password of the database
+		envVars[jdDatabaseURLEnvVar] = database.ExternalURL
+		return envVars, nil
+	}
+
+	if jdInput.DBInput != nil && jdInput.DBInput.Image != "" {
+		imageName, err := dockerImageName(jdInput.DBInput.Image)
+		if err != nil {
+			return nil, err
+		}
+		imageTag, err := dockerImageTag(jdInput.DBInput.Image)
+		if err != nil {
+			return nil, err
+		}
+		envVars["JD_DB_IMAGE_REPOSITORY"] = imageName
+		envVars["JD_DB_IMAGE_TAG"] = imageTag
+	}
+
+	return envVars, nil
+}
+
+// nodesetDockerImage returns the default Docker image of the node set, which is the image used by most of its nodes
+// (the first one in case of a tie). Nodes using other images are deployed with per-node image overrides.
 func nodesetDockerImage(nodeSet *types.CapabilitiesAwareNodeSet) (string, error) {
 	dockerImages := []string{}
+	imageCounts := map[string]int{}
 	for nodeIdx, nodeSpec := range nodeSet.NodeSpecs {
 		if nodeSpec.Node.DockerContext != "" {
 			return "", fmt.Errorf("docker context is not supported in CRIB. Please remove docker_ctx from the node at index %d in nodeSet %s", nodeIdx, nodeSet.Name)
@@ -330,18 +863,33 @@ func nodesetDockerImage(nodeSet *types.CapabilitiesAwareNodeSet) (string, error)
 		if nodeSpec.Node.DockerFilePath != "" {
 			return "", fmt.Errorf("dockerfile is not supported in CRIB. Please remove docker_file from the node spec at index %d in nodeSet %s", nodeIdx, nodeSet.Name)
 		}
+		if nodeSpec.Node.Image == "" {
+			return "", fmt.Errorf("docker image is not set for the node at index %d in nodeSet %s", nodeIdx, nodeSet.Name)
+		}
 
+		imageCounts[nodeSpec.Node.Image]++
 		if slices.Contains(dockerImages, nodeSpec.Node.Image) {
 			continue
 		}
 		dockerImages = append(dockerImages, nodeSpec.Node.Image)
 	}
 
-	if len(dockerImages) != 1 {
-		return "", fmt.Errorf("all nodes in each nodeSet %s must use the same Docker image, but %d different images were found: %s", nodeSet.Name, len(dockerImages), strings.Join(dockerImages, ", "))
+	if len(dockerImages) == 0 {
+		return "", fmt.Errorf("nodeSet %s has no nodes", nodeSet.Name)
+	}
+
+	defaultImage := dockerImages[0]
+	for _, dockerImage := range dockerImages[1:] {
+		if imageCounts[dockerImage] > imageCounts[defaultImage] {
+			defaultImage = dockerImage
+		}
+	}
+
+	if len(dockerImages) > 1 {
+		fmt.Printf("nodeSet %s uses %d different Docker images, nodes not using %s will be deployed with per-node image overrides: %s\n", nodeSet.Name, len(dockerImages), defaultImage, strings.Join(dockerImages, ", "))
 	}
 
-	return dockerImages[0], nil
+	return defaultImage, nil
 }
 
 func dockerImageName(image string) (string, error) {
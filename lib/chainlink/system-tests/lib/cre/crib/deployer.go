@@ -0,0 +1,66 @@
+package crib
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/blockchain"
+	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/jd"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+)
+
+// Deployer deploys to CRIB with the settings of its template inputs, the blockchain, topology, node set and JD inputs
+// of the templates are set by each deployment
+type Deployer struct {
+	BlockchainTemplate types.DeployCribBlockchainInput
+	DonsTemplate       types.DeployCribDonsInput
+	JdTemplate         types.DeployCribJdInput
+}
+
+func (d *Deployer) DeployBlockchain(input *blockchain.Input) (*blockchain.Output, error) {
+	deployInput := d.BlockchainTemplate
+	deployInput.BlockchainInput = input
+
+	blockchainOut, err := DeployBlockchain(&deployInput)
+	if err != nil {
+		return nil, err
+	}
+	input.Out = blockchainOut
+
+	return blockchainOut, nil
+}
+
+func (d *Deployer) DeployDons(input *types.DeployDonsInput) ([]*types.WrappedNodeOutput, error) {
+	if input == nil {
+		return nil, errors.New("DeployDonsInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	deployInput := d.DonsTemplate
+	deployInput.Topology = input.Topology
+	deployInput.NodeSetInputs = input.NodeSetInputs
+	deployInput.BlockchainOutput = input.BlockchainOutput
+
+	nodeSets, err := DeployDons(&deployInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.WrapNodeOutputs(nodeSets), nil
+}
+
+func (d *Deployer) DeployJd(input *jd.Input) (*jd.Output, error) {
+	deployInput := d.JdTemplate
+	deployInput.JDInput = input
+
+	jdOut, err := DeployJd(&deployInput)
+	if err != nil {
+		return nil, err
+	}
+	input.Out = jdOut
+
+	return jdOut, nil
+}
@@ -0,0 +1,84 @@
+package crib
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+const (
+	// diagnosticsLogLines is the number of the most recent log lines fetched from every container of a failed pod
+	diagnosticsLogLines = 100
+	// diagnosticsCommandTimeout keeps collecting diagnostics of an unreachable cluster from hanging the failed test
+	diagnosticsCommandTimeout = time.Minute
+)
+
+// jdPodName matches the pods of JD and of its database
+var jdPodName = regexp.MustCompile(`^jd-`)
+
+// DeploymentError is returned when devspace fails to deploy a workload. It carries the recent logs and events of
+// the pods of the workload, which usually tell why, unlike the output of devspace.
+type DeploymentError struct {
+	// Command is the devspace command, which failed
+	Command     string
+	Err         error
+	Diagnostics string
+}
+
+func (e *DeploymentError) Error() string {
+	return fmt.Sprintf("failed to run %s: %s\n%s", e.Command, e.Err, e.Diagnostics)
+}
+
+func (e *DeploymentError) Unwrap() error {
+	return e.Err
+}
+
+// deploymentError fetches the diagnostics of the pods matching the pattern and returns them together with the error
+// of the failed command
+func deploymentError(nixShell *nix.Shell, command string, err error, podName *regexp.Regexp) error {
+	return &DeploymentError{
+		Command:     command,
+		Err:         err,
+		Diagnostics: podDiagnostics(nixShell, podName),
+	}
+}
+
+// podDiagnostics returns the recent logs and the events of the pods matching the pattern. Failures to fetch them
+// are part of the diagnostics, as they are collected for an error, which is already being returned.
+func podDiagnostics(nixShell *nix.Shell, podName *regexp.Regexp) string {
+	pods, err := listPods(nixShell, podName)
+	if err != nil {
+		return fmt.Sprintf("failed to list pods for diagnostics: %s", err)
+	}
+	if len(pods) == 0 {
+		return fmt.Sprintf("no pods matching %s were created", podName)
+	}
+
+	run := func(command string) string {
+		// diagnostics are returned in the error, no need to stream them
+		result, runErr := nixShell.Run(context.Background(), nix.Command{Command: command, Timeout: diagnosticsCommandTimeout, Output: io.Discard})
+		if runErr != nil {
+			if result == nil {
+				return fmt.Sprintf("failed to run %s: %s", command, runErr)
+			}
+			return fmt.Sprintf("failed to run %s: %s\n%s", command, runErr, result.Output)
+		}
+
+		return strings.TrimSpace(result.Output)
+	}
+
+	var diagnostics strings.Builder
+	for _, pod := range pods {
+		fmt.Fprintf(&diagnostics, "=== events of pod %s ===\n", pod)
+		fmt.Fprintln(&diagnostics, run(fmt.Sprintf(`kubectl get events -n "$DEVSPACE_NAMESPACE" --field-selector involvedObject.name=%s --sort-by=.lastTimestamp`, pod)))
+		fmt.Fprintf(&diagnostics, "=== last %d log lines of pod %s ===\n", diagnosticsLogLines, pod)
+		fmt.Fprintln(&diagnostics, run(fmt.Sprintf(`kubectl logs -n "$DEVSPACE_NAMESPACE" %s --all-containers --prefix --tail=%d`, pod, diagnosticsLogLines)))
+	}
+
+	return diagnostics.String()
+}
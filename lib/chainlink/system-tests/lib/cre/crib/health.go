@@ -0,0 +1,78 @@
+package crib
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ns "github.com/smartcontractkit/chainlink-testing-framework/framework/components/simple_node_set"
+)
+
+const (
+	readyPollInterval   = 5 * time.Second
+	readyRequestTimeout = 10 * time.Second
+)
+
+// waitForNodeSetReady polls the readiness endpoint of every node of the node set, until all of them report
+// they are ready or the timeout expires. Deployed pods might still be starting when devspace returns.
+func waitForNodeSetReady(nodeSetName string, nodeSetOut *ns.Output, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	notReady := map[string]error{}
+	for _, node := range nodeSetOut.CLNodes {
+		notReady[node.Node.ExternalURL] = errors.New("not polled yet")
+	}
+
+	ticker := time.NewTicker(readyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for nodeURL := range notReady {
+			if err := checkNodeReady(ctx, nodeURL); err != nil {
+				notReady[nodeURL] = err
+				continue
+			}
+			delete(notReady, nodeURL)
+		}
+
+		if len(notReady) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			reasons := make([]string, 0, len(notReady))
+			for nodeURL, err := range notReady {
+				reasons = append(reasons, fmt.Sprintf("%s: %s", nodeURL, err))
+			}
+			return fmt.Errorf("%d nodes in nodeset %s were not ready after %s: %s", len(notReady), nodeSetName, timeout, strings.Join(reasons, "; "))
+		case <-ticker.C:
+		}
+	}
+}
+
+func checkNodeReady(ctx context.Context, nodeURL string) error {
+	ctx, cancel := context.WithTimeout(ctx, readyRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(nodeURL, "/")+"/readyz", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package crib
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+const (
+	// namespaceManagedByLabel marks namespaces created by the NamespaceManager, only those are ever purged by it
+	namespaceManagedByLabel = "crib.chain.link/managed-by"
+	namespaceManagedByValue = "cre-system-tests"
+
+	namespaceOwnerAnnotation     = "crib.chain.link/owner"
+	namespaceCreatedAtAnnotation = "crib.chain.link/created-at"
+	namespaceTTLAnnotation       = "crib.chain.link/ttl"
+)
+
+// Namespace is a namespace created by the NamespaceManager
+type Namespace struct {
+	Name  string
+	Owner string
+	// CreatedAt is when the namespace was last (re)created, the TTL counts from it
+	CreatedAt time.Time
+	// TTL is zero for namespaces which never expire
+	TTL time.Duration
+}
+
+func (n Namespace) Expired(now time.Time) bool {
+	return n.TTL > 0 && now.After(n.CreatedAt.Add(n.TTL))
+}
+
+// NamespaceManager creates CRIB namespaces annotated with their owner and TTL, and purges the expired ones, so that
+// namespaces left behind by crashed or cancelled test runs don't pile up in shared clusters.
+type NamespaceManager struct {
+	nixShell *nix.Shell
+}
+
+func NewNamespaceManager(nixShell *nix.Shell) *NamespaceManager {
+	return &NamespaceManager{nixShell: nixShell}
+}
+
+// Create creates the namespace, if it doesn't exist, and (re)sets its owner and TTL annotations, so that the TTL of
+// a reused namespace counts from now. Zero TTL means the namespace never expires.
+func (m *NamespaceManager) Create(name, owner string, ttl time.Duration) error {
+	if name == "" {
+		return errors.New("namespace name is empty")
+	}
+	if ttl < 0 {
+		return errors.New("namespace TTL must not be negative")
+	}
+
+	_, err := m.nixShell.RunCommand(fmt.Sprintf("kubectl create namespace %s --dry-run=client -o yaml | kubectl apply -f -", name))
+	if err != nil {
+		return errors.Wrapf(err, "failed to create namespace %s", name)
+	}
+
+	_, err = m.nixShell.RunCommand(fmt.Sprintf("kubectl label namespace %s --overwrite %s=%s", name, namespaceManagedByLabel, namespaceManagedByValue))
+	if err != nil {
+		return errors.Wrapf(err, "failed to label namespace %s", name)
+	}
+
+	annotations := []string{
+		fmt.Sprintf("%s=%s", namespaceOwnerAnnotation, shellQuote(owner)),
+		fmt.Sprintf("%s=%s", namespaceCreatedAtAnnotation, time.Now().UTC().Format(time.RFC3339)),
+		fmt.Sprintf("%s=%s", namespaceTTLAnnotation, ttl),
+	}
+	_, err = m.nixShell.RunCommand(fmt.Sprintf("kubectl annotate namespace %s --overwrite %s", name, strings.Join(annotations, " ")))
+	if err != nil {
+		return errors.Wrapf(err, "failed to annotate namespace %s", name)
+	}
+
+	return nil
+}
+
+// Reset removes everything deployed to the namespace with devspace, so that it can be reused
+func (m *NamespaceManager) Reset(name string) error {
+	_, err := m.nixShell.RunCommand(fmt.Sprintf("devspace purge --no-warn --namespace %s", name))
+	if err != nil {
+		return errors.Wrapf(err, "failed to run devspace purge in namespace %s", name)
+	}
+
+	return nil
+}
+
+// List returns the namespaces created by the NamespaceManager, ordered by name
+func (m *NamespaceManager) List() ([]Namespace, error) {
+	output, err := m.nixShell.RunCommand(fmt.Sprintf("kubectl get namespaces -l %s=%s -o json", namespaceManagedByLabel, namespaceManagedByValue))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list namespaces")
+	}
+
+	namespaces, err := parseNamespaces(output)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse namespaces")
+	}
+
+	return namespaces, nil
+}
+
+// ListStale returns the namespaces, whose TTL expired at the given time
+func (m *NamespaceManager) ListStale(now time.Time) ([]Namespace, error) {
+	namespaces, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(namespaces, func(namespace Namespace) bool { return !namespace.Expired(now) }), nil
+}
+
+// Purge deletes the namespace together with everything deployed to it, without waiting for the deletion to finish
+func (m *NamespaceManager) Purge(name string) error {
+	_, err := m.nixShell.RunCommand(fmt.Sprintf("kubectl delete namespace %s --ignore-not-found --wait=false", name))
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete namespace %s", name)
+	}
+
+	return nil
+}
+
+// PurgeExpired purges the namespaces, whose TTL has expired, except the ones listed in keep, and returns the names
+// of the purged namespaces
+func (m *NamespaceManager) PurgeExpired(keep ...string) ([]string, error) {
+	stale, err := m.ListStale(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	purged := []string{}
+	for _, namespace := range stale {
+		if slices.Contains(keep, namespace.Name) {
+			continue
+		}
+
+		fmt.Printf("purging namespace %s of %s, which expired at %s\n", namespace.Name, namespace.Owner, namespace.CreatedAt.Add(namespace.TTL).Format(time.RFC3339))
+		if err := m.Purge(namespace.Name); err != nil {
+			return purged, err
+		}
+		purged = append(purged, namespace.Name)
+	}
+
+	return purged, nil
+}
+
+// setUpNamespace purges the expired namespaces of the cluster, if requested, and resets and (re)creates the namespace
+// of the environment
+func setUpNamespace(nixShell *nix.Shell, namespace string, lifecycle *types.CribNamespaceLifecycle) error {
+	manager := NewNamespaceManager(nixShell)
+
+	if lifecycle.PurgeExpired {
+		if _, err := manager.PurgeExpired(namespace); err != nil {
+			return errors.Wrap(err, "failed to purge expired namespaces")
+		}
+	}
+
+	if !lifecycle.KeepDeployments {
+		// we run `devspace purge` to clean up the environment, in case our namespace is already used
+		if err := manager.Reset(namespace); err != nil {
+			return err
+		}
+	}
+
+	owner := lifecycle.Owner
+	if owner == "" {
+		owner = os.Getenv("USER")
+	}
+
+	return manager.Create(namespace, owner, lifecycle.TTL)
+}
+
+type kubernetesNamespaceList struct {
+	Items []struct {
+		Metadata struct {
+			Name        string            `json:"name"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+	} `json:"items"`
+}
+
+func parseNamespaces(output string) ([]Namespace, error) {
+	// the output might be preceded by lines printed by the shell, the JSON document starts at the first brace
+	start := strings.Index(output, "{")
+	if start < 0 {
+		return nil, fmt.Errorf("no JSON in output: %s", output)
+	}
+
+	list := kubernetesNamespaceList{}
+	if err := json.Unmarshal([]byte(output[start:]), &list); err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]Namespace, 0, len(list.Items))
+	for _, item := range list.Items {
+		namespace := Namespace{
+			Name:  item.Metadata.Name,
+			Owner: item.Metadata.Annotations[namespaceOwnerAnnotation],
+		}
+
+		// namespaces with missing or malformed annotations are treated as never expiring, so they are never purged
+		// by mistake
+		if createdAt, err := time.Parse(time.RFC3339, item.Metadata.Annotations[namespaceCreatedAtAnnotation]); err == nil {
+			namespace.CreatedAt = createdAt
+			if ttl, err := time.ParseDuration(item.Metadata.Annotations[namespaceTTLAnnotation]); err == nil {
+				namespace.TTL = ttl
+			}
+		}
+
+		namespaces = append(namespaces, namespace)
+	}
+
+	slices.SortFunc(namespaces, func(a, b Namespace) int { return strings.Compare(a.Name, b.Name) })
+
+	return namespaces, nil
+}
@@ -0,0 +1,59 @@
+package crib
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/infra"
+)
+
+const (
+	// nodes serve Prometheus metrics on their API port
+	nodeMetricsPort = 6688
+	nodeMetricsPath = "/metrics"
+)
+
+// DeployObservability deploys Prometheus, Loki and Grafana to the namespace and annotates the pods of the already
+// deployed node sets to be scraped, so that long running tests have metrics and logs of the nodes.
+func DeployObservability(input *types.DeployCribObservabilityInput) (*types.ObservabilityOutput, error) {
+	if input == nil {
+		return nil, errors.New("DeployCribObservabilityInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	observabilityEnvVars := map[string]string{}
+	if input.Retention > 0 {
+		observabilityEnvVars["OBSERVABILITY_RETENTION"] = fmt.Sprintf("%dh", int(input.Retention.Hours()))
+	}
+	_, err := input.NixShell.RunCommandWithEnvVars("devspace run deploy-observability --no-warn", observabilityEnvVars)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to run devspace run deploy-observability")
+	}
+
+	for _, nodeSetName := range input.ScrapedNodeSets {
+		pods, podsErr := listNodeSetPods(input.NixShell, nodeSetName)
+		if podsErr != nil {
+			return nil, errors.Wrapf(podsErr, "failed to list pods of nodeset %s", nodeSetName)
+		}
+
+		for _, pod := range pods {
+			_, annotateErr := input.NixShell.RunCommand(fmt.Sprintf(`kubectl annotate pod -n "$DEVSPACE_NAMESPACE" %s --overwrite prometheus.io/scrape=true prometheus.io/port=%d prometheus.io/path=%s`, pod, nodeMetricsPort, nodeMetricsPath))
+			if annotateErr != nil {
+				return nil, errors.Wrapf(annotateErr, "failed to annotate pod %s to be scraped", pod)
+			}
+		}
+	}
+
+	observabilityOut, err := infra.ReadObservabilityURL(filepath.Join(".", input.CribConfigsDir))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read observability URLs from file")
+	}
+
+	return observabilityOut, nil
+}
@@ -0,0 +1,263 @@
+package crib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+const defaultPreflightCheckTimeout = 30 * time.Second
+
+// preflightTools are the tools required by CRIB and the commands printing their versions
+var preflightTools = map[string]string{
+	"kubectl":  "kubectl version --client -o json",
+	"devspace": "devspace --version",
+	"nix":      "nix --version",
+}
+
+var versionPattern = regexp.MustCompile(`v?(\d+\.\d+(\.\d+)?)`)
+
+type PreflightCheckResult struct {
+	Name    string
+	Details string
+	// Err is nil for passed checks
+	Err error
+}
+
+// PreflightReport is the consolidated result of all preflight checks, all of them run even if some fail
+type PreflightReport struct {
+	Checks []PreflightCheckResult
+}
+
+func (r *PreflightReport) Failed() []PreflightCheckResult {
+	failed := []PreflightCheckResult{}
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			failed = append(failed, check)
+		}
+	}
+
+	return failed
+}
+
+// Err returns an error listing all failed checks, or nil if all of them passed
+func (r *PreflightReport) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	reasons := make([]string, 0, len(failed))
+	for _, check := range failed {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", check.Name, check.Err))
+	}
+
+	return fmt.Errorf("%d of %d CRIB preflight checks failed:\n%s", len(failed), len(r.Checks), strings.Join(reasons, "\n"))
+}
+
+func (r *PreflightReport) String() string {
+	var report strings.Builder
+	for _, check := range r.Checks {
+		status := "PASS"
+		details := check.Details
+		if check.Err != nil {
+			status = "FAIL"
+			details = check.Err.Error()
+		}
+		fmt.Fprintf(&report, "[%s] %s: %s\n", status, check.Name, details)
+	}
+
+	return report.String()
+}
+
+// Preflight checks that the cluster can be deployed to, before anything is deployed: that the required tools are
+// present in the nix shell in the required versions, that the current kube context is reachable, that the ECR login
+// is valid and that the resource quotas of the namespace aren't exhausted. It returns the report of all checks,
+// use PreflightReport.Err to fail on any failed check.
+func Preflight(input *types.PreflightCribInput) (*PreflightReport, error) {
+	if input == nil {
+		return nil, errors.New("PreflightCribInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	timeout := input.CheckTimeout
+	if timeout == 0 {
+		timeout = defaultPreflightCheckTimeout
+	}
+
+	run := func(command string) (string, error) {
+		// output is part of the report, no need to stream it
+		result, err := input.NixShell.Run(context.Background(), nix.Command{Command: command, Timeout: timeout, Output: io.Discard})
+		if result == nil {
+			return "", err
+		}
+
+		return result.Output, err
+	}
+
+	report := &PreflightReport{}
+	addCheck := func(name string, check func() (string, error)) {
+		details, err := check()
+		report.Checks = append(report.Checks, PreflightCheckResult{Name: name, Details: details, Err: err})
+	}
+
+	tools := make([]string, 0, len(preflightTools))
+	for tool := range preflightTools {
+		tools = append(tools, tool)
+	}
+	slices.Sort(tools)
+	for _, tool := range tools {
+		addCheck(tool+" version", func() (string, error) {
+			return checkToolVersion(run, tool, input.MinToolVersions[tool])
+		})
+	}
+
+	addCheck("kube context", func() (string, error) {
+		kubeContext, err := run("kubectl config current-context")
+		if err != nil {
+			return "", errors.Wrap(err, "no current kube context")
+		}
+		if _, err := run(fmt.Sprintf("kubectl get --raw=/readyz --request-timeout=%ds", seconds(timeout))); err != nil {
+			return "", errors.Wrapf(err, "cluster of kube context %s is not reachable", kubeContext)
+		}
+
+		return fmt.Sprintf("cluster of kube context %s is reachable", kubeContext), nil
+	})
+
+	if input.CheckECRLogin {
+		addCheck("ECR login", func() (string, error) {
+			identity, err := run("aws sts get-caller-identity --query Arn --output text")
+			if err != nil {
+				return "", errors.Wrap(err, "AWS session is not valid, log in with aws sso login")
+			}
+			if _, err := run("aws ecr get-authorization-token --query 'authorizationData[0].expiresAt' --output text"); err != nil {
+				return "", errors.Wrapf(err, "%s can't log in to ECR", identity)
+			}
+
+			return fmt.Sprintf("logged in as %s", identity), nil
+		})
+	}
+
+	addCheck("namespace quotas", func() (string, error) {
+		output, err := run(fmt.Sprintf("kubectl get resourcequota -n %s -o json", input.Namespace))
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get resource quotas of namespace %s", input.Namespace)
+		}
+
+		return checkResourceQuotas(output)
+	})
+
+	return report, nil
+}
+
+func checkToolVersion(run func(string) (string, error), tool, minVersion string) (string, error) {
+	output, err := run(preflightTools[tool])
+	if err != nil {
+		return "", errors.Wrapf(err, "%s is not available", tool)
+	}
+
+	if tool == "kubectl" {
+		var kubectlVersion struct {
+			ClientVersion struct {
+				GitVersion string `json:"gitVersion"`
+			} `json:"clientVersion"`
+		}
+		if jsonErr := json.Unmarshal([]byte(output[max(strings.Index(output, "{"), 0):]), &kubectlVersion); jsonErr == nil {
+			output = kubectlVersion.ClientVersion.GitVersion
+		}
+	}
+
+	match := versionPattern.FindStringSubmatch(output)
+	if match == nil {
+		return "", fmt.Errorf("failed to find version of %s in: %s", tool, output)
+	}
+
+	version, err := semver.NewVersion(match[1])
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse version %s of %s", match[1], tool)
+	}
+
+	if minVersion == "" {
+		return version.String(), nil
+	}
+
+	constraint, err := semver.NewConstraint(">= " + minVersion)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse min version %s of %s", minVersion, tool)
+	}
+	if !constraint.Check(version) {
+		return "", fmt.Errorf("%s %s is older than the required %s", tool, version, minVersion)
+	}
+
+	return fmt.Sprintf("%s (>= %s)", version, minVersion), nil
+}
+
+type resourceQuotaList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Status struct {
+			Hard map[string]string `json:"hard"`
+			Used map[string]string `json:"used"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+// checkResourceQuotas fails if any resource of any quota of the namespace is used up, since nothing more of it can
+// be deployed
+func checkResourceQuotas(output string) (string, error) {
+	start := strings.Index(output, "{")
+	if start < 0 {
+		return "", fmt.Errorf("no JSON in output: %s", output)
+	}
+
+	quotas := resourceQuotaList{}
+	if err := json.Unmarshal([]byte(output[start:]), &quotas); err != nil {
+		return "", errors.Wrap(err, "failed to parse resource quotas")
+	}
+
+	if len(quotas.Items) == 0 {
+		return "no resource quotas", nil
+	}
+
+	exhausted := []string{}
+	for _, quota := range quotas.Items {
+		for name, hardValue := range quota.Status.Hard {
+			hard, hardErr := resource.ParseQuantity(hardValue)
+			used, usedErr := resource.ParseQuantity(quota.Status.Used[name])
+			if hardErr != nil || usedErr != nil {
+				continue
+			}
+			if used.Cmp(hard) >= 0 {
+				exhausted = append(exhausted, fmt.Sprintf("%s/%s (%s of %s used)", quota.Metadata.Name, name, used.String(), hard.String()))
+			}
+		}
+	}
+
+	if len(exhausted) > 0 {
+		slices.Sort(exhausted)
+		return "", fmt.Errorf("resource quotas are exhausted: %s", strings.Join(exhausted, ", "))
+	}
+
+	return fmt.Sprintf("%d resource quotas have room left", len(quotas.Items)), nil
+}
+
+func seconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,115 @@
+package crib
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	libtypes "github.com/smartcontractkit/chainlink/system-tests/lib/types"
+)
+
+// ProviderFactory creates the provider for the CRIB input
+type ProviderFactory func(cribInput *libtypes.CRIBInput) (types.CribInfraProvider, error)
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]ProviderFactory{
+		libtypes.AWS:  newAWSProvider,
+		libtypes.Kind: newKindProvider,
+	}
+)
+
+// RegisterProvider makes a provider available under the given name, which is matched case-insensitively against the
+// provider of the CRIB input. Registering a name again replaces the previous provider.
+func RegisterProvider(name string, factory ProviderFactory) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+
+	providers[strings.ToLower(name)] = factory
+}
+
+// NewProvider creates the provider registered for the provider of the CRIB input
+func NewProvider(cribInput *libtypes.CRIBInput) (types.CribInfraProvider, error) {
+	if cribInput == nil {
+		return nil, errors.New("CRIB input is nil")
+	}
+
+	providersMu.RLock()
+	factory, ok := providers[strings.ToLower(cribInput.Provider)]
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	providersMu.RUnlock()
+
+	if !ok {
+		slices.Sort(names)
+		return nil, fmt.Errorf("unsupported CRIB provider %s, supported providers: %s", cribInput.Provider, strings.Join(names, ", "))
+	}
+
+	return factory(cribInput)
+}
+
+type awsProvider struct {
+	teamInput *libtypes.TeamInput
+}
+
+func newAWSProvider(cribInput *libtypes.CRIBInput) (types.CribInfraProvider, error) {
+	return &awsProvider{teamInput: cribInput.TeamInput}, nil
+}
+
+// EnvVars are required for cost attribution in AWS
+func (p *awsProvider) EnvVars() map[string]string {
+	if p.teamInput == nil {
+		return map[string]string{}
+	}
+
+	return map[string]string{
+		"CHAINLINK_TEAM":        p.teamInput.Team,
+		"CHAINLINK_PRODUCT":     p.teamInput.Product,
+		"CHAINLINK_COST_CENTER": p.teamInput.CostCenter,
+		"CHAINLINK_COMPONENT":   p.teamInput.Component,
+	}
+}
+
+func (p *awsProvider) PreflightChecks() error {
+	if p.teamInput == nil {
+		return errors.New("team input is required for cost attribution in AWS")
+	}
+
+	missing := []string{}
+	for name, value := range map[string]string{
+		"team":        p.teamInput.Team,
+		"product":     p.teamInput.Product,
+		"cost_center": p.teamInput.CostCenter,
+		"component":   p.teamInput.Component,
+	} {
+		if value == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return fmt.Errorf("team input is missing %s, required for cost attribution in AWS", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+type kindProvider struct{}
+
+func newKindProvider(_ *libtypes.CRIBInput) (types.CribInfraProvider, error) {
+	return &kindProvider{}, nil
+}
+
+func (p *kindProvider) EnvVars() map[string]string {
+	return map[string]string{}
+}
+
+func (p *kindProvider) PreflightChecks() error {
+	return nil
+}
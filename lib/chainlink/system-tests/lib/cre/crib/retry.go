@@ -0,0 +1,120 @@
+package crib
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+// transientErrorPatterns are (lowercase) fragments of the output of devspace/kubectl/helm commands, which failed
+// for reasons unrelated to what is being deployed, so that running the command again is likely to succeed
+var transientErrorPatterns = []string{
+	"toomanyrequests",
+	"too many requests",
+	"rate exceeded",
+	"throttl",
+	"connection refused",
+	"connection reset by peer",
+	"i/o timeout",
+	"tls handshake timeout",
+	"context deadline exceeded",
+	"the server is currently unable to handle the request",
+	"etcdserver: request timed out",
+	"etcdserver: leader changed",
+	"unexpected eof",
+	"error from server (internalerror)",
+	"error from server (serviceunavailable)",
+	"another operation (install/upgrade/rollback) is in progress",
+}
+
+type retryableCommand struct {
+	command string
+	envVars map[string]string
+	// secretEnvVars are the keys of envVars holding secrets, see nix.Command.SecretEnvVars
+	secretEnvVars []string
+	// alreadyApplied reports whether a failed attempt, which started at the given time, still applied the command,
+	// e.g. because it failed after the deployment had been created. It is checked before every retry, so that
+	// non-idempotent commands are not run twice. Nil means the command is safe to run again.
+	alreadyApplied func(attemptStartedAt time.Time) bool
+}
+
+// runWithRetry runs the command in the nix shell, retrying it according to the policy when it fails with a transient
+// error, and returns the output of the last attempt. Commands that timed out are not retried, since they likely hang
+// for reasons that retrying won't fix.
+func runWithRetry(nixShell *nix.Shell, policy *types.CribRetryPolicy, command retryableCommand) (string, error) {
+	maxAttempts := 1
+	var backoff, maxBackoff time.Duration
+	if policy != nil {
+		maxAttempts = policy.MaxAttempts
+		backoff = policy.InitialBackoff
+		maxBackoff = policy.MaxBackoff
+	}
+
+	var output string
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		attemptStartedAt := time.Now()
+		output, err = nixShell.RunCommandWithEnvVars(command.command, command.envVars, command.secretEnvVars...)
+		if err == nil {
+			return output, nil
+		}
+
+		if !isTransientError(err) {
+			return output, err
+		}
+
+		if command.alreadyApplied != nil && command.alreadyApplied(attemptStartedAt) {
+			fmt.Printf("'%s' failed with a transient error, but it was applied, not running it again: %s\n", command.command, err)
+			return output, nil
+		}
+
+		if attempt == maxAttempts {
+			break
+		}
+
+		fmt.Printf("'%s' failed with a transient error (attempt %d/%d), retrying in %s: %s\n", command.command, attempt, maxAttempts, backoff, err)
+		time.Sleep(backoff)
+		backoff = min(backoff*2, maxBackoff)
+	}
+
+	if maxAttempts > 1 {
+		return output, errors.Wrapf(err, "'%s' failed after %d attempts", command.command, maxAttempts)
+	}
+
+	return output, err
+}
+
+func isTransientError(err error) bool {
+	var commandErr *nix.CommandError
+	if !errors.As(err, &commandErr) || commandErr.TimedOut {
+		return false
+	}
+
+	output := strings.ToLower(commandErr.Result.Output)
+	for _, pattern := range transientErrorPatterns {
+		if strings.Contains(output, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fileWrittenSince is an idempotency guard for commands, which write a file once they have deployed something
+// (e.g. the URLs of deployed services)
+func fileWrittenSince(fileName string) func(time.Time) bool {
+	return func(since time.Time) bool {
+		info, err := os.Stat(fileName)
+		if err != nil {
+			return false
+		}
+		// some filesystems only have a second resolution of modification times
+		return !info.ModTime().Before(since.Truncate(time.Second))
+	}
+}
@@ -0,0 +1,155 @@
+package crib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/clnode"
+	libnode "github.com/smartcontractkit/chainlink/system-tests/lib/cre/don/node"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/infra"
+	libtypes "github.com/smartcontractkit/chainlink/system-tests/lib/types"
+)
+
+// ScaleDon adds or removes worker nodes of a deployed DON and redeploys it, so that tests can exercise DON membership
+// changes. Worker nodes are added after and removed from the end of the node set, bootstrap and gateway nodes are
+// never removed. Config and secrets overrides of the DON are regenerated from scratch.
+func ScaleDon(input *types.ScaleCribDonInput) (*types.CapabilitiesAwareNodeSet, error) {
+	if input == nil {
+		return nil, errors.New("ScaleCribDonInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	donIdx := -1
+	for i, donMetadata := range input.Topology.DonsMetadata {
+		if donMetadata.Name == input.DonName {
+			donIdx = i
+			break
+		}
+	}
+	if donIdx == -1 {
+		return nil, fmt.Errorf("DON %s is not in the topology", input.DonName)
+	}
+
+	donMetadata := input.Topology.DonsMetadata[donIdx]
+	nodeSet := input.NodeSetInputs[donIdx]
+
+	workerNodes, err := libnode.FindManyWithLabel(donMetadata.NodesMetadata, &types.Label{Key: libnode.NodeTypeKey, Value: types.WorkerNode}, libnode.EqualLabels)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find worker nodes")
+	}
+
+	switch {
+	case input.WorkerCount > len(workerNodes):
+		if addErr := addWorkerNodes(donMetadata, nodeSet, input.WorkerCount-len(workerNodes), input.AddedNodeSecretsOverrides); addErr != nil {
+			return nil, errors.Wrapf(addErr, "failed to add worker nodes to DON %s", input.DonName)
+		}
+	case input.WorkerCount < len(workerNodes):
+		if removeErr := removeWorkerNodes(donMetadata, nodeSet, len(workerNodes)-input.WorkerCount); removeErr != nil {
+			return nil, errors.Wrapf(removeErr, "failed to remove worker nodes from DON %s", input.DonName)
+		}
+	default:
+		return nodeSet, nil
+	}
+	nodeSet.Nodes = len(nodeSet.NodeSpecs)
+
+	// overrides of removed nodes must not be picked up by the redeployment
+	cribConfigsDir := filepath.Join(".", input.CribConfigsDir, donMetadata.Name)
+	if removeErr := os.RemoveAll(cribConfigsDir); removeErr != nil {
+		return nil, errors.Wrapf(removeErr, "failed to remove crib configs directory '%s' for %s", cribConfigsDir, donMetadata.Name)
+	}
+
+	deployInput := &types.DeployCribDonsInput{
+		Topology:         input.Topology,
+		NodeSetInputs:    input.NodeSetInputs,
+		NixShell:         input.NixShell,
+		CribConfigsDir:   input.CribConfigsDir,
+		ReadyTimeout:     input.ReadyTimeout,
+		SecretsDelivery:  input.SecretsDelivery,
+		NodeSetResources: input.NodeSetResources,
+		BlockchainOutput: input.BlockchainOutput,
+	}
+	if deployErr := deployDon(deployInput, donIdx, donMetadata); deployErr != nil {
+		return nil, errors.Wrapf(deployErr, "failed to redeploy DON %s", input.DonName)
+	}
+
+	return nodeSet, nil
+}
+
+func addWorkerNodes(donMetadata *types.DonMetadata, nodeSet *types.CapabilitiesAwareNodeSet, count int, secretsOverrides []string) error {
+	templateIdx, err := lastWorkerNodeIndex(donMetadata)
+	if err != nil {
+		return err
+	}
+	template := nodeSet.NodeSpecs[templateIdx]
+
+	for i := range count {
+		nodeIdx := len(nodeSet.NodeSpecs)
+
+		nodeInput := *template.Node
+		nodeInput.TestSecretsOverrides = ""
+		if i < len(secretsOverrides) {
+			nodeInput.TestSecretsOverrides = secretsOverrides[i]
+		}
+		nodeInput.CapabilitiesBinaryPaths = append([]string{}, template.Node.CapabilitiesBinaryPaths...)
+		nodeSet.NodeSpecs = append(nodeSet.NodeSpecs, &clnode.Input{
+			DbInput: template.DbInput,
+			Node:    &nodeInput,
+		})
+
+		donMetadata.NodesMetadata = append(donMetadata.NodesMetadata, &types.NodeMetadata{
+			Labels: []*types.Label{
+				{Key: libnode.NodeTypeKey, Value: types.WorkerNode},
+				{Key: libnode.IndexKey, Value: strconv.Itoa(nodeIdx)},
+				{Key: libnode.HostLabelKey, Value: infra.Host(nodeIdx, types.WorkerNode, donMetadata.Name, libtypes.InfraInput{InfraType: libtypes.CRIB})},
+			},
+		})
+	}
+
+	return nil
+}
+
+func removeWorkerNodes(donMetadata *types.DonMetadata, nodeSet *types.CapabilitiesAwareNodeSet, count int) error {
+	for range count {
+		lastIdx := len(donMetadata.NodesMetadata) - 1
+		if lastIdx < 0 {
+			return errors.New("no nodes left to remove")
+		}
+
+		nodeMetadata := donMetadata.NodesMetadata[lastIdx]
+		nodeType, err := libnode.FindLabelValue(nodeMetadata, libnode.NodeTypeKey)
+		if err != nil {
+			return errors.Wrapf(err, "failed to find type of node %d", lastIdx)
+		}
+		_, isGatewayErr := libnode.FindLabelValue(nodeMetadata, libnode.ExtraRolesKey)
+		if nodeType != types.WorkerNode || isGatewayErr == nil {
+			return fmt.Errorf("node %d is not a plain worker node, only worker nodes at the end of the nodeset can be removed", lastIdx)
+		}
+
+		donMetadata.NodesMetadata = donMetadata.NodesMetadata[:lastIdx]
+		nodeSet.NodeSpecs = nodeSet.NodeSpecs[:lastIdx]
+	}
+
+	return nil
+}
+
+func lastWorkerNodeIndex(donMetadata *types.DonMetadata) (int, error) {
+	for i := len(donMetadata.NodesMetadata) - 1; i >= 0; i-- {
+		nodeType, err := libnode.FindLabelValue(donMetadata.NodesMetadata[i], libnode.NodeTypeKey)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to find type of node %d", i)
+		}
+		if nodeType == types.WorkerNode {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("DON %s has no worker nodes to copy", donMetadata.Name)
+}
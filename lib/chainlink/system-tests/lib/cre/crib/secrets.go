@@ -0,0 +1,66 @@
+package crib
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+const (
+	// secretsOverrideDonLabel marks the Kubernetes Secrets holding secrets overrides of the nodes of a DON
+	secretsOverrideDonLabel = "crib.chain.link/don"
+	// secretsOverrideSecretKey is the key of the secrets overrides in the Kubernetes Secret, mounted as a file
+	secretsOverrideSecretKey = "secrets-override.toml"
+)
+
+var invalidSecretNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// secretsOverrideSecretName returns a valid Kubernetes object name for the secrets overrides of a node, e.g.
+// workflow-secrets-override-bt-0
+func secretsOverrideSecretName(donName, overrideName string) string {
+	name := invalidSecretNameChars.ReplaceAllString(strings.ToLower(donName+"-"+overrideName), "-")
+	return strings.Trim(name, "-")
+}
+
+// applySecretsOverrideSecret creates (or updates) the Kubernetes Secret with the secrets overrides of a node. The
+// secrets are passed to kubectl in a temporary file outside the working tree, which is removed right away, so that
+// they don't end up in the CRIB configs dir or CI artifacts.
+func applySecretsOverrideSecret(nixShell *nix.Shell, donName, secretName, secrets string) error {
+	secretsFile, err := os.CreateTemp("", "secrets-override-*.toml")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary secrets file")
+	}
+	defer os.Remove(secretsFile.Name())
+
+	_, writeErr := secretsFile.WriteString(secrets)
+	closeErr := secretsFile.Close()
+	if writeErr != nil {
+		return errors.Wrap(writeErr, "failed to write temporary secrets file")
+	}
+	if closeErr != nil {
+		return errors.Wrap(closeErr, "failed to close temporary secrets file")
+	}
+
+	command := fmt.Sprintf(`kubectl create secret generic %s -n "$DEVSPACE_NAMESPACE" --from-file=%s=%s --dry-run=client -o yaml | kubectl apply -f - && kubectl label secret %s -n "$DEVSPACE_NAMESPACE" --overwrite %s=%s`,
+		secretName, secretsOverrideSecretKey, secretsFile.Name(), secretName, secretsOverrideDonLabel, secretsOverrideSecretName(donName, ""))
+	if _, err := nixShell.RunCommand(command); err != nil {
+		return errors.Wrapf(err, "failed to apply secret %s", secretName)
+	}
+
+	return nil
+}
+
+// deleteSecretsOverrideSecrets deletes the Kubernetes Secrets with the secrets overrides of the nodes of the DON
+func deleteSecretsOverrideSecrets(nixShell *nix.Shell, donName string) error {
+	command := fmt.Sprintf(`kubectl delete secret -n "$DEVSPACE_NAMESPACE" -l %s=%s --ignore-not-found`, secretsOverrideDonLabel, secretsOverrideSecretName(donName, ""))
+	if _, err := nixShell.RunCommand(command); err != nil {
+		return errors.Wrapf(err, "failed to delete secrets of %s", donName)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package crib
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+const snapshotManifestFile = "snapshot.json"
+
+// chainSnapshotPipelines are the devspace pipelines capturing and restoring the state of a chain type, geth chains
+// are captured as an archive of their datadir and anvil chains as an anvil_dumpState dump
+var chainSnapshotPipelines = map[types.CribChainType]struct{ snapshot, restore, fileExtension string }{
+	types.CribChainGeth:  {snapshot: "snapshot-geth-chain", restore: "restore-geth-chain", fileExtension: "tar.gz"},
+	types.CribChainAnvil: {snapshot: "snapshot-anvil-chain", restore: "restore-anvil-chain", fileExtension: "json"},
+}
+
+// SnapshotEnvironment captures the state of the chains, the node databases of the DONs and the database of JD of a
+// deployed environment, so that long-running environments can be restored after destructive tests. It returns the
+// description of the snapshot, which is also written to the snapshot directory.
+func SnapshotEnvironment(input *types.SnapshotCribEnvironmentInput) (*types.CribSnapshot, error) {
+	if input == nil {
+		return nil, errors.New("SnapshotCribEnvironmentInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	// devspace runs in the CRIB folder, so it needs absolute paths
+	snapshotDir, err := filepath.Abs(filepath.Join(input.SnapshotDir, input.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve snapshot directory")
+	}
+	if _, statErr := os.Stat(snapshotDir); statErr == nil {
+		return nil, fmt.Errorf("snapshot %s already exists in %s", input.Name, input.SnapshotDir)
+	}
+	if mkdirErr := os.MkdirAll(snapshotDir, os.ModePerm); mkdirErr != nil {
+		return nil, errors.Wrapf(mkdirErr, "failed to create snapshot directory %s", snapshotDir)
+	}
+
+	snapshot := &types.CribSnapshot{
+		Name:      input.Name,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	for _, blockchainInput := range input.BlockchainInputs {
+		pipelines := chainSnapshotPipelines[blockchainInput.Type]
+		chain := types.CribSnapshotChain{
+			ChainID:   blockchainInput.ChainID,
+			ChainType: blockchainInput.Type,
+			File:      fmt.Sprintf("chain-%s.%s", blockchainInput.ChainID, pipelines.fileExtension),
+		}
+		snapshotErr := runSnapshotPipeline(input.NixShell, pipelines.snapshot, map[string]string{
+			"CHAIN_ID":      chain.ChainID,
+			"SNAPSHOT_FILE": shellQuote(filepath.Join(snapshotDir, chain.File)),
+		}, filepath.Join(snapshotDir, chain.File))
+		if snapshotErr != nil {
+			return nil, errors.Wrapf(snapshotErr, "failed to capture state of chain %s", chain.ChainID)
+		}
+		snapshot.Chains = append(snapshot.Chains, chain)
+	}
+
+	for _, donName := range input.DonNames {
+		don := types.CribSnapshotDon{
+			Name: donName,
+			Dir:  "don-" + donName,
+		}
+		snapshotErr := runSnapshotPipeline(input.NixShell, "snapshot-don-dbs", map[string]string{
+			// has to match the DON_TYPE the DON was deployed with
+			"DON_TYPE":     donName,
+			"SNAPSHOT_DIR": shellQuote(filepath.Join(snapshotDir, don.Dir)),
+		}, filepath.Join(snapshotDir, don.Dir))
+		if snapshotErr != nil {
+			return nil, errors.Wrapf(snapshotErr, "failed to capture node databases of DON %s", donName)
+		}
+		snapshot.Dons = append(snapshot.Dons, don)
+	}
+
+	if input.JD {
+		snapshot.JdFile = "jd-db.dump"
+		snapshotErr := runSnapshotPipeline(input.NixShell, "snapshot-jd-db", map[string]string{
+			"SNAPSHOT_FILE": shellQuote(filepath.Join(snapshotDir, snapshot.JdFile)),
+		}, filepath.Join(snapshotDir, snapshot.JdFile))
+		if snapshotErr != nil {
+			return nil, errors.Wrap(snapshotErr, "failed to capture JD database")
+		}
+	}
+
+	manifest, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal snapshot")
+	}
+	// written last, so that incomplete snapshots can't be restored
+	if writeErr := os.WriteFile(filepath.Join(snapshotDir, snapshotManifestFile), manifest, 0600); writeErr != nil {
+		return nil, errors.Wrap(writeErr, "failed to write snapshot")
+	}
+
+	return snapshot, nil
+}
+
+// RestoreEnvironment restores the state captured by SnapshotEnvironment to the same environment, it must not have
+// been redeployed since. Chains are restored first, then JD and the DONs, whose nodes are restarted by the restore
+// pipelines, so that they don't keep any state from before the restore.
+func RestoreEnvironment(input *types.RestoreCribEnvironmentInput) error {
+	if input == nil {
+		return errors.New("RestoreCribEnvironmentInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return errors.Wrap(valErr, "input validation failed")
+	}
+
+	snapshotDir, err := filepath.Abs(filepath.Join(input.SnapshotDir, input.Name))
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve snapshot directory")
+	}
+
+	manifest, err := os.ReadFile(filepath.Join(snapshotDir, snapshotManifestFile))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read snapshot %s, it might be incomplete", input.Name)
+	}
+	snapshot := &types.CribSnapshot{}
+	if jsonErr := json.Unmarshal(manifest, snapshot); jsonErr != nil {
+		return errors.Wrapf(jsonErr, "failed to parse snapshot %s", input.Name)
+	}
+
+	for _, chain := range snapshot.Chains {
+		pipelines, ok := chainSnapshotPipelines[chain.ChainType]
+		if !ok {
+			return fmt.Errorf("state of %s chain %s can't be restored", chain.ChainType, chain.ChainID)
+		}
+		restoreErr := runRestorePipeline(input.NixShell, pipelines.restore, map[string]string{
+			"CHAIN_ID":      chain.ChainID,
+			"SNAPSHOT_FILE": shellQuote(filepath.Join(snapshotDir, chain.File)),
+		}, filepath.Join(snapshotDir, chain.File))
+		if restoreErr != nil {
+			return errors.Wrapf(restoreErr, "failed to restore state of chain %s", chain.ChainID)
+		}
+	}
+
+	if snapshot.JdFile != "" {
+		restoreErr := runRestorePipeline(input.NixShell, "restore-jd-db", map[string]string{
+			"SNAPSHOT_FILE": shellQuote(filepath.Join(snapshotDir, snapshot.JdFile)),
+		}, filepath.Join(snapshotDir, snapshot.JdFile))
+		if restoreErr != nil {
+			return errors.Wrap(restoreErr, "failed to restore JD database")
+		}
+	}
+
+	for _, don := range snapshot.Dons {
+		restoreErr := runRestorePipeline(input.NixShell, "restore-don-dbs", map[string]string{
+			"DON_TYPE":     don.Name,
+			"SNAPSHOT_DIR": shellQuote(filepath.Join(snapshotDir, don.Dir)),
+		}, filepath.Join(snapshotDir, don.Dir))
+		if restoreErr != nil {
+			return errors.Wrapf(restoreErr, "failed to restore node databases of DON %s", don.Name)
+		}
+	}
+
+	return nil
+}
+
+// runSnapshotPipeline runs the devspace pipeline and checks it wrote the snapshot file (or directory)
+func runSnapshotPipeline(nixShell *nix.Shell, pipeline string, envVars map[string]string, snapshotPath string) error {
+	if _, err := nixShell.RunCommandWithEnvVars(fmt.Sprintf("devspace run %s --no-warn", pipeline), envVars); err != nil {
+		return errors.Wrapf(err, "failed to run devspace run %s", pipeline)
+	}
+
+	if _, err := os.Stat(snapshotPath); err != nil {
+		return errors.Wrapf(err, "devspace run %s didn't write the snapshot", pipeline)
+	}
+
+	return nil
+}
+
+// runRestorePipeline checks the snapshot file (or directory) exists and runs the devspace pipeline restoring it
+func runRestorePipeline(nixShell *nix.Shell, pipeline string, envVars map[string]string, snapshotPath string) error {
+	if _, err := os.Stat(snapshotPath); err != nil {
+		return errors.Wrap(err, "snapshot is incomplete")
+	}
+
+	if _, err := nixShell.RunCommandWithEnvVars(fmt.Sprintf("devspace run %s --no-warn", pipeline), envVars); err != nil {
+		return errors.Wrapf(err, "failed to run devspace run %s", pipeline)
+	}
+
+	return nil
+}
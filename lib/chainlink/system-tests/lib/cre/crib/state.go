@@ -0,0 +1,110 @@
+package crib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+)
+
+const donDeploymentStateFile = "deploy-state.json"
+
+// donDeploymentState is the desired state a DON was last successfully deployed with
+type donDeploymentState struct {
+	Hash       string    `json:"hash"`
+	DeployedAt time.Time `json:"deployedAt"`
+}
+
+// donDesiredStateHash hashes everything a deployment of the DON depends on: the devspace env vars (images, node counts,
+// resources...), the rendered config overrides and the secrets overrides of all nodes and the contents of the
+// capability binaries
+func donDesiredStateHash(nodeSet *types.CapabilitiesAwareNodeSet, configOverrides []string, envVars map[string]string) (string, error) {
+	hash := sha256.New()
+
+	keys := make([]string, 0, len(envVars))
+	for key := range envVars {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		fmt.Fprintf(hash, "env %q=%q\n", key, envVars[key])
+	}
+
+	for i, nodeSpec := range nodeSet.NodeSpecs {
+		fmt.Fprintf(hash, "node %d image %q\n", i, nodeSpec.Node.Image)
+		fmt.Fprintf(hash, "node %d config %q\n", i, configOverrides[i])
+		fmt.Fprintf(hash, "node %d secrets %q\n", i, nodeSpec.Node.TestSecretsOverrides)
+		fmt.Fprintf(hash, "node %d capabilities dir %q\n", i, nodeSpec.Node.CapabilityContainerDir)
+		for _, capability := range nodeSpec.Node.CapabilitiesBinaryPaths {
+			checksum, err := fileSHA256(capability)
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to calculate checksum of capability %s", capability)
+			}
+			fmt.Fprintf(hash, "node %d capability %q %s\n", i, filepath.Base(capability), checksum)
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// donUnchanged reports whether the DON was last deployed with the desired state and all of its pods still exist,
+// e.g. they weren't purged together with the namespace
+func donUnchanged(nixShell *nix.Shell, donConfigsDir string, nodeSet *types.CapabilitiesAwareNodeSet, desiredStateHash string) bool {
+	state, err := readDonDeploymentState(donConfigsDir)
+	if err != nil || state == nil || state.Hash != desiredStateHash {
+		return false
+	}
+
+	pods, err := listNodeSetPods(nixShell, nodeSet.Name)
+	if err != nil {
+		return false
+	}
+
+	return len(pods) == len(nodeSet.NodeSpecs)
+}
+
+// readDonDeploymentState returns nil, if the DON wasn't successfully deployed yet
+func readDonDeploymentState(donConfigsDir string) (*donDeploymentState, error) {
+	content, err := os.ReadFile(filepath.Join(donConfigsDir, donDeploymentStateFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	state := &donDeploymentState{}
+	if err := json.Unmarshal(content, state); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func writeDonDeploymentState(donConfigsDir, desiredStateHash string) error {
+	content, err := json.MarshalIndent(donDeploymentState{Hash: desiredStateHash, DeployedAt: time.Now().UTC()}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(donConfigsDir, donDeploymentStateFile), content, 0600)
+}
+
+// removeDonDeploymentState removes the state before the DON is deployed, so that a failed deployment is never
+// considered up-to-date
+func removeDonDeploymentState(donConfigsDir string) error {
+	if err := os.Remove(filepath.Join(donConfigsDir, donDeploymentStateFile)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package crib
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/blockchain"
+
+	libdon "github.com/smartcontractkit/chainlink/system-tests/lib/cre/don"
+	libnode "github.com/smartcontractkit/chainlink/system-tests/lib/cre/don/node"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+)
+
+const (
+	// ocrPeeringPort and capabilitiesPeeringPort are the P2P ports of the bootstrap nodes, same as in the generated
+	// node configs
+	ocrPeeringPort          = 5001
+	capabilitiesPeeringPort = 6690
+)
+
+// configOverrideFuncs are available in config override templates in addition to the builtin functions
+var configOverrideFuncs = template.FuncMap{
+	// tomlArray formats strings as a TOML array, e.g. DefaultBootstrappers = {{ tomlArray .OCRBootstrapPeers }}
+	"tomlArray": func(values []string) string {
+		quoted := make([]string, 0, len(values))
+		for _, value := range values {
+			quoted = append(quoted, fmt.Sprintf("'%s'", value))
+		}
+		return "[" + strings.Join(quoted, ", ") + "]"
+	},
+}
+
+// ConfigOverrideTemplate is the data of the Go templates, which config overrides of nodes deployed by DeployDons can
+// contain, so that tests don't need to compute per-node TOML, e.g.
+//
+//	[Feature]
+//	LogPoller = {{ eq .NodeType "worker" }}
+//	[[EVM]]
+//	ChainID = '{{ .ChainID }}'
+//	[P2P.V2]
+//	DefaultBootstrappers = {{ tomlArray .OCRBootstrapPeers }}
+//
+// Overrides without any template actions are used as they are.
+type ConfigOverrideTemplate struct {
+	// NodeIndex is the index of the node in the node specs of its node set
+	NodeIndex int
+	// NodeType is either bootstrap or worker
+	NodeType types.NodeType
+	DonName  string
+	DonID    uint32
+
+	topology         *types.Topology
+	donMetadata      *types.DonMetadata
+	blockchainOutput *blockchain.Output
+}
+
+// OCRBootstrapPeers returns the addresses (peerID@host:port) of the OCR bootstrap node of the DON, DONs without one
+// use the bootstrap node of the workflow DON
+func (t *ConfigOverrideTemplate) OCRBootstrapPeers() ([]string, error) {
+	bootstrapNodes, err := libnode.FindManyWithLabel(t.donMetadata.NodesMetadata, &types.Label{Key: libnode.NodeTypeKey, Value: types.BootstrapNode}, libnode.EqualLabels)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find bootstrap nodes")
+	}
+
+	if len(bootstrapNodes) == 0 {
+		peeringData, peeringErr := libdon.FindPeeringData(t.topology)
+		if peeringErr != nil {
+			return nil, errors.Wrap(peeringErr, "failed to find peering data")
+		}
+		return []string{fmt.Sprintf("%s@%s:%d", peeringData.GlobalBootstraperPeerID, peeringData.GlobalBootstraperHost, ocrPeeringPort)}, nil
+	}
+
+	peers := make([]string, 0, len(bootstrapNodes))
+	for _, bootstrapNode := range bootstrapNodes {
+		peerID, peerErr := libnode.ToP2PID(bootstrapNode, libnode.KeyExtractingTransformFn)
+		if peerErr != nil {
+			return nil, errors.Wrap(peerErr, "failed to get bootstrap node peer ID, were P2P keys added to the topology?")
+		}
+		host, hostErr := libnode.FindLabelValue(bootstrapNode, libnode.HostLabelKey)
+		if hostErr != nil {
+			return nil, errors.Wrap(hostErr, "failed to get bootstrap node host")
+		}
+		peers = append(peers, fmt.Sprintf("%s@%s:%d", peerID, host, ocrPeeringPort))
+	}
+
+	return peers, nil
+}
+
+// CapabilitiesBootstrapPeers returns the address (peerID@host:port) of the bootstrap node all DONs use for
+// capabilities peering, the one of the workflow DON
+func (t *ConfigOverrideTemplate) CapabilitiesBootstrapPeers() ([]string, error) {
+	peeringData, err := libdon.FindPeeringData(t.topology)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find peering data")
+	}
+
+	return []string{fmt.Sprintf("%s@%s:%d", peeringData.GlobalBootstraperPeerID, peeringData.GlobalBootstraperHost, capabilitiesPeeringPort)}, nil
+}
+
+// ChainID returns the ID of the blockchain the nodes connect to
+func (t *ConfigOverrideTemplate) ChainID() (string, error) {
+	if t.blockchainOutput == nil {
+		return "", errors.New("blockchain output not set")
+	}
+
+	return t.blockchainOutput.ChainID, nil
+}
+
+// ChainHTTPURL returns the HTTP RPC of the blockchain reachable from within the cluster
+func (t *ConfigOverrideTemplate) ChainHTTPURL() (string, error) {
+	if t.blockchainOutput == nil || len(t.blockchainOutput.Nodes) == 0 {
+		return "", errors.New("blockchain output not set")
+	}
+
+	return t.blockchainOutput.Nodes[0].InternalHTTPUrl, nil
+}
+
+// ChainWSURL returns the websocket RPC of the blockchain reachable from within the cluster
+func (t *ConfigOverrideTemplate) ChainWSURL() (string, error) {
+	if t.blockchainOutput == nil || len(t.blockchainOutput.Nodes) == 0 {
+		return "", errors.New("blockchain output not set")
+	}
+
+	return t.blockchainOutput.Nodes[0].InternalWSUrl, nil
+}
+
+// renderConfigOverrides expands the templates in the config overrides of the node set at index j, the rendered
+// overrides are indexed the same as the node specs
+func renderConfigOverrides(input *types.DeployCribDonsInput, j int, donMetadata *types.DonMetadata) ([]string, error) {
+	nodeSet := input.NodeSetInputs[j]
+	rendered := make([]string, len(nodeSet.NodeSpecs))
+	for i, nodeSpec := range nodeSet.NodeSpecs {
+		override := nodeSpec.Node.TestConfigOverrides
+		if !strings.Contains(override, "{{") {
+			rendered[i] = override
+			continue
+		}
+
+		tmpl, err := template.New(fmt.Sprintf("%s-%d", nodeSet.Name, i)).Funcs(configOverrideFuncs).Parse(override)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse config override template of node %d", i)
+		}
+
+		nodeType := types.WorkerNode
+		if i == nodeSet.BootstrapNodeIndex {
+			nodeType = types.BootstrapNode
+		}
+
+		var sb strings.Builder
+		err = tmpl.Execute(&sb, &ConfigOverrideTemplate{
+			NodeIndex:        i,
+			NodeType:         nodeType,
+			DonName:          donMetadata.Name,
+			DonID:            donMetadata.ID,
+			topology:         input.Topology,
+			donMetadata:      donMetadata,
+			blockchainOutput: input.BlockchainOutput,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to render config override template of node %d", i)
+		}
+		rendered[i] = sb.String()
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,165 @@
+package crib
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+
+	libnode "github.com/smartcontractkit/chainlink/system-tests/lib/cre/don/node"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+)
+
+// TopologyError lists everything wrong with the topology and the node sets of a deployment, so that all of it can be
+// fixed at once instead of one failed deployment at a time
+type TopologyError struct {
+	Violations []string
+}
+
+func (e *TopologyError) Error() string {
+	return fmt.Sprintf("topology has %d violations:\n  - %s", len(e.Violations), strings.Join(e.Violations, "\n  - "))
+}
+
+// ValidateTopology checks that the node metadata of the topology matches the node sets, that the config overrides
+// render and, same as the secrets overrides, are valid TOML and that the capability binaries exist. DeployDons runs it before any devspace command,
+// so that a mistake in one DON doesn't leave the DONs deployed before it behind.
+func ValidateTopology(input *types.DeployCribDonsInput) error {
+	if input == nil {
+		return errors.New("DeployCribDonsInput is nil")
+	}
+	if input.Topology == nil {
+		return errors.New("topology not set")
+	}
+
+	var violations []string
+	if len(input.Topology.DonsMetadata) != len(input.NodeSetInputs) {
+		violations = append(violations, fmt.Sprintf("topology has %d DONs, but there are %d node sets", len(input.Topology.DonsMetadata), len(input.NodeSetInputs)))
+	}
+
+	for j, donMetadata := range input.Topology.DonsMetadata {
+		if j >= len(input.NodeSetInputs) {
+			break
+		}
+		for _, violation := range nodeSetViolations(input, j, donMetadata) {
+			violations = append(violations, fmt.Sprintf("nodeset %s: %s", donMetadata.Name, violation))
+		}
+	}
+
+	if len(violations) > 0 {
+		return &TopologyError{Violations: violations}
+	}
+
+	return nil
+}
+
+func nodeSetViolations(input *types.DeployCribDonsInput, j int, donMetadata *types.DonMetadata) []string {
+	nodeSet := input.NodeSetInputs[j]
+	var violations []string
+	if donMetadata.Name != nodeSet.Name {
+		violations = append(violations, fmt.Sprintf("DON metadata is named %s, but belongs to node set %s", donMetadata.Name, nodeSet.Name))
+	}
+	if len(donMetadata.NodesMetadata) != len(nodeSet.NodeSpecs) {
+		violations = append(violations, fmt.Sprintf("DON has %d nodes, but the node set has %d node specs", len(donMetadata.NodesMetadata), len(nodeSet.NodeSpecs)))
+	}
+
+	// devspace deploys the nodes of each type in the order of their indexes, so they have to be 0..n-1
+	indexes := map[int]bool{}
+	nodeCounts := map[types.NodeType]int{}
+	for i, nodeMetadata := range donMetadata.NodesMetadata {
+		nodeType, typeErr := libnode.FindLabelValue(nodeMetadata, libnode.NodeTypeKey)
+		switch {
+		case typeErr != nil:
+			violations = append(violations, fmt.Sprintf("node %d: %s", i, typeErr))
+		case nodeType != types.BootstrapNode && nodeType != types.WorkerNode:
+			violations = append(violations, fmt.Sprintf("node %d has unsupported type %s", i, nodeType))
+		default:
+			nodeCounts[nodeType]++
+		}
+
+		indexStr, indexErr := libnode.FindLabelValue(nodeMetadata, libnode.IndexKey)
+		if indexErr != nil {
+			violations = append(violations, fmt.Sprintf("node %d: %s", i, indexErr))
+			continue
+		}
+		index, convErr := strconv.Atoi(indexStr)
+		if convErr != nil {
+			violations = append(violations, fmt.Sprintf("node %d has non-numeric index %s", i, indexStr))
+			continue
+		}
+		if indexes[index] {
+			violations = append(violations, fmt.Sprintf("node index %d is used by more than one node", index))
+		}
+		indexes[index] = true
+	}
+	for index := range len(donMetadata.NodesMetadata) {
+		if !indexes[index] {
+			violations = append(violations, fmt.Sprintf("node indexes aren't contiguous, no node has index %d", index))
+		}
+	}
+
+	expectedBootstrapNodes := 0
+	if nodeSet.BootstrapNodeIndex != -1 {
+		expectedBootstrapNodes = 1
+	}
+	if nodeCounts[types.BootstrapNode] != expectedBootstrapNodes {
+		violations = append(violations, fmt.Sprintf("DON has %d bootstrap nodes, but the node set has %d", nodeCounts[types.BootstrapNode], expectedBootstrapNodes))
+	}
+	if nodeCounts[types.BootstrapNode]+nodeCounts[types.WorkerNode] != len(nodeSet.NodeSpecs) {
+		violations = append(violations, fmt.Sprintf("DON has %d bootstrap and %d worker nodes, but the node set has %d node specs", nodeCounts[types.BootstrapNode], nodeCounts[types.WorkerNode], len(nodeSet.NodeSpecs)))
+	}
+
+	missingNodes := false
+	for i, nodeSpec := range nodeSet.NodeSpecs {
+		if nodeSpec.Node == nil {
+			violations = append(violations, fmt.Sprintf("node spec %d has no node input", i))
+			missingNodes = true
+		}
+	}
+	if missingNodes {
+		// the rest of the checks need the node inputs
+		return violations
+	}
+
+	// templates are rendered the same way as when the overrides are written, so that the rendered TOML is validated
+	configOverrides, renderErr := renderConfigOverrides(input, j, donMetadata)
+	if renderErr != nil {
+		violations = append(violations, renderErr.Error())
+	}
+
+	// nodes usually share capability binaries, each one is reported once
+	checkedBinaries := map[string]bool{}
+	for i, nodeSpec := range nodeSet.NodeSpecs {
+		if configOverrides != nil {
+			if tomlErr := validateTOML(configOverrides[i]); tomlErr != nil {
+				violations = append(violations, fmt.Sprintf("config override of node %d isn't valid TOML: %s", i, tomlErr))
+			}
+		}
+		if tomlErr := validateTOML(nodeSpec.Node.TestSecretsOverrides); tomlErr != nil {
+			violations = append(violations, fmt.Sprintf("secrets override of node %d isn't valid TOML: %s", i, tomlErr))
+		}
+		for _, capabilityBinaryPath := range nodeSpec.Node.CapabilitiesBinaryPaths {
+			if checkedBinaries[capabilityBinaryPath] {
+				continue
+			}
+			checkedBinaries[capabilityBinaryPath] = true
+			info, statErr := os.Stat(capabilityBinaryPath)
+			switch {
+			case statErr != nil:
+				violations = append(violations, fmt.Sprintf("capability binary %s: %s", capabilityBinaryPath, statErr))
+			case info.IsDir():
+				violations = append(violations, fmt.Sprintf("capability binary %s is a directory", capabilityBinaryPath))
+			}
+		}
+	}
+
+	return violations
+}
+
+// validateTOML checks the override parses, the same way it's parsed before it's written for devspace
+func validateTOML(tomlStr string) error {
+	var data any
+	return toml.Unmarshal([]byte(tomlStr), &data)
+}
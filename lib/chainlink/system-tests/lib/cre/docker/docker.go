@@ -0,0 +1,125 @@
+package docker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/blockchain"
+	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/jd"
+	ns "github.com/smartcontractkit/chainlink-testing-framework/framework/components/simple_node_set"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+)
+
+// DeployBlockchain starts the blockchain in a local Docker container, it's the Docker counterpart of crib.DeployBlockchain
+func DeployBlockchain(input *types.DeployDockerBlockchainInput) (*blockchain.Output, error) {
+	if input == nil {
+		return nil, errors.New("DeployDockerBlockchainInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	blockchainOut, err := blockchain.NewBlockchainNetwork(input.BlockchainInput)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create blockchain network")
+	}
+
+	return blockchainOut, nil
+}
+
+// DeployDons starts the nodes of each node set in local Docker containers sharing a single database, it's the Docker
+// counterpart of crib.DeployDons. The outputs of the nodes are set in the output of each node set.
+func DeployDons(input *types.DeployDockerDonsInput) ([]*types.CapabilitiesAwareNodeSet, error) {
+	if input == nil {
+		return nil, errors.New("DeployDockerDonsInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	for _, nodeSet := range input.NodeSetInputs {
+		nsOutput, err := ns.NewSharedDBNodeSet(nodeSet.Input, input.BlockchainOutput)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create node set named %s", nodeSet.Name)
+		}
+		nodeSet.Out = nsOutput
+	}
+
+	return input.NodeSetInputs, nil
+}
+
+// DeployJd starts JD and its database in local Docker containers, it's the Docker counterpart of crib.DeployJd
+func DeployJd(input *types.DeployDockerJdInput) (*jd.Output, error) {
+	if input == nil {
+		return nil, errors.New("DeployDockerJdInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	jdOut, err := jd.NewJD(input.JDInput)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to start JD container for image %s", input.JDInput.Image)
+
+		// useful end user messages
+		if strings.Contains(err.Error(), "pull access denied") || strings.Contains(err.Error(), "may require 'docker login'") {
+			err = fmt.Errorf("%w\nensure that you either you have built the local image or you are logged into AWS with a profile that can read it (`aws sso login --profile <foo>)`", err)
+		}
+		return nil, err
+	}
+
+	return jdOut, nil
+}
+
+// Deployer deploys to local Docker with the existing CTF components
+type Deployer struct{}
+
+func NewDeployer() *Deployer {
+	return &Deployer{}
+}
+
+func (d *Deployer) DeployBlockchain(input *blockchain.Input) (*blockchain.Output, error) {
+	blockchainOut, err := DeployBlockchain(&types.DeployDockerBlockchainInput{BlockchainInput: input})
+	if err != nil {
+		return nil, err
+	}
+	input.Out = blockchainOut
+
+	return blockchainOut, nil
+}
+
+func (d *Deployer) DeployDons(input *types.DeployDonsInput) ([]*types.WrappedNodeOutput, error) {
+	if input == nil {
+		return nil, errors.New("DeployDonsInput is nil")
+	}
+
+	if valErr := input.Validate(); valErr != nil {
+		return nil, errors.Wrap(valErr, "input validation failed")
+	}
+
+	nodeSets, err := DeployDons(&types.DeployDockerDonsInput{
+		NodeSetInputs:    input.NodeSetInputs,
+		BlockchainOutput: input.BlockchainOutput,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.WrapNodeOutputs(nodeSets), nil
+}
+
+func (d *Deployer) DeployJd(input *jd.Input) (*jd.Output, error) {
+	jdOut, err := DeployJd(&types.DeployDockerJdInput{JDInput: input})
+	if err != nil {
+		return nil, err
+	}
+	input.Out = jdOut
+
+	return jdOut, nil
+}
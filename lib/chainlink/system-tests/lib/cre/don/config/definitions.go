@@ -145,7 +145,7 @@ func WorkerWorkflowRegistry(workflowRegistryAddr common.Address, chainID uint64)
 }
 
 func WorkerGateway(nodeAddress common.Address, chainID uint64, donID uint32, gatewayConnectorData types.GatewayConnectorOutput) string {
-	gatewayURL := fmt.Sprintf("ws://%s:%d/%s", gatewayConnectorData.Host, 5003, "node")
+	gatewayURL := fmt.Sprintf("ws://%s:%d/%s", gatewayConnectorData.Host, gatewayConnectorData.Port, "node")
 
 	return fmt.Sprintf(`
 	[Capabilities.GatewayConnector]
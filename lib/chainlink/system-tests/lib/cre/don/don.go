@@ -40,14 +40,20 @@ func ValidateTopology(nodeSetInput []*cretypes.CapabilitiesAwareNodeSet, infraIn
 	if infraInput.InfraType == types.CRIB {
 		if len(nodeSetInput) == 1 && slices.Contains(nodeSetInput[0].DONTypes, cretypes.GatewayDON) {
 			if len(nodeSetInput[0].Capabilities) > 1 {
-				return errors.New("you must use at least 2 nodeSets when using CRIB and gateway DON. Gateway DON must be in a separate nodeSet and it must be named 'gateway'")
+				return errors.New("you must use at least 2 nodeSets when using CRIB and gateway DON. Gateway DON must be in a separate nodeSet")
 			}
 		}
+	}
 
-		for _, nodeSet := range nodeSetInput {
-			if infraInput.InfraType == types.CRIB && slices.Contains(nodeSetInput[0].DONTypes, cretypes.GatewayDON) && nodeSet.Name != "gateway" {
-				return errors.New("when using CRIB gateway nodeSet with the Gateway DON must be named 'gateway', but got " + nodeSet.Name)
-			}
+	for _, nodeSet := range nodeSetInput {
+		if nodeSet.GatewayConfig == nil {
+			continue
+		}
+		if !slices.Contains(nodeSet.DONTypes, cretypes.GatewayDON) {
+			return errors.New("gateway config is set for nodeSet " + nodeSet.Name + ", which is not a gateway DON")
+		}
+		if err := nodeSet.GatewayConfig.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid gateway config of nodeSet %s", nodeSet.Name)
 		}
 	}
 
@@ -126,7 +132,7 @@ func BuildTopology(nodeSetInput []*cretypes.CapabilitiesAwareNodeSet, infraInput
 
 					topology.GatewayConnectorOutput = &cretypes.GatewayConnectorOutput{
 						Path: "/node",
-						Port: 5003,
+						Port: nodeSetInput[donIdx].GatewayConnectorPort(),
 						Host: gatewayHost,
 						// do not set gateway connector dons, they will be resolved automatically
 					}
@@ -0,0 +1,96 @@
+package environment
+
+import (
+	"fmt"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/crib"
+	"github.com/smartcontractkit/chainlink/system-tests/lib/cre/docker"
+	keystonetypes "github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
+	libnix "github.com/smartcontractkit/chainlink/system-tests/lib/nix"
+	libtypes "github.com/smartcontractkit/chainlink/system-tests/lib/types"
+)
+
+// NewDeployer returns the deployer for the infra type of the input, CRIB deployments run in the nix shell, which
+// isn't used by Docker deployments and can be nil for them
+func NewDeployer(infraInput *libtypes.InfraInput, nixShell *libnix.Shell) (keystonetypes.Deployer, error) {
+	if infraInput == nil {
+		return nil, pkgerrors.New("infra input is nil")
+	}
+
+	switch infraInput.InfraType {
+	case libtypes.Docker:
+		return docker.NewDeployer(), nil
+	case libtypes.CRIB:
+		return newCribDeployer(infraInput.CRIB, nixShell)
+	default:
+		return nil, fmt.Errorf("unsupported infra type %s", infraInput.InfraType)
+	}
+}
+
+func newCribDeployer(cribInput *libtypes.CRIBInput, nixShell *libnix.Shell) (*crib.Deployer, error) {
+	if cribInput == nil {
+		return nil, pkgerrors.New("CRIB input is nil")
+	}
+	if nixShell == nil {
+		return nil, pkgerrors.New("nix shell is nil")
+	}
+
+	var readyTimeout time.Duration
+	if cribInput.ReadyTimeout != "" {
+		var parseErr error
+		readyTimeout, parseErr = time.ParseDuration(cribInput.ReadyTimeout)
+		if parseErr != nil {
+			return nil, pkgerrors.Wrapf(parseErr, "failed to parse CRIB ready timeout %s", cribInput.ReadyTimeout)
+		}
+	}
+
+	retryPolicy, retryErr := cribRetryPolicy(cribInput)
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	serviceReadyTimeout, readyErr := cribServiceReadyTimeout(cribInput)
+	if readyErr != nil {
+		return nil, readyErr
+	}
+
+	deployer := &crib.Deployer{
+		BlockchainTemplate: keystonetypes.DeployCribBlockchainInput{
+			NixShell:       nixShell,
+			CribConfigsDir: cribConfigsDir,
+			RetryPolicy:    retryPolicy,
+			ReadyTimeout:   serviceReadyTimeout,
+		},
+		DonsTemplate: keystonetypes.DeployCribDonsInput{
+			NixShell:             nixShell,
+			CribConfigsDir:       cribConfigsDir,
+			RollbackOnFailure:    cribInput.RollbackOnFailure,
+			ReadyTimeout:         readyTimeout,
+			RetryPolicy:          retryPolicy,
+			CapabilitiesCopyMode: cribInput.CapabilitiesCopyMode,
+			ArtifactsDir:         cribInput.ArtifactsDir,
+			SecretsDelivery:      cribInput.SecretsDelivery,
+			ScrapeMetrics:        cribInput.Observability,
+			NodeSetResources:     cribInput.NodeSetResources,
+			SkipUnchanged:        cribInput.SkipUnchangedDons,
+		},
+		JdTemplate: keystonetypes.DeployCribJdInput{
+			NixShell:       nixShell,
+			CribConfigsDir: cribConfigsDir,
+			RetryPolicy:    retryPolicy,
+			ReadyTimeout:   serviceReadyTimeout,
+		},
+	}
+	if jdDatabase := cribInput.JdDatabase; jdDatabase != nil {
+		deployer.JdTemplate.Database = &keystonetypes.CribJdDatabase{
+			Deploy:         jdDatabase.Deploy,
+			ExternalURL:    jdDatabase.ExternalURL,
+			SkipMigrations: jdDatabase.SkipMigrations,
+		}
+	}
+
+	return deployer, nil
+}
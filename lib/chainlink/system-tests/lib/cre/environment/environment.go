@@ -3,7 +3,6 @@ package environment
 import (
 	"context"
 	"crypto/tls"
-	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -24,7 +23,6 @@ import (
 	"github.com/smartcontractkit/chainlink-testing-framework/framework/clclient"
 	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/blockchain"
 	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/jd"
-	ns "github.com/smartcontractkit/chainlink-testing-framework/framework/components/simple_node_set"
 	"github.com/smartcontractkit/chainlink-testing-framework/seth"
 	keystone_changeset "github.com/smartcontractkit/chainlink/deployment/keystone/changeset"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -66,6 +64,7 @@ type SetupOutput struct {
 	BlockchainOutput                    *BlockchainOutput
 	DonTopology                         *keystonetypes.DonTopology
 	NodeOutput                          []*keystonetypes.WrappedNodeOutput
+	ObservabilityOutput                 *keystonetypes.ObservabilityOutput // only set if deployed in CRIB
 }
 
 type SetupInput struct {
@@ -94,10 +93,38 @@ func SetupTestEnvironment(
 	// We need to have this reference in the outer scope, because subsequent functions will need it
 	var nixShell *libnix.Shell
 	if input.InfraInput.InfraType == libtypes.CRIB {
+		var commandTimeout time.Duration
+		if input.InfraInput.CRIB.CommandTimeout != "" {
+			var parseErr error
+			commandTimeout, parseErr = time.ParseDuration(input.InfraInput.CRIB.CommandTimeout)
+			if parseErr != nil {
+				return nil, pkgerrors.Wrapf(parseErr, "failed to parse CRIB command timeout %s", input.InfraInput.CRIB.CommandTimeout)
+			}
+		}
+
+		var namespaceTTL time.Duration
+		if input.InfraInput.CRIB.NamespaceTTL != "" {
+			var parseErr error
+			namespaceTTL, parseErr = time.ParseDuration(input.InfraInput.CRIB.NamespaceTTL)
+			if parseErr != nil {
+				return nil, pkgerrors.Wrapf(parseErr, "failed to parse CRIB namespace TTL %s", input.InfraInput.CRIB.NamespaceTTL)
+			}
+		}
+
 		startNixShellInput := &keystonetypes.StartNixShellInput{
 			InfraInput:     &input.InfraInput,
 			CribConfigsDir: cribConfigsDir,
-			PurgeNamespace: true,
+			NamespaceLifecycle: &keystonetypes.CribNamespaceLifecycle{
+				Owner:           input.InfraInput.CRIB.NamespaceOwner,
+				TTL:             namespaceTTL,
+				PurgeExpired:    input.InfraInput.CRIB.PurgeExpiredNamespaces,
+				KeepDeployments: input.InfraInput.CRIB.SkipUnchangedDons,
+			},
+			CommandTimeout:  commandTimeout,
+			CommandOutput:   testLogger,
+			Preflight:       input.InfraInput.CRIB.Preflight,
+			MinToolVersions: input.InfraInput.CRIB.MinToolVersions,
+			ReuseSession:    input.InfraInput.CRIB.ReuseNixShell,
 		}
 
 		var nixErr error
@@ -109,14 +136,20 @@ func SetupTestEnvironment(
 
 	defer func() {
 		if nixShell != nil {
-			_ = nixShell.Close()
+			// closes shells which aren't reused
+			_ = nixShell.Release()
 		}
 	}()
 
+	// Deployer targets either CRIB or local Docker, so that the rest of the setup doesn't depend on the infra type
+	deployer, deployerErr := NewDeployer(&input.InfraInput, nixShell)
+	if deployerErr != nil {
+		return nil, pkgerrors.Wrap(deployerErr, "failed to create deployer")
+	}
+
 	blockchainsInput := BlockchainsInput{
 		blockchainInput: &input.BlockchainsInput,
-		infraInput:      &input.InfraInput,
-		nixShell:        nixShell,
+		deployer:        deployer,
 	}
 
 	blockchainsOutput, bcOutErr := CreateBlockchains(singeFileLogger, testLogger, blockchainsInput)
@@ -168,7 +201,7 @@ func SetupTestEnvironment(
 
 	// Translate node input to structure required further down the road and put as much information
 	// as we have at this point in labels. It will be used to generate node configs
-	topology, topoErr := libdon.BuildTopology(input.CapabilitiesAwareNodeSets, *blockchainsInput.infraInput)
+	topology, topoErr := libdon.BuildTopology(input.CapabilitiesAwareNodeSets, input.InfraInput)
 	if topoErr != nil {
 		return nil, pkgerrors.Wrap(topoErr, "failed to build topology")
 	}
@@ -279,58 +312,38 @@ func SetupTestEnvironment(
 		}
 	}
 
-	if input.InfraInput.InfraType == libtypes.CRIB {
-		testLogger.Info().Msg("Saving node configs and secret overrides")
-
-		deployCribDonsInput := &keystonetypes.DeployCribDonsInput{
-			Topology:       topology,
-			NodeSetInputs:  input.CapabilitiesAwareNodeSets,
-			NixShell:       nixShell,
-			CribConfigsDir: cribConfigsDir,
-		}
-
-		var devspaceErr error
-		input.CapabilitiesAwareNodeSets, devspaceErr = crib.DeployDons(deployCribDonsInput)
-		if devspaceErr != nil {
-			return nil, pkgerrors.Wrap(devspaceErr, "failed to deploy Dons with devspace")
-		}
+	// Hack for CI that allows us to dynamically set the JD image and version, same as for the nodes
+	if os.Getenv("CI") == "true" {
+		jdImage := ctfconfig.MustReadEnvVar_String(E2eJobDistributorImageEnvVarName)
+		jdVersion := os.Getenv(E2eJobDistributorVersionEnvVarName)
+		input.JdInput.Image = fmt.Sprintf("%s:%s", jdImage, jdVersion)
+	}
 
-		deployCribJdInput := &keystonetypes.DeployCribJdInput{
-			JDInput:        &input.JdInput,
+	var observabilityOutput *keystonetypes.ObservabilityOutput
+	if input.InfraInput.InfraType == libtypes.CRIB && input.InfraInput.CRIB.Observability {
+		var observabilityErr error
+		observabilityOutput, observabilityErr = crib.DeployObservability(&keystonetypes.DeployCribObservabilityInput{
 			NixShell:       nixShell,
 			CribConfigsDir: cribConfigsDir,
+		})
+		if observabilityErr != nil {
+			return nil, pkgerrors.Wrap(observabilityErr, "failed to deploy observability stack with devspace")
 		}
-
-		var jdErr error
-		input.JdInput.Out, jdErr = crib.DeployJd(deployCribJdInput)
-		if jdErr != nil {
-			return nil, pkgerrors.Wrap(jdErr, "failed to deploy JD with devspace")
-		}
+		testLogger.Info().Msgf("Grafana is available at %s", observabilityOutput.GrafanaURL)
 	}
 
-	jdOutput, jdErr := CreateJobDistributor(&input.JdInput)
-	if jdErr != nil {
-		jdErr = fmt.Errorf("failed to start JD container for image %s: %w", input.JdInput.Image, jdErr)
-
-		// useful end user messages
-		if strings.Contains(jdErr.Error(), "pull access denied") || strings.Contains(jdErr.Error(), "may require 'docker login'") {
-			jdErr = errors.Join(jdErr, errors.New("ensure that you either you have built the local image or you are logged into AWS with a profile that can read it (`aws sso login --profile <foo>)`"))
-		}
-		return nil, jdErr
+	nodeOutput, donsErr := deployer.DeployDons(&keystonetypes.DeployDonsInput{
+		Topology:         topology,
+		NodeSetInputs:    input.CapabilitiesAwareNodeSets,
+		BlockchainOutput: blockchainsOutput.BlockchainOutput,
+	})
+	if donsErr != nil {
+		return nil, pkgerrors.Wrap(donsErr, "failed to deploy DONs")
 	}
 
-	nodeOutput := make([]*keystonetypes.WrappedNodeOutput, 0, len(input.CapabilitiesAwareNodeSets))
-	for _, nodeSetInput := range input.CapabilitiesAwareNodeSets {
-		nodeset, nodesetErr := ns.NewSharedDBNodeSet(nodeSetInput.Input, blockchainsOutput.BlockchainOutput)
-		if nodesetErr != nil {
-			return nil, pkgerrors.Wrapf(nodesetErr, "failed to create node set named %s", nodeSetInput.Name)
-		}
-
-		nodeOutput = append(nodeOutput, &keystonetypes.WrappedNodeOutput{
-			Output:       nodeset,
-			NodeSetName:  nodeSetInput.Name,
-			Capabilities: nodeSetInput.Capabilities,
-		})
+	jdOutput, jdErr := deployer.DeployJd(&input.JdInput)
+	if jdErr != nil {
+		return nil, pkgerrors.Wrap(jdErr, "failed to deploy JD")
 	}
 
 	// Prepare the CLD environment that's required by the keystone changeset
@@ -442,14 +455,14 @@ func SetupTestEnvironment(
 		BlockchainOutput:                    blockchainsOutput,
 		DonTopology:                         fullCldOutput.DonTopology,
 		NodeOutput:                          nodeOutput,
+		ObservabilityOutput:                 observabilityOutput,
 		CldEnvironment:                      fullCldOutput.Environment,
 	}, nil
 }
 
 type BlockchainsInput struct {
 	blockchainInput *blockchain.Input
-	infraInput      *libtypes.InfraInput
-	nixShell        *libnix.Shell
+	deployer        keystonetypes.Deployer
 }
 
 type BlockchainOutput struct {
@@ -472,28 +485,14 @@ func CreateBlockchains(
 		return nil, pkgerrors.New("blockchain input is nil")
 	}
 
-	if input.infraInput.InfraType == libtypes.CRIB {
-		if input.nixShell == nil {
-			return nil, pkgerrors.New("nix shell is nil")
-		}
-
-		deployCribBlockchainInput := &keystonetypes.DeployCribBlockchainInput{
-			BlockchainInput: input.blockchainInput,
-			NixShell:        input.nixShell,
-			CribConfigsDir:  cribConfigsDir,
-		}
-
-		var blockchainErr error
-		input.blockchainInput.Out, blockchainErr = crib.DeployBlockchain(deployCribBlockchainInput)
-		if blockchainErr != nil {
-			return nil, pkgerrors.Wrap(blockchainErr, "failed to deploy blockchain")
-		}
+	if input.deployer == nil {
+		return nil, pkgerrors.New("deployer is nil")
 	}
 
 	// Create a new blockchain network and Seth client to interact with it
-	blockchainOutput, err := blockchain.NewBlockchainNetwork(input.blockchainInput)
+	blockchainOutput, err := input.deployer.DeployBlockchain(input.blockchainInput)
 	if err != nil {
-		return nil, pkgerrors.Wrap(err, "failed to create blockchain network")
+		return nil, pkgerrors.Wrap(err, "failed to deploy blockchain")
 	}
 
 	pkey := os.Getenv("PRIVATE_KEY")
@@ -531,19 +530,38 @@ func CreateBlockchains(
 	}, nil
 }
 
-func CreateJobDistributor(input *jd.Input) (*jd.Output, error) {
-	if os.Getenv("CI") == "true" {
-		jdImage := ctfconfig.MustReadEnvVar_String(E2eJobDistributorImageEnvVarName)
-		jdVersion := os.Getenv(E2eJobDistributorVersionEnvVarName)
-		input.Image = fmt.Sprintf("%s:%s", jdImage, jdVersion)
+func cribRetryPolicy(cribInput *libtypes.CRIBInput) (*keystonetypes.CribRetryPolicy, error) {
+	if cribInput == nil || cribInput.Retry == nil {
+		return nil, nil
 	}
 
-	jdOutput, err := jd.NewJD(input)
-	if err != nil {
-		return nil, pkgerrors.Wrap(err, "failed to create new job distributor")
+	initialBackoff, parseErr := time.ParseDuration(cribInput.Retry.InitialBackoff)
+	if parseErr != nil {
+		return nil, pkgerrors.Wrapf(parseErr, "failed to parse CRIB retry initial backoff %s", cribInput.Retry.InitialBackoff)
+	}
+	maxBackoff, parseErr := time.ParseDuration(cribInput.Retry.MaxBackoff)
+	if parseErr != nil {
+		return nil, pkgerrors.Wrapf(parseErr, "failed to parse CRIB retry max backoff %s", cribInput.Retry.MaxBackoff)
+	}
+
+	return &keystonetypes.CribRetryPolicy{
+		MaxAttempts:    cribInput.Retry.MaxAttempts,
+		InitialBackoff: initialBackoff,
+		MaxBackoff:     maxBackoff,
+	}, nil
+}
+
+func cribServiceReadyTimeout(cribInput *libtypes.CRIBInput) (time.Duration, error) {
+	if cribInput == nil || cribInput.ServiceReadyTimeout == "" {
+		return 0, nil
+	}
+
+	serviceReadyTimeout, parseErr := time.ParseDuration(cribInput.ServiceReadyTimeout)
+	if parseErr != nil {
+		return 0, pkgerrors.Wrapf(parseErr, "failed to parse CRIB service ready timeout %s", cribInput.ServiceReadyTimeout)
 	}
 
-	return jdOutput, nil
+	return serviceReadyTimeout, nil
 }
 
 func mergeJobSpecSlices(from, to keystonetypes.DonsToJobSpecs) {
@@ -1,31 +1,78 @@
 package infra
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+
 	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/blockchain"
 	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/clnode"
 	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/jd"
+	"github.com/smartcontractkit/chainlink-testing-framework/framework/components/postgres"
 	ns "github.com/smartcontractkit/chainlink-testing-framework/framework/components/simple_node_set"
 	libnode "github.com/smartcontractkit/chainlink/system-tests/lib/cre/don/node"
 	cretypes "github.com/smartcontractkit/chainlink/system-tests/lib/cre/types"
 	"github.com/smartcontractkit/chainlink/system-tests/lib/types"
 )
 
-func ReadBlockchainURL(cribConfigsDir, chainType, chainID string) (*blockchain.Output, error) {
-	fileName := filepath.Join(cribConfigsDir, fmt.Sprintf("chain-%s-urls.json", chainID))
+// BlockchainURLFile is the file with the URLs of the blockchain, written by devspace after deploying it
+func BlockchainURLFile(cribConfigsDir, chainID string) string {
+	return filepath.Join(cribConfigsDir, fmt.Sprintf("chain-%s-urls.json", chainID))
+}
+
+// JdURLFile is the file with the URLs of the Job Distributor, written by devspace after deploying it
+func JdURLFile(cribConfigsDir string) string {
+	return filepath.Join(cribConfigsDir, "jd-urls.json")
+}
+
+// NodeSetURLFile is the file with the URLs of the nodes of the DON, written by devspace after deploying it
+func NodeSetURLFile(cribConfigsDir, donName string) string {
+	return filepath.Join(cribConfigsDir, fmt.Sprintf("don-%s-urls.json", donName))
+}
+
+// ReadBlockchainURL reads the URLs of the chain and waits for its RPC endpoints to respond, zero ready timeout
+// disables waiting
+func ReadBlockchainURL(cribConfigsDir, chainType, chainID string, readyTimeout time.Duration) (*blockchain.Output, error) {
+	fileName := BlockchainURLFile(cribConfigsDir, chainID)
 	chainURLs := types.ChainURLs{}
-	err := readAndUnmarshalJSON(fileName, &chainURLs)
+	err := readURLFile(fileName, &chainURLs)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to read and unmarshal chain URLs JSON")
 	}
 
+	// solana-test-validator serves websockets on the port following the RPC port, CRIB might not expose them separately
+	if chainType == chainselectors.FamilySolana {
+		if chainURLs.WSExternalURL == "" {
+			chainURLs.WSExternalURL, err = solanaWSURL(chainURLs.HTTPExternalURL)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to derive external websocket URL of solana chain")
+			}
+		}
+		if chainURLs.WSInternalURL == "" {
+			chainURLs.WSInternalURL, err = solanaWSURL(chainURLs.HTTPInternalURL)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to derive internal websocket URL of solana chain")
+			}
+		}
+	}
+
+	if readyTimeout > 0 {
+		if readyErr := waitForEndpoints("chain "+chainID, readyTimeout, chainProbes(chainType, chainURLs.HTTPExternalURL, chainURLs.WSExternalURL)); readyErr != nil {
+			return nil, readyErr
+		}
+	}
+
 	out := &blockchain.Output{}
 	out.UseCache = true
 	out.ChainID = chainID
@@ -42,15 +89,55 @@ func ReadBlockchainURL(cribConfigsDir, chainType, chainID string) (*blockchain.O
 	return out, nil
 }
 
-func ReadJdURL(cribConfigsDir string) (*jd.Output, error) {
-	fileName := filepath.Join(cribConfigsDir, "jd-urls.json")
+// solanaWSURL derives the websocket URL of a solana-test-validator from its RPC URL, the websocket port is the RPC
+// port + 1. URLs without an explicit port are served by an ingress, which routes websockets on the same host.
+func solanaWSURL(httpURL string) (string, error) {
+	if httpURL == "" {
+		return "", errors.New("http URL is empty")
+	}
+
+	u, err := url.Parse(httpURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse URL %s", httpURL)
+	}
+
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	default:
+		return "", fmt.Errorf("unsupported scheme %s of URL %s", u.Scheme, httpURL)
+	}
+
+	if port := u.Port(); port != "" {
+		portNum, convErr := strconv.Atoi(port)
+		if convErr != nil {
+			return "", errors.Wrapf(convErr, "failed to parse port of URL %s", httpURL)
+		}
+		u.Host = net.JoinHostPort(u.Hostname(), strconv.Itoa(portNum+1))
+	}
+
+	return u.String(), nil
+}
+
+// ReadJdURL reads the URLs of JD and waits for its gRPC endpoint to accept connections, zero ready timeout disables
+// waiting
+func ReadJdURL(cribConfigsDir string, readyTimeout time.Duration) (*jd.Output, error) {
+	fileName := JdURLFile(cribConfigsDir)
 
 	jdURLs := types.JdURLs{}
-	err := readAndUnmarshalJSON(fileName, &jdURLs)
+	err := readURLFile(fileName, &jdURLs)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to read and unmarshal JD URLs JSON")
 	}
 
+	if readyTimeout > 0 {
+		if readyErr := waitForEndpoints("JD", readyTimeout, []endpointProbe{{endpoint: jdURLs.GRPCExternalURL, check: checkGRPC}}); readyErr != nil {
+			return nil, readyErr
+		}
+	}
+
 	out := &jd.Output{}
 	out.UseCache = true
 	out.ExternalGRPCUrl = jdURLs.GRPCExternalURL
@@ -61,11 +148,51 @@ func ReadJdURL(cribConfigsDir string) (*jd.Output, error) {
 	return out, nil
 }
 
+// JdDatabaseURLFile is the file with the URLs of the database of JD, written by devspace after deploying it
+func JdDatabaseURLFile(cribConfigsDir string) string {
+	return filepath.Join(cribConfigsDir, "jd-db-urls.json")
+}
+
+func ReadJdDatabaseURL(cribConfigsDir string) (*postgres.Output, error) {
+	jdDatabaseURLs := types.JdDatabaseURLs{}
+	err := readURLFile(JdDatabaseURLFile(cribConfigsDir), &jdDatabaseURLs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read and unmarshal JD database URLs JSON")
+	}
+
+	return &postgres.Output{
+		ContainerName: jdDatabaseURLs.ServiceName,
+		JDUrl:         jdDatabaseURLs.ExternalURL,
+		JDInternalURL: jdDatabaseURLs.InternalURL,
+	}, nil
+}
+
+// ObservabilityURLFile is the file with the URLs of the observability stack, written by devspace after deploying it
+func ObservabilityURLFile(cribConfigsDir string) string {
+	return filepath.Join(cribConfigsDir, "observability-urls.json")
+}
+
+func ReadObservabilityURL(cribConfigsDir string) (*cretypes.ObservabilityOutput, error) {
+	observabilityURLs := types.ObservabilityURLs{}
+	err := readURLFile(ObservabilityURLFile(cribConfigsDir), &observabilityURLs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read and unmarshal observability URLs JSON")
+	}
+
+	return &cretypes.ObservabilityOutput{
+		GrafanaURL:            observabilityURLs.GrafanaExternalURL,
+		LokiURL:               observabilityURLs.LokiExternalURL,
+		LokiInternalURL:       observabilityURLs.LokiInternalURL,
+		PrometheusURL:         observabilityURLs.PrometheusExternalURL,
+		PrometheusInternalURL: observabilityURLs.PrometheusInternalURL,
+	}, nil
+}
+
 func ReadNodeSetURL(cribConfigsDir string, donMetadata *cretypes.DonMetadata) (*ns.Output, error) {
 	// read DON URLs
-	donFileName := filepath.Join(cribConfigsDir, fmt.Sprintf("don-%s-urls.json", donMetadata.Name))
+	donFileName := NodeSetURLFile(cribConfigsDir, donMetadata.Name)
 	donURLs := types.DonURLs{}
-	err := readAndUnmarshalJSON(donFileName, &donURLs)
+	err := readURLFile(donFileName, &donURLs)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to read and unmarshal don URLs JSON")
 	}
@@ -74,7 +201,7 @@ func ReadNodeSetURL(cribConfigsDir string, donMetadata *cretypes.DonMetadata) (*
 	credsFileName := filepath.Join(".", "crib-configs", "don-api-credentials.json")
 
 	apiCredentials := types.DonAPICredentials{}
-	err = readAndUnmarshalJSON(credsFileName, &apiCredentials)
+	err = readURLFile(credsFileName, &apiCredentials)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to read and unmarshal don API credentials JSON")
 	}
@@ -132,7 +259,20 @@ func ReadNodeSetURL(cribConfigsDir string, donMetadata *cretypes.DonMetadata) (*
 	return out, nil
 }
 
-func readAndUnmarshalJSON[Type any](fileName string, target *Type) error {
+// urlFileMigrations upgrade URL files from the schema version they are keyed by to the next version. Version 1 only
+// added schema_version to the unversioned format.
+var urlFileMigrations = map[int]func(fields map[string]json.RawMessage) error{
+	0: func(map[string]json.RawMessage) error { return nil },
+}
+
+// urlFile is implemented by all structs of URL files
+type urlFile interface {
+	Validate() error
+}
+
+// readURLFile reads the URL file into the target, migrating files of older schema versions. Unknown fields, newer
+// schema versions and invalid URLs are rejected.
+func readURLFile(fileName string, target urlFile) error {
 	file, err := os.Open(fileName)
 	if err != nil {
 		return errors.Wrapf(err, "failed to open file %s", fileName)
@@ -144,10 +284,44 @@ func readAndUnmarshalJSON[Type any](fileName string, target *Type) error {
 		return errors.Wrapf(err, "failed to read file %s", fileName)
 	}
 
-	err = json.Unmarshal(byteValue, target)
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(byteValue, &fields)
 	if err != nil {
 		return errors.Wrapf(err, "failed to unmarshal JSON from file %s", fileName)
 	}
 
+	schemaVersion := 0
+	if rawVersion, ok := fields["schema_version"]; ok {
+		if versionErr := json.Unmarshal(rawVersion, &schemaVersion); versionErr != nil {
+			return errors.Wrapf(versionErr, "failed to parse schema_version of file %s", fileName)
+		}
+	}
+	if schemaVersion < 0 || schemaVersion > types.URLSchemaVersion {
+		return errors.Errorf("file %s has schema version %d, but only versions up to %d are supported, update the system tests to match CRIB", fileName, schemaVersion, types.URLSchemaVersion)
+	}
+
+	for version := schemaVersion; version < types.URLSchemaVersion; version++ {
+		if migrateErr := urlFileMigrations[version](fields); migrateErr != nil {
+			return errors.Wrapf(migrateErr, "failed to migrate file %s from schema version %d", fileName, version)
+		}
+	}
+	fields["schema_version"] = json.RawMessage(strconv.Itoa(types.URLSchemaVersion))
+
+	migrated, err := json.Marshal(fields)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal migrated file %s", fileName)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(migrated))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(target)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal JSON from file %s", fileName)
+	}
+
+	if valErr := target.Validate(); valErr != nil {
+		return errors.Wrapf(valErr, "file %s is invalid", fileName)
+	}
+
 	return nil
 }
@@ -0,0 +1,195 @@
+package infra
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+
+	chainselectors "github.com/smartcontractkit/chain-selectors"
+)
+
+const (
+	readinessPollInterval = 2 * time.Second
+	readinessProbeTimeout = 10 * time.Second
+)
+
+// endpointProbe checks once whether the endpoint serves requests
+type endpointProbe struct {
+	endpoint string
+	check    func(ctx context.Context, endpoint string) error
+}
+
+// waitForEndpoints polls the probes of the service until all of them succeed or the timeout expires. URL files are
+// written by devspace as soon as services are deployed, which doesn't mean they are already serving.
+func waitForEndpoints(service string, timeout time.Duration, probes []endpointProbe) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	notReady := map[string]error{}
+	for _, probe := range probes {
+		notReady[probe.endpoint] = errors.New("not polled yet")
+	}
+
+	ticker := time.NewTicker(readinessPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, probe := range probes {
+			if _, ok := notReady[probe.endpoint]; !ok {
+				continue
+			}
+			probeCtx, probeCancel := context.WithTimeout(ctx, readinessProbeTimeout)
+			err := probe.check(probeCtx, probe.endpoint)
+			probeCancel()
+			if err != nil {
+				notReady[probe.endpoint] = err
+				continue
+			}
+			delete(notReady, probe.endpoint)
+		}
+
+		if len(notReady) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			reasons := make([]string, 0, len(notReady))
+			for endpoint, err := range notReady {
+				reasons = append(reasons, fmt.Sprintf("%s: %s", endpoint, err))
+			}
+			slices.Sort(reasons)
+			return fmt.Errorf("%d endpoints of %s were not ready after %s: %s", len(notReady), service, timeout, strings.Join(reasons, "; "))
+		case <-ticker.C:
+		}
+	}
+}
+
+// chainProbes checks the RPC of the chain answers requests of its family and its websocket accepts connections
+func chainProbes(family, httpURL, wsURL string) []endpointProbe {
+	var checkHTTP func(ctx context.Context, endpoint string) error
+	switch family {
+	case chainselectors.FamilyEVM:
+		checkHTTP = func(ctx context.Context, endpoint string) error {
+			return checkJSONRPC(ctx, endpoint, "eth_blockNumber")
+		}
+	case chainselectors.FamilySolana:
+		checkHTTP = func(ctx context.Context, endpoint string) error {
+			return checkJSONRPC(ctx, endpoint, "getHealth")
+		}
+	default:
+		checkHTTP = checkHTTPServing
+	}
+
+	probes := []endpointProbe{{endpoint: httpURL, check: checkHTTP}}
+	if wsURL != "" {
+		probes = append(probes, endpointProbe{endpoint: wsURL, check: checkWebsocket})
+	}
+
+	return probes
+}
+
+func checkJSONRPC(ctx context.Context, endpoint, method string) error {
+	body, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": []any{}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", method, resp.StatusCode)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	rpcResp := struct {
+		Result json.RawMessage `json:"result"`
+		Error  json.RawMessage `json:"error"`
+	}{}
+	if jsonErr := json.Unmarshal(respBody, &rpcResp); jsonErr != nil {
+		return errors.Wrapf(jsonErr, "%s returned invalid JSON-RPC response", method)
+	}
+	if len(rpcResp.Error) > 0 && string(rpcResp.Error) != "null" {
+		return fmt.Errorf("%s returned error %s", method, rpcResp.Error)
+	}
+
+	return nil
+}
+
+// checkHTTPServing only checks the endpoint answers HTTP requests without a server error, e.g. for chains without
+// JSON-RPC
+func checkHTTPServing(ctx context.Context, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func checkWebsocket(ctx context.Context, endpoint string) error {
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, endpoint, nil)
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// checkGRPC checks a gRPC connection to the target (host:port) can be established
+func checkGRPC(ctx context.Context, target string) error {
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection is %s", state)
+		}
+	}
+}
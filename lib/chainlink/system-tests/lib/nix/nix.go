@@ -2,11 +2,14 @@ package nix
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
-	"strings"
+	"slices"
 	"sync"
+	"time"
 )
 
 // Shell is a wrapper around a nix shell process. It allows to run commands
@@ -17,9 +20,23 @@ type Shell struct {
 	stdin  *bufio.Writer
 	stdout *bufio.Reader
 	mu     sync.Mutex
+
+	defaultTimeout time.Duration
+	output         io.Writer
+	// abandonedErr is set when a command was abandoned before it finished, its output can't be told apart from the
+	// output of subsequent commands
+	abandonedErr error
+	// secrets are the values of all secret environment variables passed to commands, they are redacted from outputs
+	secrets []string
+	// sessionKey is set for shells acquired with AcquireNixShell, which are kept warm after they are released
+	sessionKey string
 }
 
-const ErrCommandFailed = "command failed with exit code"
+const (
+	ErrCommandFailed = "command failed with exit code"
+
+	endMarker = "END_OF_COMMAND_OUTPUT"
+)
 
 func NewNixShell(folder string, globalEnvVars map[string]string) (*Shell, error) {
 	cmd := exec.Command("nix", "develop", "--command", "sh")
@@ -33,6 +50,32 @@ func NewNixShell(folder string, globalEnvVars map[string]string) (*Shell, error)
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, value))
 	}
 
+	return startNixShell(cmd)
+}
+
+// NewSiblingShell starts a new nix shell in the folder and with the global environment variables of the shell, using
+// the same command defaults and redacting the same secrets. Commands run concurrently with the commands of the shell,
+// environment variables exported by commands of the shell are not set in the sibling.
+func (ns *Shell) NewSiblingShell() (*Shell, error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	cmd := exec.Command("nix", "develop", "--command", "sh")
+	cmd.Dir = ns.cmd.Dir
+	cmd.Env = slices.Clone(ns.cmd.Env)
+
+	sibling, err := startNixShell(cmd)
+	if err != nil {
+		return nil, err
+	}
+	sibling.defaultTimeout = ns.defaultTimeout
+	sibling.output = ns.output
+	sibling.secrets = slices.Clone(ns.secrets)
+
+	return sibling, nil
+}
+
+func startNixShell(cmd *exec.Cmd) (*Shell, error) {
 	stdin, err := cmd.StdinPipe()
 	if err != nil {
 		return nil, err
@@ -57,59 +100,25 @@ func (ns *Shell) RunCommand(command string) (string, error) {
 	return ns.RunCommandWithEnvVars(command, map[string]string{})
 }
 
-func (ns *Shell) RunCommandWithEnvVars(command string, envVars map[string]string) (string, error) {
-	ns.mu.Lock()
-	defer ns.mu.Unlock()
-
-	// send stderr to stdout, append exit code to the end of the output and
-	// add end marker to signal the end of the command output
-	endMarker := "END_OF_COMMAND_OUTPUT"
-	fullCommand := fmt.Sprintf("%s 2>&1; echo %s $?\n", command, endMarker)
-
-	// Set command-specific environment variables
-	if len(envVars) > 0 {
-		fmt.Println("Setting the following command-specific environment variables:")
-	}
+// RunCommandWithEnvVars runs the command with the environment variables. The ones listed in secretEnvVars are secrets,
+// which are used literally, never logged and only set for the command, see Command.SecretEnvVars.
+func (ns *Shell) RunCommandWithEnvVars(command string, envVars map[string]string, secretEnvVars ...string) (string, error) {
+	plainEnvVars := map[string]string{}
+	secrets := map[string]string{}
 	for key, value := range envVars {
-		fmt.Printf("%s=%s\n", key, value)
-		_, err := ns.stdin.WriteString(fmt.Sprintf("export %s=%s\n", key, value))
-		if err != nil {
-			return "", err
+		if slices.Contains(secretEnvVars, key) {
+			secrets[key] = value
+		} else {
+			plainEnvVars[key] = value
 		}
 	}
 
-	_, err := ns.stdin.WriteString(fullCommand)
-	if err != nil {
+	result, err := ns.Run(context.Background(), Command{Command: command, EnvVars: plainEnvVars, SecretEnvVars: secrets})
+	if result == nil {
 		return "", err
 	}
-	if err := ns.stdin.Flush(); err != nil {
-		return "", err
-	}
-
-	// read output until the end marker is found
-	var output strings.Builder
-	var exitCode int
-	for {
-		line, err := ns.stdout.ReadString('\n')
-		fmt.Print(line)
-		if err != nil {
-			return "", err
-		}
-		if strings.HasPrefix(line, endMarker) {
-			_, scanRrr := fmt.Sscanf(line, endMarker+" %d", &exitCode)
-			if scanRrr != nil {
-				exitCode = 1
-			}
-			break
-		}
-		output.WriteString(line)
-	}
-
-	if exitCode != 0 {
-		return output.String(), fmt.Errorf("%s %d", ErrCommandFailed, exitCode)
-	}
 
-	return strings.TrimSpace(output.String()), nil
+	return result.Output, err
 }
 
 func (ns *Shell) Close() error {
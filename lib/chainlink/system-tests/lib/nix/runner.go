@@ -0,0 +1,246 @@
+package nix
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// exit code of the coreutils timeout command, when the command timed out
+	timeoutExitCode = 124
+	// how long a timed out command gets to exit after SIGTERM, before it's killed
+	timeoutKillAfter = 10 * time.Second
+	// number of trailing output lines included in command errors
+	errorOutputLines = 20
+	// replaces values of secret environment variables in logs and outputs
+	redacted = "[REDACTED]"
+)
+
+// CommandRunner runs commands in a shell, which preserves its environment between commands.
+type CommandRunner interface {
+	Run(ctx context.Context, command Command) (*CommandResult, error)
+}
+
+var _ CommandRunner = (*Shell)(nil)
+
+type Command struct {
+	Command string
+	// EnvVars are exported before running the command, they stay set for subsequent commands. Values are used as
+	// written, so they have to be quoted, if they contain characters special to the shell.
+	EnvVars map[string]string
+	// SecretEnvVars are exported with their literal values only for the command. Their values are never logged and
+	// are redacted from the output of this and all subsequent commands.
+	SecretEnvVars map[string]string
+	// Timeout kills the command if it doesn't finish in time, zero uses the default timeout of the shell.
+	// Commands with a timeout run in a subshell, so changes they make to the shell state (e.g. cd) are not kept.
+	Timeout time.Duration
+	// Output receives the combined stdout and stderr of the command line by line, while it runs. Defaults to the output
+	// of the shell.
+	Output io.Writer
+}
+
+type CommandResult struct {
+	Command string
+	// Output is the combined stdout and stderr of the command
+	Output   string
+	ExitCode int
+	Duration time.Duration
+}
+
+// CommandError is returned for commands exiting with a non-zero exit code, including the ones that timed out.
+type CommandError struct {
+	Result   *CommandResult
+	TimedOut bool
+}
+
+func (e *CommandError) Error() string {
+	reason := fmt.Sprintf("%s %d", ErrCommandFailed, e.Result.ExitCode)
+	if e.TimedOut {
+		reason = fmt.Sprintf("command timed out after %s (%s)", e.Result.Duration.Round(time.Second), reason)
+	}
+
+	lines := strings.Split(strings.TrimSpace(e.Result.Output), "\n")
+	if len(lines) > errorOutputLines {
+		lines = lines[len(lines)-errorOutputLines:]
+	}
+
+	return fmt.Sprintf("%s: %s\nlast output lines:\n%s", reason, e.Result.Command, strings.Join(lines, "\n"))
+}
+
+// SetCommandDefaults sets the timeout and the output used by commands which don't set their own. Zero timeout disables
+// timeouts, nil output prints to stdout.
+func (ns *Shell) SetCommandDefaults(timeout time.Duration, output io.Writer) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	ns.defaultTimeout = timeout
+	ns.output = output
+}
+
+// Run runs the command, streaming its output while it runs. A command that is still running when ctx is done is
+// abandoned, the shell can't be used afterwards, because the output of the command would be mixed with the output
+// of subsequent commands. Use Command.Timeout to kill commands that take too long.
+func (ns *Shell) Run(ctx context.Context, command Command) (*CommandResult, error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.abandonedErr != nil {
+		return nil, fmt.Errorf("nix shell is unusable after an abandoned command: %w", ns.abandonedErr)
+	}
+
+	output := command.Output
+	if output == nil {
+		output = ns.output
+	}
+	if output == nil {
+		output = os.Stdout
+	}
+
+	timeout := command.Timeout
+	if timeout == 0 {
+		timeout = ns.defaultTimeout
+	}
+
+	for _, value := range command.SecretEnvVars {
+		if value != "" && !slices.Contains(ns.secrets, value) {
+			ns.secrets = append(ns.secrets, value)
+		}
+	}
+
+	// Set command-specific environment variables
+	for key, value := range command.EnvVars {
+		_, err := ns.stdin.WriteString(fmt.Sprintf("export %s=%s\n", key, value))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for key, value := range command.SecretEnvVars {
+		_, err := ns.stdin.WriteString(fmt.Sprintf("export %s=%s\n", key, shellQuote(value)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	fmt.Fprintf(output, "Running command: %s\n", ns.commandLine(command))
+
+	shellCommand := command.Command
+	if timeout > 0 {
+		shellCommand = fmt.Sprintf("timeout --kill-after=%ds %ds sh -c %s", seconds(timeoutKillAfter), seconds(timeout), shellQuote(command.Command))
+	}
+
+	// send stderr to stdout, append exit code to the end of the output and
+	// add end marker to signal the end of the command output
+	fullCommand := fmt.Sprintf("%s 2>&1; echo %s $?\n", shellCommand, endMarker)
+	if len(command.SecretEnvVars) > 0 {
+		// secrets don't outlive the command, unset runs after the end marker, so it doesn't change the exit code
+		fullCommand += fmt.Sprintf("unset %s\n", strings.Join(slices.Sorted(maps.Keys(command.SecretEnvVars)), " "))
+	}
+
+	_, err := ns.stdin.WriteString(fullCommand)
+	if err != nil {
+		return nil, err
+	}
+	if err := ns.stdin.Flush(); err != nil {
+		return nil, err
+	}
+
+	started := time.Now()
+	type readResult struct {
+		output   string
+		exitCode int
+		err      error
+	}
+	readDone := make(chan readResult, 1)
+	go func() {
+		commandOutput, exitCode, readErr := ns.readCommandOutput(output)
+		readDone <- readResult{output: commandOutput, exitCode: exitCode, err: readErr}
+	}()
+
+	var read readResult
+	select {
+	case read = <-readDone:
+	case <-ctx.Done():
+		ns.abandonedErr = fmt.Errorf("command %s: %w", ns.redact(command.Command), ctx.Err())
+		return nil, ns.abandonedErr
+	}
+	if read.err != nil {
+		return nil, read.err
+	}
+
+	result := &CommandResult{
+		Command:  ns.redact(command.Command),
+		Output:   strings.TrimSpace(read.output),
+		ExitCode: read.exitCode,
+		Duration: time.Since(started),
+	}
+
+	if result.ExitCode != 0 {
+		return result, &CommandError{
+			Result:   result,
+			TimedOut: timeout > 0 && result.ExitCode == timeoutExitCode,
+		}
+	}
+
+	return result, nil
+}
+
+// readCommandOutput reads output until the end marker is found, the exit code follows the end marker.
+func (ns *Shell) readCommandOutput(streamTo io.Writer) (string, int, error) {
+	var output strings.Builder
+	var exitCode int
+	for {
+		line, err := ns.stdout.ReadString('\n')
+		if err != nil {
+			return "", 0, err
+		}
+		if strings.HasPrefix(line, endMarker) {
+			_, scanErr := fmt.Sscanf(line, endMarker+" %d", &exitCode)
+			if scanErr != nil {
+				exitCode = 1
+			}
+			break
+		}
+		line = ns.redact(line)
+		_, _ = io.WriteString(streamTo, line)
+		output.WriteString(line)
+	}
+
+	return output.String(), exitCode, nil
+}
+
+// commandLine returns the command prefixed with its environment variables, as it's run by the shell, with values of
+// secrets redacted
+func (ns *Shell) commandLine(command Command) string {
+	assignments := make([]string, 0, len(command.EnvVars)+len(command.SecretEnvVars))
+	for _, key := range slices.Sorted(maps.Keys(command.EnvVars)) {
+		assignments = append(assignments, fmt.Sprintf("%s=%s", key, command.EnvVars[key]))
+	}
+	for _, key := range slices.Sorted(maps.Keys(command.SecretEnvVars)) {
+		assignments = append(assignments, fmt.Sprintf("%s=%s", key, redacted))
+	}
+
+	return ns.redact(strings.TrimSpace(strings.Join(append(assignments, command.Command), " ")))
+}
+
+// redact replaces the values of all secret environment variables seen by the shell so far
+func (ns *Shell) redact(s string) string {
+	for _, secret := range ns.secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+
+	return s
+}
+
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,138 @@
+package nix
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// sessionHealthCheckTimeout is how long an idle shell gets to prove it's still usable, before a new one is started
+const sessionHealthCheckTimeout = 10 * time.Second
+
+// sessions keeps released shells warm, keyed by their folder and global environment variables, so that subsequent
+// deployments don't pay the startup cost of nix develop (and crib init) again
+var sessions = &sessionCache{idle: map[string][]*Shell{}}
+
+type sessionCache struct {
+	mu   sync.Mutex
+	idle map[string][]*Shell
+}
+
+// AcquireNixShell returns an idle shell started in the folder with the same global environment variables, or starts a
+// new one, if there is none. Release the shell instead of closing it, so that it can be acquired again. Environment
+// variables exported by commands stay set in reused shells, the same as for subsequent commands in a single shell.
+func AcquireNixShell(folder string, globalEnvVars map[string]string) (shell *Shell, reused bool, err error) {
+	key, err := sessionKey(folder, globalEnvVars)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for {
+		shell = sessions.take(key)
+		if shell == nil {
+			break
+		}
+		if shell.healthy() {
+			return shell, true, nil
+		}
+		_ = shell.Close()
+	}
+
+	shell, err = NewNixShell(folder, globalEnvVars)
+	if err != nil {
+		return nil, false, err
+	}
+	shell.sessionKey = key
+
+	return shell, false, nil
+}
+
+// Release returns a shell acquired with AcquireNixShell to the idle shells, to be reused by the next AcquireNixShell.
+// Other shells and shells left unusable by an abandoned command are closed.
+func (ns *Shell) Release() error {
+	ns.mu.Lock()
+	key, abandoned := ns.sessionKey, ns.abandonedErr != nil
+	ns.mu.Unlock()
+
+	if key == "" || abandoned {
+		return ns.Close()
+	}
+
+	sessions.put(key, ns)
+
+	return nil
+}
+
+// CloseSessions closes all idle shells, call it when no more deployments will follow, e.g. at the end of TestMain
+func CloseSessions() error {
+	sessions.mu.Lock()
+	idle := sessions.idle
+	sessions.idle = map[string][]*Shell{}
+	sessions.mu.Unlock()
+
+	var errs []error
+	for _, shells := range idle {
+		for _, shell := range shells {
+			if err := shell.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *sessionCache) take(key string) *Shell {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	shells := c.idle[key]
+	if len(shells) == 0 {
+		return nil
+	}
+	shell := shells[len(shells)-1]
+	c.idle[key] = shells[:len(shells)-1]
+
+	return shell
+}
+
+func (c *sessionCache) put(key string, shell *Shell) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !slices.Contains(c.idle[key], shell) {
+		c.idle[key] = append(c.idle[key], shell)
+	}
+}
+
+// healthy reports whether the shell process is still alive and responds to commands
+func (ns *Shell) healthy() bool {
+	_, err := ns.Run(context.Background(), Command{Command: "true", Timeout: sessionHealthCheckTimeout, Output: io.Discard})
+
+	return err == nil
+}
+
+// sessionKey identifies shells, which can be used interchangeably, the folder is resolved, so that relative and
+// absolute paths of the same folder match
+func sessionKey(folder string, globalEnvVars map[string]string) (string, error) {
+	folderAbs, err := filepath.Abs(folder)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve folder %s: %w", folder, err)
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "folder %q\n", folderAbs)
+	for _, key := range slices.Sorted(maps.Keys(globalEnvVars)) {
+		fmt.Fprintf(hash, "env %q=%q\n", key, globalEnvVars[key])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
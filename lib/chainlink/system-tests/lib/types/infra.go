@@ -23,7 +23,67 @@ type CRIBInput struct {
 	Namespace string `toml:"namespace" validate:"required"`
 	// absolute path to the folder with CRIB CRE
 	FolderLocation string `toml:"folder_location" validate:"required"`
-	Provider       string `toml:"provider" validate:"oneof=aws kind"`
+	// aws, kind or any other provider registered with crib.RegisterProvider
+	Provider string `toml:"provider" validate:"required"`
 	// required for cost attribution in AWS
 	TeamInput *TeamInput `toml:"team_input" validate:"required_if=Provider aws"`
+	// purge DONs deployed so far, if deployment of any DON fails
+	RollbackOnFailure bool `toml:"rollback_on_failure"`
+	// how long to wait for the nodes to be ready after deploying DONs, e.g. "5m", empty disables waiting
+	ReadyTimeout string `toml:"ready_timeout"`
+	// how long to wait for the RPCs of each chain and for JD to respond after deploying them, e.g. "2m", empty
+	// disables waiting
+	ServiceReadyTimeout string `toml:"service_ready_timeout"`
+	// how long a single devspace or kubectl command may run before it is killed, e.g. "20m", empty disables the timeout
+	CommandTimeout string `toml:"command_timeout"`
+	// retry devspace commands failing with transient errors (e.g. ECR throttling), nil disables retries
+	Retry *CRIBRetryInput `toml:"retry"`
+	// how capability binaries are copied to the pods, "per_binary" (default) or "archive", which copies a single archive
+	// per pod and skips binaries already present in the pod
+	CapabilitiesCopyMode string `toml:"capabilities_copy_mode" validate:"omitempty,oneof=per_binary archive"`
+	// recorded on the namespace, defaults to $USER
+	NamespaceOwner string `toml:"namespace_owner"`
+	// after which the namespace can be purged by other environments, e.g. "24h", empty means it never expires
+	NamespaceTTL string `toml:"namespace_ttl"`
+	// purge expired namespaces of the cluster before deploying
+	PurgeExpiredNamespaces bool `toml:"purge_expired_namespaces"`
+	// directory receiving a bundle of the generated configs (secrets redacted), env vars and devspace outputs of
+	// each DON deployment, for reproducing CI failures locally; empty disables bundling
+	ArtifactsDir string `toml:"artifacts_dir"`
+	// how secrets overrides are delivered to the nodes, "file" (default) writes them to the configs dir,
+	// "kubernetes_secret" creates Kubernetes Secrets mounted into the pods
+	SecretsDelivery string `toml:"secrets_delivery" validate:"omitempty,oneof=file kubernetes_secret"`
+	// check tool versions, cluster access, ECR login and namespace quotas before deploying anything
+	Preflight bool `toml:"preflight"`
+	// minimum versions of kubectl, devspace and nix, e.g. { kubectl = "1.28.0" }
+	MinToolVersions map[string]string `toml:"min_tool_versions"`
+	// deploy Prometheus, Loki and Grafana to the namespace and scrape the nodes
+	Observability bool `toml:"observability"`
+	// resources, node selectors and tolerations of the pods of node sets, keyed by node set name
+	NodeSetResources map[string]*CribNodeSetResources `toml:"node_set_resources"`
+	// keep the namespace and skip DONs, which haven't changed since they were last deployed, for faster iterations
+	SkipUnchangedDons bool `toml:"skip_unchanged_dons"`
+	// keep the nix shell warm after the environment is set up and reuse it for the next environment with the same
+	// CRIB folder and env vars, e.g. in the same test binary
+	ReuseNixShell bool `toml:"reuse_nix_shell"`
+	// database of JD, nil assumes it already exists in the namespace
+	JdDatabase *CRIBJdDatabaseInput `toml:"jd_database"`
+}
+
+type CRIBJdDatabaseInput struct {
+	// deploy Postgres for JD to the namespace, using the image of [jd.db] if set
+	Deploy bool `toml:"deploy"`
+	// URI of an existing Postgres database reachable from the cluster, mutually exclusive with deploy
+	ExternalURL string `toml:"external_url"`
+	// start JD without running its migrations
+	SkipMigrations bool `toml:"skip_migrations"`
+}
+
+type CRIBRetryInput struct {
+	// includes the first attempt
+	MaxAttempts int `toml:"max_attempts" validate:"min=1"`
+	// e.g. "10s", doubles after every failed attempt
+	InitialBackoff string `toml:"initial_backoff" validate:"required"`
+	// e.g. "2m"
+	MaxBackoff string `toml:"max_backoff" validate:"required"`
 }
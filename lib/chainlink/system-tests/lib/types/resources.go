@@ -0,0 +1,122 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// CribNodeSetResources are the Kubernetes resources and scheduling constraints of the pods of a node set in CRIB,
+// e.g. to pin DONs of load tests to appropriately sized node pools. Empty values use the defaults of CRIB.
+type CribNodeSetResources struct {
+	// CPU and memory are Kubernetes quantities, e.g. "500m" or "2Gi"
+	CPURequest    string `toml:"cpu_request"`
+	CPULimit      string `toml:"cpu_limit"`
+	MemoryRequest string `toml:"memory_request"`
+	MemoryLimit   string `toml:"memory_limit"`
+	// NodeSelector labels and their values, label names and values can't contain "," or "="
+	NodeSelector map[string]string `toml:"node_selector"`
+	Tolerations  []CribToleration  `toml:"tolerations"`
+}
+
+type CribToleration struct {
+	Key string `toml:"key"`
+	// Operator is "Equal" (default) or "Exists"
+	Operator string `toml:"operator"`
+	Value    string `toml:"value"`
+	// Effect is "NoSchedule", "PreferNoSchedule", "NoExecute" or empty, which matches all of them
+	Effect string `toml:"effect"`
+}
+
+func (r *CribNodeSetResources) Validate() error {
+	if err := validateRequestAndLimit("cpu", r.CPURequest, r.CPULimit); err != nil {
+		return err
+	}
+	if err := validateRequestAndLimit("memory", r.MemoryRequest, r.MemoryLimit); err != nil {
+		return err
+	}
+	for label, value := range r.NodeSelector {
+		if label == "" || strings.ContainsAny(label, ",=") || strings.ContainsAny(value, ",=") {
+			return fmt.Errorf("invalid node selector %s=%s", label, value)
+		}
+	}
+	for _, toleration := range r.Tolerations {
+		if !slices.Contains([]string{"", "Equal", "Exists"}, toleration.Operator) {
+			return fmt.Errorf("invalid toleration operator %s", toleration.Operator)
+		}
+		if !slices.Contains([]string{"", "NoSchedule", "PreferNoSchedule", "NoExecute"}, toleration.Effect) {
+			return fmt.Errorf("invalid toleration effect %s", toleration.Effect)
+		}
+		if toleration.Operator == "Exists" && toleration.Value != "" {
+			return fmt.Errorf("toleration of %s with operator Exists can't have a value", toleration.Key)
+		}
+		if strings.ContainsAny(toleration.Key+toleration.Value, ",=:") {
+			return fmt.Errorf("toleration key and value can't contain \",\", \"=\" or \":\", got %s=%s", toleration.Key, toleration.Value)
+		}
+	}
+	return nil
+}
+
+// EnvVars returns the devspace vars of the resources, node selectors and tolerations are comma-separated lists of
+// label=value and key[=value][:effect] items, the same format as used by kubectl
+func (r *CribNodeSetResources) EnvVars() map[string]string {
+	envVars := map[string]string{}
+	for name, value := range map[string]string{
+		"NODE_CPU_REQUEST":    r.CPURequest,
+		"NODE_CPU_LIMIT":      r.CPULimit,
+		"NODE_MEMORY_REQUEST": r.MemoryRequest,
+		"NODE_MEMORY_LIMIT":   r.MemoryLimit,
+	} {
+		if value != "" {
+			envVars[name] = value
+		}
+	}
+
+	if len(r.NodeSelector) > 0 {
+		selectors := make([]string, 0, len(r.NodeSelector))
+		for label, value := range r.NodeSelector {
+			selectors = append(selectors, label+"="+value)
+		}
+		slices.Sort(selectors)
+		envVars["NODE_SELECTOR"] = strings.Join(selectors, ",")
+	}
+
+	if len(r.Tolerations) > 0 {
+		tolerations := make([]string, 0, len(r.Tolerations))
+		for _, toleration := range r.Tolerations {
+			item := toleration.Key
+			if toleration.Operator != "Exists" {
+				item += "=" + toleration.Value
+			}
+			if toleration.Effect != "" {
+				item += ":" + toleration.Effect
+			}
+			tolerations = append(tolerations, item)
+		}
+		envVars["NODE_TOLERATIONS"] = strings.Join(tolerations, ",")
+	}
+
+	return envVars
+}
+
+func validateRequestAndLimit(name, request, limit string) error {
+	var requestQuantity, limitQuantity resource.Quantity
+	var err error
+	if request != "" {
+		if requestQuantity, err = resource.ParseQuantity(request); err != nil {
+			return fmt.Errorf("invalid %s request %s: %w", name, request, err)
+		}
+	}
+	if limit != "" {
+		if limitQuantity, err = resource.ParseQuantity(limit); err != nil {
+			return fmt.Errorf("invalid %s limit %s: %w", name, limit, err)
+		}
+	}
+	if request != "" && limit != "" && requestQuantity.Cmp(limitQuantity) > 0 {
+		return errors.New(name + " request " + request + " is greater than limit " + limit)
+	}
+	return nil
+}
@@ -1,14 +1,56 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+)
+
+// URLSchemaVersion is the version of the schema of the URL files written by CRIB, which this module reads. Files
+// written by older CRIB versions are migrated when read, files written by newer ones are rejected, so that changes
+// on either side are never silently misread. Files without a schema_version are version 0, the unversioned format.
+const URLSchemaVersion = 1
+
+// Versioned is embedded by all structs of URL files
+type Versioned struct {
+	SchemaVersion int `json:"schema_version"`
+}
+
 // all structs are copies of identical structs in ${CRIB_REPO}/dependencies/donut/scripts/urls/main.go
 // in the future we should move these types to a dedicated module that would be imported both by CRIB and this module
 type JdURLs struct {
+	Versioned
 	GRPCExternalURL string `json:"grpc_host_url"`
 	GRCPInternalURL string `json:"grpc_internal_url"`
 	WSExternalURL   string `json:"ws_host_url"`
 	WSInternalURL   string `json:"ws_internal_url"`
 }
 
+func (j *JdURLs) Validate() error {
+	return validateURLs(map[string]string{
+		"grpc_host_url":     j.GRPCExternalURL,
+		"grpc_internal_url": j.GRCPInternalURL,
+		"ws_host_url":       j.WSExternalURL,
+		"ws_internal_url":   j.WSInternalURL,
+	})
+}
+
+type JdDatabaseURLs struct {
+	Versioned
+	ServiceName string `json:"service_name"`
+	ExternalURL string `json:"host_url"`
+	InternalURL string `json:"internal_url"`
+}
+
+func (j *JdDatabaseURLs) Validate() error {
+	return validateURLs(map[string]string{
+		"host_url":     j.ExternalURL,
+		"internal_url": j.InternalURL,
+	})
+}
+
 type DonURL struct {
 	ExternalURL    string `json:"host_url"`
 	InternalURL    string `json:"internal_url"`
@@ -17,18 +59,88 @@ type DonURL struct {
 }
 
 type DonURLs struct {
+	Versioned
 	BootstrapNodes []DonURL `json:"bootstrap_nodes"`
 	WorkerNodes    []DonURL `json:"worker_nodes"`
 }
 
+func (d *DonURLs) Validate() error {
+	if len(d.BootstrapNodes) == 0 && len(d.WorkerNodes) == 0 {
+		return errors.New("no nodes")
+	}
+	for nodeType, nodes := range map[string][]DonURL{"bootstrap": d.BootstrapNodes, "worker": d.WorkerNodes} {
+		for i, node := range nodes {
+			err := validateURLs(map[string]string{
+				"host_url":     node.ExternalURL,
+				"internal_url": node.InternalURL,
+			})
+			if err != nil {
+				return fmt.Errorf("%s node %d: %w", nodeType, i, err)
+			}
+		}
+	}
+	return nil
+}
+
 type DonAPICredentials struct {
+	Versioned
 	Username string `json:"username"`
 	Password string `json:"password"`
 }
 
+func (d *DonAPICredentials) Validate() error {
+	if d.Username == "" || d.Password == "" {
+		return errors.New("username and password have to be set")
+	}
+	return nil
+}
+
 type ChainURLs struct {
+	Versioned
 	HTTPExternalURL string `json:"http_host_url"`
 	WSExternalURL   string `json:"ws_host_url"`
 	HTTPInternalURL string `json:"http_internal_url"`
 	WSInternalURL   string `json:"ws_internal_url"`
 }
+
+// Validate doesn't require websocket URLs, not all chain types expose them
+func (c *ChainURLs) Validate() error {
+	return validateURLs(map[string]string{
+		"http_host_url":     c.HTTPExternalURL,
+		"http_internal_url": c.HTTPInternalURL,
+	})
+}
+
+type ObservabilityURLs struct {
+	Versioned
+	GrafanaExternalURL    string `json:"grafana_host_url"`
+	LokiExternalURL       string `json:"loki_host_url"`
+	LokiInternalURL       string `json:"loki_internal_url"`
+	PrometheusExternalURL string `json:"prometheus_host_url"`
+	PrometheusInternalURL string `json:"prometheus_internal_url"`
+}
+
+func (o *ObservabilityURLs) Validate() error {
+	return validateURLs(map[string]string{
+		"grafana_host_url":        o.GrafanaExternalURL,
+		"loki_host_url":           o.LokiExternalURL,
+		"loki_internal_url":       o.LokiInternalURL,
+		"prometheus_host_url":     o.PrometheusExternalURL,
+		"prometheus_internal_url": o.PrometheusInternalURL,
+	})
+}
+
+// validateURLs checks that the URLs keyed by their JSON field are set and parse, internal URLs might be addresses
+// without a scheme (jd:42242)
+func validateURLs(urls map[string]string) error {
+	for _, field := range slices.Sorted(maps.Keys(urls)) {
+		value := urls[field]
+		if value == "" {
+			return fmt.Errorf("%s is not set", field)
+		}
+		if _, err := url.Parse(value); err != nil {
+			return fmt.Errorf("%s is not a valid URL: %w", field, err)
+		}
+	}
+	return nil
+}